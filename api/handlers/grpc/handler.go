@@ -23,6 +23,15 @@ type H struct {
 	config.UserConfig `yaml:",inline"`
 	config.Service    `yaml:",inline"`
 	Model             *db.Model
+
+	background []func(context.Context)
+}
+
+// Background registers fn to run in a goroutine of its own once the service
+// has booted, e.g. for periodic maintenance. The context given to fn is
+// canceled when the service shuts down, and fn should return then.
+func (h *H) Background(fn func(context.Context)) {
+	h.background = append(h.background, fn)
 }
 
 // CreateServer creates the grpc server
@@ -122,6 +131,12 @@ func (h *H) Boot(t net.Listener, s *grpc.Server, finished chan struct{}) (chan s
 		if size, ok := h.UserConfig.ServiceConfig["db_pool_size"].(int); ok {
 			h.Model.SetConnPoolSize(size)
 		}
+
+		if policy, ok := h.UserConfig.ServiceConfig["queue_policy"].(string); ok {
+			if err := h.Model.SetQueuePolicy(policy); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	var err error
@@ -133,6 +148,11 @@ func (h *H) Boot(t net.Listener, s *grpc.Server, finished chan struct{}) (chan s
 	doneChan := make(chan struct{})
 	started := make(chan struct{})
 
+	ctx, cancel := context.WithCancel(context.Background())
+	for _, fn := range h.background {
+		go fn(ctx)
+	}
+
 	go func(t net.Listener, s *grpc.Server) {
 		close(started)
 		if err := s.Serve(t); err != nil {
@@ -143,6 +163,7 @@ func (h *H) Boot(t net.Listener, s *grpc.Server, finished chan struct{}) (chan s
 	go func(t net.Listener, s *grpc.Server) {
 		<-started
 		<-doneChan
+		cancel()
 		h.Service.Clients.CloseClients()
 		s.GracefulStop()
 		t.Close()
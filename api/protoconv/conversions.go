@@ -152,14 +152,27 @@ func runFromProto(ctx context.Context, i interface{}) (interface{}, error) {
 		return nil, err
 	}
 
+	var previousAttemptID *int64
+	if r.PreviousAttemptId != 0 {
+		previousAttemptID = &r.PreviousAttemptId
+	}
+
+	var failureReason *string
+	if r.FailureReason != "" {
+		failureReason = &r.FailureReason
+	}
+
 	return &uisvc.Run{
-		CreatedAt:  createdAt,
-		FinishedAt: finishedAt,
-		StartedAt:  startedAt,
-		Id:         &r.Id,
-		Name:       &r.Name,
-		RanOn:      &r.RanOn,
-		Status:     status,
+		CreatedAt:         createdAt,
+		FinishedAt:        finishedAt,
+		StartedAt:         startedAt,
+		Id:                &r.Id,
+		Name:              &r.Name,
+		RanOn:             &r.RanOn,
+		Status:            status,
+		Attempt:           &r.Attempt,
+		PreviousAttemptId: previousAttemptID,
+		FailureReason:     failureReason,
 		// Settings   *RunSettings `json:"settings,omitempty"`
 		Task: task.(*uisvc.Task),
 	}, nil
@@ -169,6 +182,29 @@ func runToProto(ctx context.Context, i interface{}) (interface{}, error) {
 	return nil, nil
 }
 
+func runnerFromProto(ctx context.Context, i interface{}) (interface{}, error) {
+	r, ok := i.(*types.Runner)
+	if !ok {
+		return nil, fmt.Errorf("%T: %w", i, ErrConversionInvalidType)
+	}
+
+	labels := append([]string{}, r.Labels...)
+
+	return &uisvc.Runner{
+		Name:          &r.Name,
+		QueueName:     &r.QueueName,
+		Labels:        &labels,
+		Cordoned:      &r.Cordoned,
+		Draining:      &r.Draining,
+		RegisteredAt:  timeToPtr(r.RegisteredAt),
+		LastHeartbeat: timeToPtr(r.LastHeartbeat),
+	}, nil
+}
+
+func runnerToProto(ctx context.Context, i interface{}) (interface{}, error) {
+	return nil, nil
+}
+
 func taskFromProto(ctx context.Context, i interface{}) (interface{}, error) {
 	t, ok := i.(*types.Task)
 	if !ok {
@@ -202,6 +238,11 @@ func taskFromProto(ctx context.Context, i interface{}) (interface{}, error) {
 		return nil, err
 	}
 
+	var rerunOfID *int64
+	if t.RerunOfId != 0 {
+		rerunOfID = &t.RerunOfId
+	}
+
 	return &uisvc.Task{
 		Canceled:   &t.Canceled,
 		CreatedAt:  createdAt,
@@ -212,6 +253,7 @@ func taskFromProto(ctx context.Context, i interface{}) (interface{}, error) {
 		Status:     status,
 		Submission: sub.(*uisvc.ModelSubmission),
 		Runs:       &t.Runs,
+		RerunOfId:  rerunOfID,
 		/*
 			Settings   *TaskSettings    `json:"settings,omitempty"`
 		*/
@@ -39,6 +39,8 @@ func New() *APIConverter {
 	c.registerConversion(fromProto, &types.Repository{}, repoFromProto)
 	c.registerConversion(toProto, &uisvc.Run{}, runToProto)
 	c.registerConversion(fromProto, &types.Run{}, runFromProto)
+	c.registerConversion(toProto, &uisvc.Runner{}, runnerToProto)
+	c.registerConversion(fromProto, &types.Runner{}, runnerFromProto)
 	c.registerConversion(toProto, &uisvc.Task{}, taskToProto)
 	c.registerConversion(fromProto, &types.Task{}, taskFromProto)
 	c.registerConversion(toProto, &uisvc.UserError{}, ueToProto)
@@ -0,0 +1,536 @@
+package assetsvc
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"mime"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tinyci/ci-agents/ci-gen/grpc/services/asset"
+	gtypes "github.com/tinyci/ci-agents/ci-gen/grpc/types"
+	"github.com/tinyci/ci-agents/types"
+	"github.com/tinyci/ci-agents/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	artifactStoreConfigKey   = "artifact_store"      // filesystem or s3
+	artifactsRootConfigKey   = "artifacts_root_path" // used by the filesystem store
+	artifactMaxSizeConfigKey = "artifact_max_size"   // bytes; unlimited if unset
+
+	defaultArtifactsRoot       = "/var/tinyci/artifacts"
+	defaultArtifactContentType = "application/octet-stream"
+
+	// artifactChunkSize is the most read from the artifact store per message sent.
+	artifactChunkSize = 64 * 1024
+)
+
+// blobStore is a store of objects named by slash-separated keys, which
+// artifacts are kept in. The filesystem and s3 log stores implement it.
+type blobStore interface {
+	// putBlob writes the object, replacing any with the same key. Nothing is
+	// stored if reading r fails.
+	putBlob(ctx context.Context, key string, r io.Reader) error
+	// openBlob reads the object, returning utils.ErrNotFound if there is none.
+	openBlob(ctx context.Context, key string) (io.ReadCloser, error)
+	// listBlobs lists the names of the objects directly under the directory.
+	listBlobs(ctx context.Context, dir string) ([]string, error)
+	// walkBlobs lists all the objects under the directory, at any depth, with
+	// their keys relative to it.
+	walkBlobs(ctx context.Context, dir string) ([]blobInfo, error)
+	// removeBlob removes the object; removing one which doesn't exist
+	// succeeds.
+	removeBlob(ctx context.Context, key string) error
+	// removeBlobs removes all the objects under the directory, reporting
+	// whether there were any.
+	removeBlobs(ctx context.Context, dir string) (bool, error)
+}
+
+// blobInfo describes an object in a blobStore.
+type blobInfo struct {
+	Key      string
+	Size     int64
+	Modified time.Time
+}
+
+// artifactStore keeps the artifacts of each run in a directory named for its
+// run ID, with the content of each under files/ and its info under meta/.
+type artifactStore struct {
+	blobs   blobStore
+	maxSize int64
+}
+
+// artifactMeta is the info stored for each artifact.
+type artifactMeta struct {
+	ContentType string    `json:"content_type"`
+	Report      string    `json:"report,omitempty"`
+	SHA256      string    `json:"sha256"`
+	Size        int64     `json:"size"`
+	Created     time.Time `json:"created"`
+}
+
+func artifactKey(runID int64, kind, name string) string {
+	return path.Join(fmt.Sprintf("%d", runID), kind, name)
+}
+
+// newArtifactStore creates the artifact store named by the `artifact_store`
+// service setting. It defaults to s3 if logs are kept there, and the
+// filesystem otherwise. The s3 store shares the log store's settings, keeping
+// artifacts under the artifacts/ prefix.
+func (as *AssetServer) newArtifactStore() (*artifactStore, error) {
+	kind, ok := as.H.ServiceConfig[artifactStoreConfigKey].(string)
+	if !ok {
+		kind = logStoreFilesystem
+		if logKind, _ := as.H.ServiceConfig[logStoreConfigKey].(string); logKind == logStoreS3 {
+			kind = logStoreS3
+		}
+	}
+
+	var maxSize int64
+	if size, ok := as.H.ServiceConfig[artifactMaxSizeConfigKey].(int); ok {
+		maxSize = int64(size)
+	}
+
+	if maxSize < 0 {
+		return nil, errors.New("the artifact size limit cannot be negative")
+	}
+
+	switch kind {
+	case logStoreFilesystem:
+		root, ok := as.H.ServiceConfig[artifactsRootConfigKey].(string)
+		if !ok {
+			root = defaultArtifactsRoot
+		}
+
+		fs, err := newFilesystemStore(root)
+		if err != nil {
+			return nil, err
+		}
+
+		return &artifactStore{blobs: fs, maxSize: maxSize}, nil
+	case logStoreS3:
+		s3, err := newS3StoreFromConfig(as.H.ServiceConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		s3.prefix = path.Join(s3.prefix, "artifacts")
+		return &artifactStore{blobs: s3, maxSize: maxSize}, nil
+	default:
+		return nil, fmt.Errorf("invalid artifact store %q: must be one of filesystem or s3", kind)
+	}
+}
+
+func (as *AssetServer) artifactStore() (*artifactStore, error) {
+	as.artifactsOnce.Do(func() {
+		as.artifacts, as.artifactsErr = as.newArtifactStore()
+	})
+
+	return as.artifacts, as.artifactsErr
+}
+
+// removeArtifacts removes all the artifacts of the run, reporting whether it
+// had any.
+func (as *AssetServer) removeArtifacts(ctx context.Context, runID int64) (bool, error) {
+	store, err := as.artifactStore()
+	if err != nil {
+		return false, err
+	}
+
+	return store.blobs.removeBlobs(ctx, fmt.Sprintf("%d", runID))
+}
+
+// checkedReader hashes and counts the content as it is read, failing instead
+// of reaching the end if the content is too large or its sum doesn't match.
+type checkedReader struct {
+	r        io.Reader
+	hash     hash.Hash
+	expected string
+	maxSize  int64
+	size     int64
+}
+
+func newCheckedReader(r io.Reader, expected string, maxSize int64) *checkedReader {
+	return &checkedReader{r: r, hash: sha256.New(), expected: strings.ToLower(expected), maxSize: maxSize}
+}
+
+func (cr *checkedReader) Read(p []byte) (int, error) {
+	n, err := cr.r.Read(p)
+	cr.hash.Write(p[:n]) // #nosec
+	cr.size += int64(n)
+
+	if cr.maxSize > 0 && cr.size > cr.maxSize {
+		return n, fmt.Errorf("the artifact is larger than the limit of %d bytes", cr.maxSize)
+	}
+
+	if err == io.EOF && cr.expected != "" && cr.expected != cr.sum() {
+		return n, fmt.Errorf("the artifact's sha256 sum %s does not match %s", cr.sum(), cr.expected)
+	}
+
+	return n, err
+}
+
+func (cr *checkedReader) sum() string {
+	return hex.EncodeToString(cr.hash.Sum(nil))
+}
+
+// put stores the artifact read from r, returning its completed info. If check
+// is given, it reads the stored content before the artifact appears, and the
+// artifact is discarded if it fails.
+func (s *artifactStore) put(ctx context.Context, info *asset.ArtifactInfo, r io.Reader, check func(io.Reader) error) (*asset.ArtifactInfo, error) {
+	if err := types.ValidateArtifactName(info.Name); err != nil {
+		return nil, err
+	}
+
+	metaKey := artifactKey(info.RunID, "meta", info.Name)
+
+	existing, err := s.blobs.openBlob(ctx, metaKey)
+	if err == nil {
+		existing.Close()
+		return nil, utils.ErrArtifactExists
+	} else if !errors.Is(err, utils.ErrNotFound) {
+		return nil, err
+	}
+
+	filesKey := artifactKey(info.RunID, "files", info.Name)
+
+	cr := newCheckedReader(r, info.Sha256, s.maxSize)
+	if err := s.blobs.putBlob(ctx, filesKey, cr); err != nil {
+		return nil, err
+	}
+
+	if check != nil {
+		if err := s.check(ctx, filesKey, check); err != nil {
+			if rmErr := s.blobs.removeBlob(ctx, filesKey); rmErr != nil {
+				return nil, utils.WrapError(rmErr, "removing the artifact after: %v", err)
+			}
+
+			return nil, err
+		}
+	}
+
+	meta := artifactMeta{
+		ContentType: info.ContentType,
+		Report:      info.Report,
+		SHA256:      cr.sum(),
+		Size:        cr.size,
+		Created:     time.Now().UTC(),
+	}
+
+	if meta.ContentType == "" {
+		meta.ContentType = mime.TypeByExtension(path.Ext(info.Name))
+		if meta.ContentType == "" {
+			meta.ContentType = defaultArtifactContentType
+		}
+	}
+
+	buf, err := json.Marshal(meta)
+	if err != nil {
+		return nil, err
+	}
+
+	// the info is written last, so the artifact only appears once it's whole.
+	if err := s.blobs.putBlob(ctx, metaKey, bytes.NewReader(buf)); err != nil {
+		return nil, err
+	}
+
+	return meta.toProto(info.RunID, info.Name), nil
+}
+
+func (s *artifactStore) check(ctx context.Context, key string, check func(io.Reader) error) error {
+	r, err := s.blobs.openBlob(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	return check(r)
+}
+
+// remove removes the artifact, returning utils.ErrNotFound if there is none.
+func (s *artifactStore) remove(ctx context.Context, runID int64, name string) error {
+	if _, err := s.info(ctx, runID, name); err != nil {
+		return err
+	}
+
+	// the info goes first, so the artifact disappears before its content does.
+	if err := s.blobs.removeBlob(ctx, artifactKey(runID, "meta", name)); err != nil {
+		return err
+	}
+
+	return s.blobs.removeBlob(ctx, artifactKey(runID, "files", name))
+}
+
+func (meta artifactMeta) toProto(runID int64, name string) *asset.ArtifactInfo {
+	return &asset.ArtifactInfo{
+		RunID:       runID,
+		Name:        name,
+		ContentType: meta.ContentType,
+		Report:      meta.Report,
+		Sha256:      meta.SHA256,
+		Size:        meta.Size,
+		Created:     timestamppb.New(meta.Created),
+	}
+}
+
+// info reads the info of the artifact, returning utils.ErrNotFound if there
+// is none.
+func (s *artifactStore) info(ctx context.Context, runID int64, name string) (*asset.ArtifactInfo, error) {
+	if err := types.ValidateArtifactName(name); err != nil {
+		return nil, err
+	}
+
+	r, err := s.blobs.openBlob(ctx, artifactKey(runID, "meta", name))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	var meta artifactMeta
+	if err := json.NewDecoder(r).Decode(&meta); err != nil {
+		return nil, utils.WrapError(err, "reading the info of artifact %q of run %d", name, runID)
+	}
+
+	return meta.toProto(runID, name), nil
+}
+
+// open reads the artifact, returning its info and its content.
+func (s *artifactStore) open(ctx context.Context, runID int64, name string) (*asset.ArtifactInfo, io.ReadCloser, error) {
+	info, err := s.info(ctx, runID, name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r, err := s.blobs.openBlob(ctx, artifactKey(runID, "files", name))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return info, r, nil
+}
+
+// list returns the info of all the artifacts of the run, sorted by name.
+func (s *artifactStore) list(ctx context.Context, runID int64) ([]*asset.ArtifactInfo, error) {
+	names, err := s.blobs.listBlobs(ctx, path.Join(fmt.Sprintf("%d", runID), "meta"))
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(names)
+
+	artifacts := []*asset.ArtifactInfo{}
+
+	for _, name := range names {
+		info, err := s.info(ctx, runID, name)
+		if errors.Is(err, utils.ErrNotFound) {
+			continue // removed since it was listed
+		} else if err != nil {
+			return nil, err
+		}
+
+		artifacts = append(artifacts, info)
+	}
+
+	return artifacts, nil
+}
+
+// usage returns the space taken by the artifacts of each run that has any, as
+// a LogInfo created when its latest artifact was.
+func (s *artifactStore) usage(ctx context.Context) ([]LogInfo, error) {
+	blobs, err := s.blobs.walkBlobs(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	runs := map[int64]*LogInfo{}
+
+	for _, blob := range blobs {
+		id, err := strconv.ParseInt(strings.SplitN(blob.Key, "/", 2)[0], 10, 64)
+		if err != nil {
+			continue // not an artifact, such as an upload in progress
+		}
+
+		run, ok := runs[id]
+		if !ok {
+			run = &LogInfo{ID: id}
+			runs[id] = run
+		}
+
+		run.Size += blob.Size
+		if blob.Modified.After(run.Created) {
+			run.Created = blob.Modified
+		}
+	}
+
+	usage := []LogInfo{}
+	for _, run := range runs {
+		usage = append(usage, *run)
+	}
+
+	return usage, nil
+}
+
+// artifactStreamReader reads the content of an artifact from the chunks of a
+// PutArtifact stream.
+type artifactStreamReader struct {
+	ap  asset.Asset_PutArtifactServer
+	buf []byte
+}
+
+func (ar *artifactStreamReader) Read(p []byte) (int, error) {
+	for len(ar.buf) == 0 {
+		msg, err := ar.ap.Recv()
+		if err != nil {
+			return 0, err
+		}
+
+		ar.buf = msg.Chunk
+	}
+
+	n := copy(p, ar.buf)
+	ar.buf = ar.buf[n:]
+	return n, nil
+}
+
+// PutArtifact stores an artifact of a run. The first message names it; the
+// content follows in that and later messages. Artifacts which are test reports
+// are parsed before they are stored, and their test cases recorded with the
+// datasvc; they are not kept if either fails.
+func (as *AssetServer) PutArtifact(ap asset.Asset_PutArtifactServer) error {
+	info, err := as.putArtifact(ap)
+	if err != nil {
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	return ap.SendAndClose(info)
+}
+
+func (as *AssetServer) putArtifact(ap asset.Asset_PutArtifactServer) (*asset.ArtifactInfo, error) {
+	store, err := as.artifactStore()
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := ap.Recv()
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Info == nil {
+		return nil, errors.New("the first message must describe the artifact")
+	}
+
+	if msg.Info.Report != "" {
+		if err := types.ValidateTestReportFormat(msg.Info.Report); err != nil {
+			return nil, err
+		}
+
+		if as.H.Clients == nil || as.H.Clients.Data == nil {
+			return nil, errors.New("storing test reports requires the datasvc")
+		}
+	}
+
+	var (
+		cases []*gtypes.TestCase
+		check func(io.Reader) error
+	)
+
+	if msg.Info.Report != "" {
+		check = func(r io.Reader) (err error) {
+			cases, err = types.ParseTestReport(msg.Info.Report, r)
+			if err != nil {
+				return utils.WrapError(err, "reading the test report in artifact %q", msg.Info.Name)
+			}
+
+			return nil
+		}
+	}
+
+	info, err := store.put(ap.Context(), msg.Info, &artifactStreamReader{ap: ap, buf: msg.Chunk}, check)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.Report != "" {
+		if err := as.H.Clients.Data.AddTestCases(ap.Context(), info.RunID, cases); err != nil {
+			if rmErr := store.remove(ap.Context(), info.RunID, info.Name); rmErr != nil {
+				return nil, utils.WrapError(rmErr, "removing artifact %q after its test cases could not be recorded: %v", info.Name, err)
+			}
+
+			return nil, utils.WrapError(err, "recording the test cases in artifact %q", info.Name)
+		}
+	}
+
+	return info, nil
+}
+
+// GetArtifact sends the info of an artifact, followed by its content.
+func (as *AssetServer) GetArtifact(req *asset.ArtifactRequest, ag asset.Asset_GetArtifactServer) error {
+	if err := as.getArtifact(req, ag); err != nil {
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	return nil
+}
+
+func (as *AssetServer) getArtifact(req *asset.ArtifactRequest, ag asset.Asset_GetArtifactServer) error {
+	store, err := as.artifactStore()
+	if err != nil {
+		return err
+	}
+
+	info, r, err := store.open(ag.Context(), req.RunID, req.Name)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	if err := ag.Send(&asset.ArtifactChunk{Info: info}); err != nil {
+		return err
+	}
+
+	buf := make([]byte, artifactChunkSize)
+
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			if err := ag.Send(&asset.ArtifactChunk{Chunk: buf[:n]}); err != nil {
+				return err
+			}
+		}
+
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+// ListArtifacts lists the artifacts of a run.
+func (as *AssetServer) ListArtifacts(ctx context.Context, req *asset.ArtifactListRequest) (*asset.ArtifactList, error) {
+	store, err := as.artifactStore()
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	artifacts, err := store.list(ctx, req.RunID)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	return &asset.ArtifactList{Artifacts: artifacts}, nil
+}
@@ -0,0 +1,240 @@
+package assetsvc
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	check "github.com/erikh/check"
+	grpcHandler "github.com/tinyci/ci-agents/api/handlers/grpc"
+	"github.com/tinyci/ci-agents/ci-gen/grpc/services/asset"
+	"github.com/tinyci/ci-agents/config"
+	"github.com/tinyci/ci-agents/utils"
+)
+
+func artifactTestServer(sc config.ServiceConfig) *AssetServer {
+	return &AssetServer{H: &grpcHandler.H{UserConfig: config.UserConfig{ServiceConfig: sc}}}
+}
+
+func sha256Hex(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func testArtifactStore(c *check.C, blobs blobStore) {
+	ctx := context.Background()
+	store := &artifactStore{blobs: blobs, maxSize: 16}
+
+	list, err := store.list(ctx, 1)
+	c.Assert(err, check.IsNil)
+	c.Assert(len(list), check.Equals, 0)
+
+	info, err := store.put(ctx, &asset.ArtifactInfo{RunID: 1, Name: "report.html"}, strings.NewReader("<p>ok</p>"), nil)
+	c.Assert(err, check.IsNil)
+	c.Assert(info.ContentType, check.Equals, "text/html; charset=utf-8")
+	c.Assert(info.Sha256, check.Equals, sha256Hex("<p>ok</p>"))
+	c.Assert(info.Size, check.Equals, int64(len("<p>ok</p>")))
+	c.Assert(info.Created.IsValid(), check.Equals, true)
+
+	info, err = store.put(ctx, &asset.ArtifactInfo{RunID: 1, Name: "bin", ContentType: "application/x-executable", Sha256: strings.ToUpper(sha256Hex("binary"))}, strings.NewReader("binary"), nil)
+	c.Assert(err, check.IsNil)
+	c.Assert(info.ContentType, check.Equals, "application/x-executable")
+
+	info, err = store.put(ctx, &asset.ArtifactInfo{RunID: 2, Name: "data", Report: "junit"}, strings.NewReader("run 2"), nil)
+	c.Assert(err, check.IsNil)
+	c.Assert(info.ContentType, check.Equals, defaultArtifactContentType)
+
+	info, err = store.info(ctx, 2, "data")
+	c.Assert(err, check.IsNil)
+	c.Assert(info.Report, check.Equals, "junit")
+
+	_, err = store.put(ctx, &asset.ArtifactInfo{RunID: 1, Name: "bin"}, strings.NewReader("again"), nil)
+	c.Assert(err, check.Equals, utils.ErrArtifactExists)
+
+	// failed uploads leave nothing behind.
+	_, err = store.put(ctx, &asset.ArtifactInfo{RunID: 1, Name: "corrupt", Sha256: sha256Hex("something else")}, strings.NewReader("content"), nil)
+	c.Assert(err, check.ErrorMatches, ".*does not match.*")
+	_, err = store.put(ctx, &asset.ArtifactInfo{RunID: 1, Name: "large"}, strings.NewReader(strings.Repeat("x", 17)), nil)
+	c.Assert(err, check.ErrorMatches, ".*larger than the limit of 16 bytes")
+	_, err = store.put(ctx, &asset.ArtifactInfo{RunID: 1, Name: "../escape"}, strings.NewReader("content"), nil)
+	c.Assert(err, check.NotNil)
+
+	_, err = store.info(ctx, 1, "corrupt")
+	c.Assert(err, check.Equals, utils.ErrNotFound)
+
+	// artifacts failing the check are not kept.
+	_, err = store.put(ctx, &asset.ArtifactInfo{RunID: 1, Name: "checked"}, strings.NewReader("content"), func(r io.Reader) error {
+		buf, err := ioutil.ReadAll(r)
+		c.Assert(err, check.IsNil)
+		c.Assert(string(buf), check.Equals, "content")
+		return errors.New("invalid")
+	})
+	c.Assert(err, check.ErrorMatches, "invalid")
+	_, err = store.info(ctx, 1, "checked")
+	c.Assert(err, check.Equals, utils.ErrNotFound)
+	_, err = blobs.openBlob(ctx, artifactKey(1, "files", "checked"))
+	c.Assert(err, check.Equals, utils.ErrNotFound)
+
+	_, err = store.put(ctx, &asset.ArtifactInfo{RunID: 1, Name: "checked"}, strings.NewReader("content"), func(r io.Reader) error { return nil })
+	c.Assert(err, check.IsNil)
+	c.Assert(store.remove(ctx, 1, "checked"), check.IsNil)
+	c.Assert(store.remove(ctx, 1, "checked"), check.Equals, utils.ErrNotFound)
+	_, err = blobs.openBlob(ctx, artifactKey(1, "files", "checked"))
+	c.Assert(err, check.Equals, utils.ErrNotFound)
+
+	info, r, err := store.open(ctx, 1, "bin")
+	c.Assert(err, check.IsNil)
+	content, err := ioutil.ReadAll(r)
+	c.Assert(err, check.IsNil)
+	c.Assert(r.Close(), check.IsNil)
+	c.Assert(string(content), check.Equals, "binary")
+	c.Assert(info.Size, check.Equals, int64(6))
+
+	_, _, err = store.open(ctx, 1, "missing")
+	c.Assert(err, check.Equals, utils.ErrNotFound)
+
+	list, err = store.list(ctx, 1)
+	c.Assert(err, check.IsNil)
+	c.Assert(len(list), check.Equals, 2)
+	c.Assert(list[0].Name, check.Equals, "bin")
+	c.Assert(list[1].Name, check.Equals, "report.html")
+
+	usage, err := store.usage(ctx)
+	c.Assert(err, check.IsNil)
+	sort.Slice(usage, func(i, j int) bool { return usage[i].ID < usage[j].ID })
+	c.Assert(len(usage), check.Equals, 2)
+	c.Assert(usage[0].ID, check.Equals, int64(1))
+	// the info of each artifact is stored too.
+	c.Assert(usage[0].Size > int64(len("<p>ok</p>")+len("binary")), check.Equals, true)
+	c.Assert(usage[1].ID, check.Equals, int64(2))
+	c.Assert(time.Since(usage[1].Created) < time.Minute, check.Equals, true)
+
+	removed, err := blobs.removeBlobs(ctx, "1")
+	c.Assert(err, check.IsNil)
+	c.Assert(removed, check.Equals, true)
+	removed, err = blobs.removeBlobs(ctx, "1")
+	c.Assert(err, check.IsNil)
+	c.Assert(removed, check.Equals, false)
+
+	list, err = store.list(ctx, 1)
+	c.Assert(err, check.IsNil)
+	c.Assert(len(list), check.Equals, 0)
+
+	list, err = store.list(ctx, 2)
+	c.Assert(err, check.IsNil)
+	c.Assert(len(list), check.Equals, 1)
+}
+
+func (ss *storeSuite) TestFilesystemArtifactStore(c *check.C) {
+	dir, err := ioutil.TempDir("", "assetsvc")
+	c.Assert(err, check.IsNil)
+	defer os.RemoveAll(dir)
+
+	store, err := newFilesystemStore(dir)
+	c.Assert(err, check.IsNil)
+	testArtifactStore(c, store)
+}
+
+func (ss *storeSuite) TestS3ArtifactStore(c *check.C) {
+	fake := &fakeS3{objects: map[string][]byte{}}
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+
+	as := artifactTestServer(config.ServiceConfig{
+		logStoreConfigKey:    logStoreS3,
+		s3EndpointConfigKey:  srv.URL,
+		s3BucketConfigKey:    "logs",
+		s3PrefixConfigKey:    "tinyci",
+		s3AccessKeyConfigKey: "access",
+		s3SecretKeyConfigKey: "secret",
+	})
+
+	store, err := as.artifactStore()
+	c.Assert(err, check.IsNil)
+	testArtifactStore(c, store.blobs)
+
+	c.Assert(fake.objects["/logs/tinyci/artifacts/2/files/data"], check.DeepEquals, []byte("run 2"))
+
+	// artifacts are not logs.
+	logs, err := store.blobs.(*s3Store).List(context.Background())
+	c.Assert(err, check.IsNil)
+	c.Assert(len(logs), check.Equals, 0)
+}
+
+func (ss *storeSuite) TestArtifactStoreConfig(c *check.C) {
+	as := artifactTestServer(config.ServiceConfig{artifactStoreConfigKey: "postgres"})
+	_, err := as.artifactStore()
+	c.Assert(err, check.NotNil)
+
+	as = artifactTestServer(config.ServiceConfig{artifactMaxSizeConfigKey: -1})
+	_, err = as.artifactStore()
+	c.Assert(err, check.NotNil)
+}
+
+func (as *assetsvcSuite) TestArtifacts(c *check.C) {
+	ctx := context.Background()
+	content := bytes.Repeat([]byte("artifact content\n"), 10000)
+
+	info, err := as.assetClient.PutArtifact(ctx, &asset.ArtifactInfo{RunID: 1, Name: "output.txt"}, bytes.NewReader(content))
+	c.Assert(err, check.IsNil)
+	c.Assert(info.Size, check.Equals, int64(len(content)))
+	c.Assert(info.ContentType, check.Equals, "text/plain; charset=utf-8")
+
+	_, err = as.assetClient.PutArtifact(ctx, &asset.ArtifactInfo{RunID: 1, Name: "output.txt"}, bytes.NewReader(content))
+	c.Assert(err, check.NotNil)
+
+	_, err = as.assetClient.PutArtifact(ctx, &asset.ArtifactInfo{RunID: 1, Name: "report.tap", Report: "tap"}, bytes.NewReader(content))
+	c.Assert(err, check.ErrorMatches, ".*invalid test report format.*")
+
+	// test reports are parsed into the datasvc, which this server has no client for.
+	_, err = as.assetClient.PutArtifact(ctx, &asset.ArtifactInfo{RunID: 1, Name: "report.xml", Report: "junit"}, strings.NewReader("<testsuites/>"))
+	c.Assert(err, check.ErrorMatches, ".*requires the datasvc.*")
+
+	_, err = as.assetClient.PutArtifact(ctx, &asset.ArtifactInfo{RunID: 1, Name: "empty"}, bytes.NewReader(nil))
+	c.Assert(err, check.IsNil)
+
+	list, err := as.assetClient.ListArtifacts(ctx, 1)
+	c.Assert(err, check.IsNil)
+	c.Assert(len(list), check.Equals, 2)
+	c.Assert(list[0].Name, check.Equals, "empty")
+	c.Assert(list[1].Sha256, check.Equals, info.Sha256)
+
+	got, r, err := as.assetClient.OpenArtifact(ctx, 1, "output.txt")
+	c.Assert(err, check.IsNil)
+	buf, err := ioutil.ReadAll(r)
+	c.Assert(err, check.IsNil)
+	c.Assert(r.Close(), check.IsNil)
+	c.Assert(buf, check.DeepEquals, content)
+	c.Assert(got.Sha256, check.Equals, info.Sha256)
+
+	_, _, err = as.assetClient.OpenArtifact(ctx, 1, "missing")
+	c.Assert(err, check.NotNil)
+
+	// purging the run's logs removes its artifacts too.
+	c.Assert(as.assetClient.Write(ctx, 1, strings.NewReader("log")), check.IsNil)
+	_, err = as.assetClient.PurgeRunLogs(ctx, 1)
+	c.Assert(err, check.IsNil)
+
+	list, err = as.assetClient.ListArtifacts(ctx, 1)
+	c.Assert(err, check.IsNil)
+	c.Assert(len(list), check.Equals, 0)
+	// runs without a log have their artifacts purged all the same.
+	_, err = as.assetClient.PutArtifact(ctx, &asset.ArtifactInfo{RunID: 2, Name: "output.txt"}, bytes.NewReader(content))
+	c.Assert(err, check.IsNil)
+	ids, err := as.assetClient.PurgeRunLogs(ctx, 2)
+	c.Assert(err, check.IsNil)
+	c.Assert(ids, check.DeepEquals, []int64{2})
+
+	list, err = as.assetClient.ListArtifacts(ctx, 2)
+	c.Assert(err, check.IsNil)
+	c.Assert(len(list), check.Equals, 0)
+}
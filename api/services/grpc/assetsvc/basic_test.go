@@ -11,6 +11,8 @@ import (
 	"time"
 
 	check "github.com/erikh/check"
+	"github.com/tinyci/ci-agents/ci-gen/grpc/services/asset"
+	client "github.com/tinyci/ci-agents/clients/asset"
 )
 
 func (as *assetsvcSuite) TestReadValidation(c *check.C) {
@@ -64,3 +66,200 @@ func (as *assetsvcSuite) TestLogStream(c *check.C) {
 
 	c.Assert(strings.HasPrefix(buf2.String(), buf2.String()), check.Equals, true)
 }
+
+func (as *assetsvcSuite) TestLogTail(c *check.C) {
+	pr, pw := io.Pipe()
+
+	writeErr := make(chan error, 1)
+	go func() {
+		writeErr <- as.assetClient.Write(context.Background(), 1, pr)
+	}()
+
+	fmt.Fprintln(pw, "before")
+	time.Sleep(100 * time.Millisecond)
+
+	buf := bytes.NewBuffer(nil)
+	readErr := make(chan error, 1)
+	go func() {
+		readErr <- as.assetClient.Read(context.Background(), 1, buf)
+	}()
+
+	time.Sleep(100 * time.Millisecond)
+	fmt.Fprintln(pw, "after")
+	pw.Close()
+
+	c.Assert(<-writeErr, check.IsNil)
+	c.Assert(<-readErr, check.IsNil)
+	c.Assert(strings.HasPrefix(buf.String(), "before\nafter\n"), check.Equals, true)
+	c.Assert(strings.Contains(buf.String(), "LOG COMPLETE"), check.Equals, true)
+}
+
+func (as *assetsvcSuite) TestPurgeLogs(c *check.C) {
+	ctx := context.Background()
+
+	c.Assert(as.assetClient.Write(ctx, 1, strings.NewReader("hello")), check.IsNil)
+
+	ids, err := as.assetClient.PurgeRunLogs(ctx, 1)
+	c.Assert(err, check.IsNil)
+	c.Assert(ids, check.DeepEquals, []int64{1})
+
+	c.Assert(as.assetClient.Read(ctx, 1, ioutil.Discard), check.NotNil)
+
+	ids, err = as.assetClient.PurgeRunLogs(ctx, 1)
+	c.Assert(err, check.IsNil)
+	c.Assert(len(ids), check.Equals, 0)
+
+	// the log can be written again once it has been purged.
+	c.Assert(as.assetClient.Write(ctx, 1, strings.NewReader("hello again")), check.IsNil)
+
+	buf := bytes.NewBuffer(nil)
+	c.Assert(as.assetClient.Read(ctx, 1, buf), check.IsNil)
+	c.Assert(strings.HasPrefix(buf.String(), "hello again"), check.Equals, true)
+
+	// there's no datasvc to find the runs of tasks or submissions.
+	_, err = as.assetClient.PurgeTaskLogs(ctx, 1)
+	c.Assert(err, check.NotNil)
+}
+
+func (as *assetsvcSuite) TestLogRange(c *check.C) {
+	ctx := context.Background()
+	content := "one\ntwo\nthree\nfour\n"
+
+	c.Assert(as.assetClient.Write(ctx, 1, strings.NewReader(content)), check.IsNil)
+
+	read := func(opts client.LogOptions) (string, []*asset.LogChunk) {
+		chunks := []*asset.LogChunk{}
+		buf := bytes.NewBuffer(nil)
+		c.Assert(as.assetClient.ReadChunks(ctx, 1, opts, func(chunk *asset.LogChunk) error {
+			chunks = append(chunks, chunk)
+			if !chunk.Complete {
+				buf.Write(chunk.Chunk)
+			}
+			return nil
+		}), check.IsNil)
+
+		return buf.String(), chunks
+	}
+
+	out, chunks := read(client.LogOptions{Offset: 4})
+	c.Assert(out, check.Equals, content[4:])
+	c.Assert(chunks[0].Offset, check.Equals, int64(4))
+	last := chunks[len(chunks)-1]
+	c.Assert(last.Complete, check.Equals, true)
+	c.Assert(last.Offset, check.Equals, int64(len(content)))
+
+	// a limited read doesn't reach the end of the log.
+	out, chunks = read(client.LogOptions{Offset: 4, Limit: 4})
+	c.Assert(out, check.Equals, "two\n")
+	c.Assert(chunks[len(chunks)-1].Complete, check.Equals, false)
+
+	out, chunks = read(client.LogOptions{Tail: 2})
+	c.Assert(out, check.Equals, "three\nfour\n")
+	c.Assert(chunks[0].Offset, check.Equals, int64(8))
+
+	out, _ = read(client.LogOptions{Tail: 10})
+	c.Assert(out, check.Equals, content)
+
+	out, chunks = read(client.LogOptions{Offset: 100})
+	c.Assert(out, check.Equals, "")
+	c.Assert(chunks[0].Complete, check.Equals, true)
+
+	c.Assert(as.assetClient.ReadRange(ctx, 1, client.LogOptions{Offset: -1}, ioutil.Discard), check.NotNil)
+	c.Assert(as.assetClient.ReadRange(ctx, 1, client.LogOptions{Tail: client.MaxTail + 1}, ioutil.Discard), check.NotNil)
+}
+
+func (as *assetsvcSuite) TestLogTailRange(c *check.C) {
+	ctx := context.Background()
+	pr, pw := io.Pipe()
+
+	writeErr := make(chan error, 1)
+	go func() {
+		writeErr <- as.assetClient.Write(ctx, 1, pr)
+	}()
+
+	fmt.Fprint(pw, "one\ntwo\nthree\n")
+	time.Sleep(100 * time.Millisecond)
+
+	buf := bytes.NewBuffer(nil)
+	readErr := make(chan error, 1)
+	go func() {
+		readErr <- as.assetClient.ReadRange(ctx, 1, client.LogOptions{Tail: 1}, buf)
+	}()
+
+	limited := bytes.NewBuffer(nil)
+	c.Assert(as.assetClient.ReadRange(ctx, 1, client.LogOptions{Offset: 4, Limit: 7}, limited), check.IsNil)
+	c.Assert(limited.String(), check.Equals, "two\nthr")
+
+	time.Sleep(100 * time.Millisecond)
+	fmt.Fprint(pw, "four\n")
+	pw.Close()
+
+	c.Assert(<-writeErr, check.IsNil)
+	c.Assert(<-readErr, check.IsNil)
+	c.Assert(strings.HasPrefix(buf.String(), "three\nfour\n"), check.Equals, true)
+	c.Assert(strings.Contains(buf.String(), "LOG COMPLETE"), check.Equals, true)
+}
+
+func (as *assetsvcSuite) TestTailOffset(c *check.C) {
+	for content, offsets := range map[string][]int64{
+		"":               {0, 0},
+		"one":            {0, 0},
+		"one\n":          {0, 0},
+		"one\ntwo":       {4, 0},
+		"one\ntwo\n":     {4, 0},
+		"one\n\nthree\n": {5, 4},
+	} {
+		for i, want := range offsets {
+			got, err := tailOffset(strings.NewReader(content), int64(i+1))
+			c.Assert(err, check.IsNil)
+			c.Assert(got, check.Equals, want, check.Commentf("%q: tail %d", content, i+1))
+		}
+	}
+}
+
+func (as *assetsvcSuite) TestSearchLogs(c *check.C) {
+	ctx := context.Background()
+
+	c.Assert(as.assetClient.Write(ctx, 1, strings.NewReader("ok\n\x1b[31mFAIL\x1b[0m: TestFlaky\nok\n")), check.IsNil)
+	c.Assert(as.assetClient.Write(ctx, 2, strings.NewReader("ok\n")), check.IsNil)
+
+	res, err := as.assetClient.SearchLogs(ctx, &asset.SearchRequest{Pattern: "^FAIL: Test", Context: 1})
+	c.Assert(err, check.IsNil)
+	c.Assert(len(res.Matches), check.Equals, 1)
+	c.Assert(res.Matches[0].RunID, check.Equals, int64(1))
+	c.Assert(res.Matches[0].Line, check.Equals, int64(2))
+	c.Assert(res.Matches[0].Text, check.Equals, "FAIL: TestFlaky")
+	c.Assert(res.Matches[0].Before, check.DeepEquals, []string{"ok"})
+	c.Assert(res.Matches[0].After, check.DeepEquals, []string{"ok"})
+
+	_, err = as.assetClient.SearchLogs(ctx, &asset.SearchRequest{Pattern: "["})
+	c.Assert(err, check.NotNil)
+}
+
+func (as *assetsvcSuite) TestLogSections(c *check.C) {
+	ctx := context.Background()
+
+	c.Assert(as.assetClient.Write(ctx, 1, strings.NewReader("::group::build\nmake\n::endgroup::\n::group::test\ngo test\n")), check.IsNil)
+
+	sections, err := as.assetClient.LogSections(ctx, 1)
+	c.Assert(err, check.IsNil)
+	c.Assert(sections.Complete, check.Equals, true)
+	c.Assert(len(sections.Sections), check.Equals, 2)
+	c.Assert(sections.Sections[1].Name, check.Equals, "test")
+	c.Assert(sections.Sections[1].Finished.IsValid(), check.Equals, true)
+
+	// the section's range reads it from the log.
+	buf := bytes.NewBuffer(nil)
+	c.Assert(as.assetClient.ReadRange(ctx, 1, client.LogOptions{Offset: sections.Sections[0].Offset, Limit: sections.Sections[0].Length}, buf), check.IsNil)
+	c.Assert(buf.String(), check.Equals, "make\n")
+
+	// logs without sections have an empty index.
+	c.Assert(as.assetClient.Write(ctx, 2, strings.NewReader("plain\n")), check.IsNil)
+	sections, err = as.assetClient.LogSections(ctx, 2)
+	c.Assert(err, check.IsNil)
+	c.Assert(sections.Complete, check.Equals, true)
+	c.Assert(len(sections.Sections), check.Equals, 0)
+
+	_, err = as.assetClient.LogSections(ctx, 3)
+	c.Assert(err, check.NotNil)
+}
@@ -0,0 +1,128 @@
+package assetsvc
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	logCompressionConfigKey = "log_compression"
+
+	compressionGzip = "gzip"
+	compressionZstd = "zstd"
+	compressionNone = "none"
+)
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// compressedStore compresses logs on their way into the underlying store and
+// decompresses them on the way out. The format of each log is detected when
+// it is read, so logs written before the setting changed, or before logs were
+// compressed at all, are still readable.
+type compressedStore struct {
+	LogStore
+	compression string
+}
+
+func newCompressedStore(store LogStore, compression string) (*compressedStore, error) {
+	switch compression {
+	case compressionGzip, compressionZstd, compressionNone:
+	default:
+		return nil, fmt.Errorf("invalid log compression %q: must be one of gzip, zstd or none", compression)
+	}
+
+	return &compressedStore{LogStore: store, compression: compression}, nil
+}
+
+func (cs *compressedStore) Create(ctx context.Context, id int64) (io.WriteCloser, error) {
+	w, err := cs.LogStore.Create(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch cs.compression {
+	case compressionGzip:
+		return &compressWriter{WriteCloser: gzip.NewWriter(w), underlying: w}, nil
+	case compressionZstd:
+		enc, err := zstd.NewWriter(w)
+		if err != nil {
+			w.Close()
+			return nil, err
+		}
+
+		return &compressWriter{WriteCloser: enc, underlying: w}, nil
+	default:
+		return w, nil
+	}
+}
+
+func (cs *compressedStore) Open(ctx context.Context, id int64) (io.ReadCloser, error) {
+	r, err := cs.LogStore.Open(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	br := bufio.NewReader(r)
+	// short logs won't fill the peek; whatever was read is still checked.
+	magic, _ := br.Peek(len(zstdMagic))
+
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		dec, err := gzip.NewReader(br)
+		if err != nil {
+			r.Close()
+			return nil, err
+		}
+
+		return &decompressReader{Reader: dec, close: dec.Close, underlying: r}, nil
+	case bytes.HasPrefix(magic, zstdMagic):
+		dec, err := zstd.NewReader(br)
+		if err != nil {
+			r.Close()
+			return nil, err
+		}
+
+		return &decompressReader{Reader: dec, close: func() error { dec.Close(); return nil }, underlying: r}, nil
+	default:
+		return &decompressReader{Reader: br, close: func() error { return nil }, underlying: r}, nil
+	}
+}
+
+// compressWriter closes the compressor, flushing it, before the log it writes to.
+type compressWriter struct {
+	io.WriteCloser
+	underlying io.WriteCloser
+}
+
+func (cw *compressWriter) Close() error {
+	if err := cw.WriteCloser.Close(); err != nil {
+		cw.underlying.Close()
+		return err
+	}
+
+	return cw.underlying.Close()
+}
+
+type decompressReader struct {
+	io.Reader
+	close      func() error
+	underlying io.Closer
+}
+
+func (dr *decompressReader) Close() error {
+	err := dr.close()
+	if cerr := dr.underlying.Close(); err == nil {
+		err = cerr
+	}
+
+	return err
+}
@@ -0,0 +1,87 @@
+package assetsvc
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+
+	check "github.com/erikh/check"
+)
+
+func (ss *storeSuite) TestCompressedStore(c *check.C) {
+	ctx := context.Background()
+	content := strings.Repeat("a very compressible log line\n", 1000)
+
+	_, err := newCompressedStore(nil, "lzma")
+	c.Assert(err, check.NotNil)
+
+	for compression, magic := range map[string][]byte{
+		compressionGzip: gzipMagic,
+		compressionZstd: zstdMagic,
+		compressionNone: []byte("a very"),
+	} {
+		dir, err := ioutil.TempDir("", "assetsvc")
+		c.Assert(err, check.IsNil)
+		defer os.RemoveAll(dir)
+
+		fs, err := newFilesystemStore(dir)
+		c.Assert(err, check.IsNil)
+
+		store, err := newCompressedStore(fs, compression)
+		c.Assert(err, check.IsNil)
+
+		w, err := store.Create(ctx, 1)
+		c.Assert(err, check.IsNil)
+		_, err = w.Write([]byte(content))
+		c.Assert(err, check.IsNil)
+		c.Assert(w.Close(), check.IsNil)
+
+		raw, err := ioutil.ReadFile(path.Join(dir, "1"))
+		c.Assert(err, check.IsNil)
+		c.Assert(bytes.HasPrefix(raw, magic), check.Equals, true, check.Commentf("%v", compression))
+		if compression != compressionNone {
+			c.Assert(len(raw) < len(content), check.Equals, true)
+		}
+
+		// logs are readable whatever the store is set to write.
+		for _, other := range []string{compressionGzip, compressionZstd, compressionNone} {
+			reader, err := newCompressedStore(fs, other)
+			c.Assert(err, check.IsNil)
+
+			r, err := reader.Open(ctx, 1)
+			c.Assert(err, check.IsNil)
+			buf, err := ioutil.ReadAll(r)
+			c.Assert(err, check.IsNil)
+			c.Assert(r.Close(), check.IsNil)
+			c.Assert(string(buf), check.Equals, content)
+		}
+	}
+}
+
+func (ss *storeSuite) TestCompressedStoreShortLogs(c *check.C) {
+	ctx := context.Background()
+
+	dir, err := ioutil.TempDir("", "assetsvc")
+	c.Assert(err, check.IsNil)
+	defer os.RemoveAll(dir)
+
+	fs, err := newFilesystemStore(dir)
+	c.Assert(err, check.IsNil)
+
+	store, err := newCompressedStore(fs, compressionGzip)
+	c.Assert(err, check.IsNil)
+
+	for id, content := range map[int64]string{1: "", 2: "a"} {
+		c.Assert(ioutil.WriteFile(fs.path(id), []byte(content), 0600), check.IsNil)
+
+		r, err := store.Open(ctx, id)
+		c.Assert(err, check.IsNil)
+		buf, err := ioutil.ReadAll(r)
+		c.Assert(err, check.IsNil)
+		c.Assert(r.Close(), check.IsNil)
+		c.Assert(string(buf), check.Equals, content)
+	}
+}
@@ -0,0 +1,211 @@
+package assetsvc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+
+	"github.com/tinyci/ci-agents/utils"
+)
+
+// filesystemStore keeps each log as a file named for its run ID under the
+// root directory, with its index alongside in a file with the .index suffix.
+type filesystemStore struct {
+	root string
+}
+
+func newFilesystemStore(root string) (*filesystemStore, error) {
+	if err := os.MkdirAll(root, 0700); err != nil {
+		return nil, err
+	}
+
+	return &filesystemStore{root: root}, nil
+}
+
+func (fs *filesystemStore) path(id int64) string {
+	return path.Join(fs.root, fmt.Sprintf("%d", id))
+}
+
+func (fs *filesystemStore) indexPath(id int64) string {
+	return fs.path(id) + ".index"
+}
+
+func (fs *filesystemStore) Create(ctx context.Context, id int64) (io.WriteCloser, error) {
+	f, err := os.OpenFile(fs.path(id), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if os.IsExist(err) {
+		return nil, utils.ErrLogExists
+	}
+
+	return f, err
+}
+
+func (fs *filesystemStore) Open(ctx context.Context, id int64) (io.ReadCloser, error) {
+	f, err := os.Open(fs.path(id))
+	if os.IsNotExist(err) {
+		return nil, utils.ErrNotFound
+	}
+
+	return f, err
+}
+
+func (fs *filesystemStore) List(ctx context.Context) ([]LogInfo, error) {
+	fis, err := ioutil.ReadDir(fs.root)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := []LogInfo{}
+
+	for _, fi := range fis {
+		id, err := strconv.ParseInt(fi.Name(), 10, 64)
+		if err != nil || !fi.Mode().IsRegular() {
+			continue // not a log
+		}
+
+		logs = append(logs, LogInfo{ID: id, Size: fi.Size(), Created: fi.ModTime()})
+	}
+
+	return logs, nil
+}
+
+func (fs *filesystemStore) Remove(ctx context.Context, id int64) error {
+	err := os.Remove(fs.path(id))
+	if os.IsNotExist(err) {
+		return utils.ErrNotFound
+	} else if err != nil {
+		return err
+	}
+
+	if err := os.Remove(fs.indexPath(id)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (fs *filesystemStore) WriteIndex(ctx context.Context, id int64, index []byte) error {
+	return ioutil.WriteFile(fs.indexPath(id), index, 0600)
+}
+
+func (fs *filesystemStore) ReadIndex(ctx context.Context, id int64) ([]byte, error) {
+	buf, err := ioutil.ReadFile(fs.indexPath(id))
+	if os.IsNotExist(err) {
+		return nil, utils.ErrNotFound
+	}
+
+	return buf, err
+}
+
+// uploadsDir is where blobs are written before they are moved into place, so
+// that they appear whole or not at all.
+const uploadsDir = ".uploads"
+
+func (fs *filesystemStore) putBlob(ctx context.Context, key string, r io.Reader) error {
+	p := path.Join(fs.root, key)
+	tmpDir := path.Join(fs.root, uploadsDir)
+
+	for _, dir := range []string{path.Dir(p), tmpDir} {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return err
+		}
+	}
+
+	f, err := ioutil.TempFile(tmpDir, "blob")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name()) // fails harmlessly once it has been moved
+
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), p)
+}
+
+func (fs *filesystemStore) openBlob(ctx context.Context, key string) (io.ReadCloser, error) {
+	f, err := os.Open(path.Join(fs.root, key))
+	if os.IsNotExist(err) {
+		return nil, utils.ErrNotFound
+	}
+
+	return f, err
+}
+
+func (fs *filesystemStore) listBlobs(ctx context.Context, dir string) ([]string, error) {
+	fis, err := ioutil.ReadDir(path.Join(fs.root, dir))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, fi := range fis {
+		if fi.Mode().IsRegular() {
+			names = append(names, fi.Name())
+		}
+	}
+
+	return names, nil
+}
+
+func (fs *filesystemStore) walkBlobs(ctx context.Context, dir string) ([]blobInfo, error) {
+	root := path.Join(fs.root, dir)
+	blobs := []blobInfo{}
+
+	err := filepath.Walk(root, func(p string, fi os.FileInfo, err error) error {
+		if os.IsNotExist(err) && p == root {
+			return filepath.SkipDir
+		} else if err != nil {
+			return err
+		}
+
+		if fi.IsDir() && p == path.Join(fs.root, uploadsDir) {
+			return filepath.SkipDir
+		}
+
+		if fi.Mode().IsRegular() {
+			rel, err := filepath.Rel(root, p)
+			if err != nil {
+				return err
+			}
+
+			blobs = append(blobs, blobInfo{Key: filepath.ToSlash(rel), Size: fi.Size(), Modified: fi.ModTime()})
+		}
+
+		return nil
+	})
+
+	return blobs, err
+}
+
+func (fs *filesystemStore) removeBlob(ctx context.Context, key string) error {
+	if err := os.Remove(path.Join(fs.root, key)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (fs *filesystemStore) removeBlobs(ctx context.Context, dir string) (bool, error) {
+	p := path.Join(fs.root, dir)
+
+	if _, err := os.Stat(p); os.IsNotExist(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, os.RemoveAll(p)
+}
@@ -1,19 +1,22 @@
 package assetsvc
 
 import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"io"
-	"os"
-	"path"
-	"time"
+	"io/ioutil"
+	"sync"
 	"unicode/utf8"
 
-	"errors"
-
 	"github.com/fatih/color"
+	"github.com/golang/protobuf/ptypes/empty"
 	grpcHandler "github.com/tinyci/ci-agents/api/handlers/grpc"
 	"github.com/tinyci/ci-agents/ci-gen/grpc/services/asset"
-	"github.com/tinyci/ci-agents/ci-gen/grpc/types"
+	assetClient "github.com/tinyci/ci-agents/clients/asset"
+	"github.com/tinyci/ci-agents/utils"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
@@ -22,11 +25,25 @@ import (
 const (
 	defaultLogsRoot   = "/var/tinyci/logs"
 	logsRootConfigKey = "logs_root_path"
+
+	// attachChunkSize is the most read from the log store per message sent.
+	attachChunkSize = 256
 )
 
 // AssetServer is the handler anchor for the GRPC network system.
 type AssetServer struct {
 	H *grpcHandler.H
+
+	storeOnce sync.Once
+	store     LogStore
+	storeErr  error
+
+	artifactsOnce sync.Once
+	artifacts     *artifactStore
+	artifactsErr  error
+
+	hubOnce sync.Once
+	hub     logHub
 }
 
 func (as *AssetServer) getLogsRoot() string {
@@ -38,17 +55,94 @@ func (as *AssetServer) getLogsRoot() string {
 	return p
 }
 
-// PutLog writes the log to disk
+// PutLog writes the log to the log store
 func (as *AssetServer) PutLog(ap asset.Asset_PutLogServer) error {
-	return as.submit(ap, as.getLogsRoot())
+	return as.submit(ap)
 }
 
-// GetLog spills the log back to connecting websocket.
-func (as *AssetServer) GetLog(id *types.IntID, ag asset.Asset_GetLogServer) error {
-	return as.attach(id.ID, ag, as.getLogsRoot())
+// GetLog spills the log back to connecting websocket. Part of the log may be
+// selected with an offset and limit, or by the number of lines from its end.
+func (as *AssetServer) GetLog(req *asset.LogRequest, ag asset.Asset_GetLogServer) error {
+	return as.attach(req, ag)
 }
 
-func (as *AssetServer) submit(ap asset.Asset_PutLogServer, p string) (retErr error) {
+// PurgeLogs removes the logs and artifacts for a run, or for all the runs of a
+// task or submission. Runs whose logs are still being written are skipped.
+func (as *AssetServer) PurgeLogs(ctx context.Context, req *asset.PurgeRequest) (*asset.PurgeResponse, error) {
+	ids, err := as.purgeIDs(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	store, err := as.logStore()
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	writing, err := as.liveLogs().writing(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	res := &asset.PurgeResponse{}
+
+	for _, id := range ids {
+		if writing[id] {
+			continue
+		}
+
+		removed, err := as.removeRun(ctx, store, id)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		if removed {
+			res.RunIDs = append(res.RunIDs, id)
+		}
+	}
+
+	return res, nil
+}
+
+// removeRun removes the log and the artifacts of the run, reporting whether it
+// had either.
+func (as *AssetServer) removeRun(ctx context.Context, store LogStore, id int64) (bool, error) {
+	removedLog := true
+	if err := store.Remove(ctx, id); errors.Is(err, utils.ErrNotFound) {
+		removedLog = false
+	} else if err != nil {
+		return false, err
+	}
+
+	removedArtifacts, err := as.removeArtifacts(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	return removedLog || removedArtifacts, nil
+}
+
+func (as *AssetServer) purgeIDs(ctx context.Context, req *asset.PurgeRequest) ([]int64, error) {
+	if req.RunID != 0 {
+		return []int64{req.RunID}, nil
+	}
+
+	if req.TaskID == 0 && req.SubmissionID == 0 {
+		return nil, errors.New("a run, task or submission ID is required")
+	}
+
+	if as.H.Clients == nil || as.H.Clients.Data == nil {
+		return nil, errors.New("purging the logs of a task or submission requires the datasvc")
+	}
+
+	if req.TaskID != 0 {
+		return as.H.Clients.Data.RunIDsForTask(ctx, req.TaskID)
+	}
+
+	return as.H.Clients.Data.RunIDsForSubmission(ctx, req.SubmissionID)
+}
+
+func (as *AssetServer) submit(ap asset.Asset_PutLogServer) (retErr error) {
 	defer func() {
 		if retErr != nil {
 			md := metadata.New(nil)
@@ -56,7 +150,9 @@ func (as *AssetServer) submit(ap asset.Asset_PutLogServer, p string) (retErr err
 			ap.SetTrailer(md)
 		}
 	}()
-	if err := os.MkdirAll(p, 0700); err != nil {
+
+	store, err := as.logStore()
+	if err != nil {
 		return err
 	}
 
@@ -65,107 +161,288 @@ func (as *AssetServer) submit(ap asset.Asset_PutLogServer, p string) (retErr err
 		return err
 	}
 
-	file := path.Join(p, fmt.Sprintf("%d", ls.ID))
-
-	if _, err := os.Stat(file); err == nil {
-		return errors.New("log already exists")
-	}
+	id := ls.ID
+	hub := as.liveLogs()
 
-	if _, err := os.Stat(file + ".writing"); err == nil {
-		return errors.New("log writing is currently in progress for this ID")
+	if err := hub.start(ap.Context(), id); err != nil {
+		return err
 	}
+	// readers must be able to find the log and its index in the store once it
+	// has left the hub, so this runs after both have been written. The stream
+	// may have been canceled by then, and the log must leave the hub anyway.
+	defer hub.finish(context.Background(), id)
 
-	writing, err := os.Create(file + ".writing")
+	w, err := store.Create(ap.Context(), id)
 	if err != nil {
 		return err
 	}
+
+	closed := false
 	defer func() {
-		writing.Close()
-		os.Remove(writing.Name())
+		if !closed {
+			w.Close()
+		}
 	}()
 
-	f, err := os.Create(file)
-	if err != nil {
-		return err
-	}
-	defer f.Close()
+	var (
+		sections = newSectionParser()
+		offset   int64
+	)
 
 	for {
-		if _, err := f.Write(ls.Chunk); err != nil {
+		if _, err := w.Write(ls.Chunk); err != nil {
 			return err
 		}
-		if ls, err = ap.Recv(); err != nil {
+
+		if err := hub.publish(ap.Context(), id, offset, ls.Chunk); err != nil {
+			return err
+		}
+
+		sections.write(ls.Chunk)
+		offset += int64(len(ls.Chunk))
+
+		ls, err = ap.Recv()
+		if err == io.EOF {
+			closed = true
+			if err := w.Close(); err != nil {
+				return err
+			}
+
+			if err := writeIndex(ap.Context(), store, id, sections); err != nil {
+				return err
+			}
+
+			return ap.SendAndClose(&empty.Empty{})
+		} else if err != nil {
 			return err
 		}
 	}
 }
 
-func write(ag asset.Asset_GetLogServer, buf []byte) error {
-	return ag.Send(&asset.LogChunk{Chunk: buf})
+// logSender sends log chunks to the client, along with their offsets.
+//
+// websockets running in textencoding (Which xterm.js requires) require UTF8
+// clean strings to be passed on each write, otherwise it will break the
+// connection, so chunks are held back until they complete any partial runes.
+// XXX this buffering can probably be abused somehow.
+type logSender struct {
+	ag      asset.Asset_GetLogServer
+	offset  int64 // offset of the first pending byte
+	pending []byte
 }
 
-func (as *AssetServer) attach(id int64, ag asset.Asset_GetLogServer, p string) (retErr error) {
+func (ls *logSender) send(buf []byte) error {
+	ls.pending = append(ls.pending, buf...)
+	if !utf8.Valid(ls.pending) {
+		return nil
+	}
+
+	if err := ls.ag.Send(&asset.LogChunk{Chunk: ls.pending, Offset: ls.offset}); err != nil {
+		return err
+	}
+
+	ls.offset += int64(len(ls.pending))
+	ls.pending = nil
+	return nil
+}
+
+func (as *AssetServer) attach(req *asset.LogRequest, ag asset.Asset_GetLogServer) (retErr error) {
 	defer func() {
-		if retErr != nil {
+		if retErr == io.EOF { // spam-free log experience
+			retErr = nil
+		} else if retErr != nil {
 			retErr = status.Errorf(codes.FailedPrecondition, "%v", retErr)
-		} else {
-			retErr = write(ag, []byte(color.New(color.FgGreen).Sprintln("---- LOG COMPLETE ----")))
 		}
 	}()
 
-	file := path.Join(p, fmt.Sprintf("%d", id))
+	if req.Offset < 0 || req.Limit < 0 || req.Tail < 0 {
+		return errors.New("offset, limit and tail cannot be negative")
+	}
 
-	log, err := os.Open(file) // #nosec
-	if err != nil {
+	// tailOffset keeps as many line starts as lines are tailed.
+	if req.Tail > assetClient.MaxTail {
+		return fmt.Errorf("tail cannot be more than %d lines", assetClient.MaxTail)
+	}
+
+	sender := &logSender{ag: ag}
+
+	complete, err := as.tail(req, sender, ag)
+	if err != nil || !complete {
 		return err
 	}
+
+	return ag.Send(&asset.LogChunk{
+		Chunk:    []byte(color.New(color.FgGreen).Sprintln("---- LOG COMPLETE ----")),
+		Offset:   sender.offset,
+		Complete: true,
+	})
+}
+
+// readStored sends the requested part of a finished log from the store. It
+// returns true if the end of the log was reached.
+func (as *AssetServer) readStored(req *asset.LogRequest, sender *logSender, ag asset.Asset_GetLogServer) (bool, error) {
+	store, err := as.logStore()
+	if err != nil {
+		return false, err
+	}
+
+	offset := req.Offset
+
+	if req.Tail > 0 {
+		log, err := store.Open(ag.Context(), req.ID)
+		if err != nil {
+			return false, err
+		}
+
+		offset, err = tailOffset(log, req.Tail)
+		log.Close()
+		if err != nil {
+			return false, err
+		}
+	}
+
+	log, err := store.Open(ag.Context(), req.ID)
+	if err != nil {
+		return false, err
+	}
 	defer log.Close()
 
-	buf := make([]byte, 256)
-	var (
-		last bool
-	)
+	// stored logs may be compressed, so they can't be seeked.
+	skipped, err := io.CopyN(ioutil.Discard, log, offset)
+	sender.offset = skipped
+	if err == io.EOF {
+		return true, nil // the offset is past the end of the log
+	} else if err != nil {
+		return false, err
+	}
 
-	writeBuf := []byte{}
+	var r io.Reader = log
+	if req.Limit > 0 {
+		r = io.LimitReader(log, req.Limit)
+	}
+
+	buf := make([]byte, attachChunkSize)
+	var read int64
 
 	for {
-		// these two conditionals kind of wrap the main body of the loop to allow
-		// the secret sauce; thanks `perldoc -q tail`!
-		n, readErr := log.Read(buf)
-		if readErr == io.EOF {
-			if _, err := os.Stat(file + ".writing"); err != nil {
-				return nil // file is done writing
+		n, err := r.Read(buf)
+		if n > 0 {
+			read += int64(n)
+			if err := sender.send(buf[:n]); err != nil {
+				return false, err
 			}
+		}
 
-			if _, err := log.Seek(0, io.SeekEnd); err != nil {
-				last = true
-			} else {
-				time.Sleep(500 * time.Millisecond)
-			}
-		} else if readErr != nil {
-			last = true
-		}
-
-		writeBuf = append(writeBuf, buf[:n]...)
-		// websockets running in textencoding (Which xterm.js requires) require UTF8
-		// clean strings to be passed on each write, otherwise it will break the
-		// connection.
-		// XXX this buffering can probably be abused somehow.
-		if utf8.ValidString(string(writeBuf)) {
-			if err := write(ag, writeBuf); err != nil {
-				if err != io.EOF { // spam-free log experience
-					return err
-				}
-
-				return nil
+		if err == io.EOF {
+			return req.Limit == 0 || read < req.Limit, nil
+		} else if err != nil {
+			return false, err
+		}
+	}
+}
+
+// tail streams the requested part of a log, following it while it is being
+// written; the rest of it is read from the store once it has been closed. It
+// returns true if the end of the log was reached.
+func (as *AssetServer) tail(req *asset.LogRequest, sender *logSender, ag asset.Asset_GetLogServer) (bool, error) {
+	ctx := ag.Context()
+	hub := as.liveLogs()
+
+	notify, unsubscribe := hub.subscribe(req.ID)
+	defer unsubscribe()
+
+	offset := req.Offset
+	if req.Tail > 0 {
+		content, live, err := hub.read(ctx, req.ID, 0)
+		if err != nil {
+			return false, err
+		}
+
+		if !live {
+			return as.readStored(req, sender, ag)
+		}
+
+		// reading from memory can't fail.
+		offset, _ = tailOffset(bytes.NewReader(content), req.Tail)
+	}
+
+	start := offset
+	sender.offset = offset
+	remaining := req.Limit
+
+	for {
+		buf, live, err := hub.read(ctx, req.ID, offset)
+		if err != nil {
+			return false, err
+		}
+
+		if req.Limit > 0 && int64(len(buf)) >= remaining {
+			return false, sender.send(buf[:remaining])
+		}
+
+		if len(buf) > 0 {
+			offset += int64(len(buf))
+			remaining -= int64(len(buf))
+			if err := sender.send(buf); err != nil {
+				return false, err
 			}
+		}
 
-			if last {
-				if readErr != io.EOF {
-					return readErr
-				}
+		if !live {
+			// continue from the first byte which has not been sent, as the
+			// sender may be holding back part of a rune.
+			rest := &asset.LogRequest{ID: req.ID, Offset: sender.offset}
+			if req.Limit > 0 {
+				rest.Limit = req.Limit - (sender.offset - start)
 			}
-			writeBuf = []byte{}
+
+			sender.pending = nil
+			return as.readStored(rest, sender, ag)
+		}
+
+		select {
+		case <-notify:
+		case <-ag.Context().Done():
+			return false, ag.Context().Err()
 		}
 	}
 }
+
+// tailOffset finds the offset at which the last n lines of the log start.
+func tailOffset(r io.Reader, n int64) (int64, error) {
+	var (
+		starts    = make([]int64, n) // ring of the latest line starts
+		count     int64
+		pos       int64
+		lineStart = true
+	)
+
+	br := bufio.NewReader(r)
+
+	for {
+		b, err := br.ReadByte()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return 0, err
+		}
+
+		if lineStart {
+			starts[count%n] = pos
+			count++
+			lineStart = false
+		}
+
+		if b == '\n' {
+			lineStart = true
+		}
+
+		pos++
+	}
+
+	if count <= n {
+		return 0, nil
+	}
+
+	return starts[count%n], nil
+}
@@ -0,0 +1,134 @@
+package assetsvc
+
+import (
+	"bufio"
+	"context"
+	"io"
+
+	"github.com/tinyci/ci-agents/db"
+)
+
+// postgresChunkSize is the size of the reads and writes made against the large
+// objects.
+const postgresChunkSize = 64 * 1024
+
+// postgresStore keeps each log in a postgres large object, and its index in
+// the row which refers to it.
+type postgresStore struct {
+	model *db.Model
+}
+
+func newPostgresStore(model *db.Model) *postgresStore {
+	return &postgresStore{model: model}
+}
+
+func (ps *postgresStore) Create(ctx context.Context, id int64) (io.WriteCloser, error) {
+	oid, err := ps.model.CreateLogObject(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	lw := &largeObjectWriter{ctx: ctx, model: ps.model, oid: oid}
+	return &postgresWriter{
+		Writer: bufio.NewWriterSize(lw, postgresChunkSize),
+		lw:     lw,
+		id:     id,
+	}, nil
+}
+
+func (ps *postgresStore) Open(ctx context.Context, id int64) (io.ReadCloser, error) {
+	oid, err := ps.model.GetLogObject(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &largeObjectReader{ctx: ctx, model: ps.model, oid: oid}, nil
+}
+
+func (ps *postgresStore) List(ctx context.Context) ([]LogInfo, error) {
+	los, err := ps.model.ListLogObjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := make([]LogInfo, len(los))
+	for i, lo := range los {
+		logs[i] = LogInfo{ID: lo.RunID, Size: lo.Size, Created: lo.CreatedAt}
+	}
+
+	return logs, nil
+}
+
+func (ps *postgresStore) Remove(ctx context.Context, id int64) error {
+	return ps.model.DeleteLogObject(ctx, id)
+}
+
+func (ps *postgresStore) WriteIndex(ctx context.Context, id int64, index []byte) error {
+	return ps.model.SetLogObjectIndex(ctx, id, index)
+}
+
+func (ps *postgresStore) ReadIndex(ctx context.Context, id int64) ([]byte, error) {
+	return ps.model.GetLogObjectIndex(ctx, id)
+}
+
+// postgresWriter batches the small writes the assetsvc receives into chunks
+// before they reach the object, and records the log's size once it is closed.
+type postgresWriter struct {
+	*bufio.Writer
+	lw *largeObjectWriter
+	id int64
+}
+
+func (pw *postgresWriter) Close() error {
+	if err := pw.Flush(); err != nil {
+		return err
+	}
+
+	return pw.lw.model.SetLogObjectSize(pw.lw.ctx, pw.id, pw.lw.offset)
+}
+
+type largeObjectWriter struct {
+	ctx    context.Context
+	model  *db.Model
+	oid    int64
+	offset int64
+}
+
+func (lw *largeObjectWriter) Write(p []byte) (int, error) {
+	if err := lw.model.WriteLogObject(lw.ctx, lw.oid, lw.offset, p); err != nil {
+		return 0, err
+	}
+
+	lw.offset += int64(len(p))
+	return len(p), nil
+}
+
+type largeObjectReader struct {
+	ctx    context.Context
+	model  *db.Model
+	oid    int64
+	offset int64
+}
+
+func (lr *largeObjectReader) Read(p []byte) (int, error) {
+	size := len(p)
+	if size > postgresChunkSize {
+		size = postgresChunkSize
+	}
+
+	buf, err := lr.model.ReadLogObject(lr.ctx, lr.oid, lr.offset, size)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(buf) == 0 {
+		return 0, io.EOF
+	}
+
+	lr.offset += int64(len(buf))
+	return copy(p, buf), nil
+}
+
+func (lr *largeObjectReader) Close() error {
+	return nil
+}
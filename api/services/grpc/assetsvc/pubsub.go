@@ -0,0 +1,191 @@
+package assetsvc
+
+import (
+	"context"
+	"sync"
+
+	"github.com/tinyci/ci-agents/db"
+	"github.com/tinyci/ci-agents/utils"
+)
+
+// logHub carries the logs that are currently being written to the readers
+// tailing them as the chunks arrive, instead of them polling the log store.
+// Once a log leaves the hub it is complete in the store, so readers finish it
+// from there.
+type logHub interface {
+	// start registers the run's log as being written. utils.ErrLogWriting is
+	// returned if it already is.
+	start(ctx context.Context, id int64) error
+	// publish appends the chunk, written at the offset, to the run's log and
+	// wakes its readers.
+	publish(ctx context.Context, id, offset int64, chunk []byte) error
+	// finish removes the run's log from the hub, waking its readers. It must
+	// only be called once the log has been closed in the store, so that
+	// readers find it there.
+	finish(ctx context.Context, id int64) error
+	// read returns the content of the run's log after the offset, and whether
+	// it is still being written.
+	read(ctx context.Context, id, offset int64) ([]byte, bool, error)
+	// subscribe returns a channel which receives a value whenever the run's log
+	// may have changed, and a function to unsubscribe it.
+	subscribe(id int64) (<-chan struct{}, func())
+	// writing returns the IDs of the runs whose logs are being written.
+	writing(ctx context.Context) (map[int64]bool, error)
+}
+
+// liveLogs returns the hub shared through the database when the service has
+// one, so logs can be tailed from any assetsvc, or one of its own otherwise.
+func (as *AssetServer) liveLogs() logHub {
+	as.hubOnce.Do(func() {
+		if as.H.Model != nil {
+			as.hub = &postgresHub{model: as.H.Model}
+		} else {
+			as.hub = &memoryHub{}
+		}
+	})
+
+	return as.hub
+}
+
+// postgresHub keeps the live logs in the database, waking readers through its
+// notifications.
+type postgresHub struct {
+	model *db.Model
+}
+
+func (ph *postgresHub) start(ctx context.Context, id int64) error {
+	return ph.model.StartLiveLog(ctx, id)
+}
+
+func (ph *postgresHub) publish(ctx context.Context, id, offset int64, chunk []byte) error {
+	return ph.model.AppendLiveLog(ctx, id, offset, chunk)
+}
+
+func (ph *postgresHub) finish(ctx context.Context, id int64) error {
+	return ph.model.FinishLiveLog(ctx, id)
+}
+
+func (ph *postgresHub) read(ctx context.Context, id, offset int64) ([]byte, bool, error) {
+	return ph.model.ReadLiveLog(ctx, id, offset)
+}
+
+func (ph *postgresHub) subscribe(id int64) (<-chan struct{}, func()) {
+	return ph.model.WatchLiveLog(id)
+}
+
+func (ph *postgresHub) writing(ctx context.Context) (map[int64]bool, error) {
+	ids, err := ph.model.LiveLogIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	writing := map[int64]bool{}
+	for _, id := range ids {
+		writing[id] = true
+	}
+
+	return writing, nil
+}
+
+// memoryHub keeps the live logs in memory, so they can only be tailed from the
+// assetsvc writing them. The zero value is ready to use.
+type memoryHub struct {
+	mutex sync.Mutex
+	logs  map[int64][]byte
+	subs  map[int64]map[chan struct{}]struct{}
+}
+
+func (mh *memoryHub) start(ctx context.Context, id int64) error {
+	mh.mutex.Lock()
+	defer mh.mutex.Unlock()
+
+	if mh.logs == nil {
+		mh.logs = map[int64][]byte{}
+	}
+
+	if _, ok := mh.logs[id]; ok {
+		return utils.ErrLogWriting
+	}
+
+	mh.logs[id] = []byte{}
+	return nil
+}
+
+func (mh *memoryHub) publish(ctx context.Context, id, offset int64, chunk []byte) error {
+	mh.mutex.Lock()
+	defer mh.mutex.Unlock()
+
+	mh.logs[id] = append(mh.logs[id], chunk...)
+	mh.notify(id)
+	return nil
+}
+
+func (mh *memoryHub) finish(ctx context.Context, id int64) error {
+	mh.mutex.Lock()
+	defer mh.mutex.Unlock()
+
+	delete(mh.logs, id)
+	mh.notify(id)
+	return nil
+}
+
+func (mh *memoryHub) read(ctx context.Context, id, offset int64) ([]byte, bool, error) {
+	mh.mutex.Lock()
+	defer mh.mutex.Unlock()
+
+	content, ok := mh.logs[id]
+	if !ok || offset >= int64(len(content)) {
+		return nil, ok, nil
+	}
+
+	return append([]byte(nil), content[offset:]...), true, nil
+}
+
+// notify must be called with the mutex held.
+func (mh *memoryHub) notify(id int64) {
+	for sub := range mh.subs[id] {
+		select {
+		case sub <- struct{}{}:
+		default: // a wakeup is already pending
+		}
+	}
+}
+
+func (mh *memoryHub) subscribe(id int64) (<-chan struct{}, func()) {
+	sub := make(chan struct{}, 1)
+
+	mh.mutex.Lock()
+	defer mh.mutex.Unlock()
+
+	if mh.subs == nil {
+		mh.subs = map[int64]map[chan struct{}]struct{}{}
+	}
+
+	if mh.subs[id] == nil {
+		mh.subs[id] = map[chan struct{}]struct{}{}
+	}
+
+	mh.subs[id][sub] = struct{}{}
+
+	return sub, func() {
+		mh.mutex.Lock()
+		defer mh.mutex.Unlock()
+
+		delete(mh.subs[id], sub)
+		if len(mh.subs[id]) == 0 {
+			delete(mh.subs, id)
+		}
+	}
+}
+
+func (mh *memoryHub) writing(ctx context.Context) (map[int64]bool, error) {
+	mh.mutex.Lock()
+	defer mh.mutex.Unlock()
+
+	writing := map[int64]bool{}
+	for id := range mh.logs {
+		writing[id] = true
+	}
+
+	return writing, nil
+}
@@ -0,0 +1,250 @@
+package assetsvc
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/tinyci/ci-agents/config"
+	"github.com/tinyci/ci-agents/utils"
+)
+
+const (
+	logRetentionMaxAgeConfigKey   = "log_retention_max_age"   // duration, e.g. 720h
+	logRetentionMaxSizeConfigKey  = "log_retention_max_size"  // bytes
+	logRetentionKeepLastConfigKey = "log_retention_keep_last" // logs per repository
+	logSweepIntervalConfigKey     = "log_sweep_interval"      // duration
+
+	defaultLogSweepInterval = time.Hour
+)
+
+// retentionPolicy decides which runs have their logs and artifacts removed by
+// the sweeper. Each limit is applied independently and a zero value disables
+// it; runs which exceed any of them are removed.
+type retentionPolicy struct {
+	MaxAge   time.Duration // Remove logs older than this
+	MaxSize  int64         // Remove the oldest logs until all of them fit in this many bytes
+	KeepLast int           // Remove all but the latest logs of each repository
+}
+
+func newRetentionPolicyFromConfig(sc config.ServiceConfig) (retentionPolicy, error) {
+	var rp retentionPolicy
+
+	if param, ok := sc[logRetentionMaxAgeConfigKey].(string); ok {
+		dur, err := time.ParseDuration(param)
+		if err != nil {
+			return rp, utils.WrapError(err, "parsing %v", logRetentionMaxAgeConfigKey)
+		}
+
+		rp.MaxAge = dur
+	}
+
+	if size, ok := sc[logRetentionMaxSizeConfigKey].(int); ok {
+		rp.MaxSize = int64(size)
+	}
+
+	if keep, ok := sc[logRetentionKeepLastConfigKey].(int); ok {
+		rp.KeepLast = keep
+	}
+
+	if rp.MaxAge < 0 || rp.MaxSize < 0 || rp.KeepLast < 0 {
+		return rp, errors.New("log retention settings cannot be negative")
+	}
+
+	return rp, nil
+}
+
+func (rp retentionPolicy) enabled() bool {
+	return rp.MaxAge > 0 || rp.MaxSize > 0 || rp.KeepLast > 0
+}
+
+// expired returns the IDs of the logs the policy removes. repos maps the run
+// IDs of the logs to their repositories; logs missing from it are not counted
+// towards any repository.
+func (rp retentionPolicy) expired(logs []LogInfo, repos map[int64]int64, now time.Time) []int64 {
+	newest := append([]LogInfo(nil), logs...)
+	sort.Slice(newest, func(i, j int) bool {
+		if newest[i].Created.Equal(newest[j].Created) {
+			return newest[i].ID > newest[j].ID
+		}
+
+		return newest[i].Created.After(newest[j].Created)
+	})
+
+	var (
+		expired []int64
+		total   int64
+		full    bool
+	)
+
+	kept := map[int64]int{}
+
+	for _, log := range newest {
+		repo, hasRepo := repos[log.ID]
+
+		switch {
+		case rp.MaxAge > 0 && now.Sub(log.Created) > rp.MaxAge:
+		case rp.KeepLast > 0 && hasRepo && kept[repo] >= rp.KeepLast:
+		case rp.MaxSize > 0 && (full || total+log.Size > rp.MaxSize):
+			// everything older than the first log which doesn't fit goes too.
+			full = true
+		default:
+			total += log.Size
+			if hasRepo {
+				kept[repo]++
+			}
+			continue
+		}
+
+		expired = append(expired, log.ID)
+	}
+
+	return expired
+}
+
+func (as *AssetServer) sweepInterval() (time.Duration, error) {
+	if param, ok := as.H.ServiceConfig[logSweepIntervalConfigKey].(string); ok {
+		dur, err := time.ParseDuration(param)
+		if err != nil {
+			return 0, utils.WrapError(err, "parsing %v", logSweepIntervalConfigKey)
+		}
+
+		if dur > 0 {
+			return dur, nil
+		}
+	}
+
+	return defaultLogSweepInterval, nil
+}
+
+// SweepLogs periodically removes the logs and artifacts which have expired
+// under the retention policy, until ctx is canceled. It does nothing if no policy is
+// configured. It is run in the background of the service; see
+// grpcHandler.H.Background.
+func (as *AssetServer) SweepLogs(ctx context.Context) {
+	policy, err := newRetentionPolicyFromConfig(as.H.ServiceConfig)
+	if err != nil {
+		as.H.Clients.Log.Error(ctx, utils.WrapError(err, "log retention is disabled"))
+		return
+	}
+
+	if !policy.enabled() {
+		return
+	}
+
+	interval, err := as.sweepInterval()
+	if err != nil {
+		as.H.Clients.Log.Error(ctx, utils.WrapError(err, "log retention is disabled"))
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		removed, err := as.sweep(ctx, policy, time.Now())
+		if err != nil {
+			as.H.Clients.Log.Error(ctx, utils.WrapError(err, "sweeping logs"))
+		} else if len(removed) > 0 {
+			as.H.Clients.Log.Infof(ctx, "Removed the logs and artifacts of %d runs under the retention policy", len(removed))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sweep removes the logs and artifacts of the runs which have expired under
+// the policy, returning their run IDs. Runs with artifacts but no log are
+// swept too, and artifacts count towards the size limit. Runs whose logs are
+// still being written are left alone.
+func (as *AssetServer) sweep(ctx context.Context, policy retentionPolicy, now time.Time) ([]int64, error) {
+	store, err := as.logStore()
+	if err != nil {
+		return nil, err
+	}
+
+	all, err := as.runUsage(ctx, store)
+	if err != nil {
+		return nil, err
+	}
+
+	writing, err := as.liveLogs().writing(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	runs := []LogInfo{}
+	ids := []int64{}
+
+	for _, run := range all {
+		if !writing[run.ID] {
+			runs = append(runs, run)
+			ids = append(ids, run.ID)
+		}
+	}
+
+	var repos map[int64]int64
+
+	if policy.KeepLast > 0 && len(ids) > 0 {
+		if as.H.Clients == nil || as.H.Clients.Data == nil {
+			return nil, errors.New("keeping the last logs of each repository requires the datasvc")
+		}
+
+		repos, err = as.H.Clients.Data.RunRepositories(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	removed := []int64{}
+
+	for _, id := range policy.expired(runs, repos, now) {
+		if _, err := as.removeRun(ctx, store, id); err != nil {
+			return removed, err
+		}
+
+		removed = append(removed, id)
+	}
+
+	return removed, nil
+}
+
+// runUsage returns the space taken by the log and the artifacts of each run
+// which has either, as a LogInfo. Runs are as old as their logs, or their
+// latest artifacts if they have none.
+func (as *AssetServer) runUsage(ctx context.Context, store LogStore) ([]LogInfo, error) {
+	logs, err := store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	artifacts, err := as.artifactStore()
+	if err != nil {
+		return nil, err
+	}
+
+	usage, err := artifacts.usage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	runs := map[int64]int{}
+	for i, log := range logs {
+		runs[log.ID] = i
+	}
+
+	for _, info := range usage {
+		if i, ok := runs[info.ID]; ok {
+			logs[i].Size += info.Size
+		} else {
+			logs = append(logs, info)
+		}
+	}
+
+	return logs, nil
+}
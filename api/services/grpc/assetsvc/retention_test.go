@@ -0,0 +1,152 @@
+package assetsvc
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	check "github.com/erikh/check"
+	grpcHandler "github.com/tinyci/ci-agents/api/handlers/grpc"
+	"github.com/tinyci/ci-agents/ci-gen/grpc/services/asset"
+	"github.com/tinyci/ci-agents/config"
+	"github.com/tinyci/ci-agents/utils"
+)
+
+func (ss *storeSuite) TestRetentionPolicyConfig(c *check.C) {
+	rp, err := newRetentionPolicyFromConfig(config.ServiceConfig{})
+	c.Assert(err, check.IsNil)
+	c.Assert(rp.enabled(), check.Equals, false)
+
+	rp, err = newRetentionPolicyFromConfig(config.ServiceConfig{
+		logRetentionMaxAgeConfigKey:   "720h",
+		logRetentionMaxSizeConfigKey:  1024,
+		logRetentionKeepLastConfigKey: 10,
+	})
+	c.Assert(err, check.IsNil)
+	c.Assert(rp, check.DeepEquals, retentionPolicy{MaxAge: 720 * time.Hour, MaxSize: 1024, KeepLast: 10})
+	c.Assert(rp.enabled(), check.Equals, true)
+
+	_, err = newRetentionPolicyFromConfig(config.ServiceConfig{logRetentionMaxAgeConfigKey: "a month"})
+	c.Assert(err, check.NotNil)
+
+	_, err = newRetentionPolicyFromConfig(config.ServiceConfig{logRetentionKeepLastConfigKey: -1})
+	c.Assert(err, check.NotNil)
+}
+
+func (ss *storeSuite) TestRetentionPolicyExpired(c *check.C) {
+	now := time.Now()
+	day := 24 * time.Hour
+
+	// IDs 1-6; 1 is the oldest. Odd runs are in repository 1, even in 2, and 7
+	// has no repository.
+	logs := []LogInfo{}
+	repos := map[int64]int64{}
+	for id := int64(1); id <= 7; id++ {
+		logs = append(logs, LogInfo{ID: id, Size: 10, Created: now.Add(-time.Duration(8-id) * day)})
+		if id < 7 {
+			repos[id] = 2 - id%2
+		}
+	}
+
+	expired := func(rp retentionPolicy) []int64 {
+		ids := rp.expired(logs, repos, now)
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		return ids
+	}
+
+	c.Assert(expired(retentionPolicy{}), check.IsNil)
+	c.Assert(expired(retentionPolicy{MaxAge: 4*day + time.Hour}), check.DeepEquals, []int64{1, 2, 3})
+	c.Assert(expired(retentionPolicy{KeepLast: 1}), check.DeepEquals, []int64{1, 2, 3, 4})
+	c.Assert(expired(retentionPolicy{KeepLast: 2}), check.DeepEquals, []int64{1, 2})
+	c.Assert(expired(retentionPolicy{MaxSize: 35}), check.DeepEquals, []int64{1, 2, 3, 4})
+
+	// logs which don't fit free no room for older ones.
+	logs[4].Size = 100
+	c.Assert(expired(retentionPolicy{MaxSize: 35}), check.DeepEquals, []int64{1, 2, 3, 4, 5})
+
+	// limits combine; a log expired by one limit doesn't count against another.
+	logs[4].Size = 10
+	c.Assert(expired(retentionPolicy{MaxAge: 2*day + time.Hour, KeepLast: 1, MaxSize: 15}), check.DeepEquals, []int64{1, 2, 3, 4, 5, 6})
+}
+
+func (ss *storeSuite) TestSweep(c *check.C) {
+	dir, err := ioutil.TempDir("", "assetsvc")
+	c.Assert(err, check.IsNil)
+	defer os.RemoveAll(dir)
+
+	as := &AssetServer{H: &grpcHandler.H{UserConfig: config.UserConfig{ServiceConfig: config.ServiceConfig{
+		logsRootConfigKey:       dir,
+		logCompressionConfigKey: compressionNone,
+		artifactsRootConfigKey:  path.Join(dir, "artifacts"),
+	}}}}
+
+	store, err := as.logStore()
+	c.Assert(err, check.IsNil)
+
+	ctx := context.Background()
+	old := time.Now().Add(-48 * time.Hour)
+
+	for id := int64(1); id <= 3; id++ {
+		w, err := store.Create(ctx, id)
+		c.Assert(err, check.IsNil)
+		c.Assert(w.Close(), check.IsNil)
+
+		if id < 3 {
+			c.Assert(os.Chtimes(as.store.(*compressedStore).LogStore.(*filesystemStore).path(id), old, old), check.IsNil)
+		}
+	}
+
+	artifacts, err := as.artifactStore()
+	c.Assert(err, check.IsNil)
+	for id := int64(1); id <= 3; id++ {
+		_, err := artifacts.put(ctx, &asset.ArtifactInfo{RunID: id, Name: "artifact"}, strings.NewReader("content"), nil)
+		c.Assert(err, check.IsNil)
+	}
+
+	// log 2 is being rewritten, so it is left for a later sweep.
+	c.Assert(as.liveLogs().start(ctx, 2), check.IsNil)
+
+	removed, err := as.sweep(ctx, retentionPolicy{MaxAge: 24 * time.Hour}, time.Now())
+	c.Assert(err, check.IsNil)
+	c.Assert(removed, check.DeepEquals, []int64{1})
+
+	// the artifacts of the run go with its log.
+	_, err = artifacts.info(ctx, 1, "artifact")
+	c.Assert(err, check.Equals, utils.ErrNotFound)
+	_, err = artifacts.info(ctx, 2, "artifact")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(as.liveLogs().finish(ctx, 2), check.IsNil)
+
+	removed, err = as.sweep(ctx, retentionPolicy{MaxAge: 24 * time.Hour}, time.Now())
+	c.Assert(err, check.IsNil)
+	c.Assert(removed, check.DeepEquals, []int64{2})
+
+	// keeping the last logs of each repository needs the datasvc to find them.
+	_, err = as.sweep(ctx, retentionPolicy{KeepLast: 1}, time.Now())
+	c.Assert(err, check.NotNil)
+
+	// runs with artifacts but no log are swept on their own.
+	_, err = artifacts.put(ctx, &asset.ArtifactInfo{RunID: 4, Name: "artifact"}, strings.NewReader("content"), nil)
+	c.Assert(err, check.IsNil)
+	for _, kind := range []string{"files", "meta"} {
+		c.Assert(os.Chtimes(path.Join(dir, "artifacts", artifactKey(4, kind, "artifact")), old, old), check.IsNil)
+	}
+
+	removed, err = as.sweep(ctx, retentionPolicy{MaxAge: 24 * time.Hour}, time.Now())
+	c.Assert(err, check.IsNil)
+	c.Assert(removed, check.DeepEquals, []int64{4})
+	_, err = artifacts.info(ctx, 4, "artifact")
+	c.Assert(err, check.Equals, utils.ErrNotFound)
+
+	// artifacts count towards the size limit; log 3 is empty.
+	removed, err = as.sweep(ctx, retentionPolicy{MaxSize: int64(len("content"))}, time.Now())
+	c.Assert(err, check.IsNil)
+	c.Assert(removed, check.DeepEquals, []int64{3})
+	_, err = artifacts.info(ctx, 3, "artifact")
+	c.Assert(err, check.Equals, utils.ErrNotFound)
+}
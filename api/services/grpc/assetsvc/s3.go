@@ -0,0 +1,524 @@
+package assetsvc
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tinyci/ci-agents/config"
+	"github.com/tinyci/ci-agents/utils"
+)
+
+const (
+	s3EndpointConfigKey  = "log_s3_endpoint"
+	s3BucketConfigKey    = "log_s3_bucket"
+	s3RegionConfigKey    = "log_s3_region"
+	s3PrefixConfigKey    = "log_s3_prefix"
+	s3AccessKeyConfigKey = "log_s3_access_key"
+	s3SecretKeyConfigKey = "log_s3_secret_key" // #nosec
+
+	defaultS3Region = "us-east-1"
+
+	s3Algorithm        = "AWS4-HMAC-SHA256"
+	s3TimeFormat       = "20060102T150405Z"
+	s3DateFormat       = "20060102"
+	s3SignedHeaders    = "host;x-amz-content-sha256;x-amz-date"
+	s3EmptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// s3Store keeps each log as an object in an S3-compatible object store, such
+// as AWS S3 or MinIO. Requests are made path-style, signed with AWS signature
+// version 4.
+type s3Store struct {
+	client    *http.Client
+	endpoint  *url.URL
+	bucket    string
+	region    string
+	prefix    string
+	accessKey string
+	secretKey string
+}
+
+func newS3StoreFromConfig(sc config.ServiceConfig) (*s3Store, error) {
+	get := func(key string) string {
+		s, _ := sc[key].(string)
+		return s
+	}
+
+	for _, key := range []string{s3EndpointConfigKey, s3BucketConfigKey, s3AccessKeyConfigKey, s3SecretKeyConfigKey} {
+		if get(key) == "" {
+			return nil, fmt.Errorf("%q must be set to use the s3 log store", key)
+		}
+	}
+
+	region := get(s3RegionConfigKey)
+	if region == "" {
+		region = defaultS3Region
+	}
+
+	return newS3Store(get(s3EndpointConfigKey), get(s3BucketConfigKey), region, get(s3PrefixConfigKey), get(s3AccessKeyConfigKey), get(s3SecretKeyConfigKey))
+}
+
+func newS3Store(endpoint, bucket, region, prefix, accessKey, secretKey string) (*s3Store, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, utils.WrapError(err, "parsing s3 endpoint")
+	}
+
+	return &s3Store{
+		client:    &http.Client{},
+		endpoint:  u,
+		bucket:    bucket,
+		region:    region,
+		prefix:    prefix,
+		accessKey: accessKey,
+		secretKey: secretKey,
+	}, nil
+}
+
+func (s3 *s3Store) url(id int64) string {
+	return s3.objectURL(fmt.Sprintf("%d", id))
+}
+
+// indexURL is the URL of the index of the log's sections, which is kept
+// alongside it.
+func (s3 *s3Store) indexURL(id int64) string {
+	return s3.objectURL(fmt.Sprintf("%d.index", id))
+}
+
+func (s3 *s3Store) objectURL(name string) string {
+	u := *s3.endpoint
+	u.Path = path.Join("/", u.Path, s3.bucket, s3.prefix, name)
+	return u.String()
+}
+
+// bucketURL is the URL of the bucket itself, with the query attached. S3
+// wants spaces in the query encoded as %20, not as +.
+func (s3 *s3Store) bucketURL(query url.Values) string {
+	u := *s3.endpoint
+	u.Path = path.Join("/", u.Path, s3.bucket)
+	u.RawQuery = strings.ReplaceAll(query.Encode(), "+", "%20")
+	return u.String()
+}
+
+// keyPrefix is the prefix of the keys of all the logs.
+func (s3 *s3Store) keyPrefix() string {
+	if s3.prefix == "" {
+		return ""
+	}
+
+	return strings.Trim(s3.prefix, "/") + "/"
+}
+
+func (s3 *s3Store) do(ctx context.Context, method string, id int64, body io.Reader, size int64, payloadHash string) (*http.Response, error) {
+	return s3.doURL(ctx, method, s3.url(id), body, size, payloadHash)
+}
+
+func (s3 *s3Store) doURL(ctx context.Context, method, u string, body io.Reader, size int64, payloadHash string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if body != nil {
+		req.ContentLength = size
+	}
+
+	s3.sign(req, payloadHash, time.Now().UTC())
+
+	return s3.client.Do(req)
+}
+
+func (s3 *s3Store) Create(ctx context.Context, id int64) (io.WriteCloser, error) {
+	resp, err := s3.do(ctx, http.MethodHead, id, nil, 0, s3EmptyPayloadHash)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return nil, utils.ErrLogExists
+	case http.StatusNotFound:
+	default:
+		return nil, fmt.Errorf("checking for log %d in s3: %v", id, resp.Status)
+	}
+
+	f, err := ioutil.TempFile("", "tinyci-log")
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3Writer{ctx: ctx, store: s3, url: s3.url(id), what: fmt.Sprintf("log %d", id), file: f, hash: sha256.New()}, nil
+}
+
+func (s3 *s3Store) Open(ctx context.Context, id int64) (io.ReadCloser, error) {
+	resp, err := s3.do(ctx, http.MethodGet, id, nil, 0, s3EmptyPayloadHash)
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, utils.ErrNotFound
+	default:
+		resp.Body.Close()
+		return nil, fmt.Errorf("reading log %d from s3: %v", id, resp.Status)
+	}
+}
+
+// s3ListResult is the part of the ListObjectsV2 response the store uses.
+type s3ListResult struct {
+	Contents              []s3Object
+	IsTruncated           bool
+	NextContinuationToken string
+}
+
+type s3Object struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+}
+
+func (s3 *s3Store) List(ctx context.Context) ([]LogInfo, error) {
+	prefix := s3.keyPrefix()
+
+	// logs are at the top level; the delimiter leaves out anything kept
+	// below it, such as artifacts.
+	objects, err := s3.listObjects(ctx, prefix, "/")
+	if err != nil {
+		return nil, utils.WrapError(err, "listing logs in s3")
+	}
+
+	logs := []LogInfo{}
+
+	for _, obj := range objects {
+		id, err := strconv.ParseInt(strings.TrimPrefix(obj.Key, prefix), 10, 64)
+		if err != nil {
+			continue // not a log
+		}
+
+		logs = append(logs, LogInfo{ID: id, Size: obj.Size, Created: obj.LastModified})
+	}
+
+	return logs, nil
+}
+
+// listObjects lists all the objects with keys starting with the prefix. If a
+// delimiter is given, keys containing it after the prefix are left out.
+func (s3 *s3Store) listObjects(ctx context.Context, prefix, delimiter string) ([]s3Object, error) {
+	objects := []s3Object{}
+	query := url.Values{"list-type": {"2"}, "prefix": {prefix}}
+	if delimiter != "" {
+		query.Set("delimiter", delimiter)
+	}
+
+	for {
+		resp, err := s3.doURL(ctx, http.MethodGet, s3.bucketURL(query), nil, 0, s3EmptyPayloadHash)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("%v", resp.Status)
+		}
+
+		var result s3ListResult
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, result.Contents...)
+
+		if !result.IsTruncated {
+			return objects, nil
+		}
+
+		query.Set("continuation-token", result.NextContinuationToken)
+	}
+}
+
+func (s3 *s3Store) Remove(ctx context.Context, id int64) error {
+	// deleting a missing object succeeds in S3, so check for it first.
+	resp, err := s3.do(ctx, http.MethodHead, id, nil, 0, s3EmptyPayloadHash)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return utils.ErrNotFound
+	default:
+		return fmt.Errorf("checking for log %d in s3: %v", id, resp.Status)
+	}
+
+	resp, err = s3.do(ctx, http.MethodDelete, id, nil, 0, s3EmptyPayloadHash)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("removing log %d from s3: %v", id, resp.Status)
+	}
+
+	// deleting the index succeeds whether the log had one or not.
+	resp, err = s3.doURL(ctx, http.MethodDelete, s3.indexURL(id), nil, 0, s3EmptyPayloadHash)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("removing the index of log %d from s3: %v", id, resp.Status)
+	}
+
+	return nil
+}
+
+func (s3 *s3Store) WriteIndex(ctx context.Context, id int64, index []byte) error {
+	sum := sha256.Sum256(index)
+
+	resp, err := s3.doURL(ctx, http.MethodPut, s3.indexURL(id), bytes.NewReader(index), int64(len(index)), hex.EncodeToString(sum[:]))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("writing the index of log %d to s3: %v: %s", id, resp.Status, body)
+	}
+
+	return nil
+}
+
+func (s3 *s3Store) ReadIndex(ctx context.Context, id int64) ([]byte, error) {
+	resp, err := s3.doURL(ctx, http.MethodGet, s3.indexURL(id), nil, 0, s3EmptyPayloadHash)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return ioutil.ReadAll(resp.Body)
+	case http.StatusNotFound:
+		return nil, utils.ErrNotFound
+	default:
+		return nil, fmt.Errorf("reading the index of log %d from s3: %v", id, resp.Status)
+	}
+}
+
+func (s3 *s3Store) putBlob(ctx context.Context, key string, r io.Reader) error {
+	f, err := ioutil.TempFile("", "tinyci-blob")
+	if err != nil {
+		return err
+	}
+
+	sw := &s3Writer{ctx: ctx, store: s3, url: s3.objectURL(key), what: key, file: f, hash: sha256.New()}
+	if _, err := io.Copy(sw, r); err != nil {
+		sw.abort()
+		return err
+	}
+
+	return sw.Close()
+}
+
+func (s3 *s3Store) openBlob(ctx context.Context, key string) (io.ReadCloser, error) {
+	resp, err := s3.doURL(ctx, http.MethodGet, s3.objectURL(key), nil, 0, s3EmptyPayloadHash)
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, utils.ErrNotFound
+	default:
+		resp.Body.Close()
+		return nil, fmt.Errorf("reading %s from s3: %v", key, resp.Status)
+	}
+}
+
+func (s3 *s3Store) listBlobs(ctx context.Context, dir string) ([]string, error) {
+	prefix := s3.keyPrefix() + strings.Trim(dir, "/") + "/"
+
+	objects, err := s3.listObjects(ctx, prefix, "/")
+	if err != nil {
+		return nil, utils.WrapError(err, "listing %s in s3", dir)
+	}
+
+	names := []string{}
+	for _, obj := range objects {
+		names = append(names, strings.TrimPrefix(obj.Key, prefix))
+	}
+
+	return names, nil
+}
+
+func (s3 *s3Store) walkBlobs(ctx context.Context, dir string) ([]blobInfo, error) {
+	prefix := s3.keyPrefix()
+	if dir = strings.Trim(dir, "/"); dir != "" {
+		prefix += dir + "/"
+	}
+
+	objects, err := s3.listObjects(ctx, prefix, "")
+	if err != nil {
+		return nil, utils.WrapError(err, "listing %s in s3", dir)
+	}
+
+	blobs := []blobInfo{}
+	for _, obj := range objects {
+		blobs = append(blobs, blobInfo{Key: strings.TrimPrefix(obj.Key, prefix), Size: obj.Size, Modified: obj.LastModified})
+	}
+
+	return blobs, nil
+}
+
+func (s3 *s3Store) removeBlob(ctx context.Context, key string) error {
+	resp, err := s3.doURL(ctx, http.MethodDelete, s3.objectURL(key), nil, 0, s3EmptyPayloadHash)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("removing %s from s3: %v", key, resp.Status)
+	}
+
+	return nil
+}
+
+func (s3 *s3Store) removeBlobs(ctx context.Context, dir string) (bool, error) {
+	prefix := s3.keyPrefix() + strings.Trim(dir, "/") + "/"
+
+	objects, err := s3.listObjects(ctx, prefix, "")
+	if err != nil {
+		return false, utils.WrapError(err, "listing %s in s3", dir)
+	}
+
+	for _, obj := range objects {
+		resp, err := s3.doURL(ctx, http.MethodDelete, s3.objectURL(strings.TrimPrefix(obj.Key, s3.keyPrefix())), nil, 0, s3EmptyPayloadHash)
+		if err != nil {
+			return false, err
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+			return false, fmt.Errorf("removing %s from s3: %v", obj.Key, resp.Status)
+		}
+	}
+
+	return len(objects) > 0, nil
+}
+
+// sign adds the headers for AWS signature version 4 to the request.
+func (s3 *s3Store) sign(req *http.Request, payloadHash string, now time.Time) {
+	amzDate := now.Format(s3TimeFormat)
+	scope := strings.Join([]string{now.Format(s3DateFormat), s3.region, "s3", "aws4_request"}, "/")
+
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+
+	canonical := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		"host:" + req.URL.Host,
+		"x-amz-content-sha256:" + payloadHash,
+		"x-amz-date:" + amzDate,
+		"",
+		s3SignedHeaders,
+		payloadHash,
+	}, "\n")
+
+	sum := sha256.Sum256([]byte(canonical))
+	toSign := strings.Join([]string{s3Algorithm, amzDate, scope, hex.EncodeToString(sum[:])}, "\n")
+
+	key := []byte("AWS4" + s3.secretKey)
+	for _, part := range strings.Split(scope, "/") {
+		key = hmacSHA256(key, part)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, s3.accessKey, scope, s3SignedHeaders, hex.EncodeToString(hmacSHA256(key, toSign)),
+	))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data)) // #nosec
+	return h.Sum(nil)
+}
+
+// s3Writer spools the log to a temporary file, as the object's size and
+// checksum must be known before it is uploaded, and uploads it on Close.
+type s3Writer struct {
+	ctx   context.Context
+	store *s3Store
+	url   string
+	what  string // what is being written, for errors
+	file  *os.File
+	hash  hash.Hash
+	size  int64
+}
+
+func (sw *s3Writer) Write(p []byte) (int, error) {
+	n, err := sw.file.Write(p)
+	sw.hash.Write(p[:n]) // #nosec
+	sw.size += int64(n)
+	return n, err
+}
+
+func (sw *s3Writer) Close() error {
+	defer sw.abort()
+
+	if _, err := sw.file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	resp, err := sw.store.doURL(sw.ctx, http.MethodPut, sw.url, sw.file, sw.size, hex.EncodeToString(sw.hash.Sum(nil)))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("writing %s to s3: %v: %s", sw.what, resp.Status, body)
+	}
+
+	return nil
+}
+
+// abort removes the spooled content without uploading it.
+func (sw *s3Writer) abort() {
+	sw.file.Close()
+	os.Remove(sw.file.Name())
+}
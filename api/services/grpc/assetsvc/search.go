@@ -0,0 +1,247 @@
+package assetsvc
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tinyci/ci-agents/ci-gen/grpc/services/asset"
+	"github.com/tinyci/ci-agents/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultSearchWindow = 24 * time.Hour
+	defaultSearchLimit  = 100
+	maxSearchContext    = 100
+)
+
+// ansiPattern matches the terminal escape sequences logs are colored with:
+// CSI sequences such as colors, OSC sequences such as titles, and the
+// remaining two-byte escapes.
+var ansiPattern = regexp.MustCompile("\x1b\\[[0-9:;<=>?]*[ -/]*[@-~]|\x1b\\][^\x07\x1b]*(?:\x07|\x1b\\\\)|\x1b[@-Z\\\\-_]")
+
+// stripANSI removes terminal escape sequences from the text.
+func stripANSI(text string) string {
+	return ansiPattern.ReplaceAllString(text, "")
+}
+
+// SearchLogs finds the lines matching a pattern in the logs of a repository
+// written within a window of time. Color codes are stripped from the logs
+// before they are matched.
+func (as *AssetServer) SearchLogs(ctx context.Context, req *asset.SearchRequest) (*asset.SearchResponse, error) {
+	res, err := as.search(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	return res, nil
+}
+
+func (as *AssetServer) search(ctx context.Context, req *asset.SearchRequest) (*asset.SearchResponse, error) {
+	if req.Pattern == "" {
+		return nil, errors.New("a pattern is required")
+	}
+
+	re, err := regexp.Compile(req.Pattern)
+	if err != nil {
+		return nil, utils.WrapError(err, "invalid pattern")
+	}
+
+	if req.Context < 0 || req.Context > maxSearchContext {
+		return nil, errors.New("context must be between 0 and 100 lines")
+	}
+
+	if req.Limit < 0 {
+		return nil, errors.New("limit cannot be negative")
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultSearchLimit
+	}
+
+	until := time.Now()
+	if req.Until.IsValid() {
+		until = req.Until.AsTime()
+	}
+
+	since := until.Add(-defaultSearchWindow)
+	if req.Since.IsValid() {
+		since = req.Since.AsTime()
+	}
+
+	logs, err := as.searchable(ctx, req.Repository, since, until)
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := as.logStore()
+	if err != nil {
+		return nil, err
+	}
+
+	res := &asset.SearchResponse{}
+
+	for _, log := range logs {
+		if len(res.Matches) == limit {
+			// the remaining logs were not searched.
+			res.Truncated = true
+			break
+		}
+
+		matches, truncated, err := searchLog(ctx, store, log.ID, re, int(req.Context), limit-len(res.Matches))
+		if err != nil {
+			if errors.Is(err, utils.ErrNotFound) {
+				continue // removed since it was listed
+			}
+
+			return nil, err
+		}
+
+		res.Matches = append(res.Matches, matches...)
+		if truncated {
+			res.Truncated = true
+			break
+		}
+	}
+
+	return res, nil
+}
+
+// searchable lists the finished logs of the repository written within the
+// window, newest first. All repositories are searched if none is given.
+func (as *AssetServer) searchable(ctx context.Context, repository string, since, until time.Time) ([]LogInfo, error) {
+	store, err := as.logStore()
+	if err != nil {
+		return nil, err
+	}
+
+	all, err := store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	writing, err := as.liveLogs().writing(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := []LogInfo{}
+	ids := []int64{}
+
+	for _, log := range all {
+		if log.Created.Before(since) || log.Created.After(until) || writing[log.ID] {
+			continue
+		}
+
+		logs = append(logs, log)
+		ids = append(ids, log.ID)
+	}
+
+	if repository != "" && len(logs) > 0 {
+		if as.H.Clients == nil || as.H.Clients.Data == nil {
+			return nil, errors.New("searching the logs of a repository requires the datasvc")
+		}
+
+		repo, err := as.H.Clients.Data.GetRepository(ctx, repository)
+		if err != nil {
+			return nil, err
+		}
+
+		repos, err := as.H.Clients.Data.RunRepositories(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+
+		filtered := []LogInfo{}
+		for _, log := range logs {
+			if id, ok := repos[log.ID]; ok && id == repo.Id {
+				filtered = append(filtered, log)
+			}
+		}
+
+		logs = filtered
+	}
+
+	sort.Slice(logs, func(i, j int) bool {
+		if logs[i].Created.Equal(logs[j].Created) {
+			return logs[i].ID > logs[j].ID
+		}
+
+		return logs[i].Created.After(logs[j].Created)
+	})
+
+	return logs, nil
+}
+
+// searchLog returns up to max matches for the pattern in the log, with n
+// lines of context around each. truncated is true if the log had more.
+func searchLog(ctx context.Context, store LogStore, id int64, re *regexp.Regexp, n, max int) (matches []*asset.SearchMatch, truncated bool, err error) {
+	log, err := store.Open(ctx, id)
+	if err != nil {
+		return nil, false, err
+	}
+	defer log.Close()
+
+	var (
+		br      = bufio.NewReader(log)
+		before  = []string{}
+		pending = []*asset.SearchMatch{} // matches still collecting context after them
+		lineNo  int64
+	)
+
+	for {
+		line, err := br.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return nil, false, err
+		}
+
+		if line == "" && err == io.EOF {
+			return matches, false, nil
+		}
+
+		lineNo++
+		text := stripANSI(strings.TrimRight(line, "\r\n"))
+
+		for len(pending) > 0 && len(pending[0].After) == n {
+			pending = pending[1:]
+		}
+
+		for _, m := range pending {
+			m.After = append(m.After, text)
+		}
+
+		if re.MatchString(text) {
+			if len(matches) == max {
+				truncated = true
+			} else {
+				m := &asset.SearchMatch{RunID: id, Line: lineNo, Text: text, Before: append([]string{}, before...), After: []string{}}
+				matches = append(matches, m)
+				pending = append(pending, m)
+			}
+		}
+
+		// past the limit, only the context of the last matches is still wanted.
+		if truncated && (len(pending) == 0 || len(pending[len(pending)-1].After) == n) {
+			return matches, true, nil
+		}
+
+		if n > 0 {
+			before = append(before, text)
+			if len(before) > n {
+				before = before[1:]
+			}
+		}
+
+		if err == io.EOF {
+			return matches, false, nil
+		}
+	}
+}
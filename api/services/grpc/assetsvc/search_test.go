@@ -0,0 +1,124 @@
+package assetsvc
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"time"
+
+	check "github.com/erikh/check"
+	"github.com/fatih/color"
+	grpcHandler "github.com/tinyci/ci-agents/api/handlers/grpc"
+	"github.com/tinyci/ci-agents/ci-gen/grpc/services/asset"
+	"github.com/tinyci/ci-agents/config"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (ss *storeSuite) TestStripANSI(c *check.C) {
+	c.Assert(stripANSI("plain"), check.Equals, "plain")
+	c.Assert(stripANSI(color.New(color.FgRed, color.Bold).Sprint("FAIL")+": TestFoo"), check.Equals, "FAIL: TestFoo")
+	c.Assert(stripANSI("\x1b[2K\x1b[1Aprogress \x1b[38;5;208m50%\x1b[0m"), check.Equals, "progress 50%")
+	c.Assert(stripANSI("\x1b]0;title\x07text"), check.Equals, "text")
+}
+
+func (ss *storeSuite) TestSearchLog(c *check.C) {
+	dir, err := ioutil.TempDir("", "assetsvc")
+	c.Assert(err, check.IsNil)
+	defer os.RemoveAll(dir)
+
+	store, err := newFilesystemStore(dir)
+	c.Assert(err, check.IsNil)
+
+	ctx := context.Background()
+	w, err := store.Create(ctx, 1)
+	c.Assert(err, check.IsNil)
+	_, err = w.Write([]byte("one\r\n\x1b[31mFAIL\x1b[0m two\nthree\nFAIL four\nfive\nsix\nFAIL seven"))
+	c.Assert(err, check.IsNil)
+	c.Assert(w.Close(), check.IsNil)
+
+	re := regexp.MustCompile("^FAIL")
+
+	matches, truncated, err := searchLog(ctx, store, 1, re, 1, 10)
+	c.Assert(err, check.IsNil)
+	c.Assert(truncated, check.Equals, false)
+	c.Assert(matches, check.DeepEquals, []*asset.SearchMatch{
+		{RunID: 1, Line: 2, Text: "FAIL two", Before: []string{"one"}, After: []string{"three"}},
+		{RunID: 1, Line: 4, Text: "FAIL four", Before: []string{"three"}, After: []string{"five"}},
+		{RunID: 1, Line: 7, Text: "FAIL seven", Before: []string{"six"}, After: []string{}},
+	})
+
+	// the last match still gets its context when the limit is reached.
+	matches, truncated, err = searchLog(ctx, store, 1, re, 2, 2)
+	c.Assert(err, check.IsNil)
+	c.Assert(truncated, check.Equals, true)
+	c.Assert(len(matches), check.Equals, 2)
+	c.Assert(matches[1].After, check.DeepEquals, []string{"five", "six"})
+
+	_, _, err = searchLog(ctx, store, 2, re, 0, 10)
+	c.Assert(err, check.NotNil)
+}
+
+func (ss *storeSuite) TestSearchWindow(c *check.C) {
+	dir, err := ioutil.TempDir("", "assetsvc")
+	c.Assert(err, check.IsNil)
+	defer os.RemoveAll(dir)
+
+	as := &AssetServer{H: &grpcHandler.H{UserConfig: config.UserConfig{ServiceConfig: config.ServiceConfig{
+		logsRootConfigKey:       dir,
+		logCompressionConfigKey: compressionNone,
+	}}}}
+
+	store, err := as.logStore()
+	c.Assert(err, check.IsNil)
+
+	ctx := context.Background()
+	old := time.Now().Add(-48 * time.Hour)
+
+	for id := int64(1); id <= 3; id++ {
+		w, err := store.Create(ctx, id)
+		c.Assert(err, check.IsNil)
+		_, err = w.Write([]byte("error: broken\n"))
+		c.Assert(err, check.IsNil)
+		c.Assert(w.Close(), check.IsNil)
+
+		if id == 1 {
+			c.Assert(os.Chtimes(as.store.(*compressedStore).LogStore.(*filesystemStore).path(id), old, old), check.IsNil)
+		}
+	}
+
+	runIDs := func(res *asset.SearchResponse) []int64 {
+		ids := []int64{}
+		for _, m := range res.Matches {
+			ids = append(ids, m.RunID)
+		}
+		return ids
+	}
+
+	res, err := as.search(ctx, &asset.SearchRequest{Pattern: "error"})
+	c.Assert(err, check.IsNil)
+	c.Assert(runIDs(res), check.DeepEquals, []int64{3, 2})
+	c.Assert(res.Truncated, check.Equals, false)
+
+	res, err = as.search(ctx, &asset.SearchRequest{Pattern: "error", Since: timestamppb.New(old.Add(-time.Hour))})
+	c.Assert(err, check.IsNil)
+	c.Assert(runIDs(res), check.DeepEquals, []int64{3, 2, 1})
+
+	res, err = as.search(ctx, &asset.SearchRequest{Pattern: "error", Since: timestamppb.New(old.Add(-time.Hour)), Until: timestamppb.New(old.Add(time.Hour))})
+	c.Assert(err, check.IsNil)
+	c.Assert(runIDs(res), check.DeepEquals, []int64{1})
+
+	res, err = as.search(ctx, &asset.SearchRequest{Pattern: "error", Limit: 1})
+	c.Assert(err, check.IsNil)
+	c.Assert(runIDs(res), check.DeepEquals, []int64{3})
+	c.Assert(res.Truncated, check.Equals, true)
+
+	_, err = as.search(ctx, &asset.SearchRequest{Pattern: "("})
+	c.Assert(err, check.NotNil)
+	_, err = as.search(ctx, &asset.SearchRequest{})
+	c.Assert(err, check.NotNil)
+
+	// finding the runs of a repository needs the datasvc.
+	_, err = as.search(ctx, &asset.SearchRequest{Pattern: "error", Repository: "erikh/test"})
+	c.Assert(err, check.NotNil)
+}
@@ -0,0 +1,225 @@
+package assetsvc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tinyci/ci-agents/ci-gen/grpc/services/asset"
+	"github.com/tinyci/ci-agents/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Runs mark the sections of their logs with lines of their own:
+//
+//	::group::build
+//	...
+//	::endgroup::
+//
+// Markers may be indented or colored. Sections don't nest; a ::group:: line
+// inside a section ends it and starts the next one.
+const (
+	groupMarker    = "::group::"
+	endGroupMarker = "::endgroup::"
+
+	// maxMarkerLength is the most of a line kept to look for a marker in.
+	maxMarkerLength = 1024
+)
+
+// sectionParser finds the sections of a log as it is written, building its
+// index. It is safe to read the index while the log is being written.
+type sectionParser struct {
+	mutex sync.Mutex
+	now   func() time.Time
+
+	offset    int64  // bytes parsed
+	lineStart int64  // offset of the current line
+	line      int64  // complete lines parsed
+	partial   []byte // start of the current line
+
+	sections []*asset.LogSection
+	open     *asset.LogSection
+}
+
+func newSectionParser() *sectionParser {
+	return &sectionParser{now: time.Now}
+}
+
+// write parses the next chunk of the log.
+func (sp *sectionParser) write(chunk []byte) {
+	sp.mutex.Lock()
+	defer sp.mutex.Unlock()
+
+	for len(chunk) > 0 {
+		i := bytes.IndexByte(chunk, '\n')
+		if i < 0 {
+			sp.appendPartial(chunk)
+			sp.offset += int64(len(chunk))
+			return
+		}
+
+		sp.appendPartial(chunk[:i])
+		sp.offset += int64(i + 1)
+		sp.endLine()
+		chunk = chunk[i+1:]
+	}
+}
+
+func (sp *sectionParser) appendPartial(buf []byte) {
+	if room := maxMarkerLength - len(sp.partial); room > 0 {
+		if len(buf) > room {
+			buf = buf[:room]
+		}
+
+		sp.partial = append(sp.partial, buf...)
+	}
+}
+
+// endLine must be called with the mutex held, once the line is complete.
+func (sp *sectionParser) endLine() {
+	sp.line++
+	text := strings.TrimSpace(stripANSI(string(sp.partial)))
+
+	switch {
+	case strings.HasPrefix(text, groupMarker):
+		sp.closeSection(sp.lineStart, sp.line-1)
+		sp.open = &asset.LogSection{
+			Name:    strings.TrimSpace(strings.TrimPrefix(text, groupMarker)),
+			Offset:  sp.offset,
+			Line:    sp.line + 1,
+			Started: timestamppb.New(sp.now()),
+		}
+	case text == endGroupMarker:
+		sp.closeSection(sp.lineStart, sp.line-1)
+	}
+
+	sp.partial = sp.partial[:0]
+	sp.lineStart = sp.offset
+}
+
+// closeSection ends the open section, if any, before the offset. lastLine is
+// the number of its last line.
+func (sp *sectionParser) closeSection(end, lastLine int64) {
+	if sp.open == nil {
+		return
+	}
+
+	sp.open.Length = end - sp.open.Offset
+	sp.open.Lines = lastLine - sp.open.Line + 1
+	sp.open.Finished = timestamppb.New(sp.now())
+	sp.sections = append(sp.sections, sp.open)
+	sp.open = nil
+}
+
+// finish ends the log, closing any section which is still open.
+func (sp *sectionParser) finish() {
+	sp.mutex.Lock()
+	defer sp.mutex.Unlock()
+
+	if sp.offset > sp.lineStart {
+		sp.endLine()
+	}
+
+	sp.closeSection(sp.offset, sp.line)
+}
+
+// index returns a copy of the sections found so far, including the one still
+// being written.
+func (sp *sectionParser) index() []*asset.LogSection {
+	sp.mutex.Lock()
+	defer sp.mutex.Unlock()
+
+	sections := make([]*asset.LogSection, 0, len(sp.sections)+1)
+	for _, section := range sp.sections {
+		sections = append(sections, proto.Clone(section).(*asset.LogSection))
+	}
+
+	if sp.open != nil {
+		open := proto.Clone(sp.open).(*asset.LogSection)
+		open.Length = sp.offset - open.Offset
+		open.Lines = sp.line - open.Line + 1
+		if sp.offset > sp.lineStart {
+			open.Lines++
+		}
+
+		sections = append(sections, open)
+	}
+
+	return sections
+}
+
+// GetLogSections returns the index of the sections of a log. Logs which are
+// still being written return the sections found so far; logs without any
+// sections return an empty index.
+func (as *AssetServer) GetLogSections(ctx context.Context, req *asset.LogSectionsRequest) (*asset.LogSections, error) {
+	sections, err := as.logSections(ctx, req.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	return sections, nil
+}
+
+func (as *AssetServer) logSections(ctx context.Context, id int64) (*asset.LogSections, error) {
+	content, live, err := as.liveLogs().read(ctx, id, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if live {
+		sp := newSectionParser()
+		sp.write(content)
+		return &asset.LogSections{Sections: sp.index()}, nil
+	}
+
+	store, err := as.logStore()
+	if err != nil {
+		return nil, err
+	}
+
+	buf, err := store.ReadIndex(ctx, id)
+	if errors.Is(err, utils.ErrNotFound) {
+		// logs written before sections were indexed, or without any, have no
+		// index; make sure the log itself exists.
+		log, err := store.Open(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		log.Close()
+
+		return &asset.LogSections{Complete: true}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	sections := &asset.LogSections{}
+	if err := proto.Unmarshal(buf, sections); err != nil {
+		return nil, utils.WrapError(err, "reading the section index of log %d", id)
+	}
+
+	return sections, nil
+}
+
+// writeIndex stores the index of the finished log's sections next to it.
+// Nothing is stored for logs without sections.
+func writeIndex(ctx context.Context, store LogStore, id int64, sp *sectionParser) error {
+	sp.finish()
+
+	sections := sp.index()
+	if len(sections) == 0 {
+		return nil
+	}
+
+	buf, err := proto.Marshal(&asset.LogSections{Sections: sections, Complete: true})
+	if err != nil {
+		return err
+	}
+
+	return store.WriteIndex(ctx, id, buf)
+}
@@ -0,0 +1,71 @@
+package assetsvc
+
+import (
+	"time"
+
+	check "github.com/erikh/check"
+	"github.com/tinyci/ci-agents/ci-gen/grpc/services/asset"
+)
+
+type sectionSummary struct {
+	Name           string
+	Offset, Length int64
+	Line, Lines    int64
+	Finished       bool
+}
+
+func summarizeSections(sections []*asset.LogSection) []sectionSummary {
+	ret := []sectionSummary{}
+	for _, s := range sections {
+		ret = append(ret, sectionSummary{s.Name, s.Offset, s.Length, s.Line, s.Lines, s.Finished.IsValid()})
+	}
+
+	return ret
+}
+
+func (ss *storeSuite) TestSectionParser(c *check.C) {
+	log := "setup\n::group::build\nmake\nok\n::endgroup::\n  \x1b[1m::group:: test \x1b[0m\ngo test\n::group::lint\n"
+
+	// feed it a byte at a time, to split the markers across chunks.
+	sp := newSectionParser()
+	for i := range log {
+		sp.write([]byte{log[i]})
+	}
+
+	build := int64(len("setup\n::group::build\n"))
+	test := build + int64(len("make\nok\n::endgroup::\n  \x1b[1m::group:: test \x1b[0m\n"))
+	lint := test + int64(len("go test\n::group::lint\n"))
+
+	c.Assert(summarizeSections(sp.index()), check.DeepEquals, []sectionSummary{
+		{"build", build, int64(len("make\nok\n")), 3, 2, true},
+		{"test", test, int64(len("go test\n")), 7, 1, true},
+		{"lint", lint, 0, 9, 0, false},
+	})
+
+	sp.write([]byte("golint\nno newline"))
+	c.Assert(summarizeSections(sp.index())[2], check.DeepEquals, sectionSummary{"lint", lint, int64(len("golint\nno newline")), 9, 2, false})
+
+	sp.finish()
+	c.Assert(summarizeSections(sp.index())[2], check.DeepEquals, sectionSummary{"lint", lint, int64(len("golint\nno newline")), 9, 2, true})
+}
+
+func (ss *storeSuite) TestSectionParserDurations(c *check.C) {
+	now := time.Unix(1000, 0)
+
+	sp := newSectionParser()
+	sp.now = func() time.Time { return now }
+
+	sp.write([]byte("::group::slow\n"))
+	now = now.Add(time.Minute)
+	sp.write([]byte("::endgroup::\n::endgroup::\nnot a ::group::\n"))
+
+	sections := sp.index()
+	c.Assert(len(sections), check.Equals, 1)
+	c.Assert(sections[0].Finished.AsTime().Sub(sections[0].Started.AsTime()), check.Equals, time.Minute)
+
+	// logs without markers have no sections.
+	sp = newSectionParser()
+	sp.write([]byte("plain\nlog\n"))
+	sp.finish()
+	c.Assert(len(sp.index()), check.Equals, 0)
+}
@@ -25,6 +25,7 @@ func TestAssetSvc(t *testing.T) {
 
 func (as *assetsvcSuite) SetUpTest(c *check.C) {
 	os.RemoveAll("/var/tinyci/logs")
+	os.RemoveAll("/var/tinyci/artifacts")
 	var err error
 	as.assetsvcHandler, as.assetsvcDoneChan, err = MakeAssetServer()
 	c.Assert(err, check.IsNil)
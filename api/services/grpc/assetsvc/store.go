@@ -0,0 +1,95 @@
+package assetsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+const (
+	logStoreConfigKey = "log_store"
+
+	logStoreFilesystem = "filesystem"
+	logStoreS3         = "s3"
+	logStorePostgres   = "postgres"
+)
+
+// LogStore is where the assetsvc keeps run logs. Each log is written once, by
+// run ID; logs which are still being written are tailed through the
+// AssetServer's logHub rather than the store, so stores need not support
+// reading a log before it has been closed.
+type LogStore interface {
+	// Create starts a new log for the run. utils.ErrLogExists is returned if
+	// the run already has one. The log is complete once the writer is closed.
+	Create(ctx context.Context, id int64) (io.WriteCloser, error)
+	// Open reads the log for the run, returning utils.ErrNotFound if there is
+	// none.
+	Open(ctx context.Context, id int64) (io.ReadCloser, error)
+	// List lists the logs in the store.
+	List(ctx context.Context) ([]LogInfo, error)
+	// Remove deletes the log for the run, along with its index, returning
+	// utils.ErrNotFound if there is none.
+	Remove(ctx context.Context, id int64) error
+	// WriteIndex stores the index of the sections of the run's log next to
+	// it, once the log has been written.
+	WriteIndex(ctx context.Context, id int64, index []byte) error
+	// ReadIndex reads the index of the sections of the run's log, returning
+	// utils.ErrNotFound if it has none.
+	ReadIndex(ctx context.Context, id int64) ([]byte, error)
+}
+
+// LogInfo describes a stored log.
+type LogInfo struct {
+	ID      int64     // ID of the run
+	Size    int64     // Size of the log as stored, after any compression
+	Created time.Time // When the log was written
+}
+
+// newLogStore creates the log store named by the `log_store` service setting;
+// the filesystem store is the default. Logs are compressed as set by
+// `log_compression`.
+func (as *AssetServer) newLogStore() (LogStore, error) {
+	compression, ok := as.H.ServiceConfig[logCompressionConfigKey].(string)
+	if !ok {
+		compression = compressionGzip
+	}
+
+	store, err := as.newBackingStore()
+	if err != nil {
+		return nil, err
+	}
+
+	return newCompressedStore(store, compression)
+}
+
+func (as *AssetServer) newBackingStore() (LogStore, error) {
+	kind, ok := as.H.ServiceConfig[logStoreConfigKey].(string)
+	if !ok {
+		kind = logStoreFilesystem
+	}
+
+	switch kind {
+	case logStoreFilesystem:
+		return newFilesystemStore(as.getLogsRoot())
+	case logStoreS3:
+		return newS3StoreFromConfig(as.H.ServiceConfig)
+	case logStorePostgres:
+		if as.H.Model == nil {
+			return nil, errors.New("the postgres log store requires the service to use the database")
+		}
+
+		return newPostgresStore(as.H.Model), nil
+	default:
+		return nil, fmt.Errorf("invalid log store %q: must be one of filesystem, s3 or postgres", kind)
+	}
+}
+
+func (as *AssetServer) logStore() (LogStore, error) {
+	as.storeOnce.Do(func() {
+		as.store, as.storeErr = as.newLogStore()
+	})
+
+	return as.store, as.storeErr
+}
@@ -0,0 +1,399 @@
+package assetsvc
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	check "github.com/erikh/check"
+	"github.com/tinyci/ci-agents/utils"
+)
+
+type storeSuite struct{}
+
+var _ = check.Suite(&storeSuite{})
+
+// fakeS3 is a just-enough, in-memory S3 for testing the s3 store. Requests
+// must be signed with the access key "access" and the secret key "secret".
+type fakeS3 struct {
+	mutex   sync.Mutex
+	objects map[string][]byte
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if err := verifySigV4(r, body, "access", "secret"); err != nil {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(err.Error())) // #nosec
+		return
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if r.URL.Path == "/logs" && r.Method == http.MethodGet && r.URL.Query().Get("list-type") == "2" {
+		f.list(w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodDelete:
+		delete(f.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodPut:
+		f.objects[r.URL.Path] = body
+	case http.MethodGet, http.MethodHead:
+		buf, ok := f.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if r.Method == http.MethodGet {
+			w.Write(buf) // #nosec
+		}
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// verifySigV4 checks the request's AWS signature version 4, following the
+// specification rather than the store's signing code, so that a mistake in
+// the latter shows up here.
+func verifySigV4(r *http.Request, body []byte, accessKey, secretKey string) error {
+	auth := strings.TrimPrefix(r.Header.Get("Authorization"), s3Algorithm+" ")
+	if auth == r.Header.Get("Authorization") {
+		return errors.New("unsupported authorization")
+	}
+
+	fields := map[string]string{}
+	for _, field := range strings.Split(auth, ", ") {
+		parts := strings.SplitN(field, "=", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("invalid authorization field %q", field)
+		}
+		fields[parts[0]] = parts[1]
+	}
+
+	credential := strings.Split(fields["Credential"], "/")
+	if len(credential) != 5 || credential[0] != accessKey || credential[3] != "s3" || credential[4] != "aws4_request" {
+		return fmt.Errorf("invalid credential %q", fields["Credential"])
+	}
+
+	amzDate := r.Header.Get("x-amz-date")
+	date, err := time.Parse(s3TimeFormat, amzDate)
+	if err != nil {
+		return err
+	}
+
+	if credential[1] != date.Format(s3DateFormat) {
+		return errors.New("the credential's date does not match the request's")
+	}
+
+	if d := time.Since(date); d > 15*time.Minute || d < -15*time.Minute {
+		return errors.New("the request has expired")
+	}
+
+	payloadHash := r.Header.Get("x-amz-content-sha256")
+	sum := sha256.Sum256(body)
+	if payloadHash != hex.EncodeToString(sum[:]) {
+		return errors.New("the payload does not match its hash")
+	}
+
+	signed := strings.Split(fields["SignedHeaders"], ";")
+	if !sort.StringsAreSorted(signed) {
+		return errors.New("the signed headers are not sorted")
+	}
+
+	var headers []string
+	required := map[string]bool{"host": true, "x-amz-content-sha256": true, "x-amz-date": true}
+
+	for _, name := range signed {
+		value := r.Header.Get(name)
+		if name == "host" {
+			value = r.Host
+		}
+
+		delete(required, name)
+		headers = append(headers, name+":"+strings.TrimSpace(value))
+	}
+
+	if len(required) > 0 {
+		return errors.New("required headers are not signed")
+	}
+
+	// the canonical query string encodes its keys and values, sorted, with
+	// spaces as %20.
+	query := strings.ReplaceAll(r.URL.Query().Encode(), "+", "%20")
+
+	canonical := strings.Join([]string{
+		r.Method,
+		r.URL.EscapedPath(),
+		query,
+		strings.Join(headers, "\n") + "\n",
+		fields["SignedHeaders"],
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join(credential[1:], "/")
+	hashed := sha256.Sum256([]byte(canonical))
+	toSign := strings.Join([]string{s3Algorithm, amzDate, scope, hex.EncodeToString(hashed[:])}, "\n")
+
+	key := []byte("AWS4" + secretKey)
+	for _, part := range credential[1:] {
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(part)) // #nosec
+		key = mac.Sum(nil)
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(toSign)) // #nosec
+
+	signature, err := hex.DecodeString(fields["Signature"])
+	if err != nil {
+		return err
+	}
+
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return errors.New("the signature does not match")
+	}
+
+	return nil
+}
+
+// list returns one key per page, to exercise the continuation of listings.
+func (f *fakeS3) list(w http.ResponseWriter, r *http.Request) {
+	prefix, delimiter := r.URL.Query().Get("prefix"), r.URL.Query().Get("delimiter")
+
+	keys := []string{}
+	for p := range f.objects {
+		key := strings.TrimPrefix(p, "/logs/")
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+
+		// keys below the delimiter are rolled up into common prefixes, which
+		// aren't listed.
+		if delimiter != "" && strings.Contains(strings.TrimPrefix(key, prefix), delimiter) {
+			continue
+		}
+
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	start, _ := strconv.Atoi(r.URL.Query().Get("continuation-token"))
+
+	type content struct {
+		Key          string
+		Size         int64
+		LastModified time.Time
+	}
+
+	result := struct {
+		XMLName               xml.Name `xml:"ListBucketResult"`
+		Contents              []content
+		IsTruncated           bool
+		NextContinuationToken string `xml:",omitempty"`
+	}{}
+
+	if start < len(keys) {
+		result.Contents = []content{{Key: keys[start], Size: int64(len(f.objects["/logs/"+keys[start]])), LastModified: time.Now()}}
+		result.IsTruncated = start+1 < len(keys)
+		result.NextContinuationToken = strconv.Itoa(start + 1)
+	}
+
+	xml.NewEncoder(w).Encode(result) // #nosec
+}
+
+func testLogStore(c *check.C, store LogStore) {
+	ctx := context.Background()
+
+	_, err := store.Open(ctx, 1)
+	c.Assert(err, check.Equals, utils.ErrNotFound)
+
+	w, err := store.Create(ctx, 1)
+	c.Assert(err, check.IsNil)
+	_, err = w.Write([]byte("hello, "))
+	c.Assert(err, check.IsNil)
+	_, err = w.Write([]byte("world"))
+	c.Assert(err, check.IsNil)
+	c.Assert(w.Close(), check.IsNil)
+
+	_, err = store.Create(ctx, 1)
+	c.Assert(err, check.Equals, utils.ErrLogExists)
+
+	r, err := store.Open(ctx, 1)
+	c.Assert(err, check.IsNil)
+	buf, err := ioutil.ReadAll(r)
+	c.Assert(err, check.IsNil)
+	c.Assert(r.Close(), check.IsNil)
+	c.Assert(string(buf), check.Equals, "hello, world")
+
+	w, err = store.Create(ctx, 2)
+	c.Assert(err, check.IsNil)
+	c.Assert(w.Close(), check.IsNil)
+
+	logs, err := store.List(ctx)
+	c.Assert(err, check.IsNil)
+	sort.Slice(logs, func(i, j int) bool { return logs[i].ID < logs[j].ID })
+	c.Assert(len(logs), check.Equals, 2)
+	c.Assert(logs[0].ID, check.Equals, int64(1))
+	c.Assert(logs[0].Size, check.Equals, int64(len("hello, world")))
+	c.Assert(logs[1].ID, check.Equals, int64(2))
+	c.Assert(logs[1].Size, check.Equals, int64(0))
+	c.Assert(time.Since(logs[0].Created) < time.Minute, check.Equals, true)
+
+	_, err = store.ReadIndex(ctx, 1)
+	c.Assert(err, check.Equals, utils.ErrNotFound)
+	c.Assert(store.WriteIndex(ctx, 1, []byte("index")), check.IsNil)
+	index, err := store.ReadIndex(ctx, 1)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(index), check.Equals, "index")
+
+	// the index is not a log of its own.
+	logs, err = store.List(ctx)
+	c.Assert(err, check.IsNil)
+	c.Assert(len(logs), check.Equals, 2)
+
+	c.Assert(store.Remove(ctx, 1), check.IsNil)
+	c.Assert(store.Remove(ctx, 1), check.Equals, utils.ErrNotFound)
+
+	_, err = store.ReadIndex(ctx, 1)
+	c.Assert(err, check.Equals, utils.ErrNotFound)
+
+	_, err = store.Open(ctx, 1)
+	c.Assert(err, check.Equals, utils.ErrNotFound)
+
+	logs, err = store.List(ctx)
+	c.Assert(err, check.IsNil)
+	c.Assert(len(logs), check.Equals, 1)
+	c.Assert(logs[0].ID, check.Equals, int64(2))
+}
+
+func (ss *storeSuite) TestFilesystemStore(c *check.C) {
+	dir, err := ioutil.TempDir("", "assetsvc")
+	c.Assert(err, check.IsNil)
+	defer os.RemoveAll(dir)
+
+	store, err := newFilesystemStore(dir)
+	c.Assert(err, check.IsNil)
+	testLogStore(c, store)
+}
+
+func (ss *storeSuite) TestS3Store(c *check.C) {
+	fake := &fakeS3{objects: map[string][]byte{}}
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+
+	store, err := newS3Store(srv.URL, "logs", defaultS3Region, "tinyci", "access", "secret")
+	c.Assert(err, check.IsNil)
+	testLogStore(c, store)
+
+	c.Assert(string(fake.objects["/logs/tinyci/2"]), check.Equals, "")
+	fake.objects["/logs/other/3"] = []byte("not ours")
+
+	logs, err := store.List(context.Background())
+	c.Assert(err, check.IsNil)
+	c.Assert(len(logs), check.Equals, 1)
+
+	store.secretKey = "wrong"
+	_, err = store.Open(context.Background(), 2)
+	c.Assert(err, check.NotNil)
+
+	store.secretKey = "secret"
+	store.accessKey = "wrong"
+	_, err = store.Open(context.Background(), 2)
+	c.Assert(err, check.NotNil)
+}
+
+func (ss *storeSuite) TestS3StoreConfig(c *check.C) {
+	_, err := newS3StoreFromConfig(map[string]interface{}{
+		s3EndpointConfigKey: "http://localhost:9000",
+		s3BucketConfigKey:   "logs",
+	})
+	c.Assert(err, check.NotNil)
+
+	store, err := newS3StoreFromConfig(map[string]interface{}{
+		s3EndpointConfigKey:  "http://localhost:9000",
+		s3BucketConfigKey:    "logs",
+		s3AccessKeyConfigKey: "access",
+		s3SecretKeyConfigKey: "secret",
+	})
+	c.Assert(err, check.IsNil)
+	c.Assert(store.region, check.Equals, defaultS3Region)
+	c.Assert(store.url(2), check.Equals, "http://localhost:9000/logs/2")
+}
+
+func (ss *storeSuite) TestLogHub(c *check.C) {
+	var hub logHub = &memoryHub{}
+	ctx := context.Background()
+
+	_, live, err := hub.read(ctx, 1, 0)
+	c.Assert(err, check.IsNil)
+	c.Assert(live, check.Equals, false)
+
+	c.Assert(hub.start(ctx, 1), check.IsNil)
+	c.Assert(hub.start(ctx, 1), check.Equals, utils.ErrLogWriting)
+
+	writing, err := hub.writing(ctx)
+	c.Assert(err, check.IsNil)
+	c.Assert(writing, check.DeepEquals, map[int64]bool{1: true})
+
+	c.Assert(hub.publish(ctx, 1, 0, []byte("before")), check.IsNil)
+
+	notify, unsubscribe := hub.subscribe(1)
+	defer unsubscribe()
+
+	buf, live, err := hub.read(ctx, 1, 0)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(buf), check.Equals, "before")
+	c.Assert(live, check.Equals, true)
+
+	woken := func() bool {
+		select {
+		case <-notify:
+			return true
+		case <-time.After(time.Second):
+			return false
+		}
+	}
+
+	c.Assert(hub.publish(ctx, 1, 6, []byte(" after")), check.IsNil)
+	c.Assert(woken(), check.Equals, true)
+
+	buf, live, err = hub.read(ctx, 1, 6)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(buf), check.Equals, " after")
+	c.Assert(live, check.Equals, true)
+
+	// once finished, the rest of the log is read from the store.
+	c.Assert(hub.finish(ctx, 1), check.IsNil)
+	c.Assert(woken(), check.Equals, true)
+
+	_, live, err = hub.read(ctx, 1, 0)
+	c.Assert(err, check.IsNil)
+	c.Assert(live, check.Equals, false)
+
+	writing, err = hub.writing(ctx)
+	c.Assert(err, check.IsNil)
+	c.Assert(len(writing), check.Equals, 0)
+}
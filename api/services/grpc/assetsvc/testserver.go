@@ -26,7 +26,9 @@ func MakeAssetServer() (*grpcHandler.H, chan struct{}, error) {
 	}
 
 	srv := grpc.NewServer()
-	asset.RegisterAssetServer(srv, &AssetServer{H: h})
+	as := &AssetServer{H: h}
+	asset.RegisterAssetServer(srv, as)
+	h.Background(as.SweepLogs)
 
 	doneChan, err := h.Boot(t, srv, make(chan struct{}))
 	return h, doneChan, err
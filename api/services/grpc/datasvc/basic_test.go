@@ -7,15 +7,19 @@ import (
 	"path"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	check "github.com/erikh/check"
 	"github.com/golang/mock/gomock"
 	"github.com/tinyci/ci-agents/ci-gen/grpc/types"
 	"github.com/tinyci/ci-agents/config"
+	"github.com/tinyci/ci-agents/db"
+	"github.com/tinyci/ci-agents/db/models"
 	"github.com/tinyci/ci-agents/mocks/github"
 	"github.com/tinyci/ci-agents/testutil"
 	topTypes "github.com/tinyci/ci-agents/types"
+	"github.com/volatiletech/sqlboiler/v4/boil"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -306,7 +310,7 @@ func (ds *datasvcSuite) TestQueue(c *check.C) {
 
 	fmt.Printf("Filling queue took %v\n", time.Since(now))
 
-	for i := 0; i < 1000; i++ {
+	for i := 0; i < 900; i++ {
 		qi, err := ds.client.Client().NextQueueItem(ctx, "default", "hi")
 		c.Assert(err, check.IsNil)
 		c.Assert(qi.Running, check.Equals, true)
@@ -315,10 +319,175 @@ func (ds *datasvcSuite) TestQueue(c *check.C) {
 		c.Assert(qi.Run.StartedAt.IsValid(), check.Equals, true)
 	}
 
+	for _, count := range []int{30, 30, 30, 10} {
+		qis, err := ds.client.Client().NextQueueItems(ctx, "default", "hi", 30)
+		c.Assert(err, check.IsNil)
+		c.Assert(len(qis), check.Equals, count)
+
+		for _, qi := range qis {
+			c.Assert(qi.Running, check.Equals, true)
+			c.Assert(qi.RunningOn, check.Equals, "hi")
+		}
+	}
+
 	_, err := ds.client.Client().NextQueueItem(ctx, "default", "hi")
 	c.Assert(err, check.NotNil)
 }
 
+func (ds *datasvcSuite) TestQueueWatch(c *check.C) {
+	config.SetDefaultGithubClient(github.NewMockClient(gomock.NewController(c)), "")
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	wakes := make(chan struct{}, 10)
+	errChan := make(chan error, 1)
+
+	go func() {
+		errChan <- ds.client.Client().WatchQueue(ctx, "default", func() error {
+			wakes <- struct{}{}
+			return nil
+		})
+	}()
+
+	// the watch notifies once it is in place, and then for each new item.
+	for i := 0; i < 3; i++ {
+		select {
+		case <-wakes:
+		case err := <-errChan:
+			c.Assert(err, check.IsNil)
+			c.Fatal("watch ended early")
+		case <-time.After(10 * time.Second):
+			c.Fatal("no notification received")
+		}
+
+		if i < 2 {
+			_, err := ds.client.MakeQueueItem()
+			c.Assert(err, check.IsNil)
+		}
+	}
+
+	cancel()
+	c.Assert(<-errChan, check.NotNil)
+}
+
+func (ds *datasvcSuite) TestWatchCancel(c *check.C) {
+	config.SetDefaultGithubClient(github.NewMockClient(gomock.NewController(c)), "")
+
+	qi, err := ds.client.MakeQueueItem()
+	c.Assert(err, check.IsNil)
+
+	type result struct {
+		canceled bool
+		err      error
+	}
+
+	resChan := make(chan result, 1)
+
+	go func() {
+		canceled, err := ds.client.Client().WatchCancel(ctx, qi.Run.Id)
+		resChan <- result{canceled, err}
+	}()
+
+	select {
+	case <-resChan:
+		c.Fatal("watch ended before the run was canceled")
+	case <-time.After(time.Second):
+	}
+
+	c.Assert(ds.client.Client().SetCancel(ctx, qi.Run.Id), check.IsNil)
+
+	select {
+	case res := <-resChan:
+		c.Assert(res.err, check.IsNil)
+		c.Assert(res.canceled, check.Equals, true)
+	case <-time.After(10 * time.Second):
+		c.Fatal("no cancellation received")
+	}
+
+	// the run is already canceled, so watching it returns at once
+	canceled, err := ds.client.Client().WatchCancel(ctx, qi.Run.Id)
+	c.Assert(err, check.IsNil)
+	c.Assert(canceled, check.Equals, true)
+}
+
+func (ds *datasvcSuite) TestTimeOutRun(c *check.C) {
+	client := github.NewMockClient(gomock.NewController(c))
+	client.EXPECT().FinishedStatus(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	config.SetDefaultGithubClient(client, "")
+
+	qi, err := ds.client.MakeQueueItem()
+	c.Assert(err, check.IsNil)
+
+	qi, err = ds.client.Client().NextQueueItem(ctx, qi.QueueName, "hi")
+	c.Assert(err, check.IsNil)
+
+	resChan := make(chan bool, 1)
+
+	go func() {
+		canceled, err := ds.client.Client().WatchCancel(ctx, qi.Run.Id)
+		c.Check(err, check.IsNil)
+		resChan <- canceled
+	}()
+
+	select {
+	case <-resChan:
+		c.Fatal("watch ended before the run timed out")
+	case <-time.After(time.Second):
+	}
+
+	c.Assert(ds.client.Client().TimeOutRun(ctx, qi.Run.Id, "The run timed out"), check.IsNil)
+
+	// the runner watching for the cancellation stops the run.
+	select {
+	case canceled := <-resChan:
+		c.Assert(canceled, check.Equals, true)
+	case <-time.After(10 * time.Second):
+		c.Fatal("no cancellation received")
+	}
+
+	run, err := ds.client.Client().GetRun(ctx, qi.Run.Id)
+	c.Assert(err, check.IsNil)
+	c.Assert(run.Status, check.Equals, false)
+	c.Assert(run.FailureReason, check.Equals, topTypes.FailureReasonTimeout)
+
+	// the run is finished, so it cannot time out twice.
+	c.Assert(ds.client.Client().TimeOutRun(ctx, qi.Run.Id, "The run timed out"), check.NotNil)
+}
+
+func (ds *datasvcSuite) TestQueueListExpired(c *check.C) {
+	config.SetDefaultGithubClient(github.NewMockClient(gomock.NewController(c)), "")
+
+	qi, err := ds.client.MakeQueueItem()
+	c.Assert(err, check.IsNil)
+
+	_, err = ds.client.Client().ListExpiredQueueItems(ctx, qi.QueueName, 0)
+	c.Assert(err, check.NotNil)
+
+	qis, err := ds.client.Client().ListExpiredQueueItems(ctx, qi.QueueName, time.Hour)
+	c.Assert(err, check.IsNil)
+	c.Assert(len(qis), check.Equals, 0)
+
+	time.Sleep(1100 * time.Millisecond)
+
+	qis, err = ds.client.Client().ListExpiredQueueItems(ctx, qi.QueueName, time.Second)
+	c.Assert(err, check.IsNil)
+	c.Assert(len(qis), check.Equals, 1)
+	c.Assert(qis[0].Run.Id, check.Equals, qi.Run.Id)
+
+	// items handed out no longer expire.
+	_, err = ds.client.Client().NextQueueItem(ctx, qi.QueueName, "hi")
+	c.Assert(err, check.IsNil)
+
+	qis, err = ds.client.Client().ListExpiredQueueItems(ctx, qi.QueueName, time.Second)
+	c.Assert(err, check.IsNil)
+	c.Assert(len(qis), check.Equals, 0)
+
+	runs, err := ds.client.Client().ListTimedOutRuns(ctx)
+	c.Assert(err, check.IsNil)
+	c.Assert(len(runs), check.Equals, 0)
+}
+
 func (ds *datasvcSuite) TestOAuth(c *check.C) {
 	c.Assert(ds.client.Client().OAuthRegisterState(ctx, "asdf", []string{"repo"}), check.IsNil)
 	res, err := ds.client.Client().OAuthValidateState(ctx, "asdf")
@@ -507,3 +676,70 @@ func (ds *datasvcSuite) TestSubmissions(c *check.C) {
 	_, err = ds.client.Client().CountSubmissions(ctx, "a/b", "")
 	c.Assert(err, check.NotNil)
 }
+
+func (ds *datasvcSuite) TestCheckRunOutput(c *check.C) {
+	failures := models.TestCaseSlice{
+		{Suite: "auth", Name: "TestLogin", Status: topTypes.TestFailed, Message: "auth_test.go:42: expected 200, got 500"},
+		{Suite: "auth", Name: "TestLogout", Status: topTypes.TestError, Message: "panic: runtime error"},
+	}
+
+	summary := testSummaryMarkdown(&db.TestSummary{Total: 10, Passed: 8, Failed: 1, Errored: 1, Duration: 1.5}, failures, "http://example.org/log/1")
+	c.Assert(strings.Contains(summary, "| 10 | 8 | 1 | 1 | 0 | 1.50s |"), check.Equals, true)
+	c.Assert(strings.Contains(summary, "#### auth: TestLogin (failed)"), check.Equals, true)
+	c.Assert(strings.Contains(summary, "#### auth: TestLogout (error)"), check.Equals, true)
+	c.Assert(strings.Contains(summary, "(http://example.org/log/1)"), check.Equals, true)
+
+	annotations := testAnnotations(failures)
+	c.Assert(len(annotations), check.Equals, 1)
+	c.Assert(annotations[0].Path, check.Equals, "auth_test.go")
+	c.Assert(annotations[0].Line, check.Equals, 42)
+	c.Assert(annotations[0].Title, check.Equals, "TestLogin")
+}
+
+func (ds *datasvcSuite) TestQuarantinedFailures(c *check.C) {
+	client := github.NewMockClient(gomock.NewController(c))
+	client.EXPECT().FinishedStatus(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	config.SetDefaultGithubClient(client, "")
+
+	// makeRun returns a run whose only failed test is quarantined.
+	makeRun := func() int64 {
+		qi, err := ds.client.MakeQueueItem()
+		c.Assert(err, check.IsNil)
+
+		task, err := ds.model.GetTaskForRun(ctx, qi.Run.Id)
+		c.Assert(err, check.IsNil)
+
+		ts := &topTypes.TaskSettings{}
+		c.Assert(task.TaskSettings.Unmarshal(ts), check.IsNil)
+		ts.Config.Quarantine = []string{"TestFlaky"}
+
+		task.TaskSettings, err = json.Marshal(ts)
+		c.Assert(err, check.IsNil)
+		_, err = task.Update(ctx, ds.model.GetDB(), boil.Infer())
+		c.Assert(err, check.IsNil)
+
+		c.Assert(ds.model.AddTestCases(ctx, qi.Run.Id, models.TestCaseSlice{
+			{Name: "TestFlaky", Status: topTypes.TestFailed},
+			{Name: "TestStable", Status: topTypes.TestPassed},
+		}), check.IsNil)
+
+		return qi.Run.Id
+	}
+
+	// failures which aren't attributed to the tests still fail the run.
+	for _, reason := range []string{"", topTypes.FailureReasonFailure, topTypes.FailureReasonInfraError} {
+		id := makeRun()
+		c.Assert(ds.client.Client().PutStatus(ctx, id, false, reason, ""), check.IsNil)
+
+		run, err := ds.client.Client().GetRun(ctx, id)
+		c.Assert(err, check.IsNil)
+		c.Assert(run.Status, check.Equals, false, check.Commentf("%q", reason))
+	}
+
+	id := makeRun()
+	c.Assert(ds.client.Client().PutStatus(ctx, id, false, topTypes.FailureReasonTests, ""), check.IsNil)
+
+	run, err := ds.client.Client().GetRun(ctx, id)
+	c.Assert(err, check.IsNil)
+	c.Assert(run.Status, check.Equals, true)
+}
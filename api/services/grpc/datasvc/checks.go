@@ -0,0 +1,159 @@
+package datasvc
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/tinyci/ci-agents/clients/github"
+	"github.com/tinyci/ci-agents/db"
+	"github.com/tinyci/ci-agents/db/models"
+	topTypes "github.com/tinyci/ci-agents/types"
+)
+
+// maxCheckFailures is the most failed tests described in a check run's summary.
+const maxCheckFailures = 20
+
+// fileLineRegexp finds the first file and line named in a test's output, e.g.
+// "auth_test.go:42" or "src/test/Auth.java:42".
+var fileLineRegexp = regexp.MustCompile(`([\w./-]+\.\w+):(\d+)`)
+
+// usesChecks returns true if the repository of the run reports through the
+// Checks API.
+func (ds *DataServer) usesChecks(ctx context.Context, runID int64) (bool, error) {
+	config, err := ds.H.Model.GetRepoConfigForRun(ctx, runID)
+	if err != nil {
+		return false, err
+	}
+
+	return config.UsesChecks(), nil
+}
+
+// statusClient returns the client the state of the run is reported to github
+// with: the GitHub App's if its repository uses the Checks API, as only apps
+// can write check runs, or the repository owner's otherwise.
+func (ds *DataServer) statusClient(ctx context.Context, u *models.User, checks bool, bits *db.RunDetail) (github.Client, error) {
+	if checks {
+		return ds.H.GithubApp.GithubClient(ctx, bits.Owner, bits.Repo)
+	}
+
+	return ds.H.OAuth.GithubClient(u.Username, u.Token)
+}
+
+// finishedCheckRun describes the finished run as a check run. If the run
+// uploaded test reports, its summary counts the tests and describes those
+// which failed, annotating the failures whose output names a file and line.
+// Runs which timed out or expired in the queue conclude as timed out. Failed
+// runs can be re-run from github.
+func (ds *DataServer) finishedCheckRun(ctx context.Context, bits *db.RunDetail, title string) (*github.CheckRun, error) {
+	cr := &github.CheckRun{
+		Name:       bits.Run.Name,
+		SHA:        bits.HeadSHA,
+		URL:        fmt.Sprintf("%s/log/%d", ds.H.URL, bits.Run.ID),
+		ExternalID: fmt.Sprintf("%d", bits.Run.ID),
+		Status:     github.CheckCompleted,
+		Conclusion: github.CheckSuccess,
+		Title:      title,
+		Rerun:      !bits.Run.Status.Bool,
+	}
+
+	if !bits.Run.Status.Bool {
+		cr.Conclusion = github.CheckFailure
+
+		switch bits.Run.FailureReason.String {
+		case topTypes.FailureReasonTimeout, topTypes.FailureReasonExpired:
+			cr.Conclusion = github.CheckTimedOut
+		}
+	}
+
+	summary, err := ds.H.Model.GetTestSummary(ctx, bits.Run.ID, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if summary.Total == 0 {
+		cr.Summary = fmt.Sprintf("The run finished: %s! See the [log](%s) for details.", cr.Conclusion, cr.URL)
+		return cr, nil
+	}
+
+	var failures models.TestCaseSlice
+
+	for _, status := range []string{topTypes.TestFailed, topTypes.TestError} {
+		cases, err := ds.H.Model.ListTestCases(ctx, bits.Run.ID, status)
+		if err != nil {
+			return nil, err
+		}
+
+		failures = append(failures, cases...)
+	}
+
+	cr.Summary = testSummaryMarkdown(summary, failures, cr.URL)
+	cr.Annotations = testAnnotations(failures)
+
+	return cr, nil
+}
+
+// testSummaryMarkdown tabulates the test summary and describes the first of
+// the failed tests.
+func testSummaryMarkdown(summary *db.TestSummary, failures models.TestCaseSlice, logURL string) string {
+	var b strings.Builder
+
+	fmt.Fprintln(&b, "| Total | Passed | Failed | Errored | Skipped | Duration |")
+	fmt.Fprintln(&b, "| ---: | ---: | ---: | ---: | ---: | ---: |")
+	fmt.Fprintf(&b, "| %d | %d | %d | %d | %d | %.2fs |\n", summary.Total, summary.Passed, summary.Failed, summary.Errored, summary.Skipped, summary.Duration)
+
+	if len(failures) > 0 {
+		fmt.Fprintln(&b, "\n### Failed tests")
+	}
+
+	for i, tc := range failures {
+		if i == maxCheckFailures {
+			fmt.Fprintf(&b, "\n...and %d more.\n", len(failures)-i)
+			break
+		}
+
+		name := tc.Name
+		if tc.Suite != "" && tc.Suite != tc.Name {
+			name = tc.Suite + ": " + tc.Name
+		}
+
+		fmt.Fprintf(&b, "\n#### %s (%s)\n", name, tc.Status)
+		if tc.Message != "" {
+			fmt.Fprintf(&b, "\n```\n%s\n```\n", strings.ReplaceAll(tc.Message, "```", "'''"))
+		}
+	}
+
+	fmt.Fprintf(&b, "\nSee the [log](%s) for details.\n", logURL)
+
+	return b.String()
+}
+
+// testAnnotations annotates the failed tests whose output names a file and
+// line.
+func testAnnotations(failures models.TestCaseSlice) []*github.CheckAnnotation {
+	annotations := []*github.CheckAnnotation{}
+
+	for _, tc := range failures {
+		match := fileLineRegexp.FindStringSubmatch(tc.Message)
+		if match == nil {
+			continue
+		}
+
+		line, err := strconv.Atoi(match[2])
+		if err != nil || line == 0 {
+			continue
+		}
+
+		annotations = append(annotations, &github.CheckAnnotation{
+			Path:    strings.TrimPrefix(match[1], "./"),
+			Line:    line,
+			Level:   "failure",
+			Title:   tc.Name,
+			Message: tc.Message,
+		})
+	}
+
+	return annotations
+}
@@ -4,12 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/tinyci/ci-agents/ci-gen/grpc/services/data"
 	"github.com/tinyci/ci-agents/ci-gen/grpc/types"
+	"github.com/tinyci/ci-agents/clients/github"
+	"github.com/tinyci/ci-agents/clients/log"
 	"github.com/tinyci/ci-agents/db"
 	"github.com/tinyci/ci-agents/db/models"
+	topTypes "github.com/tinyci/ci-agents/types"
 	"github.com/tinyci/ci-agents/utils"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -112,9 +116,10 @@ func (ds *DataServer) QueueAdd(ctx context.Context, list *data.QueueList) (*data
 	return retList, nil
 }
 
-// QueueNext returns the next item for the named queue.
+// QueueNext returns the next item for the named queue that the runner's labels
+// satisfy.
 func (ds *DataServer) QueueNext(ctx context.Context, r *types.QueueRequest) (*types.QueueItem, error) {
-	qi, err := ds.H.Model.NextQueueItem(ctx, r.RunningOn, r.QueueName)
+	qi, err := ds.H.Model.NextQueueItem(ctx, r.RunningOn, r.QueueName, r.Labels...)
 	if err != nil {
 		if errors.Is(err, utils.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, "not found")
@@ -135,14 +140,124 @@ func (ds *DataServer) QueueNext(ctx context.Context, r *types.QueueRequest) (*ty
 	return ret.(*types.QueueItem), nil
 }
 
-// PutStatus sets the status for the given run_id
+// QueueNextItems claims up to the requested count of the next items for the
+// named queue that the runner's labels satisfy.
+func (ds *DataServer) QueueNextItems(ctx context.Context, r *types.QueueRequest) (*data.QueueList, error) {
+	qis, err := ds.H.Model.NextQueueItems(ctx, r.RunningOn, r.QueueName, int(r.Count), r.Labels...)
+	if err != nil {
+		if errors.Is(err, utils.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	list := &data.QueueList{}
+
+	for _, qi := range qis {
+		ret, err := ds.C.ToProto(ctx, qi)
+		if err != nil {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		list.Items = append(list.Items, ret.(*types.QueueItem))
+	}
+
+	return list, nil
+}
+
+// QueueListExpired lists the items of the named queue which have waited to be
+// handed out for longer than the TTL given, counting only the time they could
+// be claimed; see db.Model.ExpiredQueueItems.
+func (ds *DataServer) QueueListExpired(ctx context.Context, qe *data.QueueExpiry) (*data.QueueList, error) {
+	if qe.Ttl <= 0 {
+		return nil, status.Error(codes.FailedPrecondition, "the TTL must be positive")
+	}
+
+	qis, err := ds.H.Model.ExpiredQueueItems(ctx, qe.QueueName, time.Duration(qe.Ttl)*time.Second)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	list := &data.QueueList{}
+
+	for _, qi := range qis {
+		ret, err := ds.C.ToProto(ctx, qi)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		list.Items = append(list.Items, ret.(*types.QueueItem))
+	}
+
+	return list, nil
+}
+
+// QueueWatch notifies the caller whenever items are added to the named queue,
+// or the other queues named by the runner's labels, or put back into them, or
+// may have been freed by a run or task finishing, and once as soon as the
+// watch is in place. It returns when the caller goes away.
+func (ds *DataServer) QueueWatch(r *types.QueueRequest, stream data.Data_QueueWatchServer) error {
+	wake, cancel := ds.H.Model.WatchQueue(r.QueueName, r.Labels...)
+	defer cancel()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-wake:
+			if err := stream.Send(&types.QueueNotification{QueueName: r.QueueName}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// PutStatus sets the status for the given run_id. The reason a failed run
+// failed for is recorded with it. Failed runs whose retry policy allows it are
+// retried instead, leaving the GitHub status pending until the final attempt
+// finishes. The GitHub status of a failed run names its
+// failed tests if it uploaded test reports before finishing. Runs which the
+// runner reports failed for their tests (types.FailureReasonTests) pass when
+// all of their failed tests are quarantined in the repository's tinyci.yml.
+// Repositories using the Checks API get a check run instead, summarizing the
+// test reports and annotating failed tests.
 func (ds *DataServer) PutStatus(ctx context.Context, s *types.Status) (*empty.Empty, error) {
 	u, err := ds.H.Model.GetOwnerForRun(ctx, s.Id)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := ds.H.Model.SetRunStatus(ctx, s.Id, s.Status); err != nil {
+	var quarantined []string
+
+	// only failures the runner attributes to the tests can be excused by
+	// quarantined tests; anything else failing after them must still fail.
+	if !s.Status && s.Reason == topTypes.FailureReasonTests {
+		quarantined, err = ds.H.Model.QuarantinedFailures(ctx, s.Id)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		s.Status = len(quarantined) > 0
+	}
+
+	if !s.Status {
+		retry, err := ds.H.Model.RetryRun(ctx, s.Id, s.Reason)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		if retry != nil {
+			return &empty.Empty{}, ds.retried(ctx, u, retry)
+		}
+	}
+
+	reason := s.Reason
+	if !s.Status && reason == "" {
+		reason = topTypes.FailureReasonFailure
+	}
+
+	if err := ds.H.Model.SetRunStatus(ctx, s.Id, s.Status, reason); err != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
 	}
 
@@ -151,19 +266,176 @@ func (ds *DataServer) PutStatus(ctx context.Context, s *types.Status) (*empty.Em
 		return nil, err
 	}
 
+	details := []*db.RunDetail{bits}
+	messages := map[int64]string{bits.Run.ID: "The run completed!"}
+	if s.AdditionalMessage != "" {
+		messages[bits.Run.ID] = s.AdditionalMessage
+	} else if len(quarantined) > 0 {
+		messages[bits.Run.ID] = quarantineSummary(quarantined)
+	} else if !s.Status {
+		// name the failed tests, if the run uploaded a test report.
+		summary, err := ds.H.Model.GetTestSummary(ctx, bits.Run.ID, maxSummaryFailures)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		if msg := failureSummary(summary); msg != "" {
+			messages[bits.Run.ID] = msg
+		}
+	}
+
+	if bits.Run.Attempt > 1 {
+		messages[bits.Run.ID] += fmt.Sprintf(" (attempt %d)", bits.Run.Attempt)
+	}
+
+	if !s.Status {
+		neededRuns, err := ds.H.Model.FailDependentRuns(ctx, bits.Run.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		for _, run := range neededRuns {
+			messages[run.ID] = "A run this run needs has failed"
+		}
+
+		runs, err := ds.H.Model.FailDependentTasks(ctx, bits.Run.TaskID)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		for _, run := range runs {
+			messages[run.ID] = "A task this run depends on has failed"
+		}
+
+		for _, run := range append(neededRuns, runs...) {
+			depBits, err := ds.H.Model.GetRunDetail(ctx, run.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			details = append(details, depBits)
+		}
+	}
+
+	if err := ds.reportFinished(ctx, u, details, messages); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// TimeOutRun cancels a run which exceeded its timeout, so its runner stops it,
+// and fails it for timing out through PutStatus, so it is retried if its retry
+// policy allows. The rest of its task goes on. Will fail on runs which are not
+// running.
+func (ds *DataServer) TimeOutRun(ctx context.Context, s *types.Status) (*empty.Empty, error) {
+	if err := ds.H.Model.TimeOutRun(ctx, s.Id); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	return ds.PutStatus(ctx, &types.Status{Id: s.Id, Status: false, Reason: topTypes.FailureReasonTimeout, AdditionalMessage: s.AdditionalMessage})
+}
+
+// reportFinished reports the finished runs to GitHub with their messages, as
+// check runs if the repository uses the Checks API.
+func (ds *DataServer) reportFinished(ctx context.Context, u *models.User, details []*db.RunDetail, messages map[int64]string) error {
+	if len(details) == 0 {
+		return nil
+	}
+
+	checks, err := ds.usesChecks(ctx, details[0].Run.ID)
+	if err != nil {
+		return err
+	}
+
+	checkRuns := map[int64]*github.CheckRun{}
+	if checks {
+		for _, bits := range details {
+			cr, err := ds.finishedCheckRun(ctx, bits, messages[bits.Run.ID])
+			if err != nil {
+				return err
+			}
+
+			checkRuns[bits.Run.ID] = cr
+		}
+	}
+
+	go func(ds *DataServer, u *models.User, details []*db.RunDetail) {
+		client, err := ds.statusClient(context.Background(), u, checks, details[0])
+		if err != nil {
+			ds.H.Clients.Log.Error(context.Background(), utils.WrapError(err, "while creating github client"))
+			return
+		}
+
+		for _, bits := range details {
+			if cr, ok := checkRuns[bits.Run.ID]; ok {
+				err = client.ReportCheckRun(context.Background(), bits.Owner, bits.Repo, cr)
+			} else {
+				err = client.FinishedStatus(context.Background(), bits.Owner, bits.Repo, bits.Run.Name, bits.HeadSHA, fmt.Sprintf("%s/log/%d", ds.H.URL, bits.Run.ID), bits.Run.Status.Bool, messages[bits.Run.ID])
+			}
+
+			if err != nil {
+				ds.H.Clients.Log.Error(context.Background(), err)
+			}
+		}
+	}(ds, u, details)
+
+	return nil
+}
+
+// retried logs the new attempt of a failed run and marks it pending on GitHub,
+// so the status shown is that of the final attempt.
+func (ds *DataServer) retried(ctx context.Context, u *models.User, retry *models.Run) error {
+	bits, err := ds.H.Model.GetRunDetail(ctx, retry.ID)
+	if err != nil {
+		return err
+	}
+
+	ds.H.Clients.Log.WithFields(log.FieldMap{
+		"run_id":           fmt.Sprintf("%d", retry.ID),
+		"previous_attempt": fmt.Sprintf("%d", retry.PreviousAttemptID.Int64),
+		"attempt":          fmt.Sprintf("%d", retry.Attempt),
+	}).Info(ctx, "Retrying failed run")
+
+	return ds.setPending(ctx, u, bits)
+}
+
+// setPending marks the new attempt of a run pending on GitHub, or queues its
+// check run.
+func (ds *DataServer) setPending(ctx context.Context, u *models.User, bits *db.RunDetail) error {
+	checks, err := ds.usesChecks(ctx, bits.Run.ID)
+	if err != nil {
+		return err
+	}
+
 	go func(ds *DataServer, u *models.User, bits *db.RunDetail) {
-		client, err := ds.H.OAuth.GithubClient(u.Username, u.Token)
+		client, err := ds.statusClient(context.Background(), u, checks, bits)
 		if err != nil {
 			ds.H.Clients.Log.Error(context.Background(), utils.WrapError(err, "while creating github client"))
 			return
 		}
 
-		if err := client.FinishedStatus(context.Background(), bits.Owner, bits.Repo, bits.Run.Name, bits.HeadSHA, fmt.Sprintf("%s/log/%d", ds.H.URL, s.Id), s.Status, "The run completed!"); err != nil {
+		url := fmt.Sprintf("%s/log/%d", ds.H.URL, bits.Run.ID)
+
+		if checks {
+			err = client.ReportCheckRun(context.Background(), bits.Owner, bits.Repo, &github.CheckRun{
+				Name:       bits.Run.Name,
+				SHA:        bits.HeadSHA,
+				URL:        url,
+				ExternalID: fmt.Sprintf("%d", bits.Run.ID),
+				Status:     github.CheckQueued,
+				Title:      fmt.Sprintf("Attempt %d of the run will be starting soon.", bits.Run.Attempt),
+			})
+		} else {
+			err = client.PendingStatus(context.Background(), bits.Owner, bits.Repo, bits.Run.Name, bits.HeadSHA, url)
+		}
+
+		if err != nil {
 			ds.H.Clients.Log.Error(context.Background(), err)
 		}
 	}(ds, u, bits)
 
-	return &empty.Empty{}, nil
+	return nil
 }
 
 // SetCancel flags the run (which will flag the rest of the task's runs) as
@@ -183,14 +455,36 @@ func (ds *DataServer) SetCancel(ctx context.Context, id *types.IntID) (*empty.Em
 		return nil, err
 	}
 
+	checks, err := ds.usesChecks(ctx, id.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
 	go func(ds *DataServer, u *models.User, bits *db.RunDetail) {
-		client, err := ds.H.UserConfig.OAuth.GithubClient(u.Username, u.Token)
+		client, err := ds.statusClient(context.Background(), u, checks, bits)
 		if err != nil {
 			ds.H.Clients.Log.Error(context.Background(), utils.WrapError(err, "while creating github client"))
 			return
 		}
 
-		if err := client.ErrorStatus(context.Background(), bits.Owner, bits.Repo, bits.Run.Name, bits.HeadSHA, fmt.Sprintf("%s/log/%d", ds.H.URL, id.ID), utils.ErrRunCanceled); err != nil {
+		url := fmt.Sprintf("%s/log/%d", ds.H.URL, id.ID)
+
+		if checks {
+			err = client.ReportCheckRun(context.Background(), bits.Owner, bits.Repo, &github.CheckRun{
+				Name:       bits.Run.Name,
+				SHA:        bits.HeadSHA,
+				URL:        url,
+				ExternalID: fmt.Sprintf("%d", id.ID),
+				Status:     github.CheckCompleted,
+				Conclusion: github.CheckCancelled,
+				Title:      "The run was canceled",
+				Rerun:      true,
+			})
+		} else {
+			err = client.ErrorStatus(context.Background(), bits.Owner, bits.Repo, bits.Run.Name, bits.HeadSHA, url, utils.ErrRunCanceled)
+		}
+
+		if err != nil {
 			ds.H.Clients.Log.Error(context.Background(), err)
 		}
 	}(ds, u, bits)
@@ -198,14 +492,65 @@ func (ds *DataServer) SetCancel(ctx context.Context, id *types.IntID) (*empty.Em
 	return &empty.Empty{}, nil
 }
 
-// GetCancel returns the canceled state for the run.
+// GetCancel returns the canceled state for the run. Runs are canceled with
+// their task, or on their own when they time out.
 func (ds *DataServer) GetCancel(ctx context.Context, id *types.IntID) (*types.Status, error) {
-	s := &types.Status{Id: id.ID}
-	task, err := ds.H.Model.GetTaskForRun(ctx, id.ID)
+	canceled, _, err := ds.runCanceled(ctx, id.ID)
 	if err != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
 	}
 
-	s.Status = task.Canceled
-	return s, nil
+	return &types.Status{Id: id.ID, Status: canceled}, nil
+}
+
+// WatchCancel sends the canceled state of the run once it or its task is
+// canceled. The stream ends without sending anything if the task finishes
+// otherwise, or when the caller goes away.
+func (ds *DataServer) WatchCancel(id *types.IntID, stream data.Data_WatchCancelServer) error {
+	ctx := stream.Context()
+
+	task, err := ds.H.Model.GetTaskForRun(ctx, id.ID)
+	if err != nil {
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	wake, cancel := ds.H.Model.WatchCancel(task.ID)
+	defer cancel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-wake:
+		}
+
+		canceled, finished, err := ds.runCanceled(ctx, id.ID)
+		if err != nil {
+			return status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		if canceled {
+			return stream.Send(&types.Status{Id: id.ID, Status: true})
+		}
+
+		if finished {
+			return nil
+		}
+	}
+}
+
+// runCanceled returns whether the run or its task was canceled, and whether
+// its task has finished.
+func (ds *DataServer) runCanceled(ctx context.Context, runID int64) (bool, bool, error) {
+	run, err := ds.H.Model.GetRun(ctx, runID)
+	if err != nil {
+		return false, false, err
+	}
+
+	task, err := ds.H.Model.GetTaskForRun(ctx, runID)
+	if err != nil {
+		return false, false, err
+	}
+
+	return run.Canceled || task.Canceled, task.FinishedAt.Valid, nil
 }
@@ -0,0 +1,101 @@
+package datasvc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/tinyci/ci-agents/ci-gen/grpc/types"
+	"github.com/tinyci/ci-agents/clients/log"
+	"github.com/tinyci/ci-agents/db/models"
+	"github.com/tinyci/ci-agents/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RerunRun queues a new attempt of the finished run.
+func (ds *DataServer) RerunRun(ctx context.Context, id *types.IntID) (*types.Run, error) {
+	run, err := ds.H.Model.RerunRun(ctx, id.ID)
+	if err != nil {
+		return nil, rerunError(err, "run %d not found", id.ID)
+	}
+
+	list, err := ds.rerunQueued(ctx, []*models.Run{run})
+	if err != nil {
+		return nil, err
+	}
+
+	return list.List[0], nil
+}
+
+// RerunTask copies the finished task and queues a new attempt of each of its
+// runs in the copy.
+func (ds *DataServer) RerunTask(ctx context.Context, id *types.IntID) (*types.RunList, error) {
+	_, runs, err := ds.H.Model.RerunTask(ctx, id.ID)
+	if err != nil {
+		return nil, rerunError(err, "task %d not found", id.ID)
+	}
+
+	return ds.rerunQueued(ctx, runs)
+}
+
+// RerunSubmission copies all tasks of the finished submission and queues a new
+// attempt of each of their runs.
+func (ds *DataServer) RerunSubmission(ctx context.Context, id *types.IntID) (*types.RunList, error) {
+	_, runs, err := ds.H.Model.RerunSubmission(ctx, id.ID)
+	if err != nil {
+		return nil, rerunError(err, "submission %d not found", id.ID)
+	}
+
+	return ds.rerunQueued(ctx, runs)
+}
+
+func rerunError(err error, notFound string, args ...interface{}) error {
+	if errors.Is(err, sql.ErrNoRows) || errors.Is(err, utils.ErrNotFound) {
+		return status.Errorf(codes.NotFound, notFound, args...)
+	}
+
+	return status.Errorf(codes.FailedPrecondition, "%v", err)
+}
+
+// rerunQueued logs the re-run runs and marks them pending on GitHub, returning
+// them.
+func (ds *DataServer) rerunQueued(ctx context.Context, runs []*models.Run) (*types.RunList, error) {
+	list := &types.RunList{}
+
+	if len(runs) == 0 {
+		return list, nil
+	}
+
+	u, err := ds.H.Model.GetOwnerForRun(ctx, runs[0].ID)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	for _, run := range runs {
+		bits, err := ds.H.Model.GetRunDetail(ctx, run.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		ds.H.Clients.Log.WithFields(log.FieldMap{
+			"run_id":           fmt.Sprintf("%d", run.ID),
+			"previous_attempt": fmt.Sprintf("%d", run.PreviousAttemptID.Int64),
+			"attempt":          fmt.Sprintf("%d", run.Attempt),
+		}).Info(ctx, "Re-running run")
+
+		if err := ds.setPending(ctx, u, bits); err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		r, err := ds.C.ToProto(ctx, run)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		list.List = append(list.List, r.(*types.Run))
+	}
+
+	return list, nil
+}
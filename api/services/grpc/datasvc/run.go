@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 
+	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/tinyci/ci-agents/ci-gen/grpc/services/data"
 	"github.com/tinyci/ci-agents/ci-gen/grpc/types"
 	"github.com/tinyci/ci-agents/utils"
@@ -107,3 +108,59 @@ func (ds *DataServer) GetRun(ctx context.Context, id *types.IntID) (*types.Run,
 func (ds *DataServer) GetRunUI(ctx context.Context, id *types.IntID) (*types.Run, error) {
 	return ds.GetRun(ctx, id)
 }
+
+// ListRunIDs lists the IDs of the runs in a task or submission.
+func (ds *DataServer) ListRunIDs(ctx context.Context, req *data.RunIDsRequest) (*data.RunIDs, error) {
+	var (
+		ids []int64
+		err error
+	)
+
+	switch {
+	case req.TaskID != 0:
+		ids, err = ds.H.Model.RunIDsForTask(ctx, req.TaskID)
+	case req.SubmissionID != 0:
+		ids, err = ds.H.Model.RunIDsForSubmission(ctx, req.SubmissionID)
+	default:
+		err = errors.New("a task or submission ID is required")
+	}
+
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	return &data.RunIDs{Ids: ids}, nil
+}
+
+// GetRunRepositories maps the runs to the IDs of the repositories they were
+// submitted against.
+func (ds *DataServer) GetRunRepositories(ctx context.Context, ids *data.RunIDs) (*data.RunRepositories, error) {
+	repos, err := ds.H.Model.RunRepositories(ctx, ids.Ids)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	return &data.RunRepositories{Repositories: repos}, nil
+}
+
+// ListTimedOutRuns lists the unfinished runs which have been running for
+// longer than their timeout.
+func (ds *DataServer) ListTimedOutRuns(ctx context.Context, e *empty.Empty) (*types.RunList, error) {
+	runs, err := ds.H.Model.TimedOutRuns(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	ret := &types.RunList{}
+
+	for _, run := range runs {
+		r, err := ds.C.ToProto(ctx, run)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		ret.List = append(ret.List, r.(*types.Run))
+	}
+
+	return ret, nil
+}
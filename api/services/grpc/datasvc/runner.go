@@ -0,0 +1,130 @@
+package datasvc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/tinyci/ci-agents/ci-gen/grpc/types"
+	topTypes "github.com/tinyci/ci-agents/types"
+	"github.com/tinyci/ci-agents/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	runnerTimeoutConfigKey = "runner_timeout" // seconds
+	runnerRetriesConfigKey = "runner_retries"
+
+	defaultRunnerTimeout = time.Minute
+	defaultRunnerRetries = 2
+)
+
+// RunnerHeartbeat registers the runner or records that it is still alive;
+// see ReapRunners for runners that stop sending them.
+func (ds *DataServer) RunnerHeartbeat(ctx context.Context, r *types.Runner) (*types.Runner, error) {
+	runner, err := ds.H.Model.RunnerHeartbeat(ctx, r.Name, r.QueueName, r.Labels)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	ret, err := ds.C.ToProto(ctx, runner)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	return ret.(*types.Runner), nil
+}
+
+// ListRunners lists all registered runners.
+func (ds *DataServer) ListRunners(ctx context.Context, e *empty.Empty) (*types.RunnerList, error) {
+	runners, err := ds.H.Model.ListRunners(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	list := &types.RunnerList{}
+
+	for _, runner := range runners {
+		r, err := ds.C.ToProto(ctx, runner)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		list.Runners = append(list.Runners, r.(*types.Runner))
+	}
+
+	return list, nil
+}
+
+// SetRunnerState cordons, drains or uncordons a runner.
+func (ds *DataServer) SetRunnerState(ctx context.Context, rs *types.RunnerState) (*types.Runner, error) {
+	runner, err := ds.H.Model.SetRunnerState(ctx, rs.Name, rs.Cordoned, rs.Draining)
+	if err != nil {
+		if errors.Is(err, utils.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "runner %q not found", rs.Name)
+		}
+
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	ret, err := ds.C.ToProto(ctx, runner)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	return ret.(*types.Runner), nil
+}
+
+func (ds *DataServer) runnerTimeout() time.Duration {
+	if secs, ok := ds.H.ServiceConfig[runnerTimeoutConfigKey].(int); ok && secs > 0 {
+		return time.Duration(secs) * time.Second
+	}
+
+	return defaultRunnerTimeout
+}
+
+func (ds *DataServer) runnerRetries() int {
+	if retries, ok := ds.H.ServiceConfig[runnerRetriesConfigKey].(int); ok && retries >= 0 {
+		return retries
+	}
+
+	return defaultRunnerRetries
+}
+
+// ReapRunners periodically re-queues the runs of runners which have missed
+// their heartbeats, until ctx is canceled. Runs which have already been
+// re-queued runner_retries times are failed instead. It is run in the
+// background of the service; see grpcHandler.H.Background.
+func (ds *DataServer) ReapRunners(ctx context.Context) {
+	timeout := ds.runnerTimeout()
+	retries := ds.runnerRetries()
+
+	ticker := time.NewTicker(timeout / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		requeued, failed, err := ds.H.Model.ReapRunners(ctx, timeout, retries)
+		if err != nil {
+			ds.H.Clients.Log.Error(ctx, utils.WrapError(err, "reaping lost runners"))
+			continue
+		}
+
+		for _, qi := range requeued {
+			ds.H.Clients.Log.Infof(ctx, "Re-queued run %d (attempt %d) after its runner stopped responding", qi.RunID, qi.Requeues+1)
+		}
+
+		for _, id := range failed {
+			if _, err := ds.PutStatus(ctx, &types.Status{Id: id, Status: false, Reason: topTypes.FailureReasonInfraError, AdditionalMessage: "The runner running this run stopped responding"}); err != nil {
+				ds.H.Clients.Log.Error(ctx, utils.WrapError(err, "failing run %d of lost runner", id))
+			}
+		}
+	}
+}
@@ -2,10 +2,12 @@ package datasvc
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/tinyci/ci-agents/ci-gen/grpc/services/data"
 	"github.com/tinyci/ci-agents/ci-gen/grpc/types"
+	"github.com/tinyci/ci-agents/clients/log"
 	"github.com/tinyci/ci-agents/db/models"
 	"github.com/tinyci/ci-agents/utils"
 	"google.golang.org/grpc/codes"
@@ -21,6 +23,25 @@ func (ds *DataServer) CancelTask(ctx context.Context, id *types.IntID) (*empty.E
 	return &empty.Empty{}, nil
 }
 
+// CancelConcurrentTasks cancels the older tasks in the concurrency groups the
+// task asks to cancel in progress. It is used by the queuesvc once the task's
+// runs are queued.
+func (ds *DataServer) CancelConcurrentTasks(ctx context.Context, id *types.IntID) (*empty.Empty, error) {
+	canceled, err := ds.H.Model.CancelConcurrentTasks(ctx, id.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "could not cancel tasks concurrent to task_id %d: %v", id.ID, err)
+	}
+
+	for _, taskID := range canceled {
+		ds.H.Clients.Log.WithFields(log.FieldMap{
+			"task_id":     fmt.Sprintf("%d", taskID),
+			"canceled_by": fmt.Sprintf("%d", id.ID),
+		}).Info(ctx, "Canceled task in progress of its concurrency group")
+	}
+
+	return &empty.Empty{}, nil
+}
+
 // CancelTasksByPR cancels multiple tasks by Pull Request ID.
 func (ds *DataServer) CancelTasksByPR(ctx context.Context, prq *types.CancelPRRequest) (*empty.Empty, error) {
 	if err := ds.H.Model.CancelTaskForPR(ctx, prq.Repository, prq.Id); err != nil {
@@ -0,0 +1,186 @@
+package datasvc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/tinyci/ci-agents/ci-gen/grpc/services/data"
+	"github.com/tinyci/ci-agents/ci-gen/grpc/types"
+	"github.com/tinyci/ci-agents/db"
+	"github.com/tinyci/ci-agents/db/models"
+	"github.com/tinyci/ci-agents/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxSummaryFailures is the most failed tests named in a test summary.
+	maxSummaryFailures = 10
+	// maxFailureSummaryLength leaves room in GitHub's 140 character status
+	// descriptions for the text around the summary.
+	maxFailureSummaryLength = 100
+	// defaultFlakyDays is the window flaky tests are found in if none is given.
+	defaultFlakyDays = 14
+	// maxFlakyTests is the most flaky tests listed.
+	maxFlakyTests = 100
+)
+
+// AddTestCases records the test cases read from a test report of a run.
+func (ds *DataServer) AddTestCases(ctx context.Context, list *types.TestCaseList) (*empty.Empty, error) {
+	cases := models.TestCaseSlice{}
+
+	for _, tc := range list.TestCases {
+		c, err := ds.C.FromProto(ctx, tc)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		cases = append(cases, c.(*models.TestCase))
+	}
+
+	if err := ds.H.Model.AddTestCases(ctx, list.RunID, cases); err != nil {
+		if errors.Is(err, utils.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "run %d not found", list.RunID)
+		}
+
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// ListTestCases lists the test cases of a run, optionally only those with a
+// status.
+func (ds *DataServer) ListTestCases(ctx context.Context, req *data.TestCaseRequest) (*types.TestCaseList, error) {
+	cases, err := ds.H.Model.ListTestCases(ctx, req.RunID, req.Status)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	list := &types.TestCaseList{RunID: req.RunID}
+
+	for _, tc := range cases {
+		c, err := ds.C.ToProto(ctx, tc)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		list.TestCases = append(list.TestCases, c.(*types.TestCase))
+	}
+
+	return list, nil
+}
+
+// GetTestSummary counts the test cases of a run by status.
+func (ds *DataServer) GetTestSummary(ctx context.Context, id *types.IntID) (*types.TestSummary, error) {
+	summary, err := ds.H.Model.GetTestSummary(ctx, id.ID, maxSummaryFailures)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	return &types.TestSummary{
+		RunID:    id.ID,
+		Total:    summary.Total,
+		Passed:   summary.Passed,
+		Failed:   summary.Failed,
+		Skipped:  summary.Skipped,
+		Errored:  summary.Errored,
+		Duration: summary.Duration,
+		Failures: summary.Failures,
+	}, nil
+}
+
+// FlakyTests lists the tests of a repository which both passed and failed on
+// the same SHA within the window, most flaky first.
+func (ds *DataServer) FlakyTests(ctx context.Context, req *data.FlakyTestRequest) (*types.FlakyTestList, error) {
+	days := req.Days
+	if days <= 0 {
+		days = defaultFlakyDays
+	}
+
+	repo, err := ds.H.Model.GetRepositoryByName(ctx, req.Repository)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "repository %q not found", req.Repository)
+		}
+
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	tests, err := ds.H.Model.FlakyTests(ctx, repo.ID, time.Now().AddDate(0, 0, -int(days)), maxFlakyTests)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	list := &types.FlakyTestList{}
+
+	for _, test := range tests {
+		list.Tests = append(list.Tests, &types.FlakyTest{
+			Suite:      test.Suite,
+			Name:       test.Name,
+			Score:      test.Score,
+			Executions: test.Executions,
+			Failures:   test.Failures,
+			Shas:       test.SHAs,
+			Flipped:    test.Flipped,
+		})
+	}
+
+	return list, nil
+}
+
+// quarantineSummary describes the failed tests of a run which were ignored
+// because they are quarantined.
+func quarantineSummary(names []string) string {
+	msg := fmt.Sprintf("The run completed, ignoring %d failed quarantined tests", len(names))
+	if len(names) == 1 {
+		msg = "The run completed, ignoring a failed quarantined test"
+	}
+
+	if ret := msg + ": " + strings.Join(names, ", "); len(ret) <= maxFailureSummaryLength {
+		return ret
+	}
+
+	return msg
+}
+
+// failureSummary describes the failed tests of a run for its status on
+// GitHub, naming as many of them as fit, e.g. "3 of 120 tests failed: TestA,
+// TestB and 1 more". It returns an empty string if no tests failed.
+func failureSummary(summary *db.TestSummary) string {
+	failed := summary.Failed + summary.Errored
+	if failed == 0 {
+		return ""
+	}
+
+	msg := fmt.Sprintf("%d of %d tests failed", failed, summary.Total)
+
+	describe := func(names []string) string {
+		if len(names) == 0 {
+			return msg
+		}
+
+		ret := msg + ": " + strings.Join(names, ", ")
+		if rest := failed - int64(len(names)); rest > 0 {
+			ret += fmt.Sprintf(" and %d more", rest)
+		}
+
+		return ret
+	}
+
+	var names []string
+	for i := range summary.Failures {
+		if len(describe(summary.Failures[:i+1])) > maxFailureSummaryLength {
+			break
+		}
+
+		names = summary.Failures[:i+1]
+	}
+
+	return describe(names)
+}
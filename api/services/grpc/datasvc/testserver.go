@@ -44,6 +44,7 @@ func MakeDataServer() (*DataServer, chan struct{}, error) {
 
 	ds := &DataServer{H: h, C: protoconv.New(db)}
 	data.RegisterDataServer(srv, ds)
+	h.Background(ds.ReapRunners)
 
 	doneChan, err := h.Boot(t, srv, make(chan struct{}))
 	return ds, doneChan, err
@@ -394,4 +394,64 @@ func (qs *queuesvcSuite) TestDependencies(c *check.C) {
 	c.Assert(len(tasks.Tasks), check.Equals, 2)
 	c.Assert(tasks.Tasks[0].Runs, check.Not(check.Equals), int64(0))
 	c.Assert(tasks.Tasks[1].Runs, check.Not(check.Equals), int64(0))
+
+	byPath := map[string]*types.Task{}
+	for _, task := range tasks.Tasks {
+		byPath[task.Path] = task
+	}
+
+	// bar has no runs, so the root task waits on what bar depends on instead.
+	c.Assert(byPath["."].DependsOn, check.DeepEquals, []int64{byPath["foo"].Id})
+	c.Assert(len(byPath["foo"].DependsOn), check.Equals, 0)
+}
+
+func (qs *queuesvcSuite) TestDependencyCycle(c *check.C) {
+	_, err := qs.datasvcClient.MakeUser("erikh")
+	c.Assert(err, check.IsNil)
+
+	sub := &topTypes.Submission{
+		Parent:   "erikh/foobar",
+		Fork:     "erikh/foobar2",
+		HeadSHA:  "be3d26c478991039e951097f2c99f56b55396940",
+		BaseSHA:  "be3d26c478991039e951097f2c99f56b55396941",
+		TicketID: 10,
+	}
+
+	client := github.NewMockClient(gomock.NewController(c))
+	qs.mkGithubClient(client)
+
+	c.Assert(qs.datasvcClient.MakeRepo("erikh/foobar", "erikh", false, ""), check.IsNil)
+	c.Assert(qs.datasvcClient.MakeRepo("erikh/foobar2", "erikh", false, "erikh/foobar"), check.IsNil)
+
+	repoConfigBytes, e := ioutil.ReadFile("../../../testdata/standard_repoconfig.yml")
+	c.Assert(e, check.IsNil)
+
+	taskBytes, e := ioutil.ReadFile("../../../testdata/task_with_dependencies.yml")
+	c.Assert(e, check.IsNil)
+
+	cyclicTaskBytes, e := ioutil.ReadFile("../../../testdata/cyclic_deps.yml")
+	c.Assert(e, check.IsNil)
+
+	qs.getMock().GetRepository(gomock.Any(), "erikh/foobar2").Return(&gh.Repository{FullName: gh.String("erikh/foobar2")}, nil)
+	qs.getMock().GetRepository(gomock.Any(), "erikh/foobar").Return(&gh.Repository{FullName: gh.String("erikh/foobar")}, nil)
+	qs.getMock().GetSHA(gomock.Any(), "erikh/foobar2", "heads/master").Return(sub.HeadSHA, nil)
+	qs.getMock().GetSHA(gomock.Any(), "erikh/foobar", "heads/master").Return(sub.BaseSHA, nil)
+	qs.getMock().GetRefs(gomock.Any(), sub.Fork, sub.HeadSHA).Return([]string{"heads/master"}, nil)
+	qs.getMock().GetRefs(gomock.Any(), sub.Parent, sub.BaseSHA).Return([]string{"heads/master"}, nil)
+	qs.getMock().GetFile(gomock.Any(), sub.Parent, "refs/heads/master", "tinyci.yml").Return(repoConfigBytes, nil)
+	qs.getMock().GetDiffFiles(gomock.Any(), sub.Parent, sub.BaseSHA, sub.HeadSHA).Return([]string{"task.yml"}, nil)
+	qs.getMock().GetFileList(gomock.Any(), sub.Fork, sub.HeadSHA).Return([]string{"task.yml", "bar/task.yml", "bar/quux"}, nil)
+	qs.getMock().GetRepository(gomock.Any(), sub.Parent).Return(&gh.Repository{FullName: gh.String(sub.Parent)}, nil)
+
+	qs.getMock().GetFile(gomock.Any(), sub.Fork, sub.HeadSHA, "task.yml").Return(taskBytes, nil)
+	qs.getMock().GetFile(gomock.Any(), sub.Fork, sub.HeadSHA, "bar/task.yml").Return(cyclicTaskBytes, nil)
+	qs.getMock().CommentError(gomock.Any(), sub.Parent, sub.TicketID, gomock.Any()).Return(nil)
+
+	qs.getMock().ClearStates(gomock.Any(), sub.Parent, sub.HeadSHA).Return(nil)
+	c.Assert(qs.datasvcClient.Client().EnableRepository(ctx, "erikh", sub.Parent), check.IsNil)
+
+	c.Assert(qs.queuesvcClient.Client().Submit(context.Background(), sub), check.NotNil)
+	runs, err := qs.datasvcClient.Client().ListRuns(ctx, "", "", 0, 100)
+	c.Assert(err, check.IsNil)
+	c.Assert(len(runs.List), check.Equals, 0)
 }
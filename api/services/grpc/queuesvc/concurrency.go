@@ -0,0 +1,70 @@
+package queuesvc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	grpcHandler "github.com/tinyci/ci-agents/api/handlers/grpc"
+	"github.com/tinyci/ci-agents/ci-gen/grpc/types"
+	"github.com/tinyci/ci-agents/clients/log"
+)
+
+// expandConcurrency substitutes `${ref}` and `${sha}` in the concurrency
+// groups of the task and its runs. It must be called after expandMatrix, which
+// substitutes the matrix values of the runs.
+func expandConcurrency(settings *types.TaskSettings, repoInfo *repoInfo) {
+	replacer := strings.NewReplacer(
+		"${ref}", strings.TrimPrefix(repoInfo.forkRef.RefName, "heads/"),
+		"${sha}", repoInfo.forkRef.Sha,
+	)
+
+	if settings.Concurrency != nil {
+		settings.Concurrency.Group = replacer.Replace(settings.Concurrency.Group)
+	}
+
+	for _, rs := range settings.Runs {
+		if rs.Concurrency != nil {
+			rs.Concurrency.Group = replacer.Replace(rs.Concurrency.Group)
+		}
+	}
+}
+
+// cancelsInProgress returns true if the task, or any of its runs, cancels the
+// older members of its concurrency group.
+func cancelsInProgress(settings *types.TaskSettings) bool {
+	if settings.GetConcurrency().GetCancelInProgress() {
+		return true
+	}
+
+	for _, rs := range settings.Runs {
+		if rs.GetConcurrency().GetCancelInProgress() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// cancelInProgress cancels the older members of the concurrency groups of the
+// queued tasks which ask for it. Failures are only logged, as the new runs
+// have already been queued.
+func cancelInProgress(ctx context.Context, h *grpcHandler.H, qis []*types.QueueItem) {
+	seen := map[int64]struct{}{}
+
+	for _, qi := range qis {
+		task := qi.Run.Task
+		if _, ok := seen[task.Id]; ok {
+			continue
+		}
+		seen[task.Id] = struct{}{}
+
+		if !cancelsInProgress(task.Settings) {
+			continue
+		}
+
+		if err := h.Clients.Data.CancelConcurrentTasks(ctx, task.Id); err != nil {
+			h.Clients.Log.WithFields(log.FieldMap{"task_id": fmt.Sprintf("%d", task.Id)}).Errorf(ctx, "Couldn't cancel the tasks in progress of its concurrency group: %v", err)
+		}
+	}
+}
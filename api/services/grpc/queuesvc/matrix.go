@@ -0,0 +1,123 @@
+package queuesvc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tinyci/ci-agents/ci-gen/grpc/types"
+	"google.golang.org/protobuf/proto"
+)
+
+type matrixValue struct {
+	axis  string
+	value string
+}
+
+// expandMatrix replaces each run with a matrix with one run for every
+// combination of its axis values. The expanded runs are named after the
+// original run and their values, e.g. `test[go=1.15,os=alpine]`, and any run
+// that needs a matrix run will need all of its expansions instead.
+func expandMatrix(settings *types.TaskSettings) {
+	runs := map[string]*types.RunSettings{}
+	expanded := map[string][]string{}
+
+	for name, rs := range settings.Runs {
+		if len(rs.Matrix) == 0 {
+			runs[name] = rs
+			expanded[name] = []string{name}
+			continue
+		}
+
+		for _, combination := range matrixCombinations(rs.Matrix) {
+			run := applyMatrix(name, rs, combination)
+			runs[run.Name] = run
+			expanded[name] = append(expanded[name], run.Name)
+		}
+	}
+
+	for _, rs := range runs {
+		if len(rs.Needs) == 0 {
+			continue
+		}
+
+		needs := []string{}
+		for _, need := range rs.Needs {
+			needs = append(needs, expanded[need]...)
+		}
+
+		rs.Needs = needs
+	}
+
+	settings.Runs = runs
+}
+
+// matrixCombinations returns the cartesian product of the matrix's axes. Axes
+// are ordered by name, and values are kept in the order they were declared.
+func matrixCombinations(matrix map[string]*types.MatrixAxis) [][]matrixValue {
+	axes := []string{}
+	for axis := range matrix {
+		axes = append(axes, axis)
+	}
+
+	sort.Strings(axes)
+
+	combinations := [][]matrixValue{{}}
+
+	for _, axis := range axes {
+		next := [][]matrixValue{}
+
+		for _, combination := range combinations {
+			for _, value := range matrix[axis].Values {
+				c := append(append([]matrixValue{}, combination...), matrixValue{axis: axis, value: value})
+				next = append(next, c)
+			}
+		}
+
+		combinations = next
+	}
+
+	return combinations
+}
+
+func applyMatrix(name string, rs *types.RunSettings, combination []matrixValue) *types.RunSettings {
+	run := proto.Clone(rs).(*types.RunSettings)
+	run.Matrix = nil
+
+	pairs := []string{}
+	replacements := []string{}
+
+	for _, mv := range combination {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", mv.axis, mv.value))
+		replacements = append(replacements, fmt.Sprintf("${matrix.%s}", mv.axis), mv.value)
+	}
+
+	run.Name = fmt.Sprintf("%s[%s]", name, strings.Join(pairs, ","))
+
+	replacer := strings.NewReplacer(replacements...)
+
+	run.Image = replacer.Replace(run.Image)
+
+	for i, arg := range run.Command {
+		run.Command[i] = replacer.Replace(arg)
+	}
+
+	for i, env := range run.Env {
+		run.Env[i] = replacer.Replace(env)
+	}
+
+	for i, label := range run.RunsOn {
+		run.RunsOn[i] = replacer.Replace(label)
+	}
+
+	if run.Concurrency != nil {
+		run.Concurrency.Group = replacer.Replace(run.Concurrency.Group)
+	}
+
+	// names are only unique within a run, so they need no substitution.
+	for _, artifact := range run.Artifacts {
+		artifact.Path = replacer.Replace(artifact.Path)
+	}
+
+	return run
+}
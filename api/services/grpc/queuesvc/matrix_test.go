@@ -0,0 +1,81 @@
+package queuesvc
+
+import (
+	check "github.com/erikh/check"
+	"github.com/tinyci/ci-agents/ci-gen/grpc/types"
+)
+
+type matrixSuite struct{}
+
+var _ = check.Suite(&matrixSuite{})
+
+func (ms *matrixSuite) TestExpandMatrix(c *check.C) {
+	settings := &types.TaskSettings{
+		Runs: map[string]*types.RunSettings{
+			"test": {
+				Name:        "test",
+				Image:       "golang:${matrix.go}-${matrix.os}",
+				Command:     []string{"go", "test", "-tags", "${matrix.os}"},
+				Env:         []string{"GOVERSION=${matrix.go}"},
+				RunsOn:      []string{"os=${matrix.os}"},
+				Concurrency: &types.Concurrency{Group: "test-${matrix.os}"},
+				Artifacts: []*types.Artifact{
+					{Name: "test.out", Path: "test-${matrix.go}.out"},
+				},
+				Matrix: map[string]*types.MatrixAxis{
+					"os": {Values: []string{"alpine", "buster"}},
+					"go": {Values: []string{"1.15", "1.16"}},
+				},
+			},
+			"deploy": {
+				Name:    "deploy",
+				Image:   "deployer",
+				Command: []string{"deploy"},
+				Needs:   []string{"test"},
+			},
+		},
+	}
+
+	expandMatrix(settings)
+
+	c.Assert(len(settings.Runs), check.Equals, 5)
+
+	run, ok := settings.Runs["test[go=1.16,os=alpine]"]
+	c.Assert(ok, check.Equals, true)
+	c.Assert(run.Name, check.Equals, "test[go=1.16,os=alpine]")
+	c.Assert(run.Image, check.Equals, "golang:1.16-alpine")
+	c.Assert(run.Command, check.DeepEquals, []string{"go", "test", "-tags", "alpine"})
+	c.Assert(run.Env, check.DeepEquals, []string{"GOVERSION=1.16"})
+	c.Assert(run.RunsOn, check.DeepEquals, []string{"os=alpine"})
+	c.Assert(run.Artifacts[0].Path, check.Equals, "test-1.16.out")
+	c.Assert(run.Concurrency.Group, check.Equals, "test-alpine")
+	c.Assert(run.Matrix, check.IsNil)
+
+	c.Assert(settings.Runs["deploy"].Needs, check.DeepEquals, []string{
+		"test[go=1.15,os=alpine]",
+		"test[go=1.15,os=buster]",
+		"test[go=1.16,os=alpine]",
+		"test[go=1.16,os=buster]",
+	})
+}
+
+func (ms *matrixSuite) TestExpandConcurrency(c *check.C) {
+	settings := &types.TaskSettings{
+		Concurrency: &types.Concurrency{Group: "deploy-${ref}"},
+		Runs: map[string]*types.RunSettings{
+			"build":   {Name: "build"},
+			"migrate": {Name: "migrate", Concurrency: &types.Concurrency{Group: "migrate-${sha}", CancelInProgress: true}},
+		},
+	}
+
+	c.Assert(cancelsInProgress(settings), check.Equals, true)
+
+	expandConcurrency(settings, &repoInfo{forkRef: &types.Ref{RefName: "heads/main", Sha: "be3d26c478991039e951097f2c99f56b55396940"}})
+
+	c.Assert(settings.Concurrency.Group, check.Equals, "deploy-main")
+	c.Assert(settings.Runs["build"].Concurrency, check.IsNil)
+	c.Assert(settings.Runs["migrate"].Concurrency.Group, check.Equals, "migrate-be3d26c478991039e951097f2c99f56b55396940")
+
+	delete(settings.Runs, "migrate")
+	c.Assert(cancelsInProgress(settings), check.Equals, false)
+}
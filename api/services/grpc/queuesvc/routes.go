@@ -12,6 +12,7 @@ import (
 	grpcHandler "github.com/tinyci/ci-agents/api/handlers/grpc"
 	"github.com/tinyci/ci-agents/ci-gen/grpc/services/queue"
 	gtypes "github.com/tinyci/ci-agents/ci-gen/grpc/types"
+	githubClient "github.com/tinyci/ci-agents/clients/github"
 	"github.com/tinyci/ci-agents/clients/log"
 	"github.com/tinyci/ci-agents/types"
 	"google.golang.org/grpc/codes"
@@ -42,10 +43,74 @@ func (qs *QueueServer) GetCancel(ctx context.Context, id *gtypes.IntID) (*gtypes
 	return &gtypes.Status{Status: state}, nil
 }
 
+// WatchCancel relays the canceled state of the run from the datasvc once the
+// run is canceled. Older runners poll GetCancel instead.
+func (qs *QueueServer) WatchCancel(id *gtypes.IntID, stream queue.Queue_WatchCancelServer) error {
+	canceled, err := qs.H.Clients.Data.WatchCancel(stream.Context(), id.ID)
+	if err != nil {
+		if stream.Context().Err() != nil {
+			return nil
+		}
+
+		if stat, ok := status.FromError(err); ok {
+			return stat.Err()
+		}
+
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	if !canceled {
+		return nil
+	}
+
+	return stream.Send(&gtypes.Status{Id: id.ID, Status: true})
+}
+
+// RerunRun mirrors the RerunRun in datasvc, which queues a new attempt of a
+// finished run and marks it pending on github.
+func (qs *QueueServer) RerunRun(ctx context.Context, id *gtypes.IntID) (*gtypes.Run, error) {
+	run, err := qs.H.Clients.Data.RerunRun(ctx, id.ID)
+	if err != nil {
+		return nil, rerunError(err)
+	}
+
+	return run, nil
+}
+
+// RerunTask mirrors the RerunTask in datasvc.
+func (qs *QueueServer) RerunTask(ctx context.Context, id *gtypes.IntID) (*gtypes.RunList, error) {
+	list, err := qs.H.Clients.Data.RerunTask(ctx, id.ID)
+	if err != nil {
+		return nil, rerunError(err)
+	}
+
+	return list, nil
+}
+
+// RerunSubmission mirrors the RerunSubmission in datasvc.
+func (qs *QueueServer) RerunSubmission(ctx context.Context, id *gtypes.IntID) (*gtypes.RunList, error) {
+	list, err := qs.H.Clients.Data.RerunSubmission(ctx, id.ID)
+	if err != nil {
+		return nil, rerunError(err)
+	}
+
+	return list, nil
+}
+
+// rerunError passes on the status of errors from the datasvc, so missing
+// runs, tasks and submissions are still reported as not found.
+func rerunError(err error) error {
+	if stat, ok := status.FromError(err); ok {
+		return stat.Err()
+	}
+
+	return status.Errorf(codes.FailedPrecondition, "%v", err)
+}
+
 // PutStatus pushes the finished run's status out to github and back into the
 // datasvc.
 func (qs *QueueServer) PutStatus(ctx context.Context, s *gtypes.Status) (*empty.Empty, error) {
-	if err := qs.H.Clients.Data.PutStatus(ctx, s.Id, s.Status, s.AdditionalMessage); err != nil {
+	if err := qs.H.Clients.Data.PutStatus(ctx, s.Id, s.Status, s.Reason, s.AdditionalMessage); err != nil {
 		return &empty.Empty{}, status.Errorf(codes.FailedPrecondition, "%v", err)
 	}
 
@@ -54,9 +119,9 @@ func (qs *QueueServer) PutStatus(ctx context.Context, s *gtypes.Status) (*empty.
 
 // NextQueueItem gathers the next available item from the queue, if any, and
 // returns it. If there is any failure, the queue could not be read and there
-// is a need to retry after a wait.
+// is a need to retry after a wait. Runs which cannot be started are failed.
 func (qs *QueueServer) NextQueueItem(ctx context.Context, qr *gtypes.QueueRequest) (*gtypes.QueueItem, error) {
-	qi, err := qs.H.Clients.Data.NextQueueItem(ctx, qr.QueueName, qr.RunningOn)
+	qi, err := qs.H.Clients.Data.NextQueueItem(ctx, qr.QueueName, qr.RunningOn, qr.Labels...)
 	if err != nil {
 		if stat, ok := status.FromError(err); ok {
 			return &gtypes.QueueItem{}, stat.Err()
@@ -65,6 +130,83 @@ func (qs *QueueServer) NextQueueItem(ctx context.Context, qr *gtypes.QueueReques
 		return &gtypes.QueueItem{}, status.Errorf(codes.FailedPrecondition, "%v", err)
 	}
 
+	if err := qs.startQueueItem(ctx, qr, qi); err != nil {
+		qs.failQueueItem(ctx, qi, err)
+		return nil, err
+	}
+
+	return qi, nil
+}
+
+// NextQueueItems gathers up to the requested count of available items from
+// the queue, for runners with several slots. Runs which cannot be started
+// are failed and left out; if none can, the first error is returned.
+func (qs *QueueServer) NextQueueItems(ctx context.Context, qr *gtypes.QueueRequest) (*gtypes.QueueItemList, error) {
+	qis, err := qs.H.Clients.Data.NextQueueItems(ctx, qr.QueueName, qr.RunningOn, qr.Count, qr.Labels...)
+	if err != nil {
+		if stat, ok := status.FromError(err); ok {
+			return nil, stat.Err()
+		}
+
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	var firstErr error
+	list := &gtypes.QueueItemList{}
+
+	for _, qi := range qis {
+		if err := qs.startQueueItem(ctx, qr, qi); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+
+			qs.failQueueItem(ctx, qi, err)
+			continue
+		}
+
+		list.Items = append(list.Items, qi)
+	}
+
+	if len(list.Items) == 0 {
+		return nil, firstErr
+	}
+
+	return list, nil
+}
+
+// failQueueItem fails the run of an item which could not be started, so it is
+// not left running without a runner. The reasons it could not be started do
+// not go away by themselves, so putting it back into the queue would only
+// have it claimed again; for the same reason, retry policies never retry the
+// run (see types.FailureReasonStartError). Failures are only logged.
+func (qs *QueueServer) failQueueItem(ctx context.Context, qi *gtypes.QueueItem, startErr error) {
+	msg := fmt.Sprintf("The run could not be started: %v", status.Convert(startErr).Message())
+	if err := qs.H.Clients.Data.PutStatus(ctx, qi.Run.Id, false, types.FailureReasonStartError, msg); err != nil {
+		qs.H.Clients.Log.WithFields(log.FieldMap{"run_id": fmt.Sprintf("%d", qi.Run.Id)}).Errorf(ctx, "Couldn't fail the run which could not be started: %v", err)
+	}
+}
+
+// WatchQueue relays the datasvc's notifications for the named queue, and the
+// other queues named by the runner's labels, so runners can wait for work
+// instead of polling NextQueueItem. The datasvc notifies through postgres, so
+// runners are woken by items queued through any queuesvc.
+func (qs *QueueServer) WatchQueue(qr *gtypes.QueueRequest, stream queue.Queue_WatchQueueServer) error {
+	err := qs.H.Clients.Data.WatchQueue(stream.Context(), qr.QueueName, func() error {
+		return stream.Send(&gtypes.QueueNotification{QueueName: qr.QueueName})
+	}, qr.Labels...)
+	if err != nil && stream.Context().Err() == nil {
+		if stat, ok := status.FromError(err); ok {
+			return stat.Err()
+		}
+
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	return nil
+}
+
+// startQueueItem reports the claimed item's run as started to github.
+func (qs *QueueServer) startQueueItem(ctx context.Context, qr *gtypes.QueueRequest, qi *gtypes.QueueItem) error {
 	if qi.Run.Task.Submission.BaseRef.Repository.Owner == nil {
 		err := errors.New("No owner for repository for queued run; skipping")
 		qs.H.Clients.Log.WithFields(log.FieldMap{
@@ -73,31 +215,57 @@ func (qs *QueueServer) NextQueueItem(ctx context.Context, qr *gtypes.QueueReques
 			"ran_on":     qr.RunningOn,
 		}).Error(ctx, err)
 
-		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
 	}
 
 	owner := qi.Run.Task.Submission.BaseRef.Repository.Owner
 
 	github, err := qs.H.OAuth.GithubClient(owner.Username, owner.TokenJSON)
 	if err != nil {
-		return nil, status.Error(codes.FailedPrecondition, "error crafting token")
+		return status.Error(codes.FailedPrecondition, "error crafting token")
 	}
 
 	parts := strings.SplitN(qi.Run.Task.Submission.BaseRef.Repository.Name, "/", 2)
 	if len(parts) != 2 {
-		return &gtypes.QueueItem{}, status.Errorf(codes.FailedPrecondition, "invalid repository")
+		return status.Errorf(codes.FailedPrecondition, "invalid repository")
 	}
 
 	go func() {
-		if err := github.StartedStatus(ctx, parts[0], parts[1], qi.Run.Name, qi.Run.Task.Submission.HeadRef.Sha, fmt.Sprintf("%s/typeslog/%d", qs.H.URL, qi.Run.Id)); err != nil {
-			fmt.Println(err)
+		sha, url := qi.Run.Task.Submission.HeadRef.Sha, fmt.Sprintf("%s/typeslog/%d", qs.H.URL, qi.Run.Id)
+		logger := qs.H.Clients.Log.WithFields(log.FieldMap{"run_id": fmt.Sprintf("%d", qi.Run.Id)})
+
+		var err error
+		if qi.Run.Task.Settings.GetConfig().GetStatusApi() == types.StatusAPIChecks {
+			// only apps can write check runs.
+			var app githubClient.Client
+			app, err = qs.H.GithubApp.GithubClient(ctx, parts[0], parts[1])
+			if err != nil {
+				logger.Errorf(ctx, "Couldn't obtain the github app's client to report the started run: %v", err)
+				return
+			}
+
+			err = app.ReportCheckRun(ctx, parts[0], parts[1], &githubClient.CheckRun{
+				Name:       qi.Run.Name,
+				SHA:        sha,
+				URL:        url,
+				ExternalID: fmt.Sprintf("%d", qi.Run.Id),
+				Status:     githubClient.CheckInProgress,
+				Title:      "The run has started!",
+			})
+		} else {
+			err = github.StartedStatus(ctx, parts[0], parts[1], qi.Run.Name, sha, url)
+		}
+
+		if err != nil {
+			logger.Errorf(ctx, "Couldn't report the run as started: %v", err)
 		}
 	}()
 
-	return qi, nil
+	return nil
 }
 
-func doSubmit(ctx context.Context, h *grpcHandler.H, qis []*gtypes.QueueItem) (retErr error) {
+// doSubmit queues the items, returning them as they were recorded.
+func doSubmit(ctx context.Context, h *grpcHandler.H, qis []*gtypes.QueueItem) (queued []*gtypes.QueueItem, retErr error) {
 	since := time.Now()
 	defer func() {
 		if retErr == nil {
@@ -107,11 +275,7 @@ func doSubmit(ctx context.Context, h *grpcHandler.H, qis []*gtypes.QueueItem) (r
 		}
 	}()
 
-	if _, err := h.Clients.Data.PutQueue(ctx, qis); err != nil {
-		return err
-	}
-
-	return nil
+	return h.Clients.Data.PutQueue(ctx, qis)
 }
 
 // Submit is the submission endpoint for the queue; all items gathered from the
@@ -126,6 +290,8 @@ func (qs *QueueServer) Submit(ctx context.Context, sub *queue.Submission) (*empt
 		SubmittedBy: sub.SubmittedBy,
 		All:         sub.All,
 		Manual:      sub.Manual,
+		Event:       sub.Event,
+		Priority:    sub.Priority,
 	}
 
 	submissionLogger := qs.H.Clients.Log.WithFields(
@@ -148,9 +314,10 @@ func (qs *QueueServer) Submit(ctx context.Context, sub *queue.Submission) (*empt
 	}
 
 	submissionLogger.Infof(ctx, "Putting %d queue items from submissions", len(qis))
-	if err := doSubmit(ctx, qs.H, qis); err != nil {
+	queued, err := doSubmit(ctx, qs.H, qis)
+	if err != nil {
 		for _, qi := range qis {
-			if err := qs.H.Clients.Data.PutStatus(ctx, qi.Run.Id, false, fmt.Sprintf("Canceled due to error: %v", err)); err != nil {
+			if err := qs.H.Clients.Data.PutStatus(ctx, qi.Run.Id, false, "", fmt.Sprintf("Canceled due to error: %v", err)); err != nil {
 				submissionLogger.Errorf(ctx, "While canceling runs: %v", err)
 			}
 		}
@@ -158,5 +325,18 @@ func (qs *QueueServer) Submit(ctx context.Context, sub *queue.Submission) (*empt
 		return &empty.Empty{}, status.Errorf(codes.FailedPrecondition, "%v", err)
 	}
 
+	// the statuses are only set now so their check runs carry the runs' IDs,
+	// and so no run is reported skipped for a submission that was not queued.
+	tp := sp.newTaskPicker()
+	for _, qi := range queued {
+		go tp.setPendingStatus(processCtx, qi.Run, sp.repoInfo)
+	}
+
+	for _, name := range sp.repoInfo.skipped {
+		go tp.setSkippedStatus(processCtx, name, sp.repoInfo)
+	}
+
+	cancelInProgress(ctx, qs.H, qis)
+
 	return &empty.Empty{}, nil
 }
@@ -0,0 +1,54 @@
+package queuesvc
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	gtypes "github.com/tinyci/ci-agents/ci-gen/grpc/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RegisterRunner registers a runner so that its runs can be recovered if it
+// stops sending heartbeats.
+func (qs *QueueServer) RegisterRunner(ctx context.Context, r *gtypes.Runner) (*gtypes.Runner, error) {
+	return qs.Heartbeat(ctx, r)
+}
+
+// Heartbeat records that the runner is alive, registering it if needed.
+func (qs *QueueServer) Heartbeat(ctx context.Context, r *gtypes.Runner) (*gtypes.Runner, error) {
+	runner, err := qs.H.Clients.Data.RunnerHeartbeat(ctx, r)
+	if err != nil {
+		if stat, ok := status.FromError(err); ok {
+			return nil, stat.Err()
+		}
+
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	return runner, nil
+}
+
+// ListRunners lists all registered runners.
+func (qs *QueueServer) ListRunners(ctx context.Context, e *empty.Empty) (*gtypes.RunnerList, error) {
+	runners, err := qs.H.Clients.Data.ListRunners(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	return &gtypes.RunnerList{Runners: runners}, nil
+}
+
+// SetRunnerState cordons, drains or uncordons a runner.
+func (qs *QueueServer) SetRunnerState(ctx context.Context, rs *gtypes.RunnerState) (*gtypes.Runner, error) {
+	runner, err := qs.H.Clients.Data.SetRunnerState(ctx, rs.Name, rs.Cordoned, rs.Draining)
+	if err != nil {
+		if stat, ok := status.FromError(err); ok {
+			return nil, stat.Err()
+		}
+
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	return runner, nil
+}
@@ -34,6 +34,17 @@ type repoInfo struct {
 	user       *types.User
 	repoConfig *topTypes.RepoConfig
 	ticketID   int64
+	// changedFiles is the list of files changed by the submission. It is nil
+	// when all runs should be tested regardless of what changed.
+	changedFiles []string
+	// event is the kind of event that triggered the submission; empty for
+	// manual submissions.
+	event string
+	// priority is the queue priority given to every run of the submission.
+	priority int32
+	// skipped holds the names of the runs skipped for their path filters; they
+	// are reported to github once the submission has been queued.
+	skipped []string
 }
 
 type submissionProcessor struct {
@@ -77,6 +88,28 @@ func (sp *submissionProcessor) process(ctx context.Context, sub *topTypes.Submis
 		return nil, utils.WrapError(err, "obtaining repository configuration")
 	}
 
+	// refs matching merge_options.ignore_refs are tested as they are, without
+	// merging the main branch into them.
+	ignored, err := sp.repoInfo.repoConfig.IgnoresRef(sp.repoInfo.forkRef.RefName)
+	if err != nil {
+		return nil, utils.WrapError(err, "matching ref %q", sp.repoInfo.forkRef.RefName)
+	}
+
+	if ignored {
+		sp.repoInfo.repoConfig.Merge.DoNotMerge = true
+	}
+
+	sp.repoInfo.priority = int32(sp.repoInfo.repoConfig.Priority)
+	if sub.Priority != nil {
+		if *sub.Priority > sp.repoInfo.priority {
+			if err := sp.checkRaisePriority(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		sp.repoInfo.priority = *sub.Priority
+	}
+
 	tp := sp.newTaskPicker()
 
 	return tp.pick(ctx, sub, sp.repoInfo)
@@ -138,6 +171,7 @@ func (sp *submissionProcessor) configureRepositories(ctx context.Context, sub *t
 	}
 
 	sp.repoInfo.ticketID = sub.TicketID
+	sp.repoInfo.event = sub.Event
 
 	if len(sub.HeadSHA) != 40 { // FIXME could be trumped with long branch names
 		sub.HeadSHA, err = client.GetSHA(ctx, sub.Fork, sub.HeadSHA)
@@ -246,6 +280,41 @@ func (ri *repoInfo) client(h *grpcHandler.H) (github.Client, error) {
 	return h.OAuth.GithubClient(repoOwner.Username, repoOwner.TokenJSON)
 }
 
+// statusClient returns the client the states of the runs are reported to
+// github with: the GitHub App's if the repository uses the Checks API, as only
+// apps can write check runs, or the repository owner's otherwise.
+func (ri *repoInfo) statusClient(ctx context.Context, h *grpcHandler.H) (github.Client, error) {
+	if !ri.repoConfig.UsesChecks() {
+		return ri.client(h)
+	}
+
+	owner, repo, err := utils.OwnerRepo(ri.parent.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.GithubApp.GithubClient(ctx, owner, repo)
+}
+
+// checkRaisePriority returns an error unless the submitter may queue runs at
+// a higher priority than the repository's.
+func (sp *submissionProcessor) checkRaisePriority(ctx context.Context) error {
+	if sp.repoInfo.user == nil {
+		return errors.New("only manual submissions may set a priority")
+	}
+
+	ok, err := sp.handler.Clients.Data.HasCapability(ctx, sp.repoInfo.user, topTypes.CapabilityPriority)
+	if err != nil {
+		return utils.WrapError(err, "checking capabilities of %q", sp.repoInfo.user.Username)
+	}
+
+	if !ok {
+		return fmt.Errorf("raising the priority above the repository's requires the %q capability", topTypes.CapabilityPriority)
+	}
+
+	return nil
+}
+
 func (sp *submissionProcessor) getSubmittedUserClient(ctx context.Context, submittedBy string) (*types.User, github.Client, error) {
 	if submittedBy == "" {
 		return nil, nil, errors.New("invalid submission -- no `submitted by` field supplied")
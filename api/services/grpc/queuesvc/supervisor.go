@@ -0,0 +1,122 @@
+package queuesvc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tinyci/ci-agents/types"
+	"github.com/tinyci/ci-agents/utils"
+)
+
+const (
+	supervisorIntervalConfigKey = "supervisor_interval" // seconds
+	queueTTLConfigKey           = "queue_ttl"           // queue name to seconds
+
+	defaultSupervisorInterval = 30 * time.Second
+)
+
+func (qs *QueueServer) supervisorInterval() time.Duration {
+	if secs, ok := qs.H.ServiceConfig[supervisorIntervalConfigKey].(int); ok && secs > 0 {
+		return time.Duration(secs) * time.Second
+	}
+
+	return defaultSupervisorInterval
+}
+
+// queueTTLs returns how long items may wait in each queue before they expire.
+// Queues without a TTL are not expired.
+func (qs *QueueServer) queueTTLs() map[string]time.Duration {
+	ttls := map[string]time.Duration{}
+
+	add := func(key, value interface{}) {
+		name, ok := key.(string)
+		if !ok {
+			return
+		}
+
+		if secs, ok := value.(int); ok && secs > 0 {
+			ttls[name] = time.Duration(secs) * time.Second
+		}
+	}
+
+	switch config := qs.H.ServiceConfig[queueTTLConfigKey].(type) {
+	case map[interface{}]interface{}:
+		for name, secs := range config {
+			add(name, secs)
+		}
+	case map[string]interface{}:
+		for name, secs := range config {
+			add(name, secs)
+		}
+	}
+
+	return ttls
+}
+
+// Supervise periodically fails the runs which exceeded their timeout, and
+// expires the queue items which could be claimed for longer than their
+// queue's TTL, until ctx is canceled. Each gets its own failure reason, so
+// they can be told apart from other failures. Timed out runs are canceled, so
+// that their runners stop them, and are retried if their policy allows. When
+// several queuesvcs supervise the same runs, only the first to finish a run
+// succeeds. It is run in the background of the service; see
+// grpcHandler.H.Background.
+func (qs *QueueServer) Supervise(ctx context.Context) {
+	ticker := time.NewTicker(qs.supervisorInterval())
+	defer ticker.Stop()
+
+	ttls := qs.queueTTLs()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		qs.timeOutRuns(ctx)
+		qs.expireQueueItems(ctx, ttls)
+	}
+}
+
+func (qs *QueueServer) timeOutRuns(ctx context.Context) {
+	runs, err := qs.H.Clients.Data.ListTimedOutRuns(ctx)
+	if err != nil {
+		qs.H.Clients.Log.Error(ctx, utils.WrapError(err, "listing timed out runs"))
+		return
+	}
+
+	for _, run := range runs {
+		msg := fmt.Sprintf("The run timed out after %v", time.Duration(run.Settings.GetTimeout()))
+
+		if err := qs.H.Clients.Data.TimeOutRun(ctx, run.Id, msg); err != nil {
+			qs.H.Clients.Log.Error(ctx, utils.WrapError(err, "timing out run %d", run.Id))
+			continue
+		}
+
+		qs.H.Clients.Log.Infof(ctx, "Run %d timed out", run.Id)
+	}
+}
+
+func (qs *QueueServer) expireQueueItems(ctx context.Context, ttls map[string]time.Duration) {
+	for queueName, ttl := range ttls {
+		qis, err := qs.H.Clients.Data.ListExpiredQueueItems(ctx, queueName, ttl)
+		if err != nil {
+			qs.H.Clients.Log.Error(ctx, utils.WrapError(err, "listing expired items of queue %q", queueName))
+			continue
+		}
+
+		for _, qi := range qis {
+			msg := fmt.Sprintf("The run waited in the queue for longer than %v", ttl)
+
+			// this fails if a runner took the item in the meantime.
+			if err := qs.H.Clients.Data.PutStatus(ctx, qi.Run.Id, false, types.FailureReasonExpired, msg); err != nil {
+				qs.H.Clients.Log.Error(ctx, utils.WrapError(err, "expiring run %d", qi.Run.Id))
+				continue
+			}
+
+			qs.H.Clients.Log.Infof(ctx, "Run %d expired in queue %q", qi.Run.Id, queueName)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package queuesvc
+
+import (
+	"time"
+
+	check "github.com/erikh/check"
+	grpcHandler "github.com/tinyci/ci-agents/api/handlers/grpc"
+	"github.com/tinyci/ci-agents/config"
+	yaml "gopkg.in/yaml.v2"
+)
+
+type supervisorSuite struct{}
+
+var _ = check.Suite(&supervisorSuite{})
+
+func (ss *supervisorSuite) TestConfig(c *check.C) {
+	qs := &QueueServer{H: &grpcHandler.H{}}
+	c.Assert(qs.supervisorInterval(), check.Equals, defaultSupervisorInterval)
+	c.Assert(qs.queueTTLs(), check.DeepEquals, map[string]time.Duration{})
+
+	uc := config.UserConfig{}
+	c.Assert(yaml.Unmarshal([]byte(`
+services:
+  supervisor_interval: 10
+  queue_ttl:
+    default: 3600
+    arm64: 600
+    broken: -1
+`), &uc), check.IsNil)
+
+	qs = &QueueServer{H: &grpcHandler.H{UserConfig: uc}}
+	c.Assert(qs.supervisorInterval(), check.Equals, 10*time.Second)
+	c.Assert(qs.queueTTLs(), check.DeepEquals, map[string]time.Duration{
+		"default": time.Hour,
+		"arm64":   10 * time.Minute,
+	})
+}
@@ -10,6 +10,7 @@ import (
 
 	grpcHandler "github.com/tinyci/ci-agents/api/handlers/grpc"
 	"github.com/tinyci/ci-agents/ci-gen/grpc/types"
+	"github.com/tinyci/ci-agents/clients/github"
 	"github.com/tinyci/ci-agents/clients/log"
 	topTypes "github.com/tinyci/ci-agents/types"
 	"github.com/tinyci/ci-agents/utils"
@@ -37,6 +38,8 @@ func (tp *taskPicker) pick(ctx context.Context, sub *topTypes.Submission, repoIn
 		for _, dir := range taskdirs {
 			process[dir] = struct{}{}
 		}
+
+		repoInfo.changedFiles = nil
 	} else {
 		process = tp.selectTasks(dirs, taskdirs)
 	}
@@ -57,22 +60,40 @@ func (tp *taskPicker) pick(ctx context.Context, sub *topTypes.Submission, repoIn
 		return nil, utils.WrapError(err, "computing task directories")
 	}
 
+	taskdirs, err = tp.dependencyOrder(ctx, tasks, taskdirs, repoInfo)
+	if err != nil {
+		return nil, utils.WrapError(err, "ordering tasks by their dependencies")
+	}
+
 	queueCreateTime := time.Now()
 	tp.logger.Info(ctx, "Generating Queue Items")
 	qis := []*types.QueueItem{}
 
+	// taskIDs holds the IDs of the tasks that must succeed before the task in
+	// the keyed directory has succeeded.
+	taskIDs := map[string][]int64{}
+
 	for _, dir := range taskdirs {
 		task := tasks[dir]
+		task.DependsOn = dependencyIDs(task, taskIDs)
 
 		if len(task.Settings.Runs) > 0 {
-
-			tmpQIs, err := tp.generateQueueItems(ctx, dir, task, repoInfo)
+			putTask, tmpQIs, err := tp.generateQueueItems(ctx, dir, task, repoInfo)
 			if err != nil {
 				return nil, utils.WrapError(err, "generating queue items")
 			}
 
-			qis = append(qis, tmpQIs...)
+			if putTask != nil {
+				taskIDs[dir] = []int64{putTask.Id}
+				qis = append(qis, tmpQIs...)
+				continue
+			}
 		}
+
+		// tasks without runs (or whose runs were all skipped) are never
+		// recorded, so anything depending on them waits on their dependencies
+		// instead.
+		taskIDs[dir] = task.DependsOn
 	}
 	tp.logger.Infof(ctx, "Computing queue items took %v", time.Since(queueCreateTime))
 
@@ -90,6 +111,9 @@ func (tp *taskPicker) getDiffFiles(ctx context.Context, repoInfo *repoInfo) (map
 		return nil, nil, utils.WrapError(err, "getting file list for diff")
 	}
 
+	// kept non-nil even for an empty diff; see repoInfo.changedFiles.
+	repoInfo.changedFiles = append([]string{}, diffFiles...)
+
 	dirs := map[string]struct{}{}
 
 	for _, file := range diffFiles {
@@ -115,17 +139,14 @@ func (tp *taskPicker) toProcess(ctx context.Context, repoInfo *repoInfo) (map[st
 
 	for _, file := range allFiles {
 		if path.Base(file) == taskConfigFilename {
-			var skip bool
-			for _, dir := range repoInfo.repoConfig.IgnoreDirs {
-				if strings.HasPrefix(file, dir) {
-					skip = true
-					break
-				}
-			}
+			dir := path.Dir(file)
 
-			if !skip {
-				dir := path.Dir(file)
+			ok, err := repoInfo.repoConfig.ProcessesDir(dir)
+			if err != nil {
+				return nil, nil, utils.WrapError(err, "matching directory %q", dir)
+			}
 
+			if ok {
 				if _, ok := dirMap[dir]; !ok {
 					dirMap[dir] = struct{}{}
 					taskdirs = append(taskdirs, dir)
@@ -209,6 +230,7 @@ func (tp *taskPicker) makeTask(ctx context.Context, subRecord *types.Submission,
 		Settings:   settings,
 		CreatedAt:  timestamppb.Now(),
 		Submission: subRecord,
+		Priority:   repoInfo.priority,
 	}, utils.JSONIO(ts, settings)
 }
 
@@ -230,6 +252,7 @@ func (tp *taskPicker) makeTaskDirs(ctx context.Context, process map[string]struc
 
 		tasks[taskdirs[i]] = task
 		for _, dir := range task.Settings.Dependencies {
+			dir = path.Clean(dir)
 			if _, ok := process[dir]; !ok {
 				process[dir] = struct{}{}
 				taskdirs = append(taskdirs, dir)
@@ -242,12 +265,82 @@ func (tp *taskPicker) makeTaskDirs(ctx context.Context, process map[string]struc
 	return tasks, taskdirs, nil
 }
 
-func (tp *taskPicker) generateQueueItems(ctx context.Context, dir string, task *types.Task, repoInfo *repoInfo) ([]*types.QueueItem, error) {
+// dependencyOrder sorts the task directories so that every task comes after
+// the tasks it depends on. Cyclic dependencies are an error, and are reported
+// to the pull request if there is one.
+func (tp *taskPicker) dependencyOrder(ctx context.Context, tasks map[string]*types.Task, taskdirs []string, repoInfo *repoInfo) ([]string, error) {
+	graph := map[string][]string{}
+
+	for _, dir := range taskdirs {
+		graph[dir] = []string{}
+		for _, dep := range tasks[dir].Settings.Dependencies {
+			graph[dir] = append(graph[dir], path.Clean(dep))
+		}
+	}
+
+	sorted, err := utils.TopoSort(graph)
+	if err != nil {
+		if repoInfo.ticketID != 0 {
+			client, cerr := repoInfo.client(tp.handler)
+			if cerr != nil {
+				return nil, utils.WrapError(cerr, "obtaining client for parent owner")
+			}
+
+			if cerr := client.CommentError(ctx, repoInfo.parent.Name, repoInfo.ticketID, utils.WrapError(err, "tinyCI had an error processing your pull request")); cerr != nil {
+				return nil, utils.WrapError(cerr, "attempting to alert the user about the error in their pull request")
+			}
+		}
+
+		return nil, err
+	}
+
+	return sorted, nil
+}
+
+// dependencyIDs returns the sorted, unique IDs of the tasks which must
+// succeed before the provided task can be run.
+func dependencyIDs(task *types.Task, taskIDs map[string][]int64) []int64 {
+	seen := map[int64]struct{}{}
+	ids := []int64{}
+
+	for _, dep := range task.Settings.Dependencies {
+		for _, id := range taskIDs[path.Clean(dep)] {
+			if _, ok := seen[id]; !ok {
+				seen[id] = struct{}{}
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	return ids
+}
+
+// generateQueueItems records the task and makes queue items for its runs. If
+// every run in the task is filtered out by its event or path filters, the
+// task is not recorded and a nil task is returned.
+func (tp *taskPicker) generateQueueItems(ctx context.Context, dir string, task *types.Task, repoInfo *repoInfo) (*types.Task, []*types.QueueItem, error) {
 	qis := []*types.QueueItem{}
 
+	if err := filterEvents(task.Settings, repoInfo); err != nil {
+		return nil, nil, err
+	}
+
+	expandMatrix(task.Settings)
+	expandConcurrency(task.Settings, repoInfo)
+
+	if err := tp.skipRuns(dir, task.Settings, repoInfo); err != nil {
+		return nil, nil, err
+	}
+
+	if len(task.Settings.Runs) == 0 {
+		return nil, qis, nil
+	}
+
 	task, err := tp.handler.Clients.Data.PutTask(ctx, task)
 	if err != nil {
-		return nil, utils.WrapError(err, "Could not insert task")
+		return nil, nil, utils.WrapError(err, "Could not insert task")
 	}
 
 	names := []string{}
@@ -261,50 +354,224 @@ func (tp *taskPicker) generateQueueItems(ctx context.Context, dir string, task *
 	for _, name := range names {
 		qi, err := tp.makeRunQueue(ctx, name, dir, task, repoInfo)
 		if err != nil {
-			return nil, utils.WrapError(err, "constructing queue item")
+			return nil, nil, utils.WrapError(err, "constructing queue item")
 		}
 		qis = append(qis, qi)
 	}
 
-	return qis, nil
+	return task, qis, nil
 }
 
-func (tp *taskPicker) makeRunQueue(ctx context.Context, name, dir string, task *types.Task, repoInfo *repoInfo) (*types.QueueItem, error) {
-	rs := task.Settings.Runs[name]
+// filterEvents removes the runs that the task's or their own event filters
+// exclude. Nothing is removed for manual submissions.
+func filterEvents(settings *types.TaskSettings, repoInfo *repoInfo) error {
+	var branch string
+
+	switch repoInfo.event {
+	case "":
+		return nil
+	case topTypes.EventPush:
+		branch = repoInfo.forkRef.RefName
+	case topTypes.EventPullRequest:
+		// pull requests are always tested against the default branch.
+		branch = repoInfo.mainBranch()
+	}
+
+	branch = strings.TrimPrefix(branch, "heads/")
+
+	ok, err := eventMatches(settings.On, repoInfo.event, branch)
+	if err != nil {
+		return utils.WrapError(err, "matching task event filters")
+	}
+
+	if !ok {
+		settings.Runs = map[string]*types.RunSettings{}
+		return nil
+	}
 
-	dirStr := dir
+	for name, rs := range settings.Runs {
+		ok, err := eventMatches(rs.On, repoInfo.event, branch)
+		if err != nil {
+			return utils.WrapError(err, "matching event filters for run %q", name)
+		}
+
+		if !ok {
+			delete(settings.Runs, name)
+		}
+	}
+
+	return nil
+}
 
+// eventMatches returns true if the event on the branch passes the filters.
+// Unset filters pass everything.
+func eventMatches(filters *types.EventFilters, event, branch string) (bool, error) {
+	if filters == nil {
+		return true, nil
+	}
+
+	var filter *types.BranchFilter
+
+	switch event {
+	case topTypes.EventPush:
+		filter = filters.Push
+	case topTypes.EventPullRequest:
+		filter = filters.PullRequest
+	}
+
+	if filter == nil {
+		return false, nil
+	}
+
+	m, err := utils.NewMatcher(filter.Branches)
+	if err != nil {
+		return false, err
+	}
+
+	return m.Empty() || m.Match(branch), nil
+}
+
+// skipRuns removes the runs whose path filters match none of the files
+// changed by the submission, recording them to be reported as skipped.
+func (tp *taskPicker) skipRuns(dir string, settings *types.TaskSettings, repoInfo *repoInfo) error {
+	if repoInfo.changedFiles == nil {
+		return nil
+	}
+
+	for name, rs := range settings.Runs {
+		ok, err := runMatchesPaths(rs, repoInfo.changedFiles)
+		if err != nil {
+			return utils.WrapError(err, "matching paths for run %q", name)
+		}
+
+		if !ok {
+			delete(settings.Runs, name)
+			repoInfo.skipped = append(repoInfo.skipped, runName(dir, name))
+		}
+	}
+
+	return nil
+}
+
+// runMatchesPaths returns true if any of the files trigger the run according
+// to its paths and paths_ignore settings. Runs without either always match.
+func runMatchesPaths(rs *types.RunSettings, files []string) (bool, error) {
+	if len(rs.Paths) == 0 && len(rs.PathsIgnore) == 0 {
+		return true, nil
+	}
+
+	paths, err := utils.NewMatcher(rs.Paths)
+	if err != nil {
+		return false, err
+	}
+
+	ignore, err := utils.NewMatcher(rs.PathsIgnore)
+	if err != nil {
+		return false, err
+	}
+
+	for _, file := range files {
+		if ignore.MatchPath(file) {
+			continue
+		}
+
+		if paths.Empty() || paths.MatchPath(file) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func runName(dir, name string) string {
 	if dir == "." || dir == "" {
-		dirStr = "*root*"
+		dir = "*root*"
 	}
 
+	return strings.Join([]string{dir, name}, ":")
+}
+
+func (tp *taskPicker) makeRunQueue(ctx context.Context, name, dir string, task *types.Task, repoInfo *repoInfo) (*types.QueueItem, error) {
+	rs := task.Settings.Runs[name]
+
 	run := &types.Run{
-		Name:      strings.Join([]string{dirStr, name}, ":"),
+		Name:      runName(dir, name),
 		Settings:  rs,
 		Task:      task,
 		CreatedAt: timestamppb.Now(),
 	}
 
-	go tp.setPendingStatus(ctx, run, repoInfo)
-
 	return &types.QueueItem{
 		Run:       run,
 		QueueName: run.Settings.Queue,
+		Priority:  repoInfo.priority,
 	}, nil
 }
 
+// setPendingStatus reports the queued run as pending to github. The run must
+// have been queued already, so its check run can carry its ID.
 func (tp *taskPicker) setPendingStatus(ctx context.Context, run *types.Run, repoInfo *repoInfo) {
 	parts := strings.SplitN(repoInfo.parent.Name, "/", 2)
 	if len(parts) != 2 {
 		tp.logger.Error(ctx, fmt.Errorf("invalid repo name %q", repoInfo.parent.Name))
+		return
 	}
 
-	client, err := repoInfo.client(tp.handler)
+	client, err := repoInfo.statusClient(ctx, tp.handler)
 	if err != nil {
-		tp.logger.Error(ctx, utils.WrapError(err, "could not obtain client for parent owner"))
+		tp.logger.Error(ctx, utils.WrapError(err, "could not obtain client to report statuses with"))
+		return
+	}
+
+	if repoInfo.repoConfig.UsesChecks() {
+		err = client.ReportCheckRun(ctx, parts[0], parts[1], &github.CheckRun{
+			Name:       run.Name,
+			SHA:        repoInfo.forkRef.Sha,
+			URL:        tp.handler.URL,
+			ExternalID: fmt.Sprintf("%d", run.Id),
+			Status:     github.CheckQueued,
+			Title:      "The run will be starting soon.",
+		})
+	} else {
+		err = client.PendingStatus(ctx, parts[0], parts[1], run.Name, repoInfo.forkRef.Sha, tp.handler.URL)
 	}
 
-	if err := client.PendingStatus(ctx, parts[0], parts[1], run.Name, repoInfo.forkRef.Sha, tp.handler.URL); err != nil {
+	if err != nil {
 		tp.logger.Error(ctx, utils.WrapError(err, "could not set pending status"))
 	}
 }
+
+// setSkippedStatus reports the run as skipped to github. Skipped runs are never
+// recorded, so their check runs have no ID and cannot be re-run.
+func (tp *taskPicker) setSkippedStatus(ctx context.Context, name string, repoInfo *repoInfo) {
+	parts := strings.SplitN(repoInfo.parent.Name, "/", 2)
+	if len(parts) != 2 {
+		tp.logger.Error(ctx, fmt.Errorf("invalid repo name %q", repoInfo.parent.Name))
+		return
+	}
+
+	client, err := repoInfo.statusClient(ctx, tp.handler)
+	if err != nil {
+		tp.logger.Error(ctx, utils.WrapError(err, "could not obtain client to report statuses with"))
+		return
+	}
+
+	const reason = "no changed files matched its paths"
+
+	if repoInfo.repoConfig.UsesChecks() {
+		err = client.ReportCheckRun(ctx, parts[0], parts[1], &github.CheckRun{
+			Name:       name,
+			SHA:        repoInfo.forkRef.Sha,
+			URL:        tp.handler.URL,
+			Status:     github.CheckCompleted,
+			Conclusion: github.CheckSkipped,
+			Title:      "The run was skipped: " + reason,
+		})
+	} else {
+		err = client.SkippedStatus(ctx, parts[0], parts[1], name, repoInfo.forkRef.Sha, tp.handler.URL, reason)
+	}
+
+	if err != nil {
+		tp.logger.Error(ctx, utils.WrapError(err, "could not set skipped status"))
+	}
+}
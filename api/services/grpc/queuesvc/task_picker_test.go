@@ -0,0 +1,64 @@
+package queuesvc
+
+import (
+	check "github.com/erikh/check"
+	"github.com/tinyci/ci-agents/ci-gen/grpc/types"
+	topTypes "github.com/tinyci/ci-agents/types"
+)
+
+type pickerSuite struct{}
+
+var _ = check.Suite(&pickerSuite{})
+
+func (ps *pickerSuite) TestRunMatchesPaths(c *check.C) {
+	table := []struct {
+		paths       []string
+		pathsIgnore []string
+		files       []string
+		matches     bool
+	}{
+		{files: []string{"README.md"}, matches: true},
+		{files: []string{}, matches: true},
+		{paths: []string{"src/**/*.go"}, files: []string{"README.md", "src/foo/bar.go"}, matches: true},
+		{paths: []string{"src/**/*.go"}, files: []string{"README.md", "docs/foo.go"}, matches: false},
+		{paths: []string{"src"}, files: []string{"src/foo/bar.go"}, matches: true},
+		{paths: []string{"src"}, files: []string{}, matches: false},
+		{pathsIgnore: []string{"docs", "**/*.md"}, files: []string{"docs/index.html", "src/README.md"}, matches: false},
+		{pathsIgnore: []string{"docs", "**/*.md"}, files: []string{"docs/index.html", "main.go"}, matches: true},
+		{paths: []string{"re:^src/.*\\.go$"}, pathsIgnore: []string{"**/*_test.go"}, files: []string{"src/foo_test.go"}, matches: false},
+		{paths: []string{"re:^src/.*\\.go$"}, pathsIgnore: []string{"**/*_test.go"}, files: []string{"src/foo_test.go", "src/foo.go"}, matches: true},
+	}
+
+	for i, item := range table {
+		ok, err := runMatchesPaths(&types.RunSettings{Paths: item.paths, PathsIgnore: item.pathsIgnore}, item.files)
+		c.Assert(err, check.IsNil, check.Commentf("%d", i))
+		c.Assert(ok, check.Equals, item.matches, check.Commentf("%d", i))
+	}
+}
+
+func (ps *pickerSuite) TestEventMatches(c *check.C) {
+	filters := &types.EventFilters{
+		Push:        &types.BranchFilter{Branches: []string{"master", "release-*"}},
+		PullRequest: &types.BranchFilter{},
+	}
+
+	table := []struct {
+		filters *types.EventFilters
+		event   string
+		branch  string
+		matches bool
+	}{
+		{event: topTypes.EventPush, branch: "feature", matches: true},
+		{filters: filters, event: topTypes.EventPush, branch: "master", matches: true},
+		{filters: filters, event: topTypes.EventPush, branch: "release-1.0", matches: true},
+		{filters: filters, event: topTypes.EventPush, branch: "feature", matches: false},
+		{filters: filters, event: topTypes.EventPullRequest, branch: "anything", matches: true},
+		{filters: &types.EventFilters{Push: filters.Push}, event: topTypes.EventPullRequest, branch: "master", matches: false},
+	}
+
+	for i, item := range table {
+		ok, err := eventMatches(item.filters, item.event, item.branch)
+		c.Assert(err, check.IsNil, check.Commentf("%d", i))
+		c.Assert(ok, check.Equals, item.matches, check.Commentf("%d", i))
+	}
+}
@@ -33,7 +33,9 @@ func MakeQueueServer() (*grpcHandler.H, chan struct{}, error) {
 	}
 
 	srv := grpc.NewServer()
-	queue.RegisterQueueServer(srv, &QueueServer{H: h})
+	qs := &QueueServer{H: h}
+	queue.RegisterQueueServer(srv, qs)
+	h.Background(qs.Supervise)
 
 	doneChan, err := h.Boot(t, srv, make(chan struct{}))
 	return h, doneChan, err
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strconv"
 
 	"errors"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/tinyci/ci-agents/ci-gen/grpc/types"
 	"github.com/tinyci/ci-agents/clients/data"
+	githubClient "github.com/tinyci/ci-agents/clients/github"
 	"github.com/tinyci/ci-agents/clients/log"
 	"github.com/tinyci/ci-agents/clients/queue"
 	"github.com/tinyci/ci-agents/config"
@@ -23,13 +25,29 @@ import (
 const (
 	eventPush        = "push"
 	eventPullRequest = "pull_request"
+	eventCheckRun    = "check_run"
 	eventPing        = "ping"
 
-	actionOpened      = "opened"
-	actionSynchronize = "synchronize"
-	actionClosed      = "closed"
+	actionOpened          = "opened"
+	actionSynchronize     = "synchronize"
+	actionClosed          = "closed"
+	actionRerequested     = "rerequested"
+	actionRequestedAction = "requested_action"
 )
 
+// errIgnoredEvent is returned by dispatchers for events which need no action,
+// such as the check runs tinyCI creates and completes itself.
+var errIgnoredEvent = errors.New("event ignored")
+
+// checkRunEvent is a check_run event, including the action requested through
+// the buttons of check runs, which our version of go-github predates.
+type checkRunEvent struct {
+	github.CheckRunEvent
+	RequestedAction *struct {
+		Identifier string `json:"identifier"`
+	} `json:"requested_action,omitempty"`
+}
+
 // ErrCancelPR is the error returned when a pr should be canceled.
 type ErrCancelPR struct {
 	PRID       int64
@@ -40,12 +58,22 @@ func (ec *ErrCancelPR) Error() string {
 	return fmt.Sprintf("PR ID %d is requested to be canceled", ec.PRID)
 }
 
+// ErrRerunRun is the error returned when a run should be re-run.
+type ErrRerunRun struct {
+	RunID int64
+}
+
+func (er *ErrRerunRun) Error() string {
+	return fmt.Sprintf("run %d is requested to be re-run", er.RunID)
+}
+
 // HandlerConfig configures the hooksvc handler.
 type HandlerConfig struct {
-	TLS           config.CertConfig `yaml:"tls"`
-	QueueEndpoint string            `yaml:"queue_service"` // endpoint of queuesvc to submit to
-	DataEndpoint  string            `yaml:"data_service"`
-	LogEndpoint   string            `yaml:"log_service"`
+	TLS           config.CertConfig      `yaml:"tls"`
+	QueueEndpoint string                 `yaml:"queue_service"` // endpoint of queuesvc to submit to
+	DataEndpoint  string                 `yaml:"data_service"`
+	LogEndpoint   string                 `yaml:"log_service"`
+	GithubApp     config.GithubAppConfig `yaml:"github_app"` // the app check_run events are delivered by; only its id and webhook_secret are used
 }
 
 type (
@@ -95,16 +123,19 @@ func (h *Handler) Init() error {
 	h.dispatch = dispatchFunc{
 		eventPush:        h.pushDispatch,
 		eventPullRequest: h.prDispatch,
+		eventCheckRun:    h.checkRunDispatch,
 	}
 
 	h.converter = converterFunc{
 		eventPush:        h.pushConvert,
 		eventPullRequest: h.prConvert,
+		eventCheckRun:    h.checkRunConvert,
 	}
 
 	h.getRepo = getRepoFunc{
 		eventPush:        h.pushGetRepo,
 		eventPullRequest: h.prGetRepo,
+		eventCheckRun:    h.checkRunGetRepo,
 	}
 
 	return nil
@@ -121,6 +152,7 @@ func (h *Handler) pushDispatch(obj interface{}) (*topTypes.Submission, error) {
 		Fork:    push.GetRepo().GetFullName(),
 		HeadSHA: push.GetAfter(),
 		BaseSHA: push.GetBefore(),
+		Event:   topTypes.EventPush,
 	}, nil
 }
 
@@ -140,6 +172,7 @@ func (h *Handler) prDispatch(obj interface{}) (*topTypes.Submission, error) {
 			HeadSHA:  pr.PullRequest.Head.GetSHA(),
 			BaseSHA:  pr.PullRequest.Base.GetSHA(),
 			TicketID: int64(pr.PullRequest.GetNumber()),
+			Event:    topTypes.EventPullRequest,
 		}, nil
 	case actionClosed:
 		return nil, &ErrCancelPR{Repository: pr.PullRequest.Base.Repo.GetFullName(), PRID: int64(pr.PullRequest.GetNumber())}
@@ -148,6 +181,59 @@ func (h *Handler) prDispatch(obj interface{}) (*topTypes.Submission, error) {
 	}
 }
 
+// checkRunDispatch re-runs the run of a check run when it is re-run from
+// github, through either its "Re-run" button or github's own. Only check runs
+// of tinyCI's GitHub App are re-run, and only if their run belongs to the
+// repository of the event.
+func (h *Handler) checkRunDispatch(obj interface{}) (*topTypes.Submission, error) {
+	cr, ok := obj.(*checkRunEvent)
+	if !ok {
+		return nil, errors.New("cast failed")
+	}
+
+	switch cr.GetAction() {
+	case actionRerequested:
+	case actionRequestedAction:
+		if cr.RequestedAction == nil || cr.RequestedAction.Identifier != githubClient.CheckRerunAction {
+			return nil, errIgnoredEvent
+		}
+	default:
+		return nil, errIgnoredEvent
+	}
+
+	if h.Config.GithubApp.ID == 0 || cr.GetCheckRun().GetApp().GetID() != h.Config.GithubApp.ID {
+		// the external IDs of other apps' check runs are not our run IDs.
+		return nil, errIgnoredEvent
+	}
+
+	externalID := cr.GetCheckRun().GetExternalID()
+	if externalID == "" {
+		// the check runs of skipped runs have nothing to re-run.
+		return nil, errIgnoredEvent
+	}
+
+	runID, err := strconv.ParseInt(externalID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("check run %d was not created by tinyci", cr.GetCheckRun().GetID())
+	}
+
+	repo, err := h.checkRunGetRepo(cr)
+	if err != nil {
+		return nil, err
+	}
+
+	repos, err := h.dataClient.RunRepositories(context.Background(), []int64{runID})
+	if err != nil {
+		return nil, err
+	}
+
+	if repoID, ok := repos[runID]; !ok || repoID != repo.Id {
+		return nil, fmt.Errorf("run %d of check run %d does not belong to %s", runID, cr.GetCheckRun().GetID(), cr.GetRepo().GetFullName())
+	}
+
+	return nil, &ErrRerunRun{RunID: runID}
+}
+
 func (h *Handler) pushConvert(data []byte) (interface{}, error) {
 	obj := &github.PushEvent{}
 	return obj, json.Unmarshal(data, obj)
@@ -158,6 +244,11 @@ func (h *Handler) prConvert(data []byte) (interface{}, error) {
 	return obj, json.Unmarshal(data, obj)
 }
 
+func (h *Handler) checkRunConvert(data []byte) (interface{}, error) {
+	obj := &checkRunEvent{}
+	return obj, json.Unmarshal(data, obj)
+}
+
 func (h *Handler) pushGetRepo(obj interface{}) (*types.Repository, error) {
 	push, ok := obj.(*github.PushEvent)
 	if !ok {
@@ -199,3 +290,17 @@ func (h *Handler) prGetRepo(obj interface{}) (*types.Repository, error) {
 func (h *Handler) getLog(req *http.Request, reqUUID uuid.UUID) *log.SubLogger {
 	return h.logClient.WithRequest(req).WithFields(log.FieldMap{"request_uuid": reqUUID.String()})
 }
+
+func (h *Handler) checkRunGetRepo(obj interface{}) (*types.Repository, error) {
+	cr, ok := obj.(*checkRunEvent)
+	if !ok {
+		return nil, errors.New("cast failed")
+	}
+
+	_, _, err := utils.OwnerRepo(cr.GetRepo().GetFullName())
+	if err != nil {
+		return nil, err
+	}
+
+	return h.dataClient.GetRepository(context.Background(), cr.GetRepo().GetFullName())
+}
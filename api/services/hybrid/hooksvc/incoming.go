@@ -7,6 +7,7 @@ import (
 	"crypto/hmac"
 	"crypto/sha1" // #nosec
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -69,14 +70,21 @@ func (h *Handler) checkRepo(w http.ResponseWriter, req *http.Request, logger *lo
 		return true
 	}
 
-	if repo.HookSecret == "" {
+	// check runs are written by tinyCI's github app, so their events are
+	// delivered by the app, signed with its webhook secret.
+	secret := repo.HookSecret
+	if event == eventCheckRun {
+		secret = h.Config.GithubApp.WebhookSecret
+	}
+
+	if secret == "" {
 		logger.Error(context.Background(), "Rejected hook event because the hook secret is missing")
 		http.Error(w, "Bad Request", http.StatusBadRequest)
 		return true
 	}
 
 	signature := req.Header.Get("X-Hub-Signature")
-	if !h.isValidSignature(body, repo.HookSecret, signature) {
+	if !h.isValidSignature(body, secret, signature) {
 		logger.Error(context.Background(), "Rejected hook event because the request signature is invalid")
 		http.Error(w, "Bad Request", http.StatusBadRequest)
 		return true
@@ -93,6 +101,11 @@ func (h *Handler) doSubmit(w http.ResponseWriter, logger *log.SubLogger, obj int
 		return true
 	}
 	sub, err := dispatch(obj)
+	if errors.Is(err, errIgnoredEvent) {
+		logger.Infof(context.Background(), "Ignoring %s event", event)
+		return false
+	}
+
 	if err != nil {
 		switch err := err.(type) {
 		case *ErrCancelPR:
@@ -101,6 +114,15 @@ func (h *Handler) doSubmit(w http.ResponseWriter, logger *log.SubLogger, obj int
 				return true
 			}
 
+			return false
+		case *ErrRerunRun:
+			logger.WithFields(log.FieldMap{"run_id": fmt.Sprintf("%v", err.RunID)}).Info(context.Background(), "Re-running run")
+			if _, err := h.queueClient.RerunRun(context.Background(), err.RunID); err != nil {
+				logger.Errorf(context.Background(), "Rejected hook event because the run could not be re-run: %v", err)
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return true
+			}
+
 			return false
 		default:
 			logger.Errorf(context.Background(), "Rejected hook event because we could not dispatch the submission: %v", err)
@@ -0,0 +1,63 @@
+package uisvc
+
+import (
+	"fmt"
+	"mime"
+
+	"github.com/labstack/echo/v4"
+	"github.com/tinyci/ci-agents/ci-gen/grpc/services/asset"
+	"github.com/tinyci/ci-agents/ci-gen/openapi/services/uisvc"
+)
+
+func artifactToOpenAPI(info *asset.ArtifactInfo) uisvc.Artifact {
+	artifact := uisvc.Artifact{
+		RunId:       &info.RunID,
+		Name:        &info.Name,
+		ContentType: &info.ContentType,
+		Sha256:      &info.Sha256,
+		Size:        &info.Size,
+	}
+
+	if info.Report != "" {
+		report := uisvc.ArtifactReport(info.Report)
+		artifact.Report = &report
+	}
+
+	if info.Created.IsValid() {
+		created := info.Created.AsTime()
+		artifact.CreatedAt = &created
+	}
+
+	return artifact
+}
+
+// GetArtifactsRunId lists the artifacts of a run.
+func (h *H) GetArtifactsRunId(ctx echo.Context, runID int64) error {
+	artifacts, err := h.clients.Asset.ListArtifacts(ctx.Request().Context(), runID)
+	if err != nil {
+		return err
+	}
+
+	list := uisvc.ArtifactList{}
+	for _, info := range artifacts {
+		list = append(list, artifactToOpenAPI(info))
+	}
+
+	return ctx.JSON(200, list)
+}
+
+// GetArtifactsRunIdName sends the content of an artifact as a download.
+func (h *H) GetArtifactsRunIdName(ctx echo.Context, runID int64, name string) error {
+	info, r, err := h.clients.Asset.OpenArtifact(ctx.Request().Context(), runID, name)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	header := ctx.Response().Header()
+	header.Set("Content-Length", fmt.Sprintf("%d", info.Size))
+	header.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": info.Name}))
+	header.Set("ETag", fmt.Sprintf("%q", info.Sha256))
+
+	return ctx.Stream(200, info.ContentType, r)
+}
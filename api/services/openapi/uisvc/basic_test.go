@@ -11,6 +11,10 @@ import (
 
 	check "github.com/erikh/check"
 	"github.com/golang/mock/gomock"
+	"github.com/tinyci/ci-agents/ci-gen/grpc/services/asset"
+	gtypes "github.com/tinyci/ci-agents/ci-gen/grpc/types"
+	"github.com/tinyci/ci-agents/ci-gen/openapi/services/uisvc"
+	"github.com/tinyci/ci-agents/clients/tinyci"
 	"github.com/tinyci/ci-agents/config"
 	"github.com/tinyci/ci-agents/mocks/github"
 	"github.com/tinyci/ci-agents/types"
@@ -79,6 +83,127 @@ func (us *uisvcSuite) TestLogAttach(c *check.C) {
 	c.Assert(tc.LogAttach(ctx, 1, buf), check.IsNil)
 	c.Assert(strings.HasPrefix(buf.String(), "this is a log"), check.Equals, true, check.Commentf("buf: %s", buf))
 	c.Assert(utc.LogAttach(ctx, 1, buf), check.NotNil)
+
+	buf = &closeBuffer{bytes.NewBuffer(nil)}
+	c.Assert(tc.LogAttachRange(ctx, 1, tinyci.LogOptions{Offset: 5, Limit: 2}, buf), check.IsNil)
+	c.Assert(buf.String(), check.Equals, "is")
+}
+
+func (us *uisvcSuite) TestLogSections(c *check.C) {
+	client := github.NewMockClient(gomock.NewController(c))
+	_, doneChan, tc, utc, err := MakeUIServer(client)
+	c.Assert(err, check.IsNil)
+	defer close(doneChan)
+
+	c.Assert(us.assetsvcClient.Write(context.Background(), 1, bytes.NewBufferString("::group::build\nmake\n::endgroup::\n")), check.IsNil)
+
+	res, err := tc.LogSections(ctx, 1)
+	c.Assert(err, check.IsNil)
+	c.Assert(*res.Complete, check.Equals, true)
+	c.Assert(len(*res.Sections), check.Equals, 1)
+	c.Assert(*(*res.Sections)[0].Name, check.Equals, "build")
+	c.Assert(*(*res.Sections)[0].Lines, check.Equals, int64(1))
+	c.Assert((*res.Sections)[0].Duration, check.NotNil)
+
+	buf := &closeBuffer{bytes.NewBuffer(nil)}
+	c.Assert(tc.LogAttachRange(ctx, 1, tinyci.LogOptions{Offset: *(*res.Sections)[0].Offset, Limit: *(*res.Sections)[0].Length}, buf), check.IsNil)
+	c.Assert(buf.String(), check.Equals, "make\n")
+
+	_, err = utc.LogSections(ctx, 1)
+	c.Assert(err, check.NotNil)
+}
+
+func (us *uisvcSuite) TestArtifacts(c *check.C) {
+	client := github.NewMockClient(gomock.NewController(c))
+	_, doneChan, tc, utc, err := MakeUIServer(client)
+	c.Assert(err, check.IsNil)
+	defer close(doneChan)
+
+	_, err = us.assetsvcClient.PutArtifact(context.Background(), &asset.ArtifactInfo{RunID: 1, Name: "coverage.txt"}, bytes.NewBufferString("coverage: 80%\n"))
+	c.Assert(err, check.IsNil)
+
+	list, err := tc.Artifacts(ctx, 1)
+	c.Assert(err, check.IsNil)
+	c.Assert(len(list), check.Equals, 1)
+	c.Assert(*list[0].Name, check.Equals, "coverage.txt")
+	c.Assert(*list[0].Size, check.Equals, int64(len("coverage: 80%\n")))
+
+	buf := bytes.NewBuffer(nil)
+	c.Assert(tc.DownloadArtifact(ctx, 1, "coverage.txt", buf), check.IsNil)
+	c.Assert(buf.String(), check.Equals, "coverage: 80%\n")
+
+	c.Assert(tc.DownloadArtifact(ctx, 1, "missing", bytes.NewBuffer(nil)), check.NotNil)
+
+	_, err = utc.Artifacts(ctx, 1)
+	c.Assert(err, check.NotNil)
+	c.Assert(utc.DownloadArtifact(ctx, 1, "coverage.txt", bytes.NewBuffer(nil)), check.NotNil)
+}
+
+func (us *uisvcSuite) TestTestCases(c *check.C) {
+	client := github.NewMockClient(gomock.NewController(c))
+	_, doneChan, tc, _, err := MakeUIServer(client)
+	c.Assert(err, check.IsNil)
+	defer close(doneChan)
+
+	qi, err := us.datasvcClient.MakeQueueItem()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(us.datasvcClient.Client().AddTestCases(ctx, qi.Run.Id, []*gtypes.TestCase{
+		{Suite: "api", Name: "TestA", Status: types.TestPassed, Duration: 1},
+		{Suite: "api", Name: "TestB", Status: types.TestFailed, Duration: 2, Message: "wanted 1, got 2"},
+	}), check.IsNil)
+
+	cases, err := tc.TestCases(ctx, qi.Run.Id, "")
+	c.Assert(err, check.IsNil)
+	c.Assert(len(cases), check.Equals, 2)
+	c.Assert(*cases[0].Name, check.Equals, "TestA")
+
+	cases, err = tc.TestCases(ctx, qi.Run.Id, types.TestFailed)
+	c.Assert(err, check.IsNil)
+	c.Assert(len(cases), check.Equals, 1)
+	c.Assert(*cases[0].Message, check.Equals, "wanted 1, got 2")
+
+	summary, err := tc.TestSummary(ctx, qi.Run.Id)
+	c.Assert(err, check.IsNil)
+	c.Assert(*summary.Total, check.Equals, int64(2))
+	c.Assert(*summary.Failed, check.Equals, int64(1))
+	c.Assert(*summary.Duration, check.Equals, float64(3))
+	c.Assert(*summary.Failures, check.DeepEquals, []string{"TestB"})
+}
+
+func (us *uisvcSuite) TestFlakyTests(c *check.C) {
+	client := github.NewMockClient(gomock.NewController(c))
+	_, doneChan, tc, _, err := MakeUIServer(client)
+	c.Assert(err, check.IsNil)
+	defer close(doneChan)
+
+	c.Assert(us.datasvcClient.MakeRepo("erikh/flaky", "erikh", false, ""), check.IsNil)
+
+	tests, err := tc.FlakyTests(ctx, "erikh", "flaky", 7)
+	c.Assert(err, check.IsNil)
+	c.Assert(len(tests), check.Equals, 0)
+
+	_, err = tc.FlakyTests(ctx, "erikh", "not-real", 0)
+	c.Assert(err, check.NotNil)
+}
+
+func (us *uisvcSuite) TestLogSearch(c *check.C) {
+	client := github.NewMockClient(gomock.NewController(c))
+	_, doneChan, tc, utc, err := MakeUIServer(client)
+	c.Assert(err, check.IsNil)
+	defer close(doneChan)
+
+	c.Assert(us.assetsvcClient.Write(context.Background(), 1, bytes.NewBufferString("ok\nFAIL: TestFlaky\n")), check.IsNil)
+
+	res, err := tc.LogSearch(ctx, uisvc.GetLogsSearchParams{Pattern: "^FAIL"})
+	c.Assert(err, check.IsNil)
+	c.Assert(len(*res.Matches), check.Equals, 1)
+	c.Assert(*(*res.Matches)[0].RunId, check.Equals, int64(1))
+	c.Assert(*(*res.Matches)[0].Line, check.Equals, int64(2))
+	c.Assert(*res.Truncated, check.Equals, false)
+
+	_, err = utc.LogSearch(ctx, uisvc.GetLogsSearchParams{Pattern: "^FAIL"})
+	c.Assert(err, check.NotNil)
 }
 
 func (us *uisvcSuite) TestTokenEndpoints(c *check.C) {
@@ -113,7 +238,7 @@ func (us *uisvcSuite) TestSubmit(c *check.C) {
 	config.SetDefaultGithubClient(erikhClient, "erikh")
 
 	erikhClient.EXPECT().GetRepository(gomock.Any(), "erikh/not-real").Return(nil, errors.New("not found"))
-	c.Assert(tc.Submit(ctx, "erikh/not-real", "master", true), check.ErrorMatches, ".* not found")
+	c.Assert(tc.Submit(ctx, "erikh/not-real", "master", true, nil), check.ErrorMatches, ".* not found")
 
 	erikhClient.EXPECT().MyRepositories(gomock.Any()).Return([]*gh.Repository{{FullName: gh.String("erikh/parent")}}, nil)
 
@@ -124,7 +249,7 @@ func (us *uisvcSuite) TestSubmit(c *check.C) {
 	c.Assert(us.datasvcClient.Client().EnableRepository(ctx, "erikh", "erikh/parent"), check.IsNil)
 
 	erikhClient.EXPECT().GetRepository(gomock.Any(), "erikh/not-real").Return(nil, errors.New("not found"))
-	c.Assert(tc.Submit(ctx, "erikh/not-real", "master", true), check.ErrorMatches, ".* not found")
+	c.Assert(tc.Submit(ctx, "erikh/not-real", "master", true, nil), check.ErrorMatches, ".* not found")
 
 	sub := &types.Submission{
 		Parent:  "erikh/parent",
@@ -145,7 +270,7 @@ func (us *uisvcSuite) TestSubmit(c *check.C) {
 	client.EXPECT().GetSHA(gomock.Any(), sub.Parent, "heads/master").Return(sub.HeadSHA, nil)
 	client.EXPECT().ClearStates(gomock.Any(), "erikh/parent", sub.HeadSHA).Return(nil)
 
-	c.Assert(tc.Submit(ctx, "erikh/test", "master", true), check.ErrorMatches, ".*not found")
+	c.Assert(tc.Submit(ctx, "erikh/test", "master", true, nil), check.ErrorMatches, ".*not found")
 
 	erikhClient.EXPECT().GetRepository(gomock.Any(), sub.Parent).Return(&gh.Repository{FullName: gh.String(sub.Parent)}, nil)
 	erikhClient.EXPECT().GetRepository(gomock.Any(), sub.Fork).Return(&gh.Repository{FullName: gh.String(sub.Fork), Fork: gh.Bool(true), Parent: &gh.Repository{FullName: gh.String(sub.Parent)}}, nil)
@@ -155,13 +280,16 @@ func (us *uisvcSuite) TestSubmit(c *check.C) {
 	client.EXPECT().GetSHA(gomock.Any(), "erikh/parent", "heads/master").Return("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", nil)
 	client.EXPECT().ClearStates(gomock.Any(), "erikh/parent", sub.HeadSHA).Return(nil)
 
-	c.Assert(tc.Submit(ctx, "erikh/test", "master", true), check.ErrorMatches, ".*not found")
+	c.Assert(tc.Submit(ctx, "erikh/test", "master", true, nil), check.ErrorMatches, ".*not found")
+
+	outOfRange := int32(types.MaxPriority + 1)
+	c.Assert(tc.Submit(ctx, "erikh/test", "master", true, &outOfRange), check.ErrorMatches, ".*out of range.*")
 
 	erikhClient.EXPECT().ClearStates(gomock.Any(), "erikh/parent", sub.HeadSHA).Return(nil)
 	c.Assert(us.queuesvcClient.SetMockSubmissionSuccess(erikhClient.EXPECT(), sub, "heads/master", ""), check.IsNil)
 
-	c.Assert(utc.Submit(ctx, "erikh/test", "master", true), check.NotNil)
-	c.Assert(tc.Submit(ctx, "erikh/test", "master", true), check.IsNil)
+	c.Assert(utc.Submit(ctx, "erikh/test", "master", true, nil), check.NotNil)
+	c.Assert(tc.Submit(ctx, "erikh/test", "master", true, nil), check.IsNil)
 
 	tasks, err := tc.Tasks(ctx, stringp("erikh/test"), &sub.HeadSHA, nil, nil)
 	c.Assert(err, check.IsNil)
@@ -205,6 +333,23 @@ func (us *uisvcSuite) TestSubmit(c *check.C) {
 	run, err := tc.GetRun(ctx, *runs[0].Id)
 	c.Assert(err, check.IsNil)
 	c.Assert(*run.Task.Canceled, check.Equals, true)
+
+	_, err = utc.RerunTask(ctx, *run.Task.Id)
+	c.Assert(err, check.NotNil)
+
+	erikhClient.EXPECT().PendingStatus(gomock.Any(), "erikh", "parent", gomock.Any(), sub.HeadSHA, gomock.Any()).Return(nil).Times(len(runs))
+
+	reruns, err := tc.RerunTask(ctx, *run.Task.Id)
+	c.Assert(err, check.IsNil)
+	c.Assert(len(reruns), check.Equals, len(runs))
+	c.Assert(*reruns[0].Task.RerunOfId, check.Equals, *run.Task.Id)
+	c.Assert(*reruns[0].Attempt, check.Equals, int64(2))
+
+	// only the latest copy of a task can be re-run, and unfinished runs cannot.
+	_, err = tc.RerunTask(ctx, *run.Task.Id)
+	c.Assert(err, check.NotNil)
+	_, err = tc.RerunRun(ctx, *reruns[0].Id)
+	c.Assert(err, check.NotNil)
 }
 
 func (us *uisvcSuite) TestAddDeleteCI(c *check.C) {
@@ -1,19 +1,125 @@
 package uisvc
 
 import (
+	"fmt"
+
 	"github.com/labstack/echo/v4"
+	"github.com/tinyci/ci-agents/ci-gen/grpc/services/asset"
+	"github.com/tinyci/ci-agents/ci-gen/openapi/services/uisvc"
+	assetClient "github.com/tinyci/ci-agents/clients/asset"
 	"github.com/tinyci/ci-agents/clients/jsonbuffer"
 	"golang.org/x/net/websocket"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // GetLogAttachId connects to a running logging process and outputs the return data as it arrives.
-func (h *H) GetLogAttachId(ctx echo.Context, id int64) error {
+func (h *H) GetLogAttachId(ctx echo.Context, id int64, params uisvc.GetLogAttachIdParams) error {
+	var opts assetClient.LogOptions
+
+	if params.Offset != nil {
+		opts.Offset = *params.Offset
+	}
+
+	if params.Limit != nil {
+		opts.Limit = *params.Limit
+	}
+
+	if params.Tail != nil {
+		if *params.Tail < 0 || *params.Tail > assetClient.MaxTail {
+			return fmt.Errorf("tail must be between 0 and %d lines", assetClient.MaxTail)
+		}
+
+		opts.Tail = *params.Tail
+	}
+
 	var retErr error
 	websocket.Handler(func(ws *websocket.Conn) {
 		defer ws.Close()
 
-		retErr = h.clients.Asset.Read(ctx.Request().Context(), id, jsonbuffer.NewWriteWrapper(ws))
+		w := jsonbuffer.NewWriteWrapper(ws)
+
+		retErr = h.clients.Asset.ReadChunks(ctx.Request().Context(), id, opts, func(chunk *asset.LogChunk) error {
+			if chunk.Complete {
+				return w.Send(string(chunk.Chunk))
+			}
+
+			return w.SendAt(string(chunk.Chunk), chunk.Offset)
+		})
+
+		// let the client tell a failed read from a finished one.
+		if retErr != nil {
+			w.SendError(retErr) // #nosec
+		}
 	}).ServeHTTP(ctx.Response(), ctx.Request())
 
 	return retErr
 }
+
+// GetLogsSearch finds the lines matching a pattern in the logs of finished runs.
+func (h *H) GetLogsSearch(ctx echo.Context, params uisvc.GetLogsSearchParams) error {
+	req := &asset.SearchRequest{Pattern: params.Pattern, Repository: stringDeref(params.Repository)}
+
+	if params.Since != nil {
+		req.Since = timestamppb.New(*params.Since)
+	}
+
+	if params.Until != nil {
+		req.Until = timestamppb.New(*params.Until)
+	}
+
+	if params.Context != nil {
+		req.Context = *params.Context
+	}
+
+	if params.Limit != nil {
+		req.Limit = *params.Limit
+	}
+
+	res, err := h.clients.Asset.SearchLogs(ctx.Request().Context(), req)
+	if err != nil {
+		return err
+	}
+
+	matches := []uisvc.LogMatch{}
+
+	for _, m := range res.Matches {
+		runID, line, text := m.RunID, m.Line, m.Text
+		before, after := append([]string{}, m.Before...), append([]string{}, m.After...)
+		matches = append(matches, uisvc.LogMatch{RunId: &runID, Line: &line, Text: &text, Before: &before, After: &after})
+	}
+
+	return ctx.JSON(200, uisvc.LogSearchResult{Matches: &matches, Truncated: &res.Truncated})
+}
+
+// GetLogSectionsId returns the index of the sections of a log.
+func (h *H) GetLogSectionsId(ctx echo.Context, id int64) error {
+	res, err := h.clients.Asset.LogSections(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	sections := []uisvc.LogSection{}
+
+	for _, s := range res.Sections {
+		section := uisvc.LogSection{Name: &s.Name, Offset: &s.Offset, Length: &s.Length, Line: &s.Line, Lines: &s.Lines}
+
+		if s.Started.IsValid() {
+			started := s.Started.AsTime()
+			section.StartedAt = &started
+		}
+
+		if s.Finished.IsValid() {
+			finished := s.Finished.AsTime()
+			section.FinishedAt = &finished
+
+			if section.StartedAt != nil {
+				duration := finished.Sub(*section.StartedAt).Seconds()
+				section.Duration = &duration
+			}
+		}
+
+		sections = append(sections, section)
+	}
+
+	return ctx.JSON(200, uisvc.LogSections{Sections: &sections, Complete: &res.Complete})
+}
@@ -61,3 +61,18 @@ func (h *H) PostCancelRunId(ctx echo.Context, runID int64) error {
 
 	return ctx.NoContent(200)
 }
+
+// PostRerunRunId queues a new attempt of a finished run by id.
+func (h *H) PostRerunRunId(ctx echo.Context, runID int64) error {
+	run, err := h.clients.Queue.RerunRun(ctx.Request().Context(), runID)
+	if err != nil {
+		return err
+	}
+
+	r, err := h.C.FromProto(ctx.Request().Context(), run)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(200, r)
+}
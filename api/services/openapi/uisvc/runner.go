@@ -0,0 +1,48 @@
+package uisvc
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/tinyci/ci-agents/ci-gen/openapi/services/uisvc"
+)
+
+// GetRunners lists the runners which have registered with the queue.
+func (h *H) GetRunners(ctx echo.Context) error {
+	runners, err := h.clients.Data.ListRunners(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	ret := []*uisvc.Runner{}
+	for _, runner := range runners {
+		r, err := h.C.FromProto(ctx.Request().Context(), runner)
+		if err != nil {
+			return err
+		}
+		ret = append(ret, r.(*uisvc.Runner))
+	}
+
+	return ctx.JSON(200, ret)
+}
+
+// PostRunnersCordonName stops the runner from receiving new runs.
+func (h *H) PostRunnersCordonName(ctx echo.Context, name string) error {
+	return h.setRunnerState(ctx, name, true, false)
+}
+
+// PostRunnersDrainName cordons the runner and asks it to exit when idle.
+func (h *H) PostRunnersDrainName(ctx echo.Context, name string) error {
+	return h.setRunnerState(ctx, name, true, true)
+}
+
+// PostRunnersUncordonName returns the runner to service.
+func (h *H) PostRunnersUncordonName(ctx echo.Context, name string) error {
+	return h.setRunnerState(ctx, name, false, false)
+}
+
+func (h *H) setRunnerState(ctx echo.Context, name string, cordoned, draining bool) error {
+	if _, err := h.clients.Data.SetRunnerState(ctx.Request().Context(), name, cordoned, draining); err != nil {
+		return err
+	}
+
+	return ctx.NoContent(200)
+}
@@ -2,6 +2,7 @@ package uisvc
 
 import (
 	"context"
+	"os"
 	"testing"
 	"time"
 
@@ -47,6 +48,7 @@ func TestUISvc(t *testing.T) {
 
 func (us *uisvcSuite) SetUpTest(c *check.C) {
 	testutil.WipeDB()
+	os.RemoveAll("/var/tinyci/artifacts")
 
 	var err error
 	us.dataHandler, us.dataDoneChan, err = datasvc.MakeDataServer()
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/labstack/echo/v4"
+	"github.com/tinyci/ci-agents/ci-gen/grpc/types"
 	"github.com/tinyci/ci-agents/ci-gen/openapi/services/uisvc"
 	"github.com/tinyci/ci-agents/utils"
 )
@@ -111,3 +112,18 @@ func (h *H) PostSubmissionIdCancel(ctx echo.Context, id int64) error {
 
 	return ctx.NoContent(200)
 }
+
+// PostSubmissionIdRerun re-runs a finished submission by ID.
+func (h *H) PostSubmissionIdRerun(ctx echo.Context, id int64) error {
+	runs, err := h.clients.Queue.RerunSubmission(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	r, err := h.convertRuns(ctx, &types.RunList{List: runs})
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(200, r)
+}
@@ -29,6 +29,7 @@ func (h *H) GetSubmit(ctx echo.Context, params uisvc.GetSubmitParams) error {
 		HeadSHA:     sha,
 		SubmittedBy: user,
 		All:         all,
+		Priority:    params.Priority,
 		Manual:      true,
 	})
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/labstack/echo/v4"
+	"github.com/tinyci/ci-agents/ci-gen/grpc/types"
 	"github.com/tinyci/ci-agents/ci-gen/openapi/services/uisvc"
 	"github.com/tinyci/ci-agents/utils"
 )
@@ -81,3 +82,18 @@ func (h *H) PostTasksCancelId(ctx echo.Context, id int64) error {
 
 	return ctx.NoContent(200)
 }
+
+// PostTasksRerunId re-runs a finished task by ID.
+func (h *H) PostTasksRerunId(ctx echo.Context, id int64) error {
+	runs, err := h.clients.Queue.RerunTask(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	r, err := h.convertRuns(ctx, &types.RunList{List: runs})
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(200, r)
+}
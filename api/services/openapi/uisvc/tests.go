@@ -0,0 +1,95 @@
+package uisvc
+
+import (
+	"path"
+
+	"github.com/labstack/echo/v4"
+	"github.com/tinyci/ci-agents/ci-gen/grpc/types"
+	"github.com/tinyci/ci-agents/ci-gen/openapi/services/uisvc"
+)
+
+func testCaseToOpenAPI(tc *types.TestCase) uisvc.TestCase {
+	status := uisvc.TestCaseStatus(tc.Status)
+
+	return uisvc.TestCase{
+		Id:       &tc.Id,
+		RunId:    &tc.RunID,
+		Suite:    &tc.Suite,
+		Name:     &tc.Name,
+		Status:   &status,
+		Duration: &tc.Duration,
+		Message:  &tc.Message,
+	}
+}
+
+// GetRunRunIdTests lists the test cases of a run.
+func (h *H) GetRunRunIdTests(ctx echo.Context, runID int64, params uisvc.GetRunRunIdTestsParams) error {
+	var status string
+	if params.Status != nil {
+		status = string(*params.Status)
+	}
+
+	cases, err := h.clients.Data.ListTestCases(ctx.Request().Context(), runID, status)
+	if err != nil {
+		return err
+	}
+
+	list := uisvc.TestCaseList{}
+	for _, tc := range cases {
+		list = append(list, testCaseToOpenAPI(tc))
+	}
+
+	return ctx.JSON(200, list)
+}
+
+// GetRunRunIdTestsSummary counts the test cases of a run by status.
+func (h *H) GetRunRunIdTestsSummary(ctx echo.Context, runID int64) error {
+	summary, err := h.clients.Data.GetTestSummary(ctx.Request().Context(), runID)
+	if err != nil {
+		return err
+	}
+
+	failures := summary.Failures
+	if failures == nil {
+		failures = []string{}
+	}
+
+	return ctx.JSON(200, uisvc.TestSummary{
+		RunId:    &runID,
+		Total:    &summary.Total,
+		Passed:   &summary.Passed,
+		Failed:   &summary.Failed,
+		Skipped:  &summary.Skipped,
+		Errored:  &summary.Errored,
+		Duration: &summary.Duration,
+		Failures: &failures,
+	})
+}
+
+// GetRepositoriesOwnerRepoFlaky lists the flaky tests of a repository.
+func (h *H) GetRepositoriesOwnerRepoFlaky(ctx echo.Context, owner, repository string, params uisvc.GetRepositoriesOwnerRepoFlakyParams) error {
+	var days int64
+	if params.Days != nil {
+		days = *params.Days
+	}
+
+	tests, err := h.clients.Data.FlakyTests(ctx.Request().Context(), path.Join(owner, repository), days)
+	if err != nil {
+		return err
+	}
+
+	list := uisvc.FlakyTestList{}
+	for _, test := range tests {
+		list = append(list, uisvc.FlakyTest{
+			Suite:      &test.Suite,
+			Name:       &test.Name,
+			Score:      &test.Score,
+			Executions: &test.Executions,
+			Failures:   &test.Failures,
+			Shas:       &test.Shas,
+			Flipped:    &test.Flipped,
+		})
+	}
+
+	return ctx.JSON(200, list)
+}
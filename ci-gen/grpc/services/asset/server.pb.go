@@ -8,13 +8,13 @@ package asset
 
 import (
 	context "context"
-	types "github.com/tinyci/ci-agents/ci-gen/grpc/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -82,19 +82,93 @@ func (x *LogSend) GetChunk() []byte {
 	return nil
 }
 
+// GetLog request type
+type LogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID     int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`         // ID of *Run*
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"` // Byte offset to start reading from
+	Limit  int64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`   // Most bytes to read; 0 reads to the end of the log
+	Tail   int64 `protobuf:"varint,4,opt,name=tail,proto3" json:"tail,omitempty"`     // Start from the last N lines instead of the offset
+}
+
+func (x *LogRequest) Reset() {
+	*x = LogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_services_asset_server_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogRequest) ProtoMessage() {}
+
+func (x *LogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_services_asset_server_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogRequest.ProtoReflect.Descriptor instead.
+func (*LogRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_services_asset_server_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LogRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *LogRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *LogRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *LogRequest) GetTail() int64 {
+	if x != nil {
+		return x.Tail
+	}
+	return 0
+}
+
 // Receive type
 type LogChunk struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Chunk []byte `protobuf:"bytes,1,opt,name=Chunk,proto3" json:"Chunk,omitempty"` // Log binary chunk; typically between 64 and 256 bytes per payload.
+	Chunk    []byte `protobuf:"bytes,1,opt,name=Chunk,proto3" json:"Chunk,omitempty"`        // Log binary chunk; typically between 64 and 256 bytes per payload.
+	Offset   int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`     // Byte offset of the chunk within the log
+	Complete bool   `protobuf:"varint,3,opt,name=complete,proto3" json:"complete,omitempty"` // The chunk is the end-of-log banner, not log content
 }
 
 func (x *LogChunk) Reset() {
 	*x = LogChunk{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_services_asset_server_proto_msgTypes[1]
+		mi := &file_grpc_services_asset_server_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -107,96 +181,1284 @@ func (x *LogChunk) String() string {
 func (*LogChunk) ProtoMessage() {}
 
 func (x *LogChunk) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_services_asset_server_proto_msgTypes[1]
+	mi := &file_grpc_services_asset_server_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogChunk.ProtoReflect.Descriptor instead.
+func (*LogChunk) Descriptor() ([]byte, []int) {
+	return file_grpc_services_asset_server_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LogChunk) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *LogChunk) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *LogChunk) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+// PurgeLogs request type; exactly one of the IDs should be set.
+type PurgeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunID        int64 `protobuf:"varint,1,opt,name=runID,proto3" json:"runID,omitempty"`               // ID of the run
+	TaskID       int64 `protobuf:"varint,2,opt,name=taskID,proto3" json:"taskID,omitempty"`             // ID of the task; all of its runs are purged
+	SubmissionID int64 `protobuf:"varint,3,opt,name=submissionID,proto3" json:"submissionID,omitempty"` // ID of the submission; all of its runs are purged
+}
+
+func (x *PurgeRequest) Reset() {
+	*x = PurgeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_services_asset_server_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeRequest) ProtoMessage() {}
+
+func (x *PurgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_services_asset_server_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeRequest.ProtoReflect.Descriptor instead.
+func (*PurgeRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_services_asset_server_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PurgeRequest) GetRunID() int64 {
+	if x != nil {
+		return x.RunID
+	}
+	return 0
+}
+
+func (x *PurgeRequest) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+func (x *PurgeRequest) GetSubmissionID() int64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+// PurgeLogs response type
+type PurgeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunIDs []int64 `protobuf:"varint,1,rep,packed,name=runIDs,proto3" json:"runIDs,omitempty"` // IDs of the runs whose logs or artifacts were removed
+}
+
+func (x *PurgeResponse) Reset() {
+	*x = PurgeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_services_asset_server_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeResponse) ProtoMessage() {}
+
+func (x *PurgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_services_asset_server_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeResponse.ProtoReflect.Descriptor instead.
+func (*PurgeResponse) Descriptor() ([]byte, []int) {
+	return file_grpc_services_asset_server_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PurgeResponse) GetRunIDs() []int64 {
+	if x != nil {
+		return x.RunIDs
+	}
+	return nil
+}
+
+// SearchLogs request type
+type SearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pattern    string                 `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`       // Regular expression (RE2 syntax) to match lines with
+	Repository string                 `protobuf:"bytes,2,opt,name=repository,proto3" json:"repository,omitempty"` // Only search the runs of this repository, in owner/repo format
+	Since      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`           // Only search logs written after this; defaults to a day before until
+	Until      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=until,proto3" json:"until,omitempty"`           // Only search logs written before this; defaults to now
+	Context    int64                  `protobuf:"varint,5,opt,name=context,proto3" json:"context,omitempty"`      // Lines of context to return either side of each match
+	Limit      int64                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`          // Most matches to return; defaults to 100
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_services_asset_server_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_services_asset_server_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_services_asset_server_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SearchRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetContext() int64 {
+	if x != nil {
+		return x.Context
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// A line matched by SearchLogs
+type SearchMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunID  int64    `protobuf:"varint,1,opt,name=runID,proto3" json:"runID,omitempty"`  // ID of the run whose log matched
+	Line   int64    `protobuf:"varint,2,opt,name=line,proto3" json:"line,omitempty"`    // Line number of the match, counting from 1
+	Text   string   `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`     // The matching line
+	Before []string `protobuf:"bytes,4,rep,name=before,proto3" json:"before,omitempty"` // Lines of context before the match
+	After  []string `protobuf:"bytes,5,rep,name=after,proto3" json:"after,omitempty"`   // Lines of context after the match
+}
+
+func (x *SearchMatch) Reset() {
+	*x = SearchMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_services_asset_server_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMatch) ProtoMessage() {}
+
+func (x *SearchMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_services_asset_server_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use LogChunk.ProtoReflect.Descriptor instead.
-func (*LogChunk) Descriptor() ([]byte, []int) {
-	return file_grpc_services_asset_server_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *LogChunk) GetChunk() []byte {
-	if x != nil {
-		return x.Chunk
-	}
-	return nil
-}
-
-var File_grpc_services_asset_server_proto protoreflect.FileDescriptor
-
-var file_grpc_services_asset_server_proto_rawDesc = []byte{
-	0x0a, 0x20, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x69, 0x6e, 0x79,
-	0x63, 0x69, 0x2f, 0x63, 0x69, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x69, 0x2d,
-	0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x69,
-	0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2f, 0x0a, 0x07, 0x4c, 0x6f, 0x67, 0x53, 0x65,
-	0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
-	0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x05, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x20, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x43,
-	0x68, 0x75, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x05, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x32, 0x5a, 0x0a, 0x05, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x50, 0x75, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x08, 0x2e,
-	0x4c, 0x6f, 0x67, 0x53, 0x65, 0x6e, 0x64, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x28,
-	0x01, 0x12, 0x23, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x0c, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x49, 0x44, 0x1a, 0x09, 0x2e, 0x4c, 0x6f, 0x67, 0x43,
-	0x68, 0x75, 0x6e, 0x6b, 0x30, 0x01, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x69, 0x6e, 0x79, 0x63, 0x69, 0x2f, 0x63, 0x69, 0x2d, 0x61,
-	0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x69, 0x2d, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72, 0x70,
-	0x63, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_grpc_services_asset_server_proto_rawDescOnce sync.Once
-	file_grpc_services_asset_server_proto_rawDescData = file_grpc_services_asset_server_proto_rawDesc
-)
-
-func file_grpc_services_asset_server_proto_rawDescGZIP() []byte {
-	file_grpc_services_asset_server_proto_rawDescOnce.Do(func() {
-		file_grpc_services_asset_server_proto_rawDescData = protoimpl.X.CompressGZIP(file_grpc_services_asset_server_proto_rawDescData)
-	})
-	return file_grpc_services_asset_server_proto_rawDescData
-}
-
-var file_grpc_services_asset_server_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
-var file_grpc_services_asset_server_proto_goTypes = []interface{}{
-	(*LogSend)(nil),       // 0: LogSend
-	(*LogChunk)(nil),      // 1: LogChunk
-	(*types.IntID)(nil),   // 2: types.IntID
-	(*emptypb.Empty)(nil), // 3: google.protobuf.Empty
-}
-var file_grpc_services_asset_server_proto_depIdxs = []int32{
-	0, // 0: Asset.PutLog:input_type -> LogSend
-	2, // 1: Asset.GetLog:input_type -> types.IntID
-	3, // 2: Asset.PutLog:output_type -> google.protobuf.Empty
-	1, // 3: Asset.GetLog:output_type -> LogChunk
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
-}
-
-func init() { file_grpc_services_asset_server_proto_init() }
-func file_grpc_services_asset_server_proto_init() {
-	if File_grpc_services_asset_server_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_grpc_services_asset_server_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LogSend); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMatch.ProtoReflect.Descriptor instead.
+func (*SearchMatch) Descriptor() ([]byte, []int) {
+	return file_grpc_services_asset_server_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SearchMatch) GetRunID() int64 {
+	if x != nil {
+		return x.RunID
+	}
+	return 0
+}
+
+func (x *SearchMatch) GetLine() int64 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *SearchMatch) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SearchMatch) GetBefore() []string {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *SearchMatch) GetAfter() []string {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+// SearchLogs response type
+type SearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Matches   []*SearchMatch `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`      // Matches, newest logs first
+	Truncated bool           `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"` // The limit was reached before all the logs were searched
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_services_asset_server_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_services_asset_server_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_grpc_services_asset_server_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SearchResponse) GetMatches() []*SearchMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// GetLogSections request type
+type LogSectionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"` // ID of *Run*
+}
+
+func (x *LogSectionsRequest) Reset() {
+	*x = LogSectionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_services_asset_server_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogSectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogSectionsRequest) ProtoMessage() {}
+
+func (x *LogSectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_services_asset_server_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogSectionsRequest.ProtoReflect.Descriptor instead.
+func (*LogSectionsRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_services_asset_server_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LogSectionsRequest) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+// A section of a log, delimited in the log by a "::group::<name>" line and
+// an "::endgroup::" line. The offset and length cover the lines between the
+// markers, so the section can be read with GetLog.
+type LogSection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`         // Name given by the ::group:: marker
+	Offset   int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`    // Byte offset of the section's first line
+	Length   int64                  `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`    // Length of the section in bytes
+	Line     int64                  `protobuf:"varint,4,opt,name=line,proto3" json:"line,omitempty"`        // Line number of the section's first line, counting from 1
+	Lines    int64                  `protobuf:"varint,5,opt,name=lines,proto3" json:"lines,omitempty"`      // Number of lines in the section
+	Started  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started,proto3" json:"started,omitempty"`   // When the ::group:: marker was written
+	Finished *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=finished,proto3" json:"finished,omitempty"` // When the section ended; unset while it is still being written
+}
+
+func (x *LogSection) Reset() {
+	*x = LogSection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_services_asset_server_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogSection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogSection) ProtoMessage() {}
+
+func (x *LogSection) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_services_asset_server_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogSection.ProtoReflect.Descriptor instead.
+func (*LogSection) Descriptor() ([]byte, []int) {
+	return file_grpc_services_asset_server_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *LogSection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LogSection) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *LogSection) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *LogSection) GetLine() int64 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *LogSection) GetLines() int64 {
+	if x != nil {
+		return x.Lines
+	}
+	return 0
+}
+
+func (x *LogSection) GetStarted() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Started
+	}
+	return nil
+}
+
+func (x *LogSection) GetFinished() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Finished
+	}
+	return nil
+}
+
+// GetLogSections response type
+type LogSections struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sections []*LogSection `protobuf:"bytes,1,rep,name=sections,proto3" json:"sections,omitempty"`  // Sections in the order they appear in the log
+	Complete bool          `protobuf:"varint,2,opt,name=complete,proto3" json:"complete,omitempty"` // The log has finished writing, so the index is final
+}
+
+func (x *LogSections) Reset() {
+	*x = LogSections{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_services_asset_server_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogSections) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogSections) ProtoMessage() {}
+
+func (x *LogSections) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_services_asset_server_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogSections.ProtoReflect.Descriptor instead.
+func (*LogSections) Descriptor() ([]byte, []int) {
+	return file_grpc_services_asset_server_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LogSections) GetSections() []*LogSection {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *LogSections) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+// Describes an artifact stored for a run
+type ArtifactInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunID       int64                  `protobuf:"varint,1,opt,name=runID,proto3" json:"runID,omitempty"`            // ID of the run the artifact belongs to
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`               // Name of the artifact; unique within the run
+	ContentType string                 `protobuf:"bytes,3,opt,name=contentType,proto3" json:"contentType,omitempty"` // MIME type of the artifact
+	Sha256      string                 `protobuf:"bytes,4,opt,name=sha256,proto3" json:"sha256,omitempty"`           // Hex-encoded SHA-256 sum of the content
+	Size        int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`              // Size of the content in bytes
+	Created     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created,proto3" json:"created,omitempty"`         // When the artifact was stored
+	Report      string                 `protobuf:"bytes,7,opt,name=report,proto3" json:"report,omitempty"`           // Test report format; reports are parsed into test cases when stored
+}
+
+func (x *ArtifactInfo) Reset() {
+	*x = ArtifactInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_services_asset_server_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArtifactInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtifactInfo) ProtoMessage() {}
+
+func (x *ArtifactInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_services_asset_server_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtifactInfo.ProtoReflect.Descriptor instead.
+func (*ArtifactInfo) Descriptor() ([]byte, []int) {
+	return file_grpc_services_asset_server_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ArtifactInfo) GetRunID() int64 {
+	if x != nil {
+		return x.RunID
+	}
+	return 0
+}
+
+func (x *ArtifactInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ArtifactInfo) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ArtifactInfo) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *ArtifactInfo) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ArtifactInfo) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *ArtifactInfo) GetReport() string {
+	if x != nil {
+		return x.Report
+	}
+	return ""
+}
+
+// PutArtifact sending type. The first message carries the info; a sha256 in it
+// is checked against the content, and its size and created time are ignored.
+type ArtifactSend struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Info  *ArtifactInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`   // Only set on the first message
+	Chunk []byte        `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"` // Content binary chunk
+}
+
+func (x *ArtifactSend) Reset() {
+	*x = ArtifactSend{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_services_asset_server_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArtifactSend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtifactSend) ProtoMessage() {}
+
+func (x *ArtifactSend) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_services_asset_server_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtifactSend.ProtoReflect.Descriptor instead.
+func (*ArtifactSend) Descriptor() ([]byte, []int) {
+	return file_grpc_services_asset_server_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ArtifactSend) GetInfo() *ArtifactInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *ArtifactSend) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// GetArtifact request type
+type ArtifactRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunID int64  `protobuf:"varint,1,opt,name=runID,proto3" json:"runID,omitempty"` // ID of the run
+	Name  string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`    // Name of the artifact
+}
+
+func (x *ArtifactRequest) Reset() {
+	*x = ArtifactRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_services_asset_server_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArtifactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtifactRequest) ProtoMessage() {}
+
+func (x *ArtifactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_services_asset_server_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtifactRequest.ProtoReflect.Descriptor instead.
+func (*ArtifactRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_services_asset_server_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ArtifactRequest) GetRunID() int64 {
+	if x != nil {
+		return x.RunID
+	}
+	return 0
+}
+
+func (x *ArtifactRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// GetArtifact receive type. The first message carries only the info.
+type ArtifactChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Info  *ArtifactInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`   // Only set on the first message
+	Chunk []byte        `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"` // Content binary chunk
+}
+
+func (x *ArtifactChunk) Reset() {
+	*x = ArtifactChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_services_asset_server_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArtifactChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtifactChunk) ProtoMessage() {}
+
+func (x *ArtifactChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_services_asset_server_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtifactChunk.ProtoReflect.Descriptor instead.
+func (*ArtifactChunk) Descriptor() ([]byte, []int) {
+	return file_grpc_services_asset_server_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ArtifactChunk) GetInfo() *ArtifactInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *ArtifactChunk) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// ListArtifacts request type
+type ArtifactListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunID int64 `protobuf:"varint,1,opt,name=runID,proto3" json:"runID,omitempty"` // ID of the run
+}
+
+func (x *ArtifactListRequest) Reset() {
+	*x = ArtifactListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_services_asset_server_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArtifactListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtifactListRequest) ProtoMessage() {}
+
+func (x *ArtifactListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_services_asset_server_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtifactListRequest.ProtoReflect.Descriptor instead.
+func (*ArtifactListRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_services_asset_server_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ArtifactListRequest) GetRunID() int64 {
+	if x != nil {
+		return x.RunID
+	}
+	return 0
+}
+
+// ListArtifacts response type
+type ArtifactList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Artifacts []*ArtifactInfo `protobuf:"bytes,1,rep,name=artifacts,proto3" json:"artifacts,omitempty"` // Artifacts of the run, by name
+}
+
+func (x *ArtifactList) Reset() {
+	*x = ArtifactList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_services_asset_server_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArtifactList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtifactList) ProtoMessage() {}
+
+func (x *ArtifactList) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_services_asset_server_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtifactList.ProtoReflect.Descriptor instead.
+func (*ArtifactList) Descriptor() ([]byte, []int) {
+	return file_grpc_services_asset_server_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ArtifactList) GetArtifacts() []*ArtifactInfo {
+	if x != nil {
+		return x.Artifacts
+	}
+	return nil
+}
+
+var File_grpc_services_asset_server_proto protoreflect.FileDescriptor
+
+var file_grpc_services_asset_server_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x2f, 0x0a, 0x07, 0x4c, 0x6f, 0x67, 0x53, 0x65, 0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x43, 0x68, 0x75, 0x6e,
+	0x6b, 0x22, 0x5e, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x61, 0x69,
+	0x6c, 0x22, 0x54, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x14, 0x0a,
+	0x05, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x43, 0x68,
+	0x75, 0x6e, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x22, 0x60, 0x0a, 0x0c, 0x50, 0x75, 0x72, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74,
+	0x61, 0x73, 0x6b, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x27, 0x0a, 0x0d, 0x50, 0x75, 0x72,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x75,
+	0x6e, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x72, 0x75, 0x6e, 0x49,
+	0x44, 0x73, 0x22, 0xdd, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x30,
+	0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65,
+	0x12, 0x30, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x75, 0x6e, 0x74,
+	0x69, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x22, 0x79, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0x56, 0x0a,
+	0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x26, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0x24, 0x0a, 0x12, 0x4c, 0x6f, 0x67, 0x53, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x22, 0xe8, 0x01, 0x0a, 0x0a,
+	0x4c, 0x6f, 0x67, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x12,
+	0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6c, 0x69,
+	0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x36,
+	0x0a, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x66, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x22, 0x52, 0x0a, 0x0b, 0x4c, 0x6f, 0x67, 0x53, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x4c, 0x6f, 0x67, 0x53, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x22, 0xd4, 0x01, 0x0a, 0x0c, 0x41,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x72,
+	0x75, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49,
+	0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35,
+	0x36, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x22, 0x47, 0x0a, 0x0c, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x53, 0x65, 0x6e,
+	0x64, 0x12, 0x21, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04,
+	0x69, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x3b, 0x0a, 0x0f, 0x41, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x75, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x75,
+	0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x48, 0x0a, 0x0d, 0x41, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x21, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x22, 0x2b, 0x0a, 0x13, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x75, 0x6e, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x44, 0x22, 0x3b,
+	0x0a, 0x0c, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b,
+	0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x32, 0x81, 0x03, 0x0a, 0x05,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x50, 0x75, 0x74, 0x4c, 0x6f, 0x67, 0x12,
+	0x08, 0x2e, 0x4c, 0x6f, 0x67, 0x53, 0x65, 0x6e, 0x64, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x28, 0x01, 0x12, 0x22, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x0b, 0x2e,
+	0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x4c, 0x6f, 0x67,
+	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x30, 0x01, 0x12, 0x2a, 0x0a, 0x09, 0x50, 0x75, 0x72, 0x67, 0x65,
+	0x4c, 0x6f, 0x67, 0x73, 0x12, 0x0d, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x0a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4c, 0x6f, 0x67,
+	0x73, 0x12, 0x0e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0f, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x33, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x53, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x13, 0x2e, 0x4c, 0x6f, 0x67, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x4c, 0x6f, 0x67, 0x53,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x0b, 0x50, 0x75, 0x74, 0x41, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x0d, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x53, 0x65, 0x6e, 0x64, 0x1a, 0x0d, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x28, 0x01, 0x12, 0x31, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x10, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61,
+	0x63, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x30, 0x01, 0x12, 0x34, 0x0a, 0x0d, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x12, 0x14, 0x2e, 0x41, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0d, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x69,
+	0x6e, 0x79, 0x63, 0x69, 0x2f, 0x63, 0x69, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x63,
+	0x69, 0x2d, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_grpc_services_asset_server_proto_rawDescOnce sync.Once
+	file_grpc_services_asset_server_proto_rawDescData = file_grpc_services_asset_server_proto_rawDesc
+)
+
+func file_grpc_services_asset_server_proto_rawDescGZIP() []byte {
+	file_grpc_services_asset_server_proto_rawDescOnce.Do(func() {
+		file_grpc_services_asset_server_proto_rawDescData = protoimpl.X.CompressGZIP(file_grpc_services_asset_server_proto_rawDescData)
+	})
+	return file_grpc_services_asset_server_proto_rawDescData
+}
+
+var file_grpc_services_asset_server_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_grpc_services_asset_server_proto_goTypes = []interface{}{
+	(*LogSend)(nil),               // 0: LogSend
+	(*LogRequest)(nil),            // 1: LogRequest
+	(*LogChunk)(nil),              // 2: LogChunk
+	(*PurgeRequest)(nil),          // 3: PurgeRequest
+	(*PurgeResponse)(nil),         // 4: PurgeResponse
+	(*SearchRequest)(nil),         // 5: SearchRequest
+	(*SearchMatch)(nil),           // 6: SearchMatch
+	(*SearchResponse)(nil),        // 7: SearchResponse
+	(*LogSectionsRequest)(nil),    // 8: LogSectionsRequest
+	(*LogSection)(nil),            // 9: LogSection
+	(*LogSections)(nil),           // 10: LogSections
+	(*ArtifactInfo)(nil),          // 11: ArtifactInfo
+	(*ArtifactSend)(nil),          // 12: ArtifactSend
+	(*ArtifactRequest)(nil),       // 13: ArtifactRequest
+	(*ArtifactChunk)(nil),         // 14: ArtifactChunk
+	(*ArtifactListRequest)(nil),   // 15: ArtifactListRequest
+	(*ArtifactList)(nil),          // 16: ArtifactList
+	(*timestamppb.Timestamp)(nil), // 17: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),         // 18: google.protobuf.Empty
+}
+var file_grpc_services_asset_server_proto_depIdxs = []int32{
+	17, // 0: SearchRequest.since:type_name -> google.protobuf.Timestamp
+	17, // 1: SearchRequest.until:type_name -> google.protobuf.Timestamp
+	6,  // 2: SearchResponse.matches:type_name -> SearchMatch
+	17, // 3: LogSection.started:type_name -> google.protobuf.Timestamp
+	17, // 4: LogSection.finished:type_name -> google.protobuf.Timestamp
+	9,  // 5: LogSections.sections:type_name -> LogSection
+	17, // 6: ArtifactInfo.created:type_name -> google.protobuf.Timestamp
+	11, // 7: ArtifactSend.info:type_name -> ArtifactInfo
+	11, // 8: ArtifactChunk.info:type_name -> ArtifactInfo
+	11, // 9: ArtifactList.artifacts:type_name -> ArtifactInfo
+	0,  // 10: Asset.PutLog:input_type -> LogSend
+	1,  // 11: Asset.GetLog:input_type -> LogRequest
+	3,  // 12: Asset.PurgeLogs:input_type -> PurgeRequest
+	5,  // 13: Asset.SearchLogs:input_type -> SearchRequest
+	8,  // 14: Asset.GetLogSections:input_type -> LogSectionsRequest
+	12, // 15: Asset.PutArtifact:input_type -> ArtifactSend
+	13, // 16: Asset.GetArtifact:input_type -> ArtifactRequest
+	15, // 17: Asset.ListArtifacts:input_type -> ArtifactListRequest
+	18, // 18: Asset.PutLog:output_type -> google.protobuf.Empty
+	2,  // 19: Asset.GetLog:output_type -> LogChunk
+	4,  // 20: Asset.PurgeLogs:output_type -> PurgeResponse
+	7,  // 21: Asset.SearchLogs:output_type -> SearchResponse
+	10, // 22: Asset.GetLogSections:output_type -> LogSections
+	11, // 23: Asset.PutArtifact:output_type -> ArtifactInfo
+	14, // 24: Asset.GetArtifact:output_type -> ArtifactChunk
+	16, // 25: Asset.ListArtifacts:output_type -> ArtifactList
+	18, // [18:26] is the sub-list for method output_type
+	10, // [10:18] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_grpc_services_asset_server_proto_init() }
+func file_grpc_services_asset_server_proto_init() {
+	if File_grpc_services_asset_server_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_grpc_services_asset_server_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogSend); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_services_asset_server_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_services_asset_server_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_services_asset_server_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_services_asset_server_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_services_asset_server_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_services_asset_server_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_services_asset_server_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_services_asset_server_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogSectionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_services_asset_server_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogSection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_services_asset_server_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogSections); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -207,8 +1469,68 @@ func file_grpc_services_asset_server_proto_init() {
 				return nil
 			}
 		}
-		file_grpc_services_asset_server_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LogChunk); i {
+		file_grpc_services_asset_server_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArtifactInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_services_asset_server_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArtifactSend); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_services_asset_server_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArtifactRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_services_asset_server_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArtifactChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_services_asset_server_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArtifactListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_services_asset_server_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArtifactList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -226,7 +1548,7 @@ func file_grpc_services_asset_server_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_grpc_services_asset_server_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -253,7 +1575,13 @@ const _ = grpc.SupportPackageIsVersion6
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type AssetClient interface {
 	PutLog(ctx context.Context, opts ...grpc.CallOption) (Asset_PutLogClient, error)
-	GetLog(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (Asset_GetLogClient, error)
+	GetLog(ctx context.Context, in *LogRequest, opts ...grpc.CallOption) (Asset_GetLogClient, error)
+	PurgeLogs(ctx context.Context, in *PurgeRequest, opts ...grpc.CallOption) (*PurgeResponse, error)
+	SearchLogs(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	GetLogSections(ctx context.Context, in *LogSectionsRequest, opts ...grpc.CallOption) (*LogSections, error)
+	PutArtifact(ctx context.Context, opts ...grpc.CallOption) (Asset_PutArtifactClient, error)
+	GetArtifact(ctx context.Context, in *ArtifactRequest, opts ...grpc.CallOption) (Asset_GetArtifactClient, error)
+	ListArtifacts(ctx context.Context, in *ArtifactListRequest, opts ...grpc.CallOption) (*ArtifactList, error)
 }
 
 type assetClient struct {
@@ -298,7 +1626,7 @@ func (x *assetPutLogClient) CloseAndRecv() (*emptypb.Empty, error) {
 	return m, nil
 }
 
-func (c *assetClient) GetLog(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (Asset_GetLogClient, error) {
+func (c *assetClient) GetLog(ctx context.Context, in *LogRequest, opts ...grpc.CallOption) (Asset_GetLogClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_Asset_serviceDesc.Streams[1], "/Asset/GetLog", opts...)
 	if err != nil {
 		return nil, err
@@ -330,10 +1658,118 @@ func (x *assetGetLogClient) Recv() (*LogChunk, error) {
 	return m, nil
 }
 
+func (c *assetClient) PurgeLogs(ctx context.Context, in *PurgeRequest, opts ...grpc.CallOption) (*PurgeResponse, error) {
+	out := new(PurgeResponse)
+	err := c.cc.Invoke(ctx, "/Asset/PurgeLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetClient) SearchLogs(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/Asset/SearchLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetClient) GetLogSections(ctx context.Context, in *LogSectionsRequest, opts ...grpc.CallOption) (*LogSections, error) {
+	out := new(LogSections)
+	err := c.cc.Invoke(ctx, "/Asset/GetLogSections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetClient) PutArtifact(ctx context.Context, opts ...grpc.CallOption) (Asset_PutArtifactClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Asset_serviceDesc.Streams[2], "/Asset/PutArtifact", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &assetPutArtifactClient{stream}
+	return x, nil
+}
+
+type Asset_PutArtifactClient interface {
+	Send(*ArtifactSend) error
+	CloseAndRecv() (*ArtifactInfo, error)
+	grpc.ClientStream
+}
+
+type assetPutArtifactClient struct {
+	grpc.ClientStream
+}
+
+func (x *assetPutArtifactClient) Send(m *ArtifactSend) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *assetPutArtifactClient) CloseAndRecv() (*ArtifactInfo, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ArtifactInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *assetClient) GetArtifact(ctx context.Context, in *ArtifactRequest, opts ...grpc.CallOption) (Asset_GetArtifactClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Asset_serviceDesc.Streams[3], "/Asset/GetArtifact", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &assetGetArtifactClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Asset_GetArtifactClient interface {
+	Recv() (*ArtifactChunk, error)
+	grpc.ClientStream
+}
+
+type assetGetArtifactClient struct {
+	grpc.ClientStream
+}
+
+func (x *assetGetArtifactClient) Recv() (*ArtifactChunk, error) {
+	m := new(ArtifactChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *assetClient) ListArtifacts(ctx context.Context, in *ArtifactListRequest, opts ...grpc.CallOption) (*ArtifactList, error) {
+	out := new(ArtifactList)
+	err := c.cc.Invoke(ctx, "/Asset/ListArtifacts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AssetServer is the server API for Asset service.
 type AssetServer interface {
 	PutLog(Asset_PutLogServer) error
-	GetLog(*types.IntID, Asset_GetLogServer) error
+	GetLog(*LogRequest, Asset_GetLogServer) error
+	PurgeLogs(context.Context, *PurgeRequest) (*PurgeResponse, error)
+	SearchLogs(context.Context, *SearchRequest) (*SearchResponse, error)
+	GetLogSections(context.Context, *LogSectionsRequest) (*LogSections, error)
+	PutArtifact(Asset_PutArtifactServer) error
+	GetArtifact(*ArtifactRequest, Asset_GetArtifactServer) error
+	ListArtifacts(context.Context, *ArtifactListRequest) (*ArtifactList, error)
 }
 
 // UnimplementedAssetServer can be embedded to have forward compatible implementations.
@@ -343,9 +1779,27 @@ type UnimplementedAssetServer struct {
 func (*UnimplementedAssetServer) PutLog(Asset_PutLogServer) error {
 	return status.Errorf(codes.Unimplemented, "method PutLog not implemented")
 }
-func (*UnimplementedAssetServer) GetLog(*types.IntID, Asset_GetLogServer) error {
+func (*UnimplementedAssetServer) GetLog(*LogRequest, Asset_GetLogServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetLog not implemented")
 }
+func (*UnimplementedAssetServer) PurgeLogs(context.Context, *PurgeRequest) (*PurgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeLogs not implemented")
+}
+func (*UnimplementedAssetServer) SearchLogs(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchLogs not implemented")
+}
+func (*UnimplementedAssetServer) GetLogSections(context.Context, *LogSectionsRequest) (*LogSections, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLogSections not implemented")
+}
+func (*UnimplementedAssetServer) PutArtifact(Asset_PutArtifactServer) error {
+	return status.Errorf(codes.Unimplemented, "method PutArtifact not implemented")
+}
+func (*UnimplementedAssetServer) GetArtifact(*ArtifactRequest, Asset_GetArtifactServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetArtifact not implemented")
+}
+func (*UnimplementedAssetServer) ListArtifacts(context.Context, *ArtifactListRequest) (*ArtifactList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArtifacts not implemented")
+}
 
 func RegisterAssetServer(s *grpc.Server, srv AssetServer) {
 	s.RegisterService(&_Asset_serviceDesc, srv)
@@ -378,7 +1832,7 @@ func (x *assetPutLogServer) Recv() (*LogSend, error) {
 }
 
 func _Asset_GetLog_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(types.IntID)
+	m := new(LogRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
@@ -398,10 +1852,146 @@ func (x *assetGetLogServer) Send(m *LogChunk) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Asset_PurgeLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetServer).PurgeLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Asset/PurgeLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetServer).PurgeLogs(ctx, req.(*PurgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Asset_SearchLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetServer).SearchLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Asset/SearchLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetServer).SearchLogs(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Asset_GetLogSections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogSectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetServer).GetLogSections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Asset/GetLogSections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetServer).GetLogSections(ctx, req.(*LogSectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Asset_PutArtifact_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AssetServer).PutArtifact(&assetPutArtifactServer{stream})
+}
+
+type Asset_PutArtifactServer interface {
+	SendAndClose(*ArtifactInfo) error
+	Recv() (*ArtifactSend, error)
+	grpc.ServerStream
+}
+
+type assetPutArtifactServer struct {
+	grpc.ServerStream
+}
+
+func (x *assetPutArtifactServer) SendAndClose(m *ArtifactInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *assetPutArtifactServer) Recv() (*ArtifactSend, error) {
+	m := new(ArtifactSend)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Asset_GetArtifact_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ArtifactRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AssetServer).GetArtifact(m, &assetGetArtifactServer{stream})
+}
+
+type Asset_GetArtifactServer interface {
+	Send(*ArtifactChunk) error
+	grpc.ServerStream
+}
+
+type assetGetArtifactServer struct {
+	grpc.ServerStream
+}
+
+func (x *assetGetArtifactServer) Send(m *ArtifactChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Asset_ListArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArtifactListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetServer).ListArtifacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Asset/ListArtifacts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetServer).ListArtifacts(ctx, req.(*ArtifactListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Asset_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "Asset",
 	HandlerType: (*AssetServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PurgeLogs",
+			Handler:    _Asset_PurgeLogs_Handler,
+		},
+		{
+			MethodName: "SearchLogs",
+			Handler:    _Asset_SearchLogs_Handler,
+		},
+		{
+			MethodName: "GetLogSections",
+			Handler:    _Asset_GetLogSections_Handler,
+		},
+		{
+			MethodName: "ListArtifacts",
+			Handler:    _Asset_ListArtifacts_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "PutLog",
@@ -413,6 +2003,16 @@ var _Asset_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Asset_GetLog_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "PutArtifact",
+			Handler:       _Asset_PutArtifact_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "GetArtifact",
+			Handler:       _Asset_GetArtifact_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "grpc/services/asset/server.proto",
 }
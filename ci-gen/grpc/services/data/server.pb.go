@@ -514,6 +514,265 @@ func (x *TaskListRequest) GetPerPage() int64 {
 	return 0
 }
 
+type RunIDsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskID       int64 `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`             // Task ID; set this or submissionID
+	SubmissionID int64 `protobuf:"varint,2,opt,name=submissionID,proto3" json:"submissionID,omitempty"` // Submission ID
+}
+
+func (x *RunIDsRequest) Reset() {
+	*x = RunIDsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_services_data_server_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunIDsRequest) ProtoMessage() {}
+
+func (x *RunIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_services_data_server_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunIDsRequest.ProtoReflect.Descriptor instead.
+func (*RunIDsRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_services_data_server_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RunIDsRequest) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+func (x *RunIDsRequest) GetSubmissionID() int64 {
+	if x != nil {
+		return x.SubmissionID
+	}
+	return 0
+}
+
+type RunIDs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *RunIDs) Reset() {
+	*x = RunIDs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_services_data_server_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunIDs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunIDs) ProtoMessage() {}
+
+func (x *RunIDs) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_services_data_server_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunIDs.ProtoReflect.Descriptor instead.
+func (*RunIDs) Descriptor() ([]byte, []int) {
+	return file_grpc_services_data_server_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RunIDs) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type TestCaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunID  int64  `protobuf:"varint,1,opt,name=runID,proto3" json:"runID,omitempty"`
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // only list test cases with this status, if set
+}
+
+func (x *TestCaseRequest) Reset() {
+	*x = TestCaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_services_data_server_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestCaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestCaseRequest) ProtoMessage() {}
+
+func (x *TestCaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_services_data_server_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestCaseRequest.ProtoReflect.Descriptor instead.
+func (*TestCaseRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_services_data_server_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TestCaseRequest) GetRunID() int64 {
+	if x != nil {
+		return x.RunID
+	}
+	return 0
+}
+
+func (x *TestCaseRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type FlakyTestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Repository string `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"` // full name of the repository, e.g. "tinyci/ci-agents"
+	Days       int64  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`            // size of the window, ending now
+}
+
+func (x *FlakyTestRequest) Reset() {
+	*x = FlakyTestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_services_data_server_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlakyTestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlakyTestRequest) ProtoMessage() {}
+
+func (x *FlakyTestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_services_data_server_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlakyTestRequest.ProtoReflect.Descriptor instead.
+func (*FlakyTestRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_services_data_server_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FlakyTestRequest) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *FlakyTestRequest) GetDays() int64 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type RunRepositories struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Repositories map[int64]int64 `protobuf:"bytes,1,rep,name=repositories,proto3" json:"repositories,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // run ID -> repository ID
+}
+
+func (x *RunRepositories) Reset() {
+	*x = RunRepositories{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_services_data_server_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunRepositories) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunRepositories) ProtoMessage() {}
+
+func (x *RunRepositories) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_services_data_server_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunRepositories.ProtoReflect.Descriptor instead.
+func (*RunRepositories) Descriptor() ([]byte, []int) {
+	return file_grpc_services_data_server_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RunRepositories) GetRepositories() map[int64]int64 {
+	if x != nil {
+		return x.Repositories
+	}
+	return nil
+}
+
 type RunListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -528,7 +787,7 @@ type RunListRequest struct {
 func (x *RunListRequest) Reset() {
 	*x = RunListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_services_data_server_proto_msgTypes[8]
+		mi := &file_grpc_services_data_server_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -541,7 +800,7 @@ func (x *RunListRequest) String() string {
 func (*RunListRequest) ProtoMessage() {}
 
 func (x *RunListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_services_data_server_proto_msgTypes[8]
+	mi := &file_grpc_services_data_server_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -554,7 +813,7 @@ func (x *RunListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RunListRequest.ProtoReflect.Descriptor instead.
 func (*RunListRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_services_data_server_proto_rawDescGZIP(), []int{8}
+	return file_grpc_services_data_server_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *RunListRequest) GetRepository() string {
@@ -597,7 +856,7 @@ type RepoUserSelection struct {
 func (x *RepoUserSelection) Reset() {
 	*x = RepoUserSelection{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_services_data_server_proto_msgTypes[9]
+		mi := &file_grpc_services_data_server_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -610,7 +869,7 @@ func (x *RepoUserSelection) String() string {
 func (*RepoUserSelection) ProtoMessage() {}
 
 func (x *RepoUserSelection) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_services_data_server_proto_msgTypes[9]
+	mi := &file_grpc_services_data_server_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -623,7 +882,7 @@ func (x *RepoUserSelection) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepoUserSelection.ProtoReflect.Descriptor instead.
 func (*RepoUserSelection) Descriptor() ([]byte, []int) {
-	return file_grpc_services_data_server_proto_rawDescGZIP(), []int{9}
+	return file_grpc_services_data_server_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *RepoUserSelection) GetUsername() string {
@@ -652,7 +911,7 @@ type RepoRef struct {
 func (x *RepoRef) Reset() {
 	*x = RepoRef{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_services_data_server_proto_msgTypes[10]
+		mi := &file_grpc_services_data_server_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -665,7 +924,7 @@ func (x *RepoRef) String() string {
 func (*RepoRef) ProtoMessage() {}
 
 func (x *RepoRef) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_services_data_server_proto_msgTypes[10]
+	mi := &file_grpc_services_data_server_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -678,7 +937,7 @@ func (x *RepoRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepoRef.ProtoReflect.Descriptor instead.
 func (*RepoRef) Descriptor() ([]byte, []int) {
-	return file_grpc_services_data_server_proto_rawDescGZIP(), []int{10}
+	return file_grpc_services_data_server_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *RepoRef) GetRepository() int64 {
@@ -707,7 +966,7 @@ type RefPair struct {
 func (x *RefPair) Reset() {
 	*x = RefPair{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_services_data_server_proto_msgTypes[11]
+		mi := &file_grpc_services_data_server_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -720,7 +979,7 @@ func (x *RefPair) String() string {
 func (*RefPair) ProtoMessage() {}
 
 func (x *RefPair) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_services_data_server_proto_msgTypes[11]
+	mi := &file_grpc_services_data_server_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -733,7 +992,7 @@ func (x *RefPair) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefPair.ProtoReflect.Descriptor instead.
 func (*RefPair) Descriptor() ([]byte, []int) {
-	return file_grpc_services_data_server_proto_rawDescGZIP(), []int{11}
+	return file_grpc_services_data_server_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *RefPair) GetRepoName() string {
@@ -763,7 +1022,7 @@ type QueueListRequest struct {
 func (x *QueueListRequest) Reset() {
 	*x = QueueListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_services_data_server_proto_msgTypes[12]
+		mi := &file_grpc_services_data_server_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -776,7 +1035,7 @@ func (x *QueueListRequest) String() string {
 func (*QueueListRequest) ProtoMessage() {}
 
 func (x *QueueListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_services_data_server_proto_msgTypes[12]
+	mi := &file_grpc_services_data_server_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -789,7 +1048,7 @@ func (x *QueueListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueueListRequest.ProtoReflect.Descriptor instead.
 func (*QueueListRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_services_data_server_proto_rawDescGZIP(), []int{12}
+	return file_grpc_services_data_server_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *QueueListRequest) GetName() string {
@@ -824,7 +1083,7 @@ type QueueList struct {
 func (x *QueueList) Reset() {
 	*x = QueueList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_services_data_server_proto_msgTypes[13]
+		mi := &file_grpc_services_data_server_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -837,7 +1096,7 @@ func (x *QueueList) String() string {
 func (*QueueList) ProtoMessage() {}
 
 func (x *QueueList) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_services_data_server_proto_msgTypes[13]
+	mi := &file_grpc_services_data_server_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -850,7 +1109,7 @@ func (x *QueueList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueueList.ProtoReflect.Descriptor instead.
 func (*QueueList) Descriptor() ([]byte, []int) {
-	return file_grpc_services_data_server_proto_rawDescGZIP(), []int{13}
+	return file_grpc_services_data_server_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *QueueList) GetItems() []*types.QueueItem {
@@ -860,6 +1119,61 @@ func (x *QueueList) GetItems() []*types.QueueItem {
 	return nil
 }
 
+type QueueExpiry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QueueName string `protobuf:"bytes,1,opt,name=queueName,proto3" json:"queueName,omitempty"`
+	Ttl       int64  `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"` // in seconds
+}
+
+func (x *QueueExpiry) Reset() {
+	*x = QueueExpiry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_services_data_server_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueueExpiry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueExpiry) ProtoMessage() {}
+
+func (x *QueueExpiry) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_services_data_server_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueExpiry.ProtoReflect.Descriptor instead.
+func (*QueueExpiry) Descriptor() ([]byte, []int) {
+	return file_grpc_services_data_server_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *QueueExpiry) GetQueueName() string {
+	if x != nil {
+		return x.QueueName
+	}
+	return ""
+}
+
+func (x *QueueExpiry) GetTtl() int64 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
 type Count struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -871,7 +1185,7 @@ type Count struct {
 func (x *Count) Reset() {
 	*x = Count{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_services_data_server_proto_msgTypes[14]
+		mi := &file_grpc_services_data_server_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -884,7 +1198,7 @@ func (x *Count) String() string {
 func (*Count) ProtoMessage() {}
 
 func (x *Count) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_services_data_server_proto_msgTypes[14]
+	mi := &file_grpc_services_data_server_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -897,7 +1211,7 @@ func (x *Count) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Count.ProtoReflect.Descriptor instead.
 func (*Count) Descriptor() ([]byte, []int) {
-	return file_grpc_services_data_server_proto_rawDescGZIP(), []int{14}
+	return file_grpc_services_data_server_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *Count) GetCount() int64 {
@@ -918,7 +1232,7 @@ type Name struct {
 func (x *Name) Reset() {
 	*x = Name{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_services_data_server_proto_msgTypes[15]
+		mi := &file_grpc_services_data_server_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -931,7 +1245,7 @@ func (x *Name) String() string {
 func (*Name) ProtoMessage() {}
 
 func (x *Name) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_services_data_server_proto_msgTypes[15]
+	mi := &file_grpc_services_data_server_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -944,7 +1258,7 @@ func (x *Name) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Name.ProtoReflect.Descriptor instead.
 func (*Name) Descriptor() ([]byte, []int) {
-	return file_grpc_services_data_server_proto_rawDescGZIP(), []int{15}
+	return file_grpc_services_data_server_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *Name) GetName() string {
@@ -965,7 +1279,7 @@ type Search struct {
 func (x *Search) Reset() {
 	*x = Search{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_services_data_server_proto_msgTypes[16]
+		mi := &file_grpc_services_data_server_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -978,7 +1292,7 @@ func (x *Search) String() string {
 func (*Search) ProtoMessage() {}
 
 func (x *Search) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_services_data_server_proto_msgTypes[16]
+	mi := &file_grpc_services_data_server_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -991,7 +1305,7 @@ func (x *Search) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Search.ProtoReflect.Descriptor instead.
 func (*Search) Descriptor() ([]byte, []int) {
-	return file_grpc_services_data_server_proto_rawDescGZIP(), []int{16}
+	return file_grpc_services_data_server_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *Search) GetSearch() string {
@@ -1013,7 +1327,7 @@ type NameSearch struct {
 func (x *NameSearch) Reset() {
 	*x = NameSearch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_services_data_server_proto_msgTypes[17]
+		mi := &file_grpc_services_data_server_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1026,7 +1340,7 @@ func (x *NameSearch) String() string {
 func (*NameSearch) ProtoMessage() {}
 
 func (x *NameSearch) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_services_data_server_proto_msgTypes[17]
+	mi := &file_grpc_services_data_server_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1039,7 +1353,7 @@ func (x *NameSearch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NameSearch.ProtoReflect.Descriptor instead.
 func (*NameSearch) Descriptor() ([]byte, []int) {
-	return file_grpc_services_data_server_proto_rawDescGZIP(), []int{17}
+	return file_grpc_services_data_server_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *NameSearch) GetName() string {
@@ -1068,7 +1382,7 @@ type OAuthState struct {
 func (x *OAuthState) Reset() {
 	*x = OAuthState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_services_data_server_proto_msgTypes[18]
+		mi := &file_grpc_services_data_server_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1081,7 +1395,7 @@ func (x *OAuthState) String() string {
 func (*OAuthState) ProtoMessage() {}
 
 func (x *OAuthState) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_services_data_server_proto_msgTypes[18]
+	mi := &file_grpc_services_data_server_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1094,7 +1408,7 @@ func (x *OAuthState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OAuthState.ProtoReflect.Descriptor instead.
 func (*OAuthState) Descriptor() ([]byte, []int) {
-	return file_grpc_services_data_server_proto_rawDescGZIP(), []int{18}
+	return file_grpc_services_data_server_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *OAuthState) GetState() string {
@@ -1124,7 +1438,7 @@ type GithubJSON struct {
 func (x *GithubJSON) Reset() {
 	*x = GithubJSON{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_services_data_server_proto_msgTypes[19]
+		mi := &file_grpc_services_data_server_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1137,7 +1451,7 @@ func (x *GithubJSON) String() string {
 func (*GithubJSON) ProtoMessage() {}
 
 func (x *GithubJSON) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_services_data_server_proto_msgTypes[19]
+	mi := &file_grpc_services_data_server_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1150,7 +1464,7 @@ func (x *GithubJSON) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GithubJSON.ProtoReflect.Descriptor instead.
 func (*GithubJSON) Descriptor() ([]byte, []int) {
-	return file_grpc_services_data_server_proto_rawDescGZIP(), []int{19}
+	return file_grpc_services_data_server_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GithubJSON) GetJSON() []byte {
@@ -1218,146 +1532,211 @@ var file_grpc_services_data_server_proto_rawDesc = []byte{
 	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x69, 0x6e, 0x79, 0x63, 0x69, 0x2f, 0x63, 0x69, 0x2d,
 	0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x69, 0x2d, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72,
 	0x70, 0x63, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4b, 0x0a, 0x17, 0x52, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
-	0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x6f, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x68, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x73, 0x68, 0x61, 0x22, 0x87, 0x01, 0x0a, 0x25, 0x52, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x6f, 0x72, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x73, 0x68, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73,
-	0x68, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x50, 0x61, 0x67,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x65, 0x72, 0x50, 0x61, 0x67, 0x65,
-	0x22, 0x72, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x12, 0x31, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x69, 0x6e, 0x79, 0x63, 0x69, 0x2f, 0x63, 0x69, 0x2d,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x69, 0x2d, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72,
+	0x70, 0x63, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x74, 0x69, 0x6e, 0x79, 0x63, 0x69, 0x2f, 0x63, 0x69, 0x2d, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x73, 0x2f, 0x63, 0x69, 0x2d, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4b, 0x0a, 0x17, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x68, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x68,
+	0x61, 0x22, 0x87, 0x01, 0x0a, 0x25, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x57, 0x69, 0x74,
+	0x68, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x72,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x68, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x68, 0x61, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x50, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x70, 0x65, 0x72, 0x50, 0x61, 0x67, 0x65, 0x22, 0x72, 0x0a, 0x0f, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x31,
+	0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x50, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x65, 0x72, 0x50, 0x61, 0x67, 0x65, 0x22,
+	0x32, 0x0a, 0x0c, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12,
+	0x22, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x22, 0x43, 0x0a, 0x11, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61,
+	0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x5a, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65,
 	0x72, 0x50, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x65, 0x72,
-	0x50, 0x61, 0x67, 0x65, 0x22, 0x32, 0x0a, 0x0c, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61,
-	0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x43, 0x0a, 0x11, 0x43, 0x61, 0x70, 0x61,
-	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a,
-	0x0a, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x5a, 0x0a,
-	0x1a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x54,
-	0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x50, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x07, 0x70, 0x65, 0x72, 0x50, 0x61, 0x67, 0x65, 0x22, 0x52, 0x0a, 0x12, 0x52, 0x75, 0x6e,
-	0x73, 0x46, 0x6f, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x50, 0x61, 0x67, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x65, 0x72, 0x50, 0x61, 0x67, 0x65, 0x22, 0x71, 0x0a,
-	0x0f, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x73, 0x68, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73,
-	0x68, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x50, 0x61, 0x67,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x65, 0x72, 0x50, 0x61, 0x67, 0x65,
-	0x22, 0x70, 0x0a, 0x0e, 0x52, 0x75, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x68, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x73, 0x68, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x50, 0x61, 0x67, 0x65, 0x22, 0x52, 0x0a, 0x12, 0x52, 0x75, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x54,
+	0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x65, 0x72, 0x50, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x70, 0x65, 0x72, 0x50, 0x61, 0x67, 0x65, 0x22, 0x71, 0x0a, 0x0f, 0x54, 0x61, 0x73, 0x6b,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x68, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x68, 0x61, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x50, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x70, 0x65, 0x72, 0x50, 0x61, 0x67, 0x65, 0x22, 0x4b, 0x0a, 0x0d, 0x52,
+	0x75, 0x6e, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x61,
+	0x73, 0x6b, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x1a, 0x0a, 0x06, 0x52, 0x75, 0x6e, 0x49,
+	0x44, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52,
+	0x03, 0x69, 0x64, 0x73, 0x22, 0x3f, 0x0a, 0x0f, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x46, 0x0a, 0x10, 0x46, 0x6c, 0x61, 0x6b, 0x79, 0x54, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x22, 0x9f, 0x01,
+	0x0a, 0x0f, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x4b, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x52,
+	0x75, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x52,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x3f,
+	0x0a, 0x11, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x70, 0x0a, 0x0e, 0x52, 0x75, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x68, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x73, 0x68, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x50, 0x61,
+	0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x65, 0x72, 0x50, 0x61, 0x67,
+	0x65, 0x22, 0x4b, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x43,
+	0x0a, 0x07, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x66, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x66,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x66, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x37, 0x0a, 0x07, 0x52, 0x65, 0x66, 0x50, 0x61, 0x69, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x68,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x68, 0x61, 0x22, 0x54, 0x0a, 0x10,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x50,
-	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x65, 0x72, 0x50, 0x61,
-	0x67, 0x65, 0x22, 0x4b, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x22,
-	0x43, 0x0a, 0x07, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x66, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
-	0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
-	0x66, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x66,
-	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x37, 0x0a, 0x07, 0x52, 0x65, 0x66, 0x50, 0x61, 0x69, 0x72, 0x12,
-	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73,
-	0x68, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x68, 0x61, 0x22, 0x54, 0x0a,
-	0x10, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72,
-	0x50, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x65, 0x72, 0x50,
-	0x61, 0x67, 0x65, 0x22, 0x33, 0x0a, 0x09, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x26, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65,
-	0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x1d, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x1a, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x22, 0x20, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x22, 0x38, 0x0a, 0x0a, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x22,
-	0x3a, 0x0a, 0x0a, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22, 0x5e, 0x0a, 0x0a, 0x47,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x12, 0x0a, 0x04, 0x4a, 0x53, 0x4f,
-	0x4e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x1a, 0x0a,
-	0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x75, 0x74,
-	0x6f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
-	0x41, 0x75, 0x74, 0x6f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x32, 0xc9, 0x19, 0x0a, 0x04,
-	0x44, 0x61, 0x74, 0x61, 0x12, 0x2c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x73, 0x12, 0x0a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x11, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73,
-	0x22, 0x00, 0x12, 0x36, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x10,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0b, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x65, 0x72, 0x50, 0x61,
+	0x67, 0x65, 0x22, 0x33, 0x0a, 0x09, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x26, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3d, 0x0a, 0x0b, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x1d, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x1a, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x20, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x22, 0x38, 0x0a, 0x0a, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x22, 0x3a, 0x0a,
+	0x0a, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22, 0x5e, 0x0a, 0x0a, 0x47, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x12, 0x0a, 0x04, 0x4a, 0x53, 0x4f, 0x4e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x1a, 0x0a, 0x08, 0x55,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x6f, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x41, 0x75,
+	0x74, 0x6f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x32, 0xf4, 0x21, 0x0a, 0x04, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x2c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12,
+	0x0a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x11, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x00,
+	0x12, 0x36, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x10, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x12, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x12, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x12, 0x4f, 0x41, 0x75, 0x74, 0x68,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x1a,
-	0x10, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x75, 0x65, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0b, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x75,
-	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x6f, 0x72, 0x79, 0x12, 0x0a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x1a,
-	0x0b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x43,
-	0x0a, 0x16, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x52, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e,
-	0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x0f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x73,
-	0x74, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x08, 0x51, 0x75, 0x65, 0x75, 0x65, 0x41, 0x64, 0x64, 0x12,
-	0x0f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x1a, 0x0f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x73,
-	0x74, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4e, 0x65, 0x78, 0x74,
-	0x12, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
-	0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09, 0x50, 0x75, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
-	0x33, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x0c, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x49, 0x44, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x63, 0x65,
-	0x6c, 0x12, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x49, 0x44, 0x1a,
-	0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x12, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x1a, 0x10, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22,
+	0x00, 0x12, 0x33, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x75, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x75, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x79, 0x12, 0x0a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x0b, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x16,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22,
+	0x00, 0x12, 0x2e, 0x0a, 0x08, 0x51, 0x75, 0x65, 0x75, 0x65, 0x41, 0x64, 0x64, 0x12, 0x0f, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x0f,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22,
+	0x00, 0x12, 0x34, 0x0a, 0x09, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4e, 0x65, 0x78, 0x74, 0x12, 0x13,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x49, 0x74, 0x65, 0x6d, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0e, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x4e, 0x65, 0x78, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22,
+	0x00, 0x12, 0x3f, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x75, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x38, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x11, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x1a, 0x0f, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09,
+	0x50, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x35, 0x0a, 0x0a, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x75, 0x74, 0x52, 0x75, 0x6e,
+	0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x09, 0x53, 0x65, 0x74,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49,
+	0x6e, 0x74, 0x49, 0x44, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x2a,
+	0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x0c, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x49, 0x44, 0x1a, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x0b, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x49, 0x6e, 0x74, 0x49, 0x44, 0x1a, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x30, 0x01, 0x12, 0x31, 0x0a, 0x0f, 0x52, 0x75,
+	0x6e, 0x6e, 0x65, 0x72, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x0d, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x1a, 0x0d, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x22, 0x00, 0x12, 0x3a, 0x0a,
+	0x0b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e,
+	0x6e, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0e, 0x53, 0x65, 0x74,
+	0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x1a,
+	0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x22, 0x00,
 	0x12, 0x31, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x66, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65,
 	0x41, 0x6e, 0x64, 0x53, 0x48, 0x41, 0x12, 0x0d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x65,
 	0x66, 0x50, 0x61, 0x69, 0x72, 0x1a, 0x0a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65,
@@ -1370,158 +1749,197 @@ var file_grpc_services_data_server_proto_rawDesc = []byte{
 	0x79, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61, 0x73,
 	0x6b, 0x12, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x49, 0x44, 0x1a,
 	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x10, 0x45, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x17, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x46, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x65, 0x70,
-	0x6f, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x10, 0x53, 0x61, 0x76, 0x65,
-	0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x10, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x2e, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x4a, 0x53, 0x4f, 0x4e, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x13, 0x50, 0x72, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12,
-	0x10, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x1a, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x11, 0x4f, 0x77,
-	0x6e, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12,
-	0x10, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x1a, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0f, 0x41, 0x6c,
-	0x6c, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x10, 0x2e,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x15, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x73,
+	0x6b, 0x73, 0x12, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x49, 0x44,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x09, 0x52, 0x65,
+	0x72, 0x75, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x49, 0x6e, 0x74, 0x49, 0x44, 0x1a, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x75,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x10, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x17, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x46,
+	0x0a, 0x11, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x55,
+	0x73, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x10, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x10, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x2e, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x4a, 0x53, 0x4f, 0x4e, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x13, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x10, 0x2e,
 	0x64, 0x61, 0x74, 0x61, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x1a,
 	0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
-	0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x12, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x0c,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x1a, 0x15, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x4c,
-	0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x0a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4e, 0x61,
-	0x6d, 0x65, 0x1a, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x00, 0x12, 0x28, 0x0a, 0x08, 0x52, 0x75, 0x6e, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x0d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x65, 0x66, 0x50, 0x61,
-	0x69, 0x72, 0x1a, 0x0b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0x00, 0x12, 0x31, 0x0a, 0x07, 0x52, 0x75, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x2e, 0x52, 0x75, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x4c, 0x69,
-	0x73, 0x74, 0x22, 0x00, 0x12, 0x24, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x12, 0x0c,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x49, 0x44, 0x1a, 0x0a, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x22, 0x00, 0x12, 0x26, 0x0a, 0x08, 0x47, 0x65,
-	0x74, 0x52, 0x75, 0x6e, 0x55, 0x49, 0x12, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49,
-	0x6e, 0x74, 0x49, 0x44, 0x1a, 0x0a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e,
-	0x22, 0x00, 0x12, 0x36, 0x0a, 0x0a, 0x50, 0x75, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0b, 0x4c, 0x6f,
-	0x61, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x1a, 0x0e, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x12,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x73,
-	0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x11, 0x4f, 0x77, 0x6e, 0x65,
+	0x64, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x10, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x1a,
+	0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0f, 0x41, 0x6c, 0x6c, 0x52,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x10, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x1a, 0x15, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
+	0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x12, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x0c, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x1a, 0x15, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x73,
+	0x74, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x0a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
+	0x1a, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x79, 0x22, 0x00, 0x12, 0x28, 0x0a, 0x08, 0x52, 0x75, 0x6e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x0d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x65, 0x66, 0x50, 0x61, 0x69, 0x72,
+	0x1a, 0x0b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x00, 0x12,
+	0x31, 0x0a, 0x07, 0x52, 0x75, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x2e, 0x52, 0x75, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x22, 0x00, 0x12, 0x24, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x12, 0x0c, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x49, 0x44, 0x1a, 0x0a, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x22, 0x00, 0x12, 0x26, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x52,
+	0x75, 0x6e, 0x55, 0x49, 0x12, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74,
+	0x49, 0x44, 0x1a, 0x0a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x22, 0x00,
+	0x12, 0x26, 0x0a, 0x08, 0x52, 0x65, 0x72, 0x75, 0x6e, 0x52, 0x75, 0x6e, 0x12, 0x0c, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x49, 0x44, 0x1a, 0x0a, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x75, 0x6e, 0x49, 0x44, 0x73, 0x12, 0x13, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x75,
+	0x6e, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x52, 0x75, 0x6e, 0x49, 0x44, 0x73, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x0c, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x75, 0x6e, 0x49, 0x44, 0x73, 0x1a,
+	0x15, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x54, 0x65, 0x73,
+	0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x12, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54,
+	0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x53, 0x75, 0x62, 0x73,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x73,
+	0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x12, 0x15, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x65,
+	0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x73, 0x74, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49,
+	0x6e, 0x74, 0x49, 0x44, 0x1a, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x65, 0x73,
+	0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0a, 0x46, 0x6c,
+	0x61, 0x6b, 0x79, 0x54, 0x65, 0x73, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x46, 0x6c, 0x61, 0x6b, 0x79, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x46, 0x6c, 0x61, 0x6b, 0x79, 0x54, 0x65,
+	0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0a, 0x50, 0x75, 0x74, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x30, 0x0a, 0x0b, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x44,
+	0x1a, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x00, 0x12, 0x47, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x75, 0x62, 0x73,
 	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e,
 	0x52, 0x65, 0x70, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
 	0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x11, 0x4c,
-	0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x10, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x1a, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0d, 0x50,
-	0x75, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x11, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a,
-	0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e,
-	0x74, 0x49, 0x44, 0x1a, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53,
-	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x15,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x61,
-	0x73, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53,
-	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x15, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x1a, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e,
-	0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75,
-	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0f, 0x41,
+	0x64, 0x64, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x3e, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x10, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4e, 0x61,
+	0x6d, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x1a, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x22,
+	0x00, 0x12, 0x37, 0x0a, 0x0d, 0x50, 0x75, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x49, 0x44, 0x1a, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x3e,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54,
+	0x61, 0x73, 0x6b, 0x73, 0x12, 0x15, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x0f, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3c,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x75, 0x6e, 0x73, 0x12, 0x15, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x0e, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0f,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x2b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x57, 0x69,
+	0x74, 0x68, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x15, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c,
+	0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x10, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61,
 	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x50, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53,
-	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12,
-	0x40, 0x0a, 0x10, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x6f, 0x72, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0x00, 0x12, 0x3a, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e,
-	0x74, 0x49, 0x44, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x25, 0x0a,
-	0x07, 0x50, 0x75, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x0b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x0b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x61,
-	0x73, 0x6b, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b,
-	0x73, 0x12, 0x15, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0a, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x15, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x0b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x00, 0x12,
-	0x43, 0x0a, 0x0f, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x42, 0x79,
-	0x50, 0x52, 0x12, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65,
-	0x6c, 0x50, 0x52, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x49, 0x44, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0f, 0x52, 0x65, 0x72, 0x75, 0x6e, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49,
+	0x6e, 0x74, 0x49, 0x44, 0x1a, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x25, 0x0a, 0x07, 0x50, 0x75, 0x74, 0x54, 0x61, 0x73,
+	0x6b, 0x12, 0x0b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x0b,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x22, 0x00, 0x12, 0x35, 0x0a,
+	0x09, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x15, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69,
+	0x73, 0x74, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x61, 0x73,
+	0x6b, 0x73, 0x12, 0x15, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0f, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x42, 0x79, 0x50, 0x52, 0x12, 0x16, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x52, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x39, 0x0a,
+	0x0b, 0x52, 0x75, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x18, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x2e, 0x52, 0x75, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52,
+	0x75, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x10, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x75, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x0c, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x49, 0x44, 0x1a, 0x0b, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x27, 0x0a, 0x0a, 0x55, 0x73, 0x65,
+	0x72, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x0a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4e,
+	0x61, 0x6d, 0x65, 0x1a, 0x0b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x22, 0x00, 0x12, 0x32, 0x0a, 0x09, 0x50, 0x61, 0x74, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x0b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x25, 0x0a, 0x07, 0x50, 0x75, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x0b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x0b,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x22, 0x00, 0x12, 0x36, 0x0a,
+	0x09, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0b, 0x52, 0x75, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x54,
-	0x61, 0x73, 0x6b, 0x12, 0x18, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x75, 0x6e, 0x73, 0x46,
-	0x6f, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12,
-	0x2f, 0x0a, 0x10, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x54,
-	0x61, 0x73, 0x6b, 0x12, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x49,
-	0x44, 0x1a, 0x0b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x00,
-	0x12, 0x27, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x0a,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x0b, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x09, 0x50, 0x61, 0x74,
-	0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x25, 0x0a,
-	0x07, 0x50, 0x75, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x0b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x29, 0x0a, 0x08,
-	0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x0a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e,
-	0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x49, 0x44, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x0a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4e, 0x61,
-	0x6d, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0d,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x0f, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x1a, 0x0b,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x22, 0x00, 0x12, 0x34, 0x0a,
-	0x0f, 0x47, 0x65, 0x74, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73,
-	0x12, 0x0b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x12, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x2e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65,
-	0x73, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0d, 0x48, 0x61, 0x73, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69,
-	0x6c, 0x69, 0x74, 0x79, 0x12, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x43, 0x61, 0x70, 0x61,
-	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0d,
-	0x41, 0x64, 0x64, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x17, 0x2e,
+	0x74, 0x79, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x29, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x0a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x0f, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x22, 0x00,
+	0x12, 0x33, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x0a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x1a, 0x0b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x61, 0x70,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x0b, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x12, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x43, 0x61,
+	0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0d,
+	0x48, 0x61, 0x73, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x17, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6c, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x43, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x43, 0x61,
+	0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x10, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x17, 0x2e,
 	0x64, 0x61, 0x74, 0x61, 0x2e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x45, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69,
-	0x6c, 0x69, 0x74, 0x79, 0x12, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x43, 0x61, 0x70, 0x61,
-	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x69, 0x6e, 0x79, 0x63, 0x69, 0x2f, 0x63, 0x69, 0x2d,
-	0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x69, 0x2d, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72,
-	0x70, 0x63, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x64, 0x61, 0x74, 0x61,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74,
+	0x69, 0x6e, 0x79, 0x63, 0x69, 0x2f, 0x63, 0x69, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f,
+	0x63, 0x69, 0x2d, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -1536,7 +1954,7 @@ func file_grpc_services_data_server_proto_rawDescGZIP() []byte {
 	return file_grpc_services_data_server_proto_rawDescData
 }
 
-var file_grpc_services_data_server_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_grpc_services_data_server_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_grpc_services_data_server_proto_goTypes = []interface{}{
 	(*RepositoryFilterRequest)(nil),               // 0: data.RepositoryFilterRequest
 	(*RepositoryFilterRequestWithPagination)(nil), // 1: data.RepositoryFilterRequestWithPagination
@@ -1546,165 +1964,218 @@ var file_grpc_services_data_server_proto_goTypes = []interface{}{
 	(*ListSubscribedTasksRequest)(nil),            // 5: data.ListSubscribedTasksRequest
 	(*RunsForTaskRequest)(nil),                    // 6: data.RunsForTaskRequest
 	(*TaskListRequest)(nil),                       // 7: data.TaskListRequest
-	(*RunListRequest)(nil),                        // 8: data.RunListRequest
-	(*RepoUserSelection)(nil),                     // 9: data.RepoUserSelection
-	(*RepoRef)(nil),                               // 10: data.RepoRef
-	(*RefPair)(nil),                               // 11: data.RefPair
-	(*QueueListRequest)(nil),                      // 12: data.QueueListRequest
-	(*QueueList)(nil),                             // 13: data.QueueList
-	(*Count)(nil),                                 // 14: data.Count
-	(*Name)(nil),                                  // 15: data.Name
-	(*Search)(nil),                                // 16: data.Search
-	(*NameSearch)(nil),                            // 17: data.NameSearch
-	(*OAuthState)(nil),                            // 18: data.OAuthState
-	(*GithubJSON)(nil),                            // 19: data.GithubJSON
-	(*types.Submission)(nil),                      // 20: types.Submission
-	(*types.QueueItem)(nil),                       // 21: types.QueueItem
-	(*types.UserError)(nil),                       // 22: types.UserError
-	(*emptypb.Empty)(nil),                         // 23: google.protobuf.Empty
-	(*types.QueueRequest)(nil),                    // 24: types.QueueRequest
-	(*types.Status)(nil),                          // 25: types.Status
-	(*types.IntID)(nil),                           // 26: types.IntID
-	(*types.Ref)(nil),                             // 27: types.Ref
-	(*types.Session)(nil),                         // 28: types.Session
-	(*types.StringID)(nil),                        // 29: types.StringID
-	(*types.Task)(nil),                            // 30: types.Task
-	(*types.CancelPRRequest)(nil),                 // 31: types.CancelPRRequest
-	(*types.User)(nil),                            // 32: types.User
-	(*types.UserErrors)(nil),                      // 33: types.UserErrors
-	(*types.RepositoryList)(nil),                  // 34: types.RepositoryList
-	(*types.Repository)(nil),                      // 35: types.Repository
-	(*types.RunList)(nil),                         // 36: types.RunList
-	(*types.Run)(nil),                             // 37: types.Run
-	(*types.TaskList)(nil),                        // 38: types.TaskList
-	(*types.SubmissionList)(nil),                  // 39: types.SubmissionList
-	(*types.UserList)(nil),                        // 40: types.UserList
-	(*types.Bool)(nil),                            // 41: types.Bool
+	(*RunIDsRequest)(nil),                         // 8: data.RunIDsRequest
+	(*RunIDs)(nil),                                // 9: data.RunIDs
+	(*TestCaseRequest)(nil),                       // 10: data.TestCaseRequest
+	(*FlakyTestRequest)(nil),                      // 11: data.FlakyTestRequest
+	(*RunRepositories)(nil),                       // 12: data.RunRepositories
+	(*RunListRequest)(nil),                        // 13: data.RunListRequest
+	(*RepoUserSelection)(nil),                     // 14: data.RepoUserSelection
+	(*RepoRef)(nil),                               // 15: data.RepoRef
+	(*RefPair)(nil),                               // 16: data.RefPair
+	(*QueueListRequest)(nil),                      // 17: data.QueueListRequest
+	(*QueueList)(nil),                             // 18: data.QueueList
+	(*QueueExpiry)(nil),                           // 19: data.QueueExpiry
+	(*Count)(nil),                                 // 20: data.Count
+	(*Name)(nil),                                  // 21: data.Name
+	(*Search)(nil),                                // 22: data.Search
+	(*NameSearch)(nil),                            // 23: data.NameSearch
+	(*OAuthState)(nil),                            // 24: data.OAuthState
+	(*GithubJSON)(nil),                            // 25: data.GithubJSON
+	nil,                                           // 26: data.RunRepositories.RepositoriesEntry
+	(*types.Submission)(nil),                      // 27: types.Submission
+	(*types.QueueItem)(nil),                       // 28: types.QueueItem
+	(*types.UserError)(nil),                       // 29: types.UserError
+	(*emptypb.Empty)(nil),                         // 30: google.protobuf.Empty
+	(*types.QueueRequest)(nil),                    // 31: types.QueueRequest
+	(*types.IntID)(nil),                           // 32: types.IntID
+	(*types.Status)(nil),                          // 33: types.Status
+	(*types.Runner)(nil),                          // 34: types.Runner
+	(*types.RunnerState)(nil),                     // 35: types.RunnerState
+	(*types.Ref)(nil),                             // 36: types.Ref
+	(*types.TestCaseList)(nil),                    // 37: types.TestCaseList
+	(*types.Session)(nil),                         // 38: types.Session
+	(*types.StringID)(nil),                        // 39: types.StringID
+	(*types.Task)(nil),                            // 40: types.Task
+	(*types.CancelPRRequest)(nil),                 // 41: types.CancelPRRequest
+	(*types.User)(nil),                            // 42: types.User
+	(*types.UserErrors)(nil),                      // 43: types.UserErrors
+	(*types.QueueNotification)(nil),               // 44: types.QueueNotification
+	(*types.RunnerList)(nil),                      // 45: types.RunnerList
+	(*types.RunList)(nil),                         // 46: types.RunList
+	(*types.RepositoryList)(nil),                  // 47: types.RepositoryList
+	(*types.Repository)(nil),                      // 48: types.Repository
+	(*types.Run)(nil),                             // 49: types.Run
+	(*types.TestSummary)(nil),                     // 50: types.TestSummary
+	(*types.FlakyTestList)(nil),                   // 51: types.FlakyTestList
+	(*types.TaskList)(nil),                        // 52: types.TaskList
+	(*types.SubmissionList)(nil),                  // 53: types.SubmissionList
+	(*types.UserList)(nil),                        // 54: types.UserList
+	(*types.Bool)(nil),                            // 55: types.Bool
 }
 var file_grpc_services_data_server_proto_depIdxs = []int32{
-	20, // 0: data.SubmissionQuery.submission:type_name -> types.Submission
-	21, // 1: data.QueueList.items:type_name -> types.QueueItem
-	15, // 2: data.Data.GetErrors:input_type -> data.Name
-	22, // 3: data.Data.AddError:input_type -> types.UserError
-	22, // 4: data.Data.DeleteError:input_type -> types.UserError
-	18, // 5: data.Data.OAuthRegisterState:input_type -> data.OAuthState
-	18, // 6: data.Data.OAuthValidateState:input_type -> data.OAuthState
-	23, // 7: data.Data.QueueCount:input_type -> google.protobuf.Empty
-	15, // 8: data.Data.QueueCountForRepository:input_type -> data.Name
-	12, // 9: data.Data.QueueListForRepository:input_type -> data.QueueListRequest
-	13, // 10: data.Data.QueueAdd:input_type -> data.QueueList
-	24, // 11: data.Data.QueueNext:input_type -> types.QueueRequest
-	25, // 12: data.Data.PutStatus:input_type -> types.Status
-	26, // 13: data.Data.SetCancel:input_type -> types.IntID
-	26, // 14: data.Data.GetCancel:input_type -> types.IntID
-	11, // 15: data.Data.GetRefByNameAndSHA:input_type -> data.RefPair
-	27, // 16: data.Data.PutRef:input_type -> types.Ref
-	10, // 17: data.Data.CancelRefByName:input_type -> data.RepoRef
-	26, // 18: data.Data.CancelTask:input_type -> types.IntID
-	9,  // 19: data.Data.EnableRepository:input_type -> data.RepoUserSelection
-	9,  // 20: data.Data.DisableRepository:input_type -> data.RepoUserSelection
-	19, // 21: data.Data.SaveRepositories:input_type -> data.GithubJSON
-	17, // 22: data.Data.PrivateRepositories:input_type -> data.NameSearch
-	17, // 23: data.Data.OwnedRepositories:input_type -> data.NameSearch
-	17, // 24: data.Data.AllRepositories:input_type -> data.NameSearch
-	16, // 25: data.Data.PublicRepositories:input_type -> data.Search
-	15, // 26: data.Data.GetRepository:input_type -> data.Name
-	11, // 27: data.Data.RunCount:input_type -> data.RefPair
-	8,  // 28: data.Data.RunList:input_type -> data.RunListRequest
-	26, // 29: data.Data.GetRun:input_type -> types.IntID
-	26, // 30: data.Data.GetRunUI:input_type -> types.IntID
-	28, // 31: data.Data.PutSession:input_type -> types.Session
-	29, // 32: data.Data.LoadSession:input_type -> types.StringID
-	9,  // 33: data.Data.RemoveSubscription:input_type -> data.RepoUserSelection
-	9,  // 34: data.Data.AddSubscription:input_type -> data.RepoUserSelection
-	17, // 35: data.Data.ListSubscriptions:input_type -> data.NameSearch
-	20, // 36: data.Data.PutSubmission:input_type -> types.Submission
-	26, // 37: data.Data.GetSubmission:input_type -> types.IntID
-	2,  // 38: data.Data.GetSubmissionTasks:input_type -> data.SubmissionQuery
-	2,  // 39: data.Data.GetSubmissionRuns:input_type -> data.SubmissionQuery
-	1,  // 40: data.Data.ListSubmissions:input_type -> data.RepositoryFilterRequestWithPagination
-	0,  // 41: data.Data.CountSubmissions:input_type -> data.RepositoryFilterRequest
-	26, // 42: data.Data.CancelSubmission:input_type -> types.IntID
-	30, // 43: data.Data.PutTask:input_type -> types.Task
-	7,  // 44: data.Data.ListTasks:input_type -> data.TaskListRequest
-	7,  // 45: data.Data.CountTasks:input_type -> data.TaskListRequest
-	31, // 46: data.Data.CancelTasksByPR:input_type -> types.CancelPRRequest
-	6,  // 47: data.Data.RunsForTask:input_type -> data.RunsForTaskRequest
-	26, // 48: data.Data.CountRunsForTask:input_type -> types.IntID
-	15, // 49: data.Data.UserByName:input_type -> data.Name
-	32, // 50: data.Data.PatchUser:input_type -> types.User
-	32, // 51: data.Data.PutUser:input_type -> types.User
-	23, // 52: data.Data.ListUsers:input_type -> google.protobuf.Empty
-	15, // 53: data.Data.GetToken:input_type -> data.Name
-	15, // 54: data.Data.DeleteToken:input_type -> data.Name
-	29, // 55: data.Data.ValidateToken:input_type -> types.StringID
-	32, // 56: data.Data.GetCapabilities:input_type -> types.User
-	4,  // 57: data.Data.HasCapability:input_type -> data.CapabilityRequest
-	4,  // 58: data.Data.AddCapability:input_type -> data.CapabilityRequest
-	4,  // 59: data.Data.RemoveCapability:input_type -> data.CapabilityRequest
-	33, // 60: data.Data.GetErrors:output_type -> types.UserErrors
-	23, // 61: data.Data.AddError:output_type -> google.protobuf.Empty
-	23, // 62: data.Data.DeleteError:output_type -> google.protobuf.Empty
-	23, // 63: data.Data.OAuthRegisterState:output_type -> google.protobuf.Empty
-	18, // 64: data.Data.OAuthValidateState:output_type -> data.OAuthState
-	14, // 65: data.Data.QueueCount:output_type -> data.Count
-	14, // 66: data.Data.QueueCountForRepository:output_type -> data.Count
-	13, // 67: data.Data.QueueListForRepository:output_type -> data.QueueList
-	13, // 68: data.Data.QueueAdd:output_type -> data.QueueList
-	21, // 69: data.Data.QueueNext:output_type -> types.QueueItem
-	23, // 70: data.Data.PutStatus:output_type -> google.protobuf.Empty
-	23, // 71: data.Data.SetCancel:output_type -> google.protobuf.Empty
-	25, // 72: data.Data.GetCancel:output_type -> types.Status
-	27, // 73: data.Data.GetRefByNameAndSHA:output_type -> types.Ref
-	27, // 74: data.Data.PutRef:output_type -> types.Ref
-	23, // 75: data.Data.CancelRefByName:output_type -> google.protobuf.Empty
-	23, // 76: data.Data.CancelTask:output_type -> google.protobuf.Empty
-	23, // 77: data.Data.EnableRepository:output_type -> google.protobuf.Empty
-	23, // 78: data.Data.DisableRepository:output_type -> google.protobuf.Empty
-	23, // 79: data.Data.SaveRepositories:output_type -> google.protobuf.Empty
-	34, // 80: data.Data.PrivateRepositories:output_type -> types.RepositoryList
-	34, // 81: data.Data.OwnedRepositories:output_type -> types.RepositoryList
-	34, // 82: data.Data.AllRepositories:output_type -> types.RepositoryList
-	34, // 83: data.Data.PublicRepositories:output_type -> types.RepositoryList
-	35, // 84: data.Data.GetRepository:output_type -> types.Repository
-	14, // 85: data.Data.RunCount:output_type -> data.Count
-	36, // 86: data.Data.RunList:output_type -> types.RunList
-	37, // 87: data.Data.GetRun:output_type -> types.Run
-	37, // 88: data.Data.GetRunUI:output_type -> types.Run
-	23, // 89: data.Data.PutSession:output_type -> google.protobuf.Empty
-	28, // 90: data.Data.LoadSession:output_type -> types.Session
-	23, // 91: data.Data.RemoveSubscription:output_type -> google.protobuf.Empty
-	23, // 92: data.Data.AddSubscription:output_type -> google.protobuf.Empty
-	34, // 93: data.Data.ListSubscriptions:output_type -> types.RepositoryList
-	20, // 94: data.Data.PutSubmission:output_type -> types.Submission
-	20, // 95: data.Data.GetSubmission:output_type -> types.Submission
-	38, // 96: data.Data.GetSubmissionTasks:output_type -> types.TaskList
-	36, // 97: data.Data.GetSubmissionRuns:output_type -> types.RunList
-	39, // 98: data.Data.ListSubmissions:output_type -> types.SubmissionList
-	14, // 99: data.Data.CountSubmissions:output_type -> data.Count
-	23, // 100: data.Data.CancelSubmission:output_type -> google.protobuf.Empty
-	30, // 101: data.Data.PutTask:output_type -> types.Task
-	38, // 102: data.Data.ListTasks:output_type -> types.TaskList
-	14, // 103: data.Data.CountTasks:output_type -> data.Count
-	23, // 104: data.Data.CancelTasksByPR:output_type -> google.protobuf.Empty
-	36, // 105: data.Data.RunsForTask:output_type -> types.RunList
-	14, // 106: data.Data.CountRunsForTask:output_type -> data.Count
-	32, // 107: data.Data.UserByName:output_type -> types.User
-	23, // 108: data.Data.PatchUser:output_type -> google.protobuf.Empty
-	32, // 109: data.Data.PutUser:output_type -> types.User
-	40, // 110: data.Data.ListUsers:output_type -> types.UserList
-	29, // 111: data.Data.GetToken:output_type -> types.StringID
-	23, // 112: data.Data.DeleteToken:output_type -> google.protobuf.Empty
-	32, // 113: data.Data.ValidateToken:output_type -> types.User
-	3,  // 114: data.Data.GetCapabilities:output_type -> data.Capabilities
-	41, // 115: data.Data.HasCapability:output_type -> types.Bool
-	23, // 116: data.Data.AddCapability:output_type -> google.protobuf.Empty
-	23, // 117: data.Data.RemoveCapability:output_type -> google.protobuf.Empty
-	60, // [60:118] is the sub-list for method output_type
-	2,  // [2:60] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	27, // 0: data.SubmissionQuery.submission:type_name -> types.Submission
+	26, // 1: data.RunRepositories.repositories:type_name -> data.RunRepositories.RepositoriesEntry
+	28, // 2: data.QueueList.items:type_name -> types.QueueItem
+	21, // 3: data.Data.GetErrors:input_type -> data.Name
+	29, // 4: data.Data.AddError:input_type -> types.UserError
+	29, // 5: data.Data.DeleteError:input_type -> types.UserError
+	24, // 6: data.Data.OAuthRegisterState:input_type -> data.OAuthState
+	24, // 7: data.Data.OAuthValidateState:input_type -> data.OAuthState
+	30, // 8: data.Data.QueueCount:input_type -> google.protobuf.Empty
+	21, // 9: data.Data.QueueCountForRepository:input_type -> data.Name
+	17, // 10: data.Data.QueueListForRepository:input_type -> data.QueueListRequest
+	18, // 11: data.Data.QueueAdd:input_type -> data.QueueList
+	31, // 12: data.Data.QueueNext:input_type -> types.QueueRequest
+	31, // 13: data.Data.QueueNextItems:input_type -> types.QueueRequest
+	31, // 14: data.Data.QueueWatch:input_type -> types.QueueRequest
+	19, // 15: data.Data.QueueListExpired:input_type -> data.QueueExpiry
+	33, // 16: data.Data.PutStatus:input_type -> types.Status
+	33, // 17: data.Data.TimeOutRun:input_type -> types.Status
+	32, // 18: data.Data.SetCancel:input_type -> types.IntID
+	32, // 19: data.Data.GetCancel:input_type -> types.IntID
+	32, // 20: data.Data.WatchCancel:input_type -> types.IntID
+	34, // 21: data.Data.RunnerHeartbeat:input_type -> types.Runner
+	30, // 22: data.Data.ListRunners:input_type -> google.protobuf.Empty
+	35, // 23: data.Data.SetRunnerState:input_type -> types.RunnerState
+	16, // 24: data.Data.GetRefByNameAndSHA:input_type -> data.RefPair
+	36, // 25: data.Data.PutRef:input_type -> types.Ref
+	15, // 26: data.Data.CancelRefByName:input_type -> data.RepoRef
+	32, // 27: data.Data.CancelTask:input_type -> types.IntID
+	32, // 28: data.Data.CancelConcurrentTasks:input_type -> types.IntID
+	32, // 29: data.Data.RerunTask:input_type -> types.IntID
+	14, // 30: data.Data.EnableRepository:input_type -> data.RepoUserSelection
+	14, // 31: data.Data.DisableRepository:input_type -> data.RepoUserSelection
+	25, // 32: data.Data.SaveRepositories:input_type -> data.GithubJSON
+	23, // 33: data.Data.PrivateRepositories:input_type -> data.NameSearch
+	23, // 34: data.Data.OwnedRepositories:input_type -> data.NameSearch
+	23, // 35: data.Data.AllRepositories:input_type -> data.NameSearch
+	22, // 36: data.Data.PublicRepositories:input_type -> data.Search
+	21, // 37: data.Data.GetRepository:input_type -> data.Name
+	16, // 38: data.Data.RunCount:input_type -> data.RefPair
+	13, // 39: data.Data.RunList:input_type -> data.RunListRequest
+	32, // 40: data.Data.GetRun:input_type -> types.IntID
+	32, // 41: data.Data.GetRunUI:input_type -> types.IntID
+	32, // 42: data.Data.RerunRun:input_type -> types.IntID
+	8,  // 43: data.Data.ListRunIDs:input_type -> data.RunIDsRequest
+	9,  // 44: data.Data.GetRunRepositories:input_type -> data.RunIDs
+	30, // 45: data.Data.ListTimedOutRuns:input_type -> google.protobuf.Empty
+	37, // 46: data.Data.AddTestCases:input_type -> types.TestCaseList
+	10, // 47: data.Data.ListTestCases:input_type -> data.TestCaseRequest
+	32, // 48: data.Data.GetTestSummary:input_type -> types.IntID
+	11, // 49: data.Data.FlakyTests:input_type -> data.FlakyTestRequest
+	38, // 50: data.Data.PutSession:input_type -> types.Session
+	39, // 51: data.Data.LoadSession:input_type -> types.StringID
+	14, // 52: data.Data.RemoveSubscription:input_type -> data.RepoUserSelection
+	14, // 53: data.Data.AddSubscription:input_type -> data.RepoUserSelection
+	23, // 54: data.Data.ListSubscriptions:input_type -> data.NameSearch
+	27, // 55: data.Data.PutSubmission:input_type -> types.Submission
+	32, // 56: data.Data.GetSubmission:input_type -> types.IntID
+	2,  // 57: data.Data.GetSubmissionTasks:input_type -> data.SubmissionQuery
+	2,  // 58: data.Data.GetSubmissionRuns:input_type -> data.SubmissionQuery
+	1,  // 59: data.Data.ListSubmissions:input_type -> data.RepositoryFilterRequestWithPagination
+	0,  // 60: data.Data.CountSubmissions:input_type -> data.RepositoryFilterRequest
+	32, // 61: data.Data.CancelSubmission:input_type -> types.IntID
+	32, // 62: data.Data.RerunSubmission:input_type -> types.IntID
+	40, // 63: data.Data.PutTask:input_type -> types.Task
+	7,  // 64: data.Data.ListTasks:input_type -> data.TaskListRequest
+	7,  // 65: data.Data.CountTasks:input_type -> data.TaskListRequest
+	41, // 66: data.Data.CancelTasksByPR:input_type -> types.CancelPRRequest
+	6,  // 67: data.Data.RunsForTask:input_type -> data.RunsForTaskRequest
+	32, // 68: data.Data.CountRunsForTask:input_type -> types.IntID
+	21, // 69: data.Data.UserByName:input_type -> data.Name
+	42, // 70: data.Data.PatchUser:input_type -> types.User
+	42, // 71: data.Data.PutUser:input_type -> types.User
+	30, // 72: data.Data.ListUsers:input_type -> google.protobuf.Empty
+	21, // 73: data.Data.GetToken:input_type -> data.Name
+	21, // 74: data.Data.DeleteToken:input_type -> data.Name
+	39, // 75: data.Data.ValidateToken:input_type -> types.StringID
+	42, // 76: data.Data.GetCapabilities:input_type -> types.User
+	4,  // 77: data.Data.HasCapability:input_type -> data.CapabilityRequest
+	4,  // 78: data.Data.AddCapability:input_type -> data.CapabilityRequest
+	4,  // 79: data.Data.RemoveCapability:input_type -> data.CapabilityRequest
+	43, // 80: data.Data.GetErrors:output_type -> types.UserErrors
+	30, // 81: data.Data.AddError:output_type -> google.protobuf.Empty
+	30, // 82: data.Data.DeleteError:output_type -> google.protobuf.Empty
+	30, // 83: data.Data.OAuthRegisterState:output_type -> google.protobuf.Empty
+	24, // 84: data.Data.OAuthValidateState:output_type -> data.OAuthState
+	20, // 85: data.Data.QueueCount:output_type -> data.Count
+	20, // 86: data.Data.QueueCountForRepository:output_type -> data.Count
+	18, // 87: data.Data.QueueListForRepository:output_type -> data.QueueList
+	18, // 88: data.Data.QueueAdd:output_type -> data.QueueList
+	28, // 89: data.Data.QueueNext:output_type -> types.QueueItem
+	18, // 90: data.Data.QueueNextItems:output_type -> data.QueueList
+	44, // 91: data.Data.QueueWatch:output_type -> types.QueueNotification
+	18, // 92: data.Data.QueueListExpired:output_type -> data.QueueList
+	30, // 93: data.Data.PutStatus:output_type -> google.protobuf.Empty
+	30, // 94: data.Data.TimeOutRun:output_type -> google.protobuf.Empty
+	30, // 95: data.Data.SetCancel:output_type -> google.protobuf.Empty
+	33, // 96: data.Data.GetCancel:output_type -> types.Status
+	33, // 97: data.Data.WatchCancel:output_type -> types.Status
+	34, // 98: data.Data.RunnerHeartbeat:output_type -> types.Runner
+	45, // 99: data.Data.ListRunners:output_type -> types.RunnerList
+	34, // 100: data.Data.SetRunnerState:output_type -> types.Runner
+	36, // 101: data.Data.GetRefByNameAndSHA:output_type -> types.Ref
+	36, // 102: data.Data.PutRef:output_type -> types.Ref
+	30, // 103: data.Data.CancelRefByName:output_type -> google.protobuf.Empty
+	30, // 104: data.Data.CancelTask:output_type -> google.protobuf.Empty
+	30, // 105: data.Data.CancelConcurrentTasks:output_type -> google.protobuf.Empty
+	46, // 106: data.Data.RerunTask:output_type -> types.RunList
+	30, // 107: data.Data.EnableRepository:output_type -> google.protobuf.Empty
+	30, // 108: data.Data.DisableRepository:output_type -> google.protobuf.Empty
+	30, // 109: data.Data.SaveRepositories:output_type -> google.protobuf.Empty
+	47, // 110: data.Data.PrivateRepositories:output_type -> types.RepositoryList
+	47, // 111: data.Data.OwnedRepositories:output_type -> types.RepositoryList
+	47, // 112: data.Data.AllRepositories:output_type -> types.RepositoryList
+	47, // 113: data.Data.PublicRepositories:output_type -> types.RepositoryList
+	48, // 114: data.Data.GetRepository:output_type -> types.Repository
+	20, // 115: data.Data.RunCount:output_type -> data.Count
+	46, // 116: data.Data.RunList:output_type -> types.RunList
+	49, // 117: data.Data.GetRun:output_type -> types.Run
+	49, // 118: data.Data.GetRunUI:output_type -> types.Run
+	49, // 119: data.Data.RerunRun:output_type -> types.Run
+	9,  // 120: data.Data.ListRunIDs:output_type -> data.RunIDs
+	12, // 121: data.Data.GetRunRepositories:output_type -> data.RunRepositories
+	46, // 122: data.Data.ListTimedOutRuns:output_type -> types.RunList
+	30, // 123: data.Data.AddTestCases:output_type -> google.protobuf.Empty
+	37, // 124: data.Data.ListTestCases:output_type -> types.TestCaseList
+	50, // 125: data.Data.GetTestSummary:output_type -> types.TestSummary
+	51, // 126: data.Data.FlakyTests:output_type -> types.FlakyTestList
+	30, // 127: data.Data.PutSession:output_type -> google.protobuf.Empty
+	38, // 128: data.Data.LoadSession:output_type -> types.Session
+	30, // 129: data.Data.RemoveSubscription:output_type -> google.protobuf.Empty
+	30, // 130: data.Data.AddSubscription:output_type -> google.protobuf.Empty
+	47, // 131: data.Data.ListSubscriptions:output_type -> types.RepositoryList
+	27, // 132: data.Data.PutSubmission:output_type -> types.Submission
+	27, // 133: data.Data.GetSubmission:output_type -> types.Submission
+	52, // 134: data.Data.GetSubmissionTasks:output_type -> types.TaskList
+	46, // 135: data.Data.GetSubmissionRuns:output_type -> types.RunList
+	53, // 136: data.Data.ListSubmissions:output_type -> types.SubmissionList
+	20, // 137: data.Data.CountSubmissions:output_type -> data.Count
+	30, // 138: data.Data.CancelSubmission:output_type -> google.protobuf.Empty
+	46, // 139: data.Data.RerunSubmission:output_type -> types.RunList
+	40, // 140: data.Data.PutTask:output_type -> types.Task
+	52, // 141: data.Data.ListTasks:output_type -> types.TaskList
+	20, // 142: data.Data.CountTasks:output_type -> data.Count
+	30, // 143: data.Data.CancelTasksByPR:output_type -> google.protobuf.Empty
+	46, // 144: data.Data.RunsForTask:output_type -> types.RunList
+	20, // 145: data.Data.CountRunsForTask:output_type -> data.Count
+	42, // 146: data.Data.UserByName:output_type -> types.User
+	30, // 147: data.Data.PatchUser:output_type -> google.protobuf.Empty
+	42, // 148: data.Data.PutUser:output_type -> types.User
+	54, // 149: data.Data.ListUsers:output_type -> types.UserList
+	39, // 150: data.Data.GetToken:output_type -> types.StringID
+	30, // 151: data.Data.DeleteToken:output_type -> google.protobuf.Empty
+	42, // 152: data.Data.ValidateToken:output_type -> types.User
+	3,  // 153: data.Data.GetCapabilities:output_type -> data.Capabilities
+	55, // 154: data.Data.HasCapability:output_type -> types.Bool
+	30, // 155: data.Data.AddCapability:output_type -> google.protobuf.Empty
+	30, // 156: data.Data.RemoveCapability:output_type -> google.protobuf.Empty
+	80, // [80:157] is the sub-list for method output_type
+	3,  // [3:80] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_grpc_services_data_server_proto_init() }
@@ -1810,7 +2281,7 @@ func file_grpc_services_data_server_proto_init() {
 			}
 		}
 		file_grpc_services_data_server_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RunListRequest); i {
+			switch v := v.(*RunIDsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1822,7 +2293,7 @@ func file_grpc_services_data_server_proto_init() {
 			}
 		}
 		file_grpc_services_data_server_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepoUserSelection); i {
+			switch v := v.(*RunIDs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1834,7 +2305,7 @@ func file_grpc_services_data_server_proto_init() {
 			}
 		}
 		file_grpc_services_data_server_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepoRef); i {
+			switch v := v.(*TestCaseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1846,7 +2317,7 @@ func file_grpc_services_data_server_proto_init() {
 			}
 		}
 		file_grpc_services_data_server_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RefPair); i {
+			switch v := v.(*FlakyTestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1858,7 +2329,7 @@ func file_grpc_services_data_server_proto_init() {
 			}
 		}
 		file_grpc_services_data_server_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueueListRequest); i {
+			switch v := v.(*RunRepositories); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1870,7 +2341,7 @@ func file_grpc_services_data_server_proto_init() {
 			}
 		}
 		file_grpc_services_data_server_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueueList); i {
+			switch v := v.(*RunListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1882,7 +2353,7 @@ func file_grpc_services_data_server_proto_init() {
 			}
 		}
 		file_grpc_services_data_server_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Count); i {
+			switch v := v.(*RepoUserSelection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1894,7 +2365,7 @@ func file_grpc_services_data_server_proto_init() {
 			}
 		}
 		file_grpc_services_data_server_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Name); i {
+			switch v := v.(*RepoRef); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1906,7 +2377,7 @@ func file_grpc_services_data_server_proto_init() {
 			}
 		}
 		file_grpc_services_data_server_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Search); i {
+			switch v := v.(*RefPair); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1918,7 +2389,7 @@ func file_grpc_services_data_server_proto_init() {
 			}
 		}
 		file_grpc_services_data_server_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NameSearch); i {
+			switch v := v.(*QueueListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1930,7 +2401,7 @@ func file_grpc_services_data_server_proto_init() {
 			}
 		}
 		file_grpc_services_data_server_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OAuthState); i {
+			switch v := v.(*QueueList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1942,6 +2413,78 @@ func file_grpc_services_data_server_proto_init() {
 			}
 		}
 		file_grpc_services_data_server_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueExpiry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_services_data_server_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Count); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_services_data_server_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Name); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_services_data_server_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Search); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_services_data_server_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NameSearch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_services_data_server_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OAuthState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_services_data_server_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GithubJSON); i {
 			case 0:
 				return &v.state
@@ -1960,7 +2503,7 @@ func file_grpc_services_data_server_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_grpc_services_data_server_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -2006,12 +2549,28 @@ type DataClient interface {
 	QueueAdd(ctx context.Context, in *QueueList, opts ...grpc.CallOption) (*QueueList, error)
 	// QueueNext retrieves the next item in the queue.
 	QueueNext(ctx context.Context, in *types.QueueRequest, opts ...grpc.CallOption) (*types.QueueItem, error)
+	// QueueNextItems claims up to the requested count of the next items in the queue.
+	QueueNextItems(ctx context.Context, in *types.QueueRequest, opts ...grpc.CallOption) (*QueueList, error)
+	// QueueWatch notifies whenever items are added to the queue, put back into it or freed by runs and tasks finishing.
+	QueueWatch(ctx context.Context, in *types.QueueRequest, opts ...grpc.CallOption) (Data_QueueWatchClient, error)
+	// QueueListExpired lists the items of a queue which were claimable for longer than its TTL.
+	QueueListExpired(ctx context.Context, in *QueueExpiry, opts ...grpc.CallOption) (*QueueList, error)
 	// PutStatus sets the status of the run in the DB.
 	PutStatus(ctx context.Context, in *types.Status, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// TimeOutRun cancels a run which exceeded its timeout and fails it for it, retrying it if its policy allows.
+	TimeOutRun(ctx context.Context, in *types.Status, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// SetCancel cancels a run.
 	SetCancel(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// GetCancel retrieves the canceled state of the run.
 	GetCancel(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (*types.Status, error)
+	// WatchCancel sends the canceled state of the run once it or its task is canceled.
+	WatchCancel(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (Data_WatchCancelClient, error)
+	// RunnerHeartbeat registers the runner, or records that it is still alive.
+	RunnerHeartbeat(ctx context.Context, in *types.Runner, opts ...grpc.CallOption) (*types.Runner, error)
+	// ListRunners lists all registered runners.
+	ListRunners(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*types.RunnerList, error)
+	// SetRunnerState cordons, drains or uncordons a runner.
+	SetRunnerState(ctx context.Context, in *types.RunnerState, opts ...grpc.CallOption) (*types.Runner, error)
 	// Given a name and sha, look up the ref.
 	GetRefByNameAndSHA(ctx context.Context, in *RefPair, opts ...grpc.CallOption) (*types.Ref, error)
 	// PutRef saves a ref.
@@ -2020,6 +2579,10 @@ type DataClient interface {
 	CancelRefByName(ctx context.Context, in *RepoRef, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// CancelTask cancels the branch by task ID.
 	CancelTask(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// CancelConcurrentTasks cancels the older tasks of the concurrency groups the task asks to cancel in progress.
+	CancelConcurrentTasks(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// RerunTask copies a finished task and queues its runs again; the new runs are returned.
+	RerunTask(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (*types.RunList, error)
 	// Enables repository for testing in CI
 	EnableRepository(ctx context.Context, in *RepoUserSelection, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Disables repository for testing in CI
@@ -2044,6 +2607,23 @@ type DataClient interface {
 	GetRun(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (*types.Run, error)
 	// Get a specific Run with security details omitted; for UI work.
 	GetRunUI(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (*types.Run, error)
+	// Queue a new attempt of a finished run; the new run is returned.
+	RerunRun(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (*types.Run, error)
+	// List the IDs of the runs in a task or submission.
+	ListRunIDs(ctx context.Context, in *RunIDsRequest, opts ...grpc.CallOption) (*RunIDs, error)
+	// Map runs to the IDs of the repositories they were submitted against.
+	GetRunRepositories(ctx context.Context, in *RunIDs, opts ...grpc.CallOption) (*RunRepositories, error)
+	// List the unfinished runs which have been running longer than their timeout.
+	ListTimedOutRuns(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*types.RunList, error)
+	// Add the test cases read from a test report of a run.
+	AddTestCases(ctx context.Context, in *types.TestCaseList, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// List the test cases of a run, optionally only those with a status.
+	ListTestCases(ctx context.Context, in *TestCaseRequest, opts ...grpc.CallOption) (*types.TestCaseList, error)
+	// Count the test cases of a run by status.
+	GetTestSummary(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (*types.TestSummary, error)
+	// List the tests of a repository which both passed and failed on the same
+	// SHA within a window of days.
+	FlakyTests(ctx context.Context, in *FlakyTestRequest, opts ...grpc.CallOption) (*types.FlakyTestList, error)
 	// PutSession saves the session.
 	PutSession(ctx context.Context, in *types.Session, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// LoadSession loads the session.
@@ -2068,6 +2648,8 @@ type DataClient interface {
 	CountSubmissions(ctx context.Context, in *RepositoryFilterRequest, opts ...grpc.CallOption) (*Count, error)
 	// Cancel a submission by ID.
 	CancelSubmission(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Re-run all tasks of a finished submission; the new runs are returned.
+	RerunSubmission(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (*types.RunList, error)
 	// Add a task to the db.
 	PutTask(ctx context.Context, in *types.Task, opts ...grpc.CallOption) (*types.Task, error)
 	// List Tasks
@@ -2130,99 +2712,217 @@ func (c *dataClient) AddError(ctx context.Context, in *types.UserError, opts ...
 	return out, nil
 }
 
-func (c *dataClient) DeleteError(ctx context.Context, in *types.UserError, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *dataClient) DeleteError(ctx context.Context, in *types.UserError, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/data.Data/DeleteError", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) OAuthRegisterState(ctx context.Context, in *OAuthState, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/data.Data/OAuthRegisterState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) OAuthValidateState(ctx context.Context, in *OAuthState, opts ...grpc.CallOption) (*OAuthState, error) {
+	out := new(OAuthState)
+	err := c.cc.Invoke(ctx, "/data.Data/OAuthValidateState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) QueueCount(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/data.Data/QueueCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) QueueCountForRepository(ctx context.Context, in *Name, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/data.Data/QueueCountForRepository", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) QueueListForRepository(ctx context.Context, in *QueueListRequest, opts ...grpc.CallOption) (*QueueList, error) {
+	out := new(QueueList)
+	err := c.cc.Invoke(ctx, "/data.Data/QueueListForRepository", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) QueueAdd(ctx context.Context, in *QueueList, opts ...grpc.CallOption) (*QueueList, error) {
+	out := new(QueueList)
+	err := c.cc.Invoke(ctx, "/data.Data/QueueAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) QueueNext(ctx context.Context, in *types.QueueRequest, opts ...grpc.CallOption) (*types.QueueItem, error) {
+	out := new(types.QueueItem)
+	err := c.cc.Invoke(ctx, "/data.Data/QueueNext", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) QueueNextItems(ctx context.Context, in *types.QueueRequest, opts ...grpc.CallOption) (*QueueList, error) {
+	out := new(QueueList)
+	err := c.cc.Invoke(ctx, "/data.Data/QueueNextItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) QueueWatch(ctx context.Context, in *types.QueueRequest, opts ...grpc.CallOption) (Data_QueueWatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Data_serviceDesc.Streams[0], "/data.Data/QueueWatch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dataQueueWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Data_QueueWatchClient interface {
+	Recv() (*types.QueueNotification, error)
+	grpc.ClientStream
+}
+
+type dataQueueWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *dataQueueWatchClient) Recv() (*types.QueueNotification, error) {
+	m := new(types.QueueNotification)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dataClient) QueueListExpired(ctx context.Context, in *QueueExpiry, opts ...grpc.CallOption) (*QueueList, error) {
+	out := new(QueueList)
+	err := c.cc.Invoke(ctx, "/data.Data/QueueListExpired", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) PutStatus(ctx context.Context, in *types.Status, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/data.Data/DeleteError", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/data.Data/PutStatus", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) OAuthRegisterState(ctx context.Context, in *OAuthState, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *dataClient) TimeOutRun(ctx context.Context, in *types.Status, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/data.Data/OAuthRegisterState", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/data.Data/TimeOutRun", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) OAuthValidateState(ctx context.Context, in *OAuthState, opts ...grpc.CallOption) (*OAuthState, error) {
-	out := new(OAuthState)
-	err := c.cc.Invoke(ctx, "/data.Data/OAuthValidateState", in, out, opts...)
+func (c *dataClient) SetCancel(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/data.Data/SetCancel", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) QueueCount(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Count, error) {
-	out := new(Count)
-	err := c.cc.Invoke(ctx, "/data.Data/QueueCount", in, out, opts...)
+func (c *dataClient) GetCancel(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (*types.Status, error) {
+	out := new(types.Status)
+	err := c.cc.Invoke(ctx, "/data.Data/GetCancel", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) QueueCountForRepository(ctx context.Context, in *Name, opts ...grpc.CallOption) (*Count, error) {
-	out := new(Count)
-	err := c.cc.Invoke(ctx, "/data.Data/QueueCountForRepository", in, out, opts...)
+func (c *dataClient) WatchCancel(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (Data_WatchCancelClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Data_serviceDesc.Streams[1], "/data.Data/WatchCancel", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *dataClient) QueueListForRepository(ctx context.Context, in *QueueListRequest, opts ...grpc.CallOption) (*QueueList, error) {
-	out := new(QueueList)
-	err := c.cc.Invoke(ctx, "/data.Data/QueueListForRepository", in, out, opts...)
-	if err != nil {
+	x := &dataWatchCancelClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *dataClient) QueueAdd(ctx context.Context, in *QueueList, opts ...grpc.CallOption) (*QueueList, error) {
-	out := new(QueueList)
-	err := c.cc.Invoke(ctx, "/data.Data/QueueAdd", in, out, opts...)
-	if err != nil {
+	if err := x.ClientStream.CloseSend(); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return x, nil
 }
 
-func (c *dataClient) QueueNext(ctx context.Context, in *types.QueueRequest, opts ...grpc.CallOption) (*types.QueueItem, error) {
-	out := new(types.QueueItem)
-	err := c.cc.Invoke(ctx, "/data.Data/QueueNext", in, out, opts...)
-	if err != nil {
+type Data_WatchCancelClient interface {
+	Recv() (*types.Status, error)
+	grpc.ClientStream
+}
+
+type dataWatchCancelClient struct {
+	grpc.ClientStream
+}
+
+func (x *dataWatchCancelClient) Recv() (*types.Status, error) {
+	m := new(types.Status)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *dataClient) PutStatus(ctx context.Context, in *types.Status, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/data.Data/PutStatus", in, out, opts...)
+func (c *dataClient) RunnerHeartbeat(ctx context.Context, in *types.Runner, opts ...grpc.CallOption) (*types.Runner, error) {
+	out := new(types.Runner)
+	err := c.cc.Invoke(ctx, "/data.Data/RunnerHeartbeat", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) SetCancel(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/data.Data/SetCancel", in, out, opts...)
+func (c *dataClient) ListRunners(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*types.RunnerList, error) {
+	out := new(types.RunnerList)
+	err := c.cc.Invoke(ctx, "/data.Data/ListRunners", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) GetCancel(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (*types.Status, error) {
-	out := new(types.Status)
-	err := c.cc.Invoke(ctx, "/data.Data/GetCancel", in, out, opts...)
+func (c *dataClient) SetRunnerState(ctx context.Context, in *types.RunnerState, opts ...grpc.CallOption) (*types.Runner, error) {
+	out := new(types.Runner)
+	err := c.cc.Invoke(ctx, "/data.Data/SetRunnerState", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2265,6 +2965,24 @@ func (c *dataClient) CancelTask(ctx context.Context, in *types.IntID, opts ...gr
 	return out, nil
 }
 
+func (c *dataClient) CancelConcurrentTasks(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/data.Data/CancelConcurrentTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) RerunTask(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (*types.RunList, error) {
+	out := new(types.RunList)
+	err := c.cc.Invoke(ctx, "/data.Data/RerunTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataClient) EnableRepository(ctx context.Context, in *RepoUserSelection, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/data.Data/EnableRepository", in, out, opts...)
@@ -2373,6 +3091,78 @@ func (c *dataClient) GetRunUI(ctx context.Context, in *types.IntID, opts ...grpc
 	return out, nil
 }
 
+func (c *dataClient) RerunRun(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (*types.Run, error) {
+	out := new(types.Run)
+	err := c.cc.Invoke(ctx, "/data.Data/RerunRun", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ListRunIDs(ctx context.Context, in *RunIDsRequest, opts ...grpc.CallOption) (*RunIDs, error) {
+	out := new(RunIDs)
+	err := c.cc.Invoke(ctx, "/data.Data/ListRunIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) GetRunRepositories(ctx context.Context, in *RunIDs, opts ...grpc.CallOption) (*RunRepositories, error) {
+	out := new(RunRepositories)
+	err := c.cc.Invoke(ctx, "/data.Data/GetRunRepositories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ListTimedOutRuns(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*types.RunList, error) {
+	out := new(types.RunList)
+	err := c.cc.Invoke(ctx, "/data.Data/ListTimedOutRuns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) AddTestCases(ctx context.Context, in *types.TestCaseList, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/data.Data/AddTestCases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ListTestCases(ctx context.Context, in *TestCaseRequest, opts ...grpc.CallOption) (*types.TestCaseList, error) {
+	out := new(types.TestCaseList)
+	err := c.cc.Invoke(ctx, "/data.Data/ListTestCases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) GetTestSummary(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (*types.TestSummary, error) {
+	out := new(types.TestSummary)
+	err := c.cc.Invoke(ctx, "/data.Data/GetTestSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) FlakyTests(ctx context.Context, in *FlakyTestRequest, opts ...grpc.CallOption) (*types.FlakyTestList, error) {
+	out := new(types.FlakyTestList)
+	err := c.cc.Invoke(ctx, "/data.Data/FlakyTests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataClient) PutSession(ctx context.Context, in *types.Session, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/data.Data/PutSession", in, out, opts...)
@@ -2481,6 +3271,15 @@ func (c *dataClient) CancelSubmission(ctx context.Context, in *types.IntID, opts
 	return out, nil
 }
 
+func (c *dataClient) RerunSubmission(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (*types.RunList, error) {
+	out := new(types.RunList)
+	err := c.cc.Invoke(ctx, "/data.Data/RerunSubmission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataClient) PutTask(ctx context.Context, in *types.Task, opts ...grpc.CallOption) (*types.Task, error) {
 	out := new(types.Task)
 	err := c.cc.Invoke(ctx, "/data.Data/PutTask", in, out, opts...)
@@ -2656,12 +3455,28 @@ type DataServer interface {
 	QueueAdd(context.Context, *QueueList) (*QueueList, error)
 	// QueueNext retrieves the next item in the queue.
 	QueueNext(context.Context, *types.QueueRequest) (*types.QueueItem, error)
+	// QueueNextItems claims up to the requested count of the next items in the queue.
+	QueueNextItems(context.Context, *types.QueueRequest) (*QueueList, error)
+	// QueueWatch notifies whenever items are added to the queue, put back into it or freed by runs and tasks finishing.
+	QueueWatch(*types.QueueRequest, Data_QueueWatchServer) error
+	// QueueListExpired lists the items of a queue which were claimable for longer than its TTL.
+	QueueListExpired(context.Context, *QueueExpiry) (*QueueList, error)
 	// PutStatus sets the status of the run in the DB.
 	PutStatus(context.Context, *types.Status) (*emptypb.Empty, error)
+	// TimeOutRun cancels a run which exceeded its timeout and fails it for it, retrying it if its policy allows.
+	TimeOutRun(context.Context, *types.Status) (*emptypb.Empty, error)
 	// SetCancel cancels a run.
 	SetCancel(context.Context, *types.IntID) (*emptypb.Empty, error)
 	// GetCancel retrieves the canceled state of the run.
 	GetCancel(context.Context, *types.IntID) (*types.Status, error)
+	// WatchCancel sends the canceled state of the run once it or its task is canceled.
+	WatchCancel(*types.IntID, Data_WatchCancelServer) error
+	// RunnerHeartbeat registers the runner, or records that it is still alive.
+	RunnerHeartbeat(context.Context, *types.Runner) (*types.Runner, error)
+	// ListRunners lists all registered runners.
+	ListRunners(context.Context, *emptypb.Empty) (*types.RunnerList, error)
+	// SetRunnerState cordons, drains or uncordons a runner.
+	SetRunnerState(context.Context, *types.RunnerState) (*types.Runner, error)
 	// Given a name and sha, look up the ref.
 	GetRefByNameAndSHA(context.Context, *RefPair) (*types.Ref, error)
 	// PutRef saves a ref.
@@ -2670,6 +3485,10 @@ type DataServer interface {
 	CancelRefByName(context.Context, *RepoRef) (*emptypb.Empty, error)
 	// CancelTask cancels the branch by task ID.
 	CancelTask(context.Context, *types.IntID) (*emptypb.Empty, error)
+	// CancelConcurrentTasks cancels the older tasks of the concurrency groups the task asks to cancel in progress.
+	CancelConcurrentTasks(context.Context, *types.IntID) (*emptypb.Empty, error)
+	// RerunTask copies a finished task and queues its runs again; the new runs are returned.
+	RerunTask(context.Context, *types.IntID) (*types.RunList, error)
 	// Enables repository for testing in CI
 	EnableRepository(context.Context, *RepoUserSelection) (*emptypb.Empty, error)
 	// Disables repository for testing in CI
@@ -2694,6 +3513,23 @@ type DataServer interface {
 	GetRun(context.Context, *types.IntID) (*types.Run, error)
 	// Get a specific Run with security details omitted; for UI work.
 	GetRunUI(context.Context, *types.IntID) (*types.Run, error)
+	// Queue a new attempt of a finished run; the new run is returned.
+	RerunRun(context.Context, *types.IntID) (*types.Run, error)
+	// List the IDs of the runs in a task or submission.
+	ListRunIDs(context.Context, *RunIDsRequest) (*RunIDs, error)
+	// Map runs to the IDs of the repositories they were submitted against.
+	GetRunRepositories(context.Context, *RunIDs) (*RunRepositories, error)
+	// List the unfinished runs which have been running longer than their timeout.
+	ListTimedOutRuns(context.Context, *emptypb.Empty) (*types.RunList, error)
+	// Add the test cases read from a test report of a run.
+	AddTestCases(context.Context, *types.TestCaseList) (*emptypb.Empty, error)
+	// List the test cases of a run, optionally only those with a status.
+	ListTestCases(context.Context, *TestCaseRequest) (*types.TestCaseList, error)
+	// Count the test cases of a run by status.
+	GetTestSummary(context.Context, *types.IntID) (*types.TestSummary, error)
+	// List the tests of a repository which both passed and failed on the same
+	// SHA within a window of days.
+	FlakyTests(context.Context, *FlakyTestRequest) (*types.FlakyTestList, error)
 	// PutSession saves the session.
 	PutSession(context.Context, *types.Session) (*emptypb.Empty, error)
 	// LoadSession loads the session.
@@ -2718,6 +3554,8 @@ type DataServer interface {
 	CountSubmissions(context.Context, *RepositoryFilterRequest) (*Count, error)
 	// Cancel a submission by ID.
 	CancelSubmission(context.Context, *types.IntID) (*emptypb.Empty, error)
+	// Re-run all tasks of a finished submission; the new runs are returned.
+	RerunSubmission(context.Context, *types.IntID) (*types.RunList, error)
 	// Add a task to the db.
 	PutTask(context.Context, *types.Task) (*types.Task, error)
 	// List Tasks
@@ -2788,15 +3626,39 @@ func (*UnimplementedDataServer) QueueAdd(context.Context, *QueueList) (*QueueLis
 func (*UnimplementedDataServer) QueueNext(context.Context, *types.QueueRequest) (*types.QueueItem, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueueNext not implemented")
 }
+func (*UnimplementedDataServer) QueueNextItems(context.Context, *types.QueueRequest) (*QueueList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueueNextItems not implemented")
+}
+func (*UnimplementedDataServer) QueueWatch(*types.QueueRequest, Data_QueueWatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method QueueWatch not implemented")
+}
+func (*UnimplementedDataServer) QueueListExpired(context.Context, *QueueExpiry) (*QueueList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueueListExpired not implemented")
+}
 func (*UnimplementedDataServer) PutStatus(context.Context, *types.Status) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PutStatus not implemented")
 }
+func (*UnimplementedDataServer) TimeOutRun(context.Context, *types.Status) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimeOutRun not implemented")
+}
 func (*UnimplementedDataServer) SetCancel(context.Context, *types.IntID) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetCancel not implemented")
 }
 func (*UnimplementedDataServer) GetCancel(context.Context, *types.IntID) (*types.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCancel not implemented")
 }
+func (*UnimplementedDataServer) WatchCancel(*types.IntID, Data_WatchCancelServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCancel not implemented")
+}
+func (*UnimplementedDataServer) RunnerHeartbeat(context.Context, *types.Runner) (*types.Runner, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunnerHeartbeat not implemented")
+}
+func (*UnimplementedDataServer) ListRunners(context.Context, *emptypb.Empty) (*types.RunnerList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRunners not implemented")
+}
+func (*UnimplementedDataServer) SetRunnerState(context.Context, *types.RunnerState) (*types.Runner, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRunnerState not implemented")
+}
 func (*UnimplementedDataServer) GetRefByNameAndSHA(context.Context, *RefPair) (*types.Ref, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRefByNameAndSHA not implemented")
 }
@@ -2809,6 +3671,12 @@ func (*UnimplementedDataServer) CancelRefByName(context.Context, *RepoRef) (*emp
 func (*UnimplementedDataServer) CancelTask(context.Context, *types.IntID) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelTask not implemented")
 }
+func (*UnimplementedDataServer) CancelConcurrentTasks(context.Context, *types.IntID) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelConcurrentTasks not implemented")
+}
+func (*UnimplementedDataServer) RerunTask(context.Context, *types.IntID) (*types.RunList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RerunTask not implemented")
+}
 func (*UnimplementedDataServer) EnableRepository(context.Context, *RepoUserSelection) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EnableRepository not implemented")
 }
@@ -2845,6 +3713,30 @@ func (*UnimplementedDataServer) GetRun(context.Context, *types.IntID) (*types.Ru
 func (*UnimplementedDataServer) GetRunUI(context.Context, *types.IntID) (*types.Run, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRunUI not implemented")
 }
+func (*UnimplementedDataServer) RerunRun(context.Context, *types.IntID) (*types.Run, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RerunRun not implemented")
+}
+func (*UnimplementedDataServer) ListRunIDs(context.Context, *RunIDsRequest) (*RunIDs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRunIDs not implemented")
+}
+func (*UnimplementedDataServer) GetRunRepositories(context.Context, *RunIDs) (*RunRepositories, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRunRepositories not implemented")
+}
+func (*UnimplementedDataServer) ListTimedOutRuns(context.Context, *emptypb.Empty) (*types.RunList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTimedOutRuns not implemented")
+}
+func (*UnimplementedDataServer) AddTestCases(context.Context, *types.TestCaseList) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTestCases not implemented")
+}
+func (*UnimplementedDataServer) ListTestCases(context.Context, *TestCaseRequest) (*types.TestCaseList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTestCases not implemented")
+}
+func (*UnimplementedDataServer) GetTestSummary(context.Context, *types.IntID) (*types.TestSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTestSummary not implemented")
+}
+func (*UnimplementedDataServer) FlakyTests(context.Context, *FlakyTestRequest) (*types.FlakyTestList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlakyTests not implemented")
+}
 func (*UnimplementedDataServer) PutSession(context.Context, *types.Session) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PutSession not implemented")
 }
@@ -2881,6 +3773,9 @@ func (*UnimplementedDataServer) CountSubmissions(context.Context, *RepositoryFil
 func (*UnimplementedDataServer) CancelSubmission(context.Context, *types.IntID) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelSubmission not implemented")
 }
+func (*UnimplementedDataServer) RerunSubmission(context.Context, *types.IntID) (*types.RunList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RerunSubmission not implemented")
+}
 func (*UnimplementedDataServer) PutTask(context.Context, *types.Task) (*types.Task, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PutTask not implemented")
 }
@@ -3027,146 +3922,296 @@ func _Data_OAuthValidateState_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_QueueCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _Data_QueueCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).QueueCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/data.Data/QueueCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).QueueCount(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_QueueCountForRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Name)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).QueueCountForRepository(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/data.Data/QueueCountForRepository",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).QueueCountForRepository(ctx, req.(*Name))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_QueueListForRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).QueueListForRepository(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/data.Data/QueueListForRepository",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).QueueListForRepository(ctx, req.(*QueueListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_QueueAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).QueueAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/data.Data/QueueAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).QueueAdd(ctx, req.(*QueueList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_QueueNext_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.QueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).QueueNext(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/data.Data/QueueNext",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).QueueNext(ctx, req.(*types.QueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_QueueNextItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.QueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).QueueNextItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/data.Data/QueueNextItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).QueueNextItems(ctx, req.(*types.QueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_QueueWatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(types.QueueRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DataServer).QueueWatch(m, &dataQueueWatchServer{stream})
+}
+
+type Data_QueueWatchServer interface {
+	Send(*types.QueueNotification) error
+	grpc.ServerStream
+}
+
+type dataQueueWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *dataQueueWatchServer) Send(m *types.QueueNotification) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Data_QueueListExpired_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueExpiry)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).QueueCount(ctx, in)
+		return srv.(DataServer).QueueListExpired(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/data.Data/QueueCount",
+		FullMethod: "/data.Data/QueueListExpired",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).QueueCount(ctx, req.(*emptypb.Empty))
+		return srv.(DataServer).QueueListExpired(ctx, req.(*QueueExpiry))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_QueueCountForRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Name)
+func _Data_PutStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Status)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).QueueCountForRepository(ctx, in)
+		return srv.(DataServer).PutStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/data.Data/QueueCountForRepository",
+		FullMethod: "/data.Data/PutStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).QueueCountForRepository(ctx, req.(*Name))
+		return srv.(DataServer).PutStatus(ctx, req.(*types.Status))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_QueueListForRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueueListRequest)
+func _Data_TimeOutRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Status)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).QueueListForRepository(ctx, in)
+		return srv.(DataServer).TimeOutRun(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/data.Data/QueueListForRepository",
+		FullMethod: "/data.Data/TimeOutRun",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).QueueListForRepository(ctx, req.(*QueueListRequest))
+		return srv.(DataServer).TimeOutRun(ctx, req.(*types.Status))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_QueueAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueueList)
+func _Data_SetCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.IntID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).QueueAdd(ctx, in)
+		return srv.(DataServer).SetCancel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/data.Data/QueueAdd",
+		FullMethod: "/data.Data/SetCancel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).QueueAdd(ctx, req.(*QueueList))
+		return srv.(DataServer).SetCancel(ctx, req.(*types.IntID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_QueueNext_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.QueueRequest)
+func _Data_GetCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.IntID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).QueueNext(ctx, in)
+		return srv.(DataServer).GetCancel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/data.Data/QueueNext",
+		FullMethod: "/data.Data/GetCancel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).QueueNext(ctx, req.(*types.QueueRequest))
+		return srv.(DataServer).GetCancel(ctx, req.(*types.IntID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_PutStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Status)
+func _Data_WatchCancel_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(types.IntID)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DataServer).WatchCancel(m, &dataWatchCancelServer{stream})
+}
+
+type Data_WatchCancelServer interface {
+	Send(*types.Status) error
+	grpc.ServerStream
+}
+
+type dataWatchCancelServer struct {
+	grpc.ServerStream
+}
+
+func (x *dataWatchCancelServer) Send(m *types.Status) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Data_RunnerHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Runner)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).PutStatus(ctx, in)
+		return srv.(DataServer).RunnerHeartbeat(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/data.Data/PutStatus",
+		FullMethod: "/data.Data/RunnerHeartbeat",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).PutStatus(ctx, req.(*types.Status))
+		return srv.(DataServer).RunnerHeartbeat(ctx, req.(*types.Runner))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_SetCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.IntID)
+func _Data_ListRunners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).SetCancel(ctx, in)
+		return srv.(DataServer).ListRunners(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/data.Data/SetCancel",
+		FullMethod: "/data.Data/ListRunners",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).SetCancel(ctx, req.(*types.IntID))
+		return srv.(DataServer).ListRunners(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.IntID)
+func _Data_SetRunnerState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.RunnerState)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetCancel(ctx, in)
+		return srv.(DataServer).SetRunnerState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/data.Data/GetCancel",
+		FullMethod: "/data.Data/SetRunnerState",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetCancel(ctx, req.(*types.IntID))
+		return srv.(DataServer).SetRunnerState(ctx, req.(*types.RunnerState))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -3243,6 +4288,42 @@ func _Data_CancelTask_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Data_CancelConcurrentTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.IntID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CancelConcurrentTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/data.Data/CancelConcurrentTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CancelConcurrentTasks(ctx, req.(*types.IntID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_RerunTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.IntID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).RerunTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/data.Data/RerunTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).RerunTask(ctx, req.(*types.IntID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Data_EnableRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RepoUserSelection)
 	if err := dec(in); err != nil {
@@ -3459,6 +4540,150 @@ func _Data_GetRunUI_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Data_RerunRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.IntID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).RerunRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/data.Data/RerunRun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).RerunRun(ctx, req.(*types.IntID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_ListRunIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListRunIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/data.Data/ListRunIDs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListRunIDs(ctx, req.(*RunIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_GetRunRepositories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunIDs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetRunRepositories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/data.Data/GetRunRepositories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetRunRepositories(ctx, req.(*RunIDs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_ListTimedOutRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListTimedOutRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/data.Data/ListTimedOutRuns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListTimedOutRuns(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_AddTestCases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.TestCaseList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).AddTestCases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/data.Data/AddTestCases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).AddTestCases(ctx, req.(*types.TestCaseList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_ListTestCases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestCaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListTestCases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/data.Data/ListTestCases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListTestCases(ctx, req.(*TestCaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_GetTestSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.IntID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetTestSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/data.Data/GetTestSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetTestSummary(ctx, req.(*types.IntID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_FlakyTests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlakyTestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).FlakyTests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/data.Data/FlakyTests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).FlakyTests(ctx, req.(*FlakyTestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Data_PutSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(types.Session)
 	if err := dec(in); err != nil {
@@ -3675,6 +4900,24 @@ func _Data_CancelSubmission_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Data_RerunSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.IntID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).RerunSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/data.Data/RerunSubmission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).RerunSubmission(ctx, req.(*types.IntID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Data_PutTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(types.Task)
 	if err := dec(in); err != nil {
@@ -4025,10 +5268,22 @@ var _Data_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueueNext",
 			Handler:    _Data_QueueNext_Handler,
 		},
+		{
+			MethodName: "QueueNextItems",
+			Handler:    _Data_QueueNextItems_Handler,
+		},
+		{
+			MethodName: "QueueListExpired",
+			Handler:    _Data_QueueListExpired_Handler,
+		},
 		{
 			MethodName: "PutStatus",
 			Handler:    _Data_PutStatus_Handler,
 		},
+		{
+			MethodName: "TimeOutRun",
+			Handler:    _Data_TimeOutRun_Handler,
+		},
 		{
 			MethodName: "SetCancel",
 			Handler:    _Data_SetCancel_Handler,
@@ -4037,6 +5292,18 @@ var _Data_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetCancel",
 			Handler:    _Data_GetCancel_Handler,
 		},
+		{
+			MethodName: "RunnerHeartbeat",
+			Handler:    _Data_RunnerHeartbeat_Handler,
+		},
+		{
+			MethodName: "ListRunners",
+			Handler:    _Data_ListRunners_Handler,
+		},
+		{
+			MethodName: "SetRunnerState",
+			Handler:    _Data_SetRunnerState_Handler,
+		},
 		{
 			MethodName: "GetRefByNameAndSHA",
 			Handler:    _Data_GetRefByNameAndSHA_Handler,
@@ -4053,6 +5320,14 @@ var _Data_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelTask",
 			Handler:    _Data_CancelTask_Handler,
 		},
+		{
+			MethodName: "CancelConcurrentTasks",
+			Handler:    _Data_CancelConcurrentTasks_Handler,
+		},
+		{
+			MethodName: "RerunTask",
+			Handler:    _Data_RerunTask_Handler,
+		},
 		{
 			MethodName: "EnableRepository",
 			Handler:    _Data_EnableRepository_Handler,
@@ -4101,6 +5376,38 @@ var _Data_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetRunUI",
 			Handler:    _Data_GetRunUI_Handler,
 		},
+		{
+			MethodName: "RerunRun",
+			Handler:    _Data_RerunRun_Handler,
+		},
+		{
+			MethodName: "ListRunIDs",
+			Handler:    _Data_ListRunIDs_Handler,
+		},
+		{
+			MethodName: "GetRunRepositories",
+			Handler:    _Data_GetRunRepositories_Handler,
+		},
+		{
+			MethodName: "ListTimedOutRuns",
+			Handler:    _Data_ListTimedOutRuns_Handler,
+		},
+		{
+			MethodName: "AddTestCases",
+			Handler:    _Data_AddTestCases_Handler,
+		},
+		{
+			MethodName: "ListTestCases",
+			Handler:    _Data_ListTestCases_Handler,
+		},
+		{
+			MethodName: "GetTestSummary",
+			Handler:    _Data_GetTestSummary_Handler,
+		},
+		{
+			MethodName: "FlakyTests",
+			Handler:    _Data_FlakyTests_Handler,
+		},
 		{
 			MethodName: "PutSession",
 			Handler:    _Data_PutSession_Handler,
@@ -4149,6 +5456,10 @@ var _Data_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelSubmission",
 			Handler:    _Data_CancelSubmission_Handler,
 		},
+		{
+			MethodName: "RerunSubmission",
+			Handler:    _Data_RerunSubmission_Handler,
+		},
 		{
 			MethodName: "PutTask",
 			Handler:    _Data_PutTask_Handler,
@@ -4218,6 +5529,17 @@ var _Data_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Data_RemoveCapability_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "QueueWatch",
+			Handler:       _Data_QueueWatch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchCancel",
+			Handler:       _Data_WatchCancel_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "grpc/services/data/server.proto",
 }
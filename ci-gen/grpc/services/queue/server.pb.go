@@ -42,6 +42,8 @@ type Submission struct {
 	TicketID    int64  `protobuf:"varint,6,opt,name=ticketID,proto3" json:"ticketID,omitempty"`                         // PullRequest ID if available -- not set during manual submissions
 	All         bool   `protobuf:"varint,7,opt,name=all,proto3" json:"all,omitempty"`                                   // Test all instead of using diff selection; this is a flag in the UI and can also be triggered by tinycli. It is not used in github hooks except for pushes to master.
 	Manual      bool   `protobuf:"varint,8,opt,name=manual,proto3" json:"manual,omitempty"`                             // Flag set if this was a manual submission. Typically managed by the uisvc.
+	Event       string `protobuf:"bytes,9,opt,name=event,proto3" json:"event,omitempty"`                                // Event which triggered the submission: "push" or "pull_request". Empty for manual submissions.
+	Priority    *int32 `protobuf:"varint,10,opt,name=priority,proto3,oneof" json:"priority,omitempty"`                  // Queue priority for the submission's runs; if unset, the repository's priority from tinyci.yml is used.
 }
 
 func (x *Submission) Reset() {
@@ -132,6 +134,20 @@ func (x *Submission) GetManual() bool {
 	return false
 }
 
+func (x *Submission) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *Submission) GetPriority() int32 {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return 0
+}
+
 var File_grpc_services_queue_server_proto protoreflect.FileDescriptor
 
 var file_grpc_services_queue_server_proto_rawDesc = []byte{
@@ -146,42 +162,86 @@ var file_grpc_services_queue_server_proto_rawDesc = []byte{
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
 	0x6f, 0x6d, 0x2f, 0x74, 0x69, 0x6e, 0x79, 0x63, 0x69, 0x2f, 0x63, 0x69, 0x2d, 0x61, 0x67, 0x65,
 	0x6e, 0x74, 0x73, 0x2f, 0x63, 0x69, 0x2d, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x69, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd5,
-	0x01, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
-	0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
-	0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x6f, 0x72, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61,
-	0x64, 0x73, 0x68, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64,
-	0x73, 0x68, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x73, 0x65, 0x73, 0x68, 0x61, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x73, 0x68, 0x61, 0x12, 0x21, 0x0a,
-	0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x42, 0x79,
-	0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03,
-	0x61, 0x6c, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x12, 0x16,
-	0x0a, 0x06, 0x6d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x6d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x32, 0x8f, 0x02, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x75, 0x65,
-	0x12, 0x34, 0x0a, 0x09, 0x50, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0d, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x51, 0x75,
-	0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x22, 0x00,
-	0x12, 0x35, 0x0a, 0x06, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x12, 0x11, 0x2e, 0x71, 0x75, 0x65,
-	0x75, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x43, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x12, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74,
-	0x49, 0x44, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x09,
-	0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x49, 0x6e, 0x74, 0x49, 0x44, 0x1a, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x69, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x3a,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x69, 0x6e, 0x79, 0x63,
+	0x69, 0x2f, 0x63, 0x69, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x69, 0x2d, 0x67,
+	0x65, 0x6e, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x72, 0x75,
+	0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x37, 0x67, 0x69, 0x74, 0x68,
 	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x69, 0x6e, 0x79, 0x63, 0x69, 0x2f, 0x63, 0x69,
 	0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x69, 0x2d, 0x67, 0x65, 0x6e, 0x2f, 0x67,
-	0x72, 0x70, 0x63, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65,
-	0x75, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x70, 0x63, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x72, 0x75, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x99, 0x02, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x6f,
+	0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x6f, 0x72, 0x6b, 0x12, 0x18,
+	0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x73, 0x68, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x68, 0x65, 0x61, 0x64, 0x73, 0x68, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x73, 0x65,
+	0x73, 0x68, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x73,
+	0x68, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f,
+	0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49,
+	0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49,
+	0x44, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03,
+	0x61, 0x6c, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x6d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x88,
+	0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x32,
+	0x99, 0x06, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x50, 0x75, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x38, 0x0a, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x12, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0e, 0x4e, 0x65, 0x78,
+	0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x13, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74,
+	0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0a, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x30, 0x01, 0x12, 0x35, 0x0a, 0x06, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x12, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x33, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x0c, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x49, 0x44, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x12, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x49, 0x44,
+	0x1a, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x00, 0x12, 0x2e, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x12, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x49, 0x44, 0x1a, 0x0d,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x26, 0x0a, 0x08, 0x52, 0x65, 0x72, 0x75, 0x6e, 0x52, 0x75, 0x6e, 0x12, 0x0c, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x49, 0x44, 0x1a, 0x0a, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x09, 0x52, 0x65, 0x72,
+	0x75, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49,
+	0x6e, 0x74, 0x49, 0x44, 0x1a, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0f, 0x52, 0x65, 0x72, 0x75, 0x6e, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x49, 0x6e, 0x74, 0x49, 0x44, 0x1a, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x52, 0x75, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0e, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x0d, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x1a, 0x0d, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x09, 0x48,
+	0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x1a, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x4c, 0x69,
+	0x73, 0x74, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x6e, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52,
+	0x75, 0x6e, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x1a, 0x0d, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x22, 0x00, 0x42, 0x38, 0x5a, 0x36, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x69, 0x6e, 0x79, 0x63, 0x69,
+	0x2f, 0x63, 0x69, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x69, 0x2d, 0x67, 0x65,
+	0x6e, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -198,29 +258,56 @@ func file_grpc_services_queue_server_proto_rawDescGZIP() []byte {
 
 var file_grpc_services_queue_server_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_grpc_services_queue_server_proto_goTypes = []interface{}{
-	(*Submission)(nil),         // 0: queue.Submission
-	(*types.Status)(nil),       // 1: types.Status
-	(*types.QueueRequest)(nil), // 2: types.QueueRequest
-	(*types.IntID)(nil),        // 3: types.IntID
-	(*emptypb.Empty)(nil),      // 4: google.protobuf.Empty
-	(*types.QueueItem)(nil),    // 5: types.QueueItem
+	(*Submission)(nil),              // 0: queue.Submission
+	(*types.Status)(nil),            // 1: types.Status
+	(*types.QueueRequest)(nil),      // 2: types.QueueRequest
+	(*types.IntID)(nil),             // 3: types.IntID
+	(*types.Runner)(nil),            // 4: types.Runner
+	(*emptypb.Empty)(nil),           // 5: google.protobuf.Empty
+	(*types.RunnerState)(nil),       // 6: types.RunnerState
+	(*types.QueueItem)(nil),         // 7: types.QueueItem
+	(*types.QueueItemList)(nil),     // 8: types.QueueItemList
+	(*types.QueueNotification)(nil), // 9: types.QueueNotification
+	(*types.Run)(nil),               // 10: types.Run
+	(*types.RunList)(nil),           // 11: types.RunList
+	(*types.RunnerList)(nil),        // 12: types.RunnerList
 }
 var file_grpc_services_queue_server_proto_depIdxs = []int32{
-	1, // 0: queue.Queue.PutStatus:input_type -> types.Status
-	2, // 1: queue.Queue.NextQueueItem:input_type -> types.QueueRequest
-	0, // 2: queue.Queue.Submit:input_type -> queue.Submission
-	3, // 3: queue.Queue.SetCancel:input_type -> types.IntID
-	3, // 4: queue.Queue.GetCancel:input_type -> types.IntID
-	4, // 5: queue.Queue.PutStatus:output_type -> google.protobuf.Empty
-	5, // 6: queue.Queue.NextQueueItem:output_type -> types.QueueItem
-	4, // 7: queue.Queue.Submit:output_type -> google.protobuf.Empty
-	4, // 8: queue.Queue.SetCancel:output_type -> google.protobuf.Empty
-	1, // 9: queue.Queue.GetCancel:output_type -> types.Status
-	5, // [5:10] is the sub-list for method output_type
-	0, // [0:5] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1,  // 0: queue.Queue.PutStatus:input_type -> types.Status
+	2,  // 1: queue.Queue.NextQueueItem:input_type -> types.QueueRequest
+	2,  // 2: queue.Queue.NextQueueItems:input_type -> types.QueueRequest
+	2,  // 3: queue.Queue.WatchQueue:input_type -> types.QueueRequest
+	0,  // 4: queue.Queue.Submit:input_type -> queue.Submission
+	3,  // 5: queue.Queue.SetCancel:input_type -> types.IntID
+	3,  // 6: queue.Queue.GetCancel:input_type -> types.IntID
+	3,  // 7: queue.Queue.WatchCancel:input_type -> types.IntID
+	3,  // 8: queue.Queue.RerunRun:input_type -> types.IntID
+	3,  // 9: queue.Queue.RerunTask:input_type -> types.IntID
+	3,  // 10: queue.Queue.RerunSubmission:input_type -> types.IntID
+	4,  // 11: queue.Queue.RegisterRunner:input_type -> types.Runner
+	4,  // 12: queue.Queue.Heartbeat:input_type -> types.Runner
+	5,  // 13: queue.Queue.ListRunners:input_type -> google.protobuf.Empty
+	6,  // 14: queue.Queue.SetRunnerState:input_type -> types.RunnerState
+	5,  // 15: queue.Queue.PutStatus:output_type -> google.protobuf.Empty
+	7,  // 16: queue.Queue.NextQueueItem:output_type -> types.QueueItem
+	8,  // 17: queue.Queue.NextQueueItems:output_type -> types.QueueItemList
+	9,  // 18: queue.Queue.WatchQueue:output_type -> types.QueueNotification
+	5,  // 19: queue.Queue.Submit:output_type -> google.protobuf.Empty
+	5,  // 20: queue.Queue.SetCancel:output_type -> google.protobuf.Empty
+	1,  // 21: queue.Queue.GetCancel:output_type -> types.Status
+	1,  // 22: queue.Queue.WatchCancel:output_type -> types.Status
+	10, // 23: queue.Queue.RerunRun:output_type -> types.Run
+	11, // 24: queue.Queue.RerunTask:output_type -> types.RunList
+	11, // 25: queue.Queue.RerunSubmission:output_type -> types.RunList
+	4,  // 26: queue.Queue.RegisterRunner:output_type -> types.Runner
+	4,  // 27: queue.Queue.Heartbeat:output_type -> types.Runner
+	12, // 28: queue.Queue.ListRunners:output_type -> types.RunnerList
+	4,  // 29: queue.Queue.SetRunnerState:output_type -> types.Runner
+	15, // [15:30] is the sub-list for method output_type
+	0,  // [0:15] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_grpc_services_queue_server_proto_init() }
@@ -242,6 +329,7 @@ func file_grpc_services_queue_server_proto_init() {
 			}
 		}
 	}
+	file_grpc_services_queue_server_proto_msgTypes[0].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -276,9 +364,19 @@ const _ = grpc.SupportPackageIsVersion6
 type QueueClient interface {
 	PutStatus(ctx context.Context, in *types.Status, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	NextQueueItem(ctx context.Context, in *types.QueueRequest, opts ...grpc.CallOption) (*types.QueueItem, error)
+	NextQueueItems(ctx context.Context, in *types.QueueRequest, opts ...grpc.CallOption) (*types.QueueItemList, error)
+	WatchQueue(ctx context.Context, in *types.QueueRequest, opts ...grpc.CallOption) (Queue_WatchQueueClient, error)
 	Submit(ctx context.Context, in *Submission, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SetCancel(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetCancel(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (*types.Status, error)
+	WatchCancel(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (Queue_WatchCancelClient, error)
+	RerunRun(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (*types.Run, error)
+	RerunTask(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (*types.RunList, error)
+	RerunSubmission(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (*types.RunList, error)
+	RegisterRunner(ctx context.Context, in *types.Runner, opts ...grpc.CallOption) (*types.Runner, error)
+	Heartbeat(ctx context.Context, in *types.Runner, opts ...grpc.CallOption) (*types.Runner, error)
+	ListRunners(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*types.RunnerList, error)
+	SetRunnerState(ctx context.Context, in *types.RunnerState, opts ...grpc.CallOption) (*types.Runner, error)
 }
 
 type queueClient struct {
@@ -307,6 +405,47 @@ func (c *queueClient) NextQueueItem(ctx context.Context, in *types.QueueRequest,
 	return out, nil
 }
 
+func (c *queueClient) NextQueueItems(ctx context.Context, in *types.QueueRequest, opts ...grpc.CallOption) (*types.QueueItemList, error) {
+	out := new(types.QueueItemList)
+	err := c.cc.Invoke(ctx, "/queue.Queue/NextQueueItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) WatchQueue(ctx context.Context, in *types.QueueRequest, opts ...grpc.CallOption) (Queue_WatchQueueClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Queue_serviceDesc.Streams[0], "/queue.Queue/WatchQueue", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queueWatchQueueClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Queue_WatchQueueClient interface {
+	Recv() (*types.QueueNotification, error)
+	grpc.ClientStream
+}
+
+type queueWatchQueueClient struct {
+	grpc.ClientStream
+}
+
+func (x *queueWatchQueueClient) Recv() (*types.QueueNotification, error) {
+	m := new(types.QueueNotification)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *queueClient) Submit(ctx context.Context, in *Submission, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/queue.Queue/Submit", in, out, opts...)
@@ -334,13 +473,118 @@ func (c *queueClient) GetCancel(ctx context.Context, in *types.IntID, opts ...gr
 	return out, nil
 }
 
+func (c *queueClient) WatchCancel(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (Queue_WatchCancelClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Queue_serviceDesc.Streams[1], "/queue.Queue/WatchCancel", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queueWatchCancelClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Queue_WatchCancelClient interface {
+	Recv() (*types.Status, error)
+	grpc.ClientStream
+}
+
+type queueWatchCancelClient struct {
+	grpc.ClientStream
+}
+
+func (x *queueWatchCancelClient) Recv() (*types.Status, error) {
+	m := new(types.Status)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *queueClient) RerunRun(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (*types.Run, error) {
+	out := new(types.Run)
+	err := c.cc.Invoke(ctx, "/queue.Queue/RerunRun", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) RerunTask(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (*types.RunList, error) {
+	out := new(types.RunList)
+	err := c.cc.Invoke(ctx, "/queue.Queue/RerunTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) RerunSubmission(ctx context.Context, in *types.IntID, opts ...grpc.CallOption) (*types.RunList, error) {
+	out := new(types.RunList)
+	err := c.cc.Invoke(ctx, "/queue.Queue/RerunSubmission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) RegisterRunner(ctx context.Context, in *types.Runner, opts ...grpc.CallOption) (*types.Runner, error) {
+	out := new(types.Runner)
+	err := c.cc.Invoke(ctx, "/queue.Queue/RegisterRunner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) Heartbeat(ctx context.Context, in *types.Runner, opts ...grpc.CallOption) (*types.Runner, error) {
+	out := new(types.Runner)
+	err := c.cc.Invoke(ctx, "/queue.Queue/Heartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) ListRunners(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*types.RunnerList, error) {
+	out := new(types.RunnerList)
+	err := c.cc.Invoke(ctx, "/queue.Queue/ListRunners", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) SetRunnerState(ctx context.Context, in *types.RunnerState, opts ...grpc.CallOption) (*types.Runner, error) {
+	out := new(types.Runner)
+	err := c.cc.Invoke(ctx, "/queue.Queue/SetRunnerState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueueServer is the server API for Queue service.
 type QueueServer interface {
 	PutStatus(context.Context, *types.Status) (*emptypb.Empty, error)
 	NextQueueItem(context.Context, *types.QueueRequest) (*types.QueueItem, error)
+	NextQueueItems(context.Context, *types.QueueRequest) (*types.QueueItemList, error)
+	WatchQueue(*types.QueueRequest, Queue_WatchQueueServer) error
 	Submit(context.Context, *Submission) (*emptypb.Empty, error)
 	SetCancel(context.Context, *types.IntID) (*emptypb.Empty, error)
 	GetCancel(context.Context, *types.IntID) (*types.Status, error)
+	WatchCancel(*types.IntID, Queue_WatchCancelServer) error
+	RerunRun(context.Context, *types.IntID) (*types.Run, error)
+	RerunTask(context.Context, *types.IntID) (*types.RunList, error)
+	RerunSubmission(context.Context, *types.IntID) (*types.RunList, error)
+	RegisterRunner(context.Context, *types.Runner) (*types.Runner, error)
+	Heartbeat(context.Context, *types.Runner) (*types.Runner, error)
+	ListRunners(context.Context, *emptypb.Empty) (*types.RunnerList, error)
+	SetRunnerState(context.Context, *types.RunnerState) (*types.Runner, error)
 }
 
 // UnimplementedQueueServer can be embedded to have forward compatible implementations.
@@ -353,6 +597,12 @@ func (*UnimplementedQueueServer) PutStatus(context.Context, *types.Status) (*emp
 func (*UnimplementedQueueServer) NextQueueItem(context.Context, *types.QueueRequest) (*types.QueueItem, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NextQueueItem not implemented")
 }
+func (*UnimplementedQueueServer) NextQueueItems(context.Context, *types.QueueRequest) (*types.QueueItemList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextQueueItems not implemented")
+}
+func (*UnimplementedQueueServer) WatchQueue(*types.QueueRequest, Queue_WatchQueueServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchQueue not implemented")
+}
 func (*UnimplementedQueueServer) Submit(context.Context, *Submission) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Submit not implemented")
 }
@@ -362,6 +612,30 @@ func (*UnimplementedQueueServer) SetCancel(context.Context, *types.IntID) (*empt
 func (*UnimplementedQueueServer) GetCancel(context.Context, *types.IntID) (*types.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCancel not implemented")
 }
+func (*UnimplementedQueueServer) WatchCancel(*types.IntID, Queue_WatchCancelServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCancel not implemented")
+}
+func (*UnimplementedQueueServer) RerunRun(context.Context, *types.IntID) (*types.Run, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RerunRun not implemented")
+}
+func (*UnimplementedQueueServer) RerunTask(context.Context, *types.IntID) (*types.RunList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RerunTask not implemented")
+}
+func (*UnimplementedQueueServer) RerunSubmission(context.Context, *types.IntID) (*types.RunList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RerunSubmission not implemented")
+}
+func (*UnimplementedQueueServer) RegisterRunner(context.Context, *types.Runner) (*types.Runner, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterRunner not implemented")
+}
+func (*UnimplementedQueueServer) Heartbeat(context.Context, *types.Runner) (*types.Runner, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (*UnimplementedQueueServer) ListRunners(context.Context, *emptypb.Empty) (*types.RunnerList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRunners not implemented")
+}
+func (*UnimplementedQueueServer) SetRunnerState(context.Context, *types.RunnerState) (*types.Runner, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRunnerState not implemented")
+}
 
 func RegisterQueueServer(s *grpc.Server, srv QueueServer) {
 	s.RegisterService(&_Queue_serviceDesc, srv)
@@ -403,6 +677,45 @@ func _Queue_NextQueueItem_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Queue_NextQueueItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.QueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).NextQueueItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queue.Queue/NextQueueItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).NextQueueItems(ctx, req.(*types.QueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_WatchQueue_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(types.QueueRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueueServer).WatchQueue(m, &queueWatchQueueServer{stream})
+}
+
+type Queue_WatchQueueServer interface {
+	Send(*types.QueueNotification) error
+	grpc.ServerStream
+}
+
+type queueWatchQueueServer struct {
+	grpc.ServerStream
+}
+
+func (x *queueWatchQueueServer) Send(m *types.QueueNotification) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Queue_Submit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Submission)
 	if err := dec(in); err != nil {
@@ -457,6 +770,153 @@ func _Queue_GetCancel_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Queue_WatchCancel_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(types.IntID)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueueServer).WatchCancel(m, &queueWatchCancelServer{stream})
+}
+
+type Queue_WatchCancelServer interface {
+	Send(*types.Status) error
+	grpc.ServerStream
+}
+
+type queueWatchCancelServer struct {
+	grpc.ServerStream
+}
+
+func (x *queueWatchCancelServer) Send(m *types.Status) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Queue_RerunRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.IntID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).RerunRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queue.Queue/RerunRun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).RerunRun(ctx, req.(*types.IntID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_RerunTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.IntID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).RerunTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queue.Queue/RerunTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).RerunTask(ctx, req.(*types.IntID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_RerunSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.IntID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).RerunSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queue.Queue/RerunSubmission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).RerunSubmission(ctx, req.(*types.IntID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_RegisterRunner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Runner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).RegisterRunner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queue.Queue/RegisterRunner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).RegisterRunner(ctx, req.(*types.Runner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Runner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queue.Queue/Heartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).Heartbeat(ctx, req.(*types.Runner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_ListRunners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).ListRunners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queue.Queue/ListRunners",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).ListRunners(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_SetRunnerState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.RunnerState)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).SetRunnerState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queue.Queue/SetRunnerState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).SetRunnerState(ctx, req.(*types.RunnerState))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Queue_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "queue.Queue",
 	HandlerType: (*QueueServer)(nil),
@@ -469,6 +929,10 @@ var _Queue_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NextQueueItem",
 			Handler:    _Queue_NextQueueItem_Handler,
 		},
+		{
+			MethodName: "NextQueueItems",
+			Handler:    _Queue_NextQueueItems_Handler,
+		},
 		{
 			MethodName: "Submit",
 			Handler:    _Queue_Submit_Handler,
@@ -481,7 +945,46 @@ var _Queue_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetCancel",
 			Handler:    _Queue_GetCancel_Handler,
 		},
+		{
+			MethodName: "RerunRun",
+			Handler:    _Queue_RerunRun_Handler,
+		},
+		{
+			MethodName: "RerunTask",
+			Handler:    _Queue_RerunTask_Handler,
+		},
+		{
+			MethodName: "RerunSubmission",
+			Handler:    _Queue_RerunSubmission_Handler,
+		},
+		{
+			MethodName: "RegisterRunner",
+			Handler:    _Queue_RegisterRunner_Handler,
+		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _Queue_Heartbeat_Handler,
+		},
+		{
+			MethodName: "ListRunners",
+			Handler:    _Queue_ListRunners_Handler,
+		},
+		{
+			MethodName: "SetRunnerState",
+			Handler:    _Queue_SetRunnerState_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchQueue",
+			Handler:       _Queue_WatchQueue_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchCancel",
+			Handler:       _Queue_WatchCancel_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "grpc/services/queue/server.proto",
 }
@@ -34,6 +34,7 @@ type QueueItem struct {
 	StartedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=startedAt,proto3" json:"startedAt,omitempty"` // When did it start?
 	QueueName string                 `protobuf:"bytes,5,opt,name=queueName,proto3" json:"queueName,omitempty"` // The name of the queue
 	Run       *Run                   `protobuf:"bytes,6,opt,name=run,proto3" json:"run,omitempty"`             // The run itself.
+	Priority  int32                  `protobuf:"varint,7,opt,name=priority,proto3" json:"priority,omitempty"`  // Items with higher priorities are handed out first.
 }
 
 func (x *QueueItem) Reset() {
@@ -110,14 +111,23 @@ func (x *QueueItem) GetRun() *Run {
 	return nil
 }
 
+func (x *QueueItem) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
 // QueueRequest is issued by runners to the queuesvc.
 type QueueRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	QueueName string `protobuf:"bytes,1,opt,name=queueName,proto3" json:"queueName,omitempty"`
-	RunningOn string `protobuf:"bytes,2,opt,name=runningOn,proto3" json:"runningOn,omitempty"`
+	QueueName string   `protobuf:"bytes,1,opt,name=queueName,proto3" json:"queueName,omitempty"`
+	RunningOn string   `protobuf:"bytes,2,opt,name=runningOn,proto3" json:"runningOn,omitempty"`
+	Labels    []string `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty"` // labels the runner has, such as `arch=arm64`; runs requiring others are not handed out.
+	Count     int64    `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`  // the most items NextQueueItems claims at once; at least one.
 }
 
 func (x *QueueRequest) Reset() {
@@ -166,6 +176,117 @@ func (x *QueueRequest) GetRunningOn() string {
 	return ""
 }
 
+func (x *QueueRequest) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *QueueRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// QueueItemList is a list of queue items.
+type QueueItemList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*QueueItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *QueueItemList) Reset() {
+	*x = QueueItemList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_queue_item_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueueItemList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueItemList) ProtoMessage() {}
+
+func (x *QueueItemList) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_queue_item_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueItemList.ProtoReflect.Descriptor instead.
+func (*QueueItemList) Descriptor() ([]byte, []int) {
+	return file_github_com_tinyci_ci_agents_ci_gen_grpc_types_queue_item_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueueItemList) GetItems() []*QueueItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// QueueNotification is streamed to runners watching a queue when items may
+// be waiting in it; runners then claim them with NextQueueItem(s).
+type QueueNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QueueName string `protobuf:"bytes,1,opt,name=queueName,proto3" json:"queueName,omitempty"`
+}
+
+func (x *QueueNotification) Reset() {
+	*x = QueueNotification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_queue_item_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueueNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueNotification) ProtoMessage() {}
+
+func (x *QueueNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_queue_item_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueNotification.ProtoReflect.Descriptor instead.
+func (*QueueNotification) Descriptor() ([]byte, []int) {
+	return file_github_com_tinyci_ci_agents_ci_gen_grpc_types_queue_item_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueueNotification) GetQueueName() string {
+	if x != nil {
+		return x.QueueName
+	}
+	return ""
+}
+
 // Status is reported to the queuesvc on completion of a run.
 type Status struct {
 	state         protoimpl.MessageState
@@ -175,12 +296,13 @@ type Status struct {
 	Id                int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Status            bool   `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
 	AdditionalMessage string `protobuf:"bytes,3,opt,name=additionalMessage,proto3" json:"additionalMessage,omitempty"`
+	Reason            string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"` // why a failed run failed: failure (the default), tests, infra_error or timeout
 }
 
 func (x *Status) Reset() {
 	*x = Status{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_queue_item_proto_msgTypes[2]
+		mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_queue_item_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -193,7 +315,7 @@ func (x *Status) String() string {
 func (*Status) ProtoMessage() {}
 
 func (x *Status) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_queue_item_proto_msgTypes[2]
+	mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_queue_item_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -206,7 +328,7 @@ func (x *Status) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Status.ProtoReflect.Descriptor instead.
 func (*Status) Descriptor() ([]byte, []int) {
-	return file_github_com_tinyci_ci_agents_ci_gen_grpc_types_queue_item_proto_rawDescGZIP(), []int{2}
+	return file_github_com_tinyci_ci_agents_ci_gen_grpc_types_queue_item_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Status) GetId() int64 {
@@ -230,6 +352,13 @@ func (x *Status) GetAdditionalMessage() string {
 	return ""
 }
 
+func (x *Status) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 var File_github_com_tinyci_ci_agents_ci_gen_grpc_types_queue_item_proto protoreflect.FileDescriptor
 
 var file_github_com_tinyci_ci_agents_ci_gen_grpc_types_queue_item_proto_rawDesc = []byte{
@@ -243,7 +372,7 @@ var file_github_com_tinyci_ci_agents_ci_gen_grpc_types_queue_item_proto_rawDesc
 	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x69, 0x6e, 0x79, 0x63, 0x69, 0x2f, 0x63, 0x69, 0x2d, 0x61,
 	0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x69, 0x2d, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72, 0x70,
 	0x63, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x72, 0x75, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xc9, 0x01, 0x0a, 0x09, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12,
+	0x6f, 0x22, 0xe5, 0x01, 0x0a, 0x09, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12,
 	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
 	0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
 	0x52, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x75, 0x6e,
@@ -255,22 +384,34 @@ var file_github_com_tinyci_ci_agents_ci_gen_grpc_types_queue_item_proto_rawDesc
 	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
 	0x1c, 0x0a, 0x03, 0x72, 0x75, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x03, 0x72, 0x75, 0x6e, 0x22, 0x4a, 0x0a,
-	0x0c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
-	0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72,
-	0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x4f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x4f, 0x6e, 0x22, 0x5e, 0x0a, 0x06, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x61,
-	0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x69, 0x6e, 0x79, 0x63, 0x69, 0x2f, 0x63,
-	0x69, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x69, 0x2d, 0x67, 0x65, 0x6e, 0x2f,
-	0x67, 0x72, 0x70, 0x63, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x03, 0x72, 0x75, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x78, 0x0a, 0x0c, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x75, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x4f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x75, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x4f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x37, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x31, 0x0a, 0x11,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x76, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x64,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x69, 0x6e, 0x79, 0x63, 0x69, 0x2f, 0x63, 0x69, 0x2d,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x69, 0x2d, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72,
+	0x70, 0x63, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -285,22 +426,25 @@ func file_github_com_tinyci_ci_agents_ci_gen_grpc_types_queue_item_proto_rawDesc
 	return file_github_com_tinyci_ci_agents_ci_gen_grpc_types_queue_item_proto_rawDescData
 }
 
-var file_github_com_tinyci_ci_agents_ci_gen_grpc_types_queue_item_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_github_com_tinyci_ci_agents_ci_gen_grpc_types_queue_item_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_github_com_tinyci_ci_agents_ci_gen_grpc_types_queue_item_proto_goTypes = []interface{}{
 	(*QueueItem)(nil),             // 0: types.QueueItem
 	(*QueueRequest)(nil),          // 1: types.QueueRequest
-	(*Status)(nil),                // 2: types.Status
-	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
-	(*Run)(nil),                   // 4: types.Run
+	(*QueueItemList)(nil),         // 2: types.QueueItemList
+	(*QueueNotification)(nil),     // 3: types.QueueNotification
+	(*Status)(nil),                // 4: types.Status
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(*Run)(nil),                   // 6: types.Run
 }
 var file_github_com_tinyci_ci_agents_ci_gen_grpc_types_queue_item_proto_depIdxs = []int32{
-	3, // 0: types.QueueItem.startedAt:type_name -> google.protobuf.Timestamp
-	4, // 1: types.QueueItem.run:type_name -> types.Run
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	5, // 0: types.QueueItem.startedAt:type_name -> google.protobuf.Timestamp
+	6, // 1: types.QueueItem.run:type_name -> types.Run
+	0, // 2: types.QueueItemList.items:type_name -> types.QueueItem
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_github_com_tinyci_ci_agents_ci_gen_grpc_types_queue_item_proto_init() }
@@ -335,6 +479,30 @@ func file_github_com_tinyci_ci_agents_ci_gen_grpc_types_queue_item_proto_init()
 			}
 		}
 		file_github_com_tinyci_ci_agents_ci_gen_grpc_types_queue_item_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueItemList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_tinyci_ci_agents_ci_gen_grpc_types_queue_item_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueNotification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_tinyci_ci_agents_ci_gen_grpc_types_queue_item_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Status); i {
 			case 0:
 				return &v.state
@@ -353,7 +521,7 @@ func file_github_com_tinyci_ci_agents_ci_gen_grpc_types_queue_item_proto_init()
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_tinyci_ci_agents_ci_gen_grpc_types_queue_item_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
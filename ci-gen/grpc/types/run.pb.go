@@ -27,17 +27,20 @@ type Run struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                // ID is the internal ID of the run.
-	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`             // Name is the name of the run. Typically this is in `dir:run_name` format.
-	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`   // When was this run created
-	StartedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=startedAt,proto3" json:"startedAt,omitempty"`   // When did this run start
-	FinishedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=finishedAt,proto3" json:"finishedAt,omitempty"` // When did this run finish
-	Status     bool                   `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`        // What is the status of this run
-	StatusSet  bool                   `protobuf:"varint,7,opt,name=statusSet,proto3" json:"statusSet,omitempty"`  // Is the status valid? (nil internally for invalid settings, but proto doesn't like nil)
-	Settings   *RunSettings           `protobuf:"bytes,8,opt,name=settings,proto3" json:"settings,omitempty"`     // The settings for the Run (image, command etc)
-	Task       *Task                  `protobuf:"bytes,9,opt,name=task,proto3" json:"task,omitempty"`             // Task for the Run.
-	RanOn      string                 `protobuf:"bytes,10,opt,name=ranOn,proto3" json:"ranOn,omitempty"`          // what host the run happened on
-	RanOnSet   bool                   `protobuf:"varint,11,opt,name=ranOnSet,proto3" json:"ranOnSet,omitempty"`   // if the ranOn host was set.
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                // ID is the internal ID of the run.
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                             // Name is the name of the run. Typically this is in `dir:run_name` format.
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`                   // When was this run created
+	StartedAt         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=startedAt,proto3" json:"startedAt,omitempty"`                   // When did this run start
+	FinishedAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=finishedAt,proto3" json:"finishedAt,omitempty"`                 // When did this run finish
+	Status            bool                   `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`                        // What is the status of this run
+	StatusSet         bool                   `protobuf:"varint,7,opt,name=statusSet,proto3" json:"statusSet,omitempty"`                  // Is the status valid? (nil internally for invalid settings, but proto doesn't like nil)
+	Settings          *RunSettings           `protobuf:"bytes,8,opt,name=settings,proto3" json:"settings,omitempty"`                     // The settings for the Run (image, command etc)
+	Task              *Task                  `protobuf:"bytes,9,opt,name=task,proto3" json:"task,omitempty"`                             // Task for the Run.
+	RanOn             string                 `protobuf:"bytes,10,opt,name=ranOn,proto3" json:"ranOn,omitempty"`                          // what host the run happened on
+	RanOnSet          bool                   `protobuf:"varint,11,opt,name=ranOnSet,proto3" json:"ranOnSet,omitempty"`                   // if the ranOn host was set.
+	Attempt           int64                  `protobuf:"varint,12,opt,name=attempt,proto3" json:"attempt,omitempty"`                     // Which attempt of the run this is, starting at 1.
+	PreviousAttemptId int64                  `protobuf:"varint,13,opt,name=previousAttemptId,proto3" json:"previousAttemptId,omitempty"` // ID of the attempt this one retries, if any.
+	FailureReason     string                 `protobuf:"bytes,14,opt,name=failureReason,proto3" json:"failureReason,omitempty"`          // Why the run failed, if known: failure, tests, infra_error, timeout, expired or start_error.
 }
 
 func (x *Run) Reset() {
@@ -149,6 +152,27 @@ func (x *Run) GetRanOnSet() bool {
 	return false
 }
 
+func (x *Run) GetAttempt() int64 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *Run) GetPreviousAttemptId() int64 {
+	if x != nil {
+		return x.PreviousAttemptId
+	}
+	return 0
+}
+
+func (x *Run) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
 // RunList is just an array of runs
 type RunList struct {
 	state         protoimpl.MessageState
@@ -213,7 +237,7 @@ var file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_proto_rawDesc = []byt
 	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x69, 0x6e, 0x79, 0x63, 0x69, 0x2f, 0x63,
 	0x69, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x69, 0x2d, 0x67, 0x65, 0x6e, 0x2f,
 	0x67, 0x72, 0x70, 0x63, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x72, 0x75, 0x6e, 0x5f, 0x73,
-	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x92, 0x03,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x80, 0x04,
 	0x0a, 0x03, 0x52, 0x75, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65,
@@ -239,13 +263,20 @@ var file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_proto_rawDesc = []byt
 	0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x4f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x05, 0x72, 0x61, 0x6e, 0x4f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x61, 0x6e, 0x4f, 0x6e, 0x53,
 	0x65, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x61, 0x6e, 0x4f, 0x6e, 0x53,
-	0x65, 0x74, 0x22, 0x29, 0x0a, 0x07, 0x52, 0x75, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x0a,
-	0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x42, 0x2f, 0x5a,
-	0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x69, 0x6e, 0x79,
-	0x63, 0x69, 0x2f, 0x63, 0x69, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x69, 0x2d,
-	0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x2c, 0x0a, 0x11,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x49,
+	0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0x29, 0x0a, 0x07, 0x52, 0x75, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x04, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x42, 0x2f, 0x5a, 0x2d, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x69, 0x6e, 0x79, 0x63, 0x69,
+	0x2f, 0x63, 0x69, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x69, 0x2d, 0x67, 0x65,
+	0x6e, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
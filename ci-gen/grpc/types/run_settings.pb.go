@@ -28,15 +28,24 @@ type RunSettings struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Command    []string         `protobuf:"bytes,1,rep,name=command,proto3" json:"command,omitempty"`        // Command is the command in execv() form (array of strings)
-	Image      string           `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`            // Image is an arbitrary image name, the overlay runner needs docker registry format
-	Queue      string           `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`            // Queue is the name of the queue this run should be placed in.
-	Metadata   *structpb.Struct `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`      // Metadata is a free form grab-bag of properties for runners to use.
-	Name       string           `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`              // Name is the name of the run
-	Timeout    int64            `protobuf:"varint,6,opt,name=timeout,proto3" json:"timeout,omitempty"`       // Timeout is the timeout, in seconds, to wait before automatically canceling a run.
-	Resources  *Resources       `protobuf:"bytes,7,opt,name=resources,proto3" json:"resources,omitempty"`    // Resource constraint values
-	Privileged bool             `protobuf:"varint,8,opt,name=privileged,proto3" json:"privileged,omitempty"` // use a privileged container to run this test?
-	Env        []string         `protobuf:"bytes,9,rep,name=env,proto3" json:"env,omitempty"`                // environment variables
+	Command     []string               `protobuf:"bytes,1,rep,name=command,proto3" json:"command,omitempty"`                                                                                        // Command is the command in execv() form (array of strings)
+	Image       string                 `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`                                                                                            // Image is an arbitrary image name, the overlay runner needs docker registry format
+	Queue       string                 `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`                                                                                            // Queue is the name of the queue this run should be placed in.
+	Metadata    *structpb.Struct       `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`                                                                                      // Metadata is a free form grab-bag of properties for runners to use.
+	Name        string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`                                                                                              // Name is the name of the run
+	Timeout     int64                  `protobuf:"varint,6,opt,name=timeout,proto3" json:"timeout,omitempty"`                                                                                       // Timeout is the timeout, in seconds, to wait before automatically canceling a run.
+	Resources   *Resources             `protobuf:"bytes,7,opt,name=resources,proto3" json:"resources,omitempty"`                                                                                    // Resource constraint values
+	Privileged  bool                   `protobuf:"varint,8,opt,name=privileged,proto3" json:"privileged,omitempty"`                                                                                 // use a privileged container to run this test?
+	Env         []string               `protobuf:"bytes,9,rep,name=env,proto3" json:"env,omitempty"`                                                                                                // environment variables
+	Needs       []string               `protobuf:"bytes,10,rep,name=needs,proto3" json:"needs,omitempty"`                                                                                           // names of runs in the same task which must succeed before this one starts
+	Matrix      map[string]*MatrixAxis `protobuf:"bytes,11,rep,name=matrix,proto3" json:"matrix,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // the run is expanded into one run for each combination of these axis values
+	Paths       []string               `protobuf:"bytes,12,rep,name=paths,proto3" json:"paths,omitempty"`                                                                                           // only run if a changed file matches one of these patterns
+	PathsIgnore []string               `protobuf:"bytes,13,rep,name=pathsIgnore,proto3" json:"pathsIgnore,omitempty"`                                                                               // changed files matching these patterns do not trigger the run
+	On          *EventFilters          `protobuf:"bytes,14,opt,name=on,proto3" json:"on,omitempty"`                                                                                                 // limits the run to certain events and branches
+	RunsOn      []string               `protobuf:"bytes,15,rep,name=runsOn,proto3" json:"runsOn,omitempty"`                                                                                         // labels, such as `arch=arm64`, a runner must have to be handed this run
+	Retries     *RetryPolicy           `protobuf:"bytes,16,opt,name=retries,proto3" json:"retries,omitempty"`                                                                                       // automatically enqueue new attempts of the run when it fails
+	Artifacts   []*Artifact            `protobuf:"bytes,17,rep,name=artifacts,proto3" json:"artifacts,omitempty"`                                                                                   // files the runner uploads to the assetsvc once the run finishes
+	Concurrency *Concurrency           `protobuf:"bytes,18,opt,name=concurrency,proto3" json:"concurrency,omitempty"`                                                                               // keeps runs of the same group in the repository from running at once
 }
 
 func (x *RunSettings) Reset() {
@@ -134,6 +143,409 @@ func (x *RunSettings) GetEnv() []string {
 	return nil
 }
 
+func (x *RunSettings) GetNeeds() []string {
+	if x != nil {
+		return x.Needs
+	}
+	return nil
+}
+
+func (x *RunSettings) GetMatrix() map[string]*MatrixAxis {
+	if x != nil {
+		return x.Matrix
+	}
+	return nil
+}
+
+func (x *RunSettings) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *RunSettings) GetPathsIgnore() []string {
+	if x != nil {
+		return x.PathsIgnore
+	}
+	return nil
+}
+
+func (x *RunSettings) GetOn() *EventFilters {
+	if x != nil {
+		return x.On
+	}
+	return nil
+}
+
+func (x *RunSettings) GetRunsOn() []string {
+	if x != nil {
+		return x.RunsOn
+	}
+	return nil
+}
+
+func (x *RunSettings) GetRetries() *RetryPolicy {
+	if x != nil {
+		return x.Retries
+	}
+	return nil
+}
+
+func (x *RunSettings) GetArtifacts() []*Artifact {
+	if x != nil {
+		return x.Artifacts
+	}
+	return nil
+}
+
+func (x *RunSettings) GetConcurrency() *Concurrency {
+	if x != nil {
+		return x.Concurrency
+	}
+	return nil
+}
+
+// Artifact declares a file produced by a run which the runner should upload.
+type Artifact struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`               // name the artifact is stored under
+	Path        string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`               // path of the file, relative to the working directory of the run
+	ContentType string `protobuf:"bytes,3,opt,name=contentType,proto3" json:"contentType,omitempty"` // MIME type; guessed from the path's extension if unset
+	Report      string `protobuf:"bytes,4,opt,name=report,proto3" json:"report,omitempty"`           // test report format (junit or go-test-json), if the artifact is one
+}
+
+func (x *Artifact) Reset() {
+	*x = Artifact{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Artifact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Artifact) ProtoMessage() {}
+
+func (x *Artifact) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Artifact.ProtoReflect.Descriptor instead.
+func (*Artifact) Descriptor() ([]byte, []int) {
+	return file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Artifact) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Artifact) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Artifact) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Artifact) GetReport() string {
+	if x != nil {
+		return x.Report
+	}
+	return ""
+}
+
+// RetryPolicy is how many times, and for which kinds of failure, a run is
+// attempted again.
+type RetryPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int64    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"` // the number of additional attempts to make
+	On    []string `protobuf:"bytes,2,rep,name=on,proto3" json:"on,omitempty"`        // failure, tests, infra_error or timeout; empty means all of them
+}
+
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPolicy) ProtoMessage() {}
+
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RetryPolicy) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetOn() []string {
+	if x != nil {
+		return x.On
+	}
+	return nil
+}
+
+// Concurrency names the group a run or task belongs to. Only one member of a
+// group runs at a time in each repository; the others are held in the queue.
+type Concurrency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group            string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`                        // group name, with ${ref}, ${sha} and ${matrix.<axis>} substituted when queued
+	CancelInProgress bool   `protobuf:"varint,2,opt,name=cancelInProgress,proto3" json:"cancelInProgress,omitempty"` // cancel the older members of the group when a new one is queued
+}
+
+func (x *Concurrency) Reset() {
+	*x = Concurrency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Concurrency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Concurrency) ProtoMessage() {}
+
+func (x *Concurrency) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Concurrency.ProtoReflect.Descriptor instead.
+func (*Concurrency) Descriptor() ([]byte, []int) {
+	return file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Concurrency) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *Concurrency) GetCancelInProgress() bool {
+	if x != nil {
+		return x.CancelInProgress
+	}
+	return false
+}
+
+// EventFilters limits runs to the events, and the branches within those
+// events, they are listed under. An unset event does not trigger the run.
+type EventFilters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Push        *BranchFilter `protobuf:"bytes,1,opt,name=push,proto3" json:"push,omitempty"`               // pushes to a branch
+	PullRequest *BranchFilter `protobuf:"bytes,2,opt,name=pullRequest,proto3" json:"pullRequest,omitempty"` // pull requests, by the branch they target
+}
+
+func (x *EventFilters) Reset() {
+	*x = EventFilters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventFilters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventFilters) ProtoMessage() {}
+
+func (x *EventFilters) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventFilters.ProtoReflect.Descriptor instead.
+func (*EventFilters) Descriptor() ([]byte, []int) {
+	return file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EventFilters) GetPush() *BranchFilter {
+	if x != nil {
+		return x.Push
+	}
+	return nil
+}
+
+func (x *EventFilters) GetPullRequest() *BranchFilter {
+	if x != nil {
+		return x.PullRequest
+	}
+	return nil
+}
+
+// BranchFilter is the list of branch patterns an event must match. An empty
+// list matches all branches.
+type BranchFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Branches []string `protobuf:"bytes,1,rep,name=branches,proto3" json:"branches,omitempty"` // globs, or regexes prefixed with "re:"
+}
+
+func (x *BranchFilter) Reset() {
+	*x = BranchFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BranchFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BranchFilter) ProtoMessage() {}
+
+func (x *BranchFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BranchFilter.ProtoReflect.Descriptor instead.
+func (*BranchFilter) Descriptor() ([]byte, []int) {
+	return file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BranchFilter) GetBranches() []string {
+	if x != nil {
+		return x.Branches
+	}
+	return nil
+}
+
+// MatrixAxis is the list of values one axis of a run matrix can take.
+type MatrixAxis struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"` // axis values, substituted wherever ${matrix.<axis>} appears
+}
+
+func (x *MatrixAxis) Reset() {
+	*x = MatrixAxis{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatrixAxis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatrixAxis) ProtoMessage() {}
+
+func (x *MatrixAxis) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatrixAxis.ProtoReflect.Descriptor instead.
+func (*MatrixAxis) Descriptor() ([]byte, []int) {
+	return file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MatrixAxis) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
 // Resources covers resource constraints that a runner might act on. It is
 // voluntary for a runner to take these values into consideration. It is also
 // up to the runner to interpret these values, and will differ between
@@ -152,7 +564,7 @@ type Resources struct {
 func (x *Resources) Reset() {
 	*x = Resources{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_msgTypes[1]
+		mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -165,7 +577,7 @@ func (x *Resources) String() string {
 func (*Resources) ProtoMessage() {}
 
 func (x *Resources) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_msgTypes[1]
+	mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -178,7 +590,7 @@ func (x *Resources) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Resources.ProtoReflect.Descriptor instead.
 func (*Resources) Descriptor() ([]byte, []int) {
-	return file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_rawDescGZIP(), []int{1}
+	return file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Resources) GetCpu() string {
@@ -218,7 +630,7 @@ var file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_rawDes
 	0x72, 0x75, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x12, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x98, 0x02, 0x0a, 0x0b, 0x52, 0x75, 0x6e, 0x53,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbc, 0x05, 0x0a, 0x0b, 0x52, 0x75, 0x6e, 0x53,
 	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
 	0x6e, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
 	0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
@@ -236,16 +648,70 @@ var file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_rawDes
 	0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x64, 0x18, 0x08,
 	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x64,
 	0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x65,
-	0x6e, 0x76, 0x22, 0x5d, 0x0a, 0x09, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12,
-	0x10, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x70,
-	0x75, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x73,
-	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x12, 0x12, 0x0a,
-	0x04, 0x69, 0x6f, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6f, 0x70,
-	0x73, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x74, 0x69, 0x6e, 0x79, 0x63, 0x69, 0x2f, 0x63, 0x69, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73,
-	0x2f, 0x63, 0x69, 0x2d, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x76, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x65, 0x65, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x6e, 0x65, 0x65, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x06, 0x6d, 0x61, 0x74, 0x72,
+	0x69, 0x78, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x52, 0x75, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x4d, 0x61, 0x74,
+	0x72, 0x69, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x74, 0x68, 0x73, 0x49,
+	0x67, 0x6e, 0x6f, 0x72, 0x65, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x74,
+	0x68, 0x73, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x02, 0x6f, 0x6e, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x02, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x75, 0x6e, 0x73, 0x4f, 0x6e, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x75, 0x6e, 0x73, 0x4f, 0x6e, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52,
+	0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73,
+	0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x1a, 0x4c, 0x0a, 0x0b, 0x4d, 0x61, 0x74, 0x72,
+	0x69, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x41, 0x78, 0x69, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6c, 0x0a, 0x08, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61,
+	0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x22, 0x33, 0x0a, 0x0b, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x6e, 0x22, 0x4f, 0x0a, 0x0b, 0x43, 0x6f, 0x6e,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2a,
+	0x0a, 0x10, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x49, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x6e, 0x0a, 0x0c, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x04, 0x70, 0x75,
+	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x04, 0x70,
+	0x75, 0x73, 0x68, 0x12, 0x35, 0x0a, 0x0b, 0x70, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x70,
+	0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2a, 0x0a, 0x0c, 0x42, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x62, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x22, 0x24, 0x0a, 0x0a, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78,
+	0x41, 0x78, 0x69, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x5d, 0x0a, 0x09,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x70, 0x75,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x70, 0x75, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6f, 0x70, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6f, 0x70, 0x73, 0x42, 0x2f, 0x5a, 0x2d, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x69, 0x6e, 0x79, 0x63, 0x69,
+	0x2f, 0x63, 0x69, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x69, 0x2d, 0x67, 0x65,
+	0x6e, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -260,20 +726,35 @@ func file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_rawDe
 	return file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_rawDescData
 }
 
-var file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_goTypes = []interface{}{
 	(*RunSettings)(nil),     // 0: types.RunSettings
-	(*Resources)(nil),       // 1: types.Resources
-	(*structpb.Struct)(nil), // 2: google.protobuf.Struct
+	(*Artifact)(nil),        // 1: types.Artifact
+	(*RetryPolicy)(nil),     // 2: types.RetryPolicy
+	(*Concurrency)(nil),     // 3: types.Concurrency
+	(*EventFilters)(nil),    // 4: types.EventFilters
+	(*BranchFilter)(nil),    // 5: types.BranchFilter
+	(*MatrixAxis)(nil),      // 6: types.MatrixAxis
+	(*Resources)(nil),       // 7: types.Resources
+	nil,                     // 8: types.RunSettings.MatrixEntry
+	(*structpb.Struct)(nil), // 9: google.protobuf.Struct
 }
 var file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_depIdxs = []int32{
-	2, // 0: types.RunSettings.metadata:type_name -> google.protobuf.Struct
-	1, // 1: types.RunSettings.resources:type_name -> types.Resources
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	9,  // 0: types.RunSettings.metadata:type_name -> google.protobuf.Struct
+	7,  // 1: types.RunSettings.resources:type_name -> types.Resources
+	8,  // 2: types.RunSettings.matrix:type_name -> types.RunSettings.MatrixEntry
+	4,  // 3: types.RunSettings.on:type_name -> types.EventFilters
+	2,  // 4: types.RunSettings.retries:type_name -> types.RetryPolicy
+	1,  // 5: types.RunSettings.artifacts:type_name -> types.Artifact
+	3,  // 6: types.RunSettings.concurrency:type_name -> types.Concurrency
+	5,  // 7: types.EventFilters.push:type_name -> types.BranchFilter
+	5,  // 8: types.EventFilters.pullRequest:type_name -> types.BranchFilter
+	6,  // 9: types.RunSettings.MatrixEntry.value:type_name -> types.MatrixAxis
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_init() }
@@ -295,6 +776,78 @@ func file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_init(
 			}
 		}
 		file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Artifact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Concurrency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventFilters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BranchFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatrixAxis); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Resources); i {
 			case 0:
 				return &v.state
@@ -313,7 +866,7 @@ func file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_init(
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_tinyci_ci_agents_ci_gen_grpc_types_run_settings_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
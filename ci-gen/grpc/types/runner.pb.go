@@ -0,0 +1,376 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.15.6
+// source: github.com/tinyci/ci-agents/ci-gen/grpc/types/runner.proto
+
+package types
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Runner is a runner which has registered with the queuesvc. Runners send
+// these periodically as heartbeats; if they stop, the runs they were running
+// are re-queued.
+type Runner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                      // ID of the runner
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                   // Name of the runner; the hostname it passes as runningOn
+	QueueName     string                 `protobuf:"bytes,3,opt,name=queueName,proto3" json:"queueName,omitempty"`         // Queue the runner pulls from
+	Labels        []string               `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty"`               // Labels the runner has, see QueueRequest
+	Cordoned      bool                   `protobuf:"varint,5,opt,name=cordoned,proto3" json:"cordoned,omitempty"`          // Cordoned runners are not handed new runs
+	Draining      bool                   `protobuf:"varint,6,opt,name=draining,proto3" json:"draining,omitempty"`          // Draining runners are cordoned, and should exit once their runs finish
+	RegisteredAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=registeredAt,proto3" json:"registeredAt,omitempty"`   // When the runner first registered
+	LastHeartbeat *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=lastHeartbeat,proto3" json:"lastHeartbeat,omitempty"` // When the runner last sent a heartbeat
+}
+
+func (x *Runner) Reset() {
+	*x = Runner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Runner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Runner) ProtoMessage() {}
+
+func (x *Runner) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Runner.ProtoReflect.Descriptor instead.
+func (*Runner) Descriptor() ([]byte, []int) {
+	return file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Runner) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Runner) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Runner) GetQueueName() string {
+	if x != nil {
+		return x.QueueName
+	}
+	return ""
+}
+
+func (x *Runner) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Runner) GetCordoned() bool {
+	if x != nil {
+		return x.Cordoned
+	}
+	return false
+}
+
+func (x *Runner) GetDraining() bool {
+	if x != nil {
+		return x.Draining
+	}
+	return false
+}
+
+func (x *Runner) GetRegisteredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RegisteredAt
+	}
+	return nil
+}
+
+func (x *Runner) GetLastHeartbeat() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastHeartbeat
+	}
+	return nil
+}
+
+// RunnerList is a list of runners.
+type RunnerList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Runners []*Runner `protobuf:"bytes,1,rep,name=runners,proto3" json:"runners,omitempty"`
+}
+
+func (x *RunnerList) Reset() {
+	*x = RunnerList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunnerList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunnerList) ProtoMessage() {}
+
+func (x *RunnerList) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunnerList.ProtoReflect.Descriptor instead.
+func (*RunnerList) Descriptor() ([]byte, []int) {
+	return file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RunnerList) GetRunners() []*Runner {
+	if x != nil {
+		return x.Runners
+	}
+	return nil
+}
+
+// RunnerState sets the cordon and drain state of the named runner. Draining
+// implies cordoning; setting both to false returns the runner to service.
+type RunnerState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Cordoned bool   `protobuf:"varint,2,opt,name=cordoned,proto3" json:"cordoned,omitempty"`
+	Draining bool   `protobuf:"varint,3,opt,name=draining,proto3" json:"draining,omitempty"`
+}
+
+func (x *RunnerState) Reset() {
+	*x = RunnerState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunnerState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunnerState) ProtoMessage() {}
+
+func (x *RunnerState) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunnerState.ProtoReflect.Descriptor instead.
+func (*RunnerState) Descriptor() ([]byte, []int) {
+	return file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RunnerState) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RunnerState) GetCordoned() bool {
+	if x != nil {
+		return x.Cordoned
+	}
+	return false
+}
+
+func (x *RunnerState) GetDraining() bool {
+	if x != nil {
+		return x.Draining
+	}
+	return false
+}
+
+var File_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto protoreflect.FileDescriptor
+
+var file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_rawDesc = []byte{
+	0x0a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x69, 0x6e,
+	0x79, 0x63, 0x69, 0x2f, 0x63, 0x69, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x69,
+	0x2d, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f,
+	0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9c, 0x02, 0x0a, 0x06, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x72,
+	0x64, 0x6f, 0x6e, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x72,
+	0x64, 0x6f, 0x6e, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x12, 0x3e, 0x0a, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x40, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
+	0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62,
+	0x65, 0x61, 0x74, 0x22, 0x35, 0x0a, 0x0a, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x27, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x6e, 0x65,
+	0x72, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x22, 0x59, 0x0a, 0x0b, 0x52, 0x75,
+	0x6e, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x63, 0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x72, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x72, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x69, 0x6e, 0x79, 0x63, 0x69, 0x2f, 0x63, 0x69, 0x2d, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x69, 0x2d, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72, 0x70, 0x63,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_rawDescOnce sync.Once
+	file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_rawDescData = file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_rawDesc
+)
+
+func file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_rawDescGZIP() []byte {
+	file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_rawDescOnce.Do(func() {
+		file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_rawDescData)
+	})
+	return file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_rawDescData
+}
+
+var file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_goTypes = []interface{}{
+	(*Runner)(nil),                // 0: types.Runner
+	(*RunnerList)(nil),            // 1: types.RunnerList
+	(*RunnerState)(nil),           // 2: types.RunnerState
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_depIdxs = []int32{
+	3, // 0: types.Runner.registeredAt:type_name -> google.protobuf.Timestamp
+	3, // 1: types.Runner.lastHeartbeat:type_name -> google.protobuf.Timestamp
+	0, // 2: types.RunnerList.runners:type_name -> types.Runner
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_init() }
+func file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_init() {
+	if File_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Runner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunnerList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunnerState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_goTypes,
+		DependencyIndexes: file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_depIdxs,
+		MessageInfos:      file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_msgTypes,
+	}.Build()
+	File_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto = out.File
+	file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_rawDesc = nil
+	file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_goTypes = nil
+	file_github_com_tinyci_ci_agents_ci_gen_grpc_types_runner_proto_depIdxs = nil
+}
@@ -0,0 +1,598 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.15.6
+// source: github.com/tinyci/ci-agents/ci-gen/grpc/types/test_case.proto
+
+package types
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TestCase is the result of a single test, read from a test report uploaded by
+// the run.
+type TestCase struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`              // ID of the test case
+	RunID    int64   `protobuf:"varint,2,opt,name=runID,proto3" json:"runID,omitempty"`        // ID of the run the test ran in
+	Suite    string  `protobuf:"bytes,3,opt,name=suite,proto3" json:"suite,omitempty"`         // Suite, class or package of the test
+	Name     string  `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`           // Name of the test
+	Status   string  `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`       // passed, failed, skipped or error
+	Duration float64 `protobuf:"fixed64,6,opt,name=duration,proto3" json:"duration,omitempty"` // Duration of the test in seconds
+	Message  string  `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`     // Failure, error or skip message; truncated
+}
+
+func (x *TestCase) Reset() {
+	*x = TestCase{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestCase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestCase) ProtoMessage() {}
+
+func (x *TestCase) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestCase.ProtoReflect.Descriptor instead.
+func (*TestCase) Descriptor() ([]byte, []int) {
+	return file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TestCase) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TestCase) GetRunID() int64 {
+	if x != nil {
+		return x.RunID
+	}
+	return 0
+}
+
+func (x *TestCase) GetSuite() string {
+	if x != nil {
+		return x.Suite
+	}
+	return ""
+}
+
+func (x *TestCase) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TestCase) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TestCase) GetDuration() float64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *TestCase) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// TestCaseList is a list of the test cases of a run.
+type TestCaseList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunID     int64       `protobuf:"varint,1,opt,name=runID,proto3" json:"runID,omitempty"`
+	TestCases []*TestCase `protobuf:"bytes,2,rep,name=testCases,proto3" json:"testCases,omitempty"`
+}
+
+func (x *TestCaseList) Reset() {
+	*x = TestCaseList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestCaseList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestCaseList) ProtoMessage() {}
+
+func (x *TestCaseList) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestCaseList.ProtoReflect.Descriptor instead.
+func (*TestCaseList) Descriptor() ([]byte, []int) {
+	return file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TestCaseList) GetRunID() int64 {
+	if x != nil {
+		return x.RunID
+	}
+	return 0
+}
+
+func (x *TestCaseList) GetTestCases() []*TestCase {
+	if x != nil {
+		return x.TestCases
+	}
+	return nil
+}
+
+// TestSummary counts the test cases of a run by status.
+type TestSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunID    int64    `protobuf:"varint,1,opt,name=runID,proto3" json:"runID,omitempty"`
+	Total    int64    `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Passed   int64    `protobuf:"varint,3,opt,name=passed,proto3" json:"passed,omitempty"`
+	Failed   int64    `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	Skipped  int64    `protobuf:"varint,5,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Errored  int64    `protobuf:"varint,6,opt,name=errored,proto3" json:"errored,omitempty"`
+	Duration float64  `protobuf:"fixed64,7,opt,name=duration,proto3" json:"duration,omitempty"` // Sum of the durations of the tests in seconds
+	Failures []string `protobuf:"bytes,8,rep,name=failures,proto3" json:"failures,omitempty"`   // Names of the first failed and errored tests
+}
+
+func (x *TestSummary) Reset() {
+	*x = TestSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestSummary) ProtoMessage() {}
+
+func (x *TestSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestSummary.ProtoReflect.Descriptor instead.
+func (*TestSummary) Descriptor() ([]byte, []int) {
+	return file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TestSummary) GetRunID() int64 {
+	if x != nil {
+		return x.RunID
+	}
+	return 0
+}
+
+func (x *TestSummary) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *TestSummary) GetPassed() int64 {
+	if x != nil {
+		return x.Passed
+	}
+	return 0
+}
+
+func (x *TestSummary) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *TestSummary) GetSkipped() int64 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *TestSummary) GetErrored() int64 {
+	if x != nil {
+		return x.Errored
+	}
+	return 0
+}
+
+func (x *TestSummary) GetDuration() float64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *TestSummary) GetFailures() []string {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+// FlakyTest scores how often a test of a repository both passed and failed on
+// the same SHA, over the window it was computed for.
+type FlakyTest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Suite      string  `protobuf:"bytes,1,opt,name=suite,proto3" json:"suite,omitempty"`
+	Name       string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Score      float64 `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`          // flipped / shas: 0 is stable, 1 flipped on every SHA
+	Executions int64   `protobuf:"varint,4,opt,name=executions,proto3" json:"executions,omitempty"` // times the test passed or failed
+	Failures   int64   `protobuf:"varint,5,opt,name=failures,proto3" json:"failures,omitempty"`     // times the test failed or errored
+	Shas       int64   `protobuf:"varint,6,opt,name=shas,proto3" json:"shas,omitempty"`             // SHAs the test ran on
+	Flipped    int64   `protobuf:"varint,7,opt,name=flipped,proto3" json:"flipped,omitempty"`       // SHAs the test both passed and failed on
+}
+
+func (x *FlakyTest) Reset() {
+	*x = FlakyTest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlakyTest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlakyTest) ProtoMessage() {}
+
+func (x *FlakyTest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlakyTest.ProtoReflect.Descriptor instead.
+func (*FlakyTest) Descriptor() ([]byte, []int) {
+	return file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FlakyTest) GetSuite() string {
+	if x != nil {
+		return x.Suite
+	}
+	return ""
+}
+
+func (x *FlakyTest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FlakyTest) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *FlakyTest) GetExecutions() int64 {
+	if x != nil {
+		return x.Executions
+	}
+	return 0
+}
+
+func (x *FlakyTest) GetFailures() int64 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *FlakyTest) GetShas() int64 {
+	if x != nil {
+		return x.Shas
+	}
+	return 0
+}
+
+func (x *FlakyTest) GetFlipped() int64 {
+	if x != nil {
+		return x.Flipped
+	}
+	return 0
+}
+
+// FlakyTestList is a list of the flaky tests of a repository, most flaky first.
+type FlakyTestList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tests []*FlakyTest `protobuf:"bytes,1,rep,name=tests,proto3" json:"tests,omitempty"`
+}
+
+func (x *FlakyTestList) Reset() {
+	*x = FlakyTestList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlakyTestList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlakyTestList) ProtoMessage() {}
+
+func (x *FlakyTestList) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlakyTestList.ProtoReflect.Descriptor instead.
+func (*FlakyTestList) Descriptor() ([]byte, []int) {
+	return file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FlakyTestList) GetTests() []*FlakyTest {
+	if x != nil {
+		return x.Tests
+	}
+	return nil
+}
+
+var File_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto protoreflect.FileDescriptor
+
+var file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_rawDesc = []byte{
+	0x0a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x69, 0x6e,
+	0x79, 0x63, 0x69, 0x2f, 0x63, 0x69, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x69,
+	0x2d, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f,
+	0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x08, 0x54, 0x65, 0x73, 0x74, 0x43,
+	0x61, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x75, 0x69,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x75, 0x69, 0x74, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x53, 0x0a, 0x0c, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x09, 0x74, 0x65, 0x73, 0x74, 0x43,
+	0x61, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x09, 0x74, 0x65, 0x73,
+	0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x22, 0xd5, 0x01, 0x0a, 0x0b, 0x54, 0x65, 0x73, 0x74, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x22, 0xb5,
+	0x01, 0x0a, 0x09, 0x46, 0x6c, 0x61, 0x6b, 0x79, 0x54, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x75, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x75, 0x69,
+	0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x68, 0x61, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x68, 0x61, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x66, 0x6c, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x66,
+	0x6c, 0x69, 0x70, 0x70, 0x65, 0x64, 0x22, 0x37, 0x0a, 0x0d, 0x46, 0x6c, 0x61, 0x6b, 0x79, 0x54,
+	0x65, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x46,
+	0x6c, 0x61, 0x6b, 0x79, 0x54, 0x65, 0x73, 0x74, 0x52, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x42,
+	0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x69,
+	0x6e, 0x79, 0x63, 0x69, 0x2f, 0x63, 0x69, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x63,
+	0x69, 0x2d, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_rawDescOnce sync.Once
+	file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_rawDescData = file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_rawDesc
+)
+
+func file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_rawDescGZIP() []byte {
+	file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_rawDescOnce.Do(func() {
+		file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_rawDescData)
+	})
+	return file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_rawDescData
+}
+
+var file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_goTypes = []interface{}{
+	(*TestCase)(nil),      // 0: types.TestCase
+	(*TestCaseList)(nil),  // 1: types.TestCaseList
+	(*TestSummary)(nil),   // 2: types.TestSummary
+	(*FlakyTest)(nil),     // 3: types.FlakyTest
+	(*FlakyTestList)(nil), // 4: types.FlakyTestList
+}
+var file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_depIdxs = []int32{
+	0, // 0: types.TestCaseList.testCases:type_name -> types.TestCase
+	3, // 1: types.FlakyTestList.tests:type_name -> types.FlakyTest
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_init() }
+func file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_init() {
+	if File_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestCase); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestCaseList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlakyTest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlakyTestList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_goTypes,
+		DependencyIndexes: file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_depIdxs,
+		MessageInfos:      file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_msgTypes,
+	}.Build()
+	File_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto = out.File
+	file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_rawDesc = nil
+	file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_goTypes = nil
+	file_github_com_tinyci_ci_agents_ci_gen_grpc_types_test_case_proto_depIdxs = nil
+}
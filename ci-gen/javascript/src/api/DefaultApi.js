@@ -1185,6 +1185,7 @@ export default class DefaultApi {
      * @param {String} sha the sha or branch to be tested
      * @param {Object} opts Optional parameters
      * @param {Boolean} opts.all Run all tests instead of relying on diff selection to pick them.
+     * @param {Number} opts.priority Queue priority for the submitted runs; higher goes first. If unset, the repository's configured priority is used.
      * @param {module:api/DefaultApi~submitGetCallback} callback The callback function, accepting three arguments: error, data, response
      */
     submitGet(repository, sha, opts, callback) {
@@ -1204,7 +1205,8 @@ export default class DefaultApi {
       let queryParams = {
         'repository': repository,
         'sha': sha,
-        'all': opts['all']
+        'all': opts['all'],
+        'priority': opts['priority']
       };
       let headerParams = {
       };
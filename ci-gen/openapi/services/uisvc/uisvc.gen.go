@@ -28,12 +28,128 @@ const (
 	TokenScopes   = "token.Scopes"
 )
 
+// Defines values for ArtifactReport.
+const (
+	ArtifactReportGoTestJson ArtifactReport = "go-test-json"
+
+	ArtifactReportJunit ArtifactReport = "junit"
+)
+
+// Defines values for TestCaseStatus.
+const (
+	TestCaseStatusError TestCaseStatus = "error"
+
+	TestCaseStatusFailed TestCaseStatus = "failed"
+
+	TestCaseStatusPassed TestCaseStatus = "passed"
+
+	TestCaseStatusSkipped TestCaseStatus = "skipped"
+)
+
+// Artifact defines model for Artifact.
+type Artifact struct {
+	ContentType *string    `json:"content_type,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	Name        *string    `json:"name,omitempty"`
+
+	// The format of the test report the artifact is, if it is one.
+	Report *ArtifactReport `json:"report,omitempty"`
+	RunId  *int64          `json:"run_id,omitempty"`
+
+	// The hex-encoded sha256 sum of the content.
+	Sha256 *string `json:"sha256,omitempty"`
+
+	// The size of the content in bytes.
+	Size *int64 `json:"size,omitempty"`
+}
+
+// The format of the test report the artifact is, if it is one.
+type ArtifactReport string
+
+// ArtifactList defines model for ArtifactList.
+type ArtifactList []Artifact
+
 // Error defines model for Error.
 type Error struct {
 	Errors *[]string `json:"errors,omitempty"`
 	Log    *bool     `json:"log,omitempty"`
 }
 
+// FlakyTest defines model for FlakyTest.
+type FlakyTest struct {
+
+	// The times the test passed or failed.
+	Executions *int64 `json:"executions,omitempty"`
+
+	// The times the test failed or errored.
+	Failures *int64 `json:"failures,omitempty"`
+
+	// The SHAs the test both passed and failed on.
+	Flipped *int64  `json:"flipped,omitempty"`
+	Name    *string `json:"name,omitempty"`
+
+	// The share of SHAs the test ran on where it both passed and failed, from 0 to 1.
+	Score *float64 `json:"score,omitempty"`
+
+	// The SHAs the test ran on.
+	Shas  *int64  `json:"shas,omitempty"`
+	Suite *string `json:"suite,omitempty"`
+}
+
+// FlakyTestList defines model for FlakyTestList.
+type FlakyTestList []FlakyTest
+
+// LogMatch defines model for LogMatch.
+type LogMatch struct {
+	After  *[]string `json:"after,omitempty"`
+	Before *[]string `json:"before,omitempty"`
+
+	// The line number of the match within the log, starting at 1.
+	Line  *int64  `json:"line,omitempty"`
+	RunId *int64  `json:"run_id,omitempty"`
+	Text  *string `json:"text,omitempty"`
+}
+
+// LogSearchResult defines model for LogSearchResult.
+type LogSearchResult struct {
+	Matches *[]LogMatch `json:"matches,omitempty"`
+
+	// True if the limit was reached before all the logs were searched.
+	Truncated *bool `json:"truncated,omitempty"`
+}
+
+// LogSection defines model for LogSection.
+type LogSection struct {
+
+	// The duration of the section in seconds; null while it is still being written.
+	Duration *float64 `json:"duration"`
+
+	// When the section ended; null while it is still being written.
+	FinishedAt *time.Time `json:"finished_at"`
+
+	// The length of the section in bytes.
+	Length *int64 `json:"length,omitempty"`
+
+	// The line number of the section's first line, starting at 1.
+	Line *int64 `json:"line,omitempty"`
+
+	// The number of lines in the section.
+	Lines *int64  `json:"lines,omitempty"`
+	Name  *string `json:"name,omitempty"`
+
+	// The byte offset of the section's first line.
+	Offset    *int64     `json:"offset,omitempty"`
+	StartedAt *time.Time `json:"started_at,omitempty"`
+}
+
+// LogSections defines model for LogSections.
+type LogSections struct {
+
+	// True if the log has finished writing, so the sections will not change.
+	Complete *bool         `json:"complete,omitempty"`
+	Sections *[]LogSection `json:"sections,omitempty"`
+}
+
 // ModelSubmission defines model for ModelSubmission.
 type ModelSubmission struct {
 	BaseRef    *Ref       `json:"base_ref,omitempty"`
@@ -98,15 +214,24 @@ type Resources struct {
 
 // Run defines model for Run.
 type Run struct {
-	CreatedAt  *time.Time   `json:"created_at,omitempty"`
-	FinishedAt *time.Time   `json:"finished_at"`
-	Id         *int64       `json:"id,omitempty"`
-	Name       *string      `json:"name,omitempty"`
-	RanOn      *string      `json:"ran_on"`
-	Settings   *RunSettings `json:"settings,omitempty"`
-	StartedAt  *time.Time   `json:"started_at"`
-	Status     *bool        `json:"status"`
-	Task       *Task        `json:"task,omitempty"`
+
+	// which attempt of the run this is, starting at 1.
+	Attempt   *int64     `json:"attempt,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// why the run failed, if known: failure, infra_error, timeout (it exceeded its timeout), expired (it waited in the queue for too long) or start_error (it could not be started once claimed).
+	FailureReason *string    `json:"failure_reason"`
+	FinishedAt    *time.Time `json:"finished_at"`
+	Id            *int64     `json:"id,omitempty"`
+	Name          *string    `json:"name,omitempty"`
+
+	// the ID of the failed attempt this run retries, if any.
+	PreviousAttemptId *int64       `json:"previous_attempt_id"`
+	RanOn             *string      `json:"ran_on"`
+	Settings          *RunSettings `json:"settings,omitempty"`
+	StartedAt         *time.Time   `json:"started_at"`
+	Status            *bool        `json:"status"`
+	Task              *Task        `json:"task,omitempty"`
 }
 
 // RunList defines model for RunList.
@@ -128,13 +253,30 @@ type RunSettings_Metadata struct {
 	AdditionalProperties map[string]interface{} `json:"-"`
 }
 
+// Runner defines model for Runner.
+type Runner struct {
+	Cordoned      *bool      `json:"cordoned,omitempty"`
+	Draining      *bool      `json:"draining,omitempty"`
+	Labels        *[]string  `json:"labels,omitempty"`
+	LastHeartbeat *time.Time `json:"last_heartbeat,omitempty"`
+	Name          *string    `json:"name,omitempty"`
+	QueueName     *string    `json:"queue_name,omitempty"`
+	RegisteredAt  *time.Time `json:"registered_at,omitempty"`
+}
+
+// RunnerList defines model for RunnerList.
+type RunnerList []Runner
+
 // Task defines model for Task.
 type Task struct {
-	Canceled   *bool            `json:"canceled,omitempty"`
-	CreatedAt  *time.Time       `json:"created_at,omitempty"`
-	FinishedAt *time.Time       `json:"finished_at"`
-	Id         *int64           `json:"id,omitempty"`
-	Path       *string          `json:"path,omitempty"`
+	Canceled   *bool      `json:"canceled,omitempty"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at"`
+	Id         *int64     `json:"id,omitempty"`
+	Path       *string    `json:"path,omitempty"`
+
+	// the ID of the task this task re-runs, if any.
+	RerunOfId  *int64           `json:"rerun_of_id"`
 	Runs       *int64           `json:"runs,omitempty"`
 	Settings   *TaskSettings    `json:"settings,omitempty"`
 	StartedAt  *time.Time       `json:"started_at"`
@@ -166,6 +308,43 @@ type TaskSettings_Runs struct {
 	AdditionalProperties map[string]RunSettings `json:"-"`
 }
 
+// TestCase defines model for TestCase.
+type TestCase struct {
+
+	// The duration of the test in seconds.
+	Duration *float64 `json:"duration,omitempty"`
+	Id       *int64   `json:"id,omitempty"`
+
+	// The failure or skip message of the test.
+	Message *string         `json:"message,omitempty"`
+	Name    *string         `json:"name,omitempty"`
+	RunId   *int64          `json:"run_id,omitempty"`
+	Status  *TestCaseStatus `json:"status,omitempty"`
+	Suite   *string         `json:"suite,omitempty"`
+}
+
+// TestCaseStatus defines model for TestCase.Status.
+type TestCaseStatus string
+
+// TestCaseList defines model for TestCaseList.
+type TestCaseList []TestCase
+
+// TestSummary defines model for TestSummary.
+type TestSummary struct {
+
+	// The sum of the durations of the tests in seconds.
+	Duration *float64 `json:"duration,omitempty"`
+	Errored  *int64   `json:"errored,omitempty"`
+	Failed   *int64   `json:"failed,omitempty"`
+
+	// The names of the first tests which failed or errored.
+	Failures *[]string `json:"failures,omitempty"`
+	Passed   *int64    `json:"passed,omitempty"`
+	RunId    *int64    `json:"run_id,omitempty"`
+	Skipped  *int64    `json:"skipped,omitempty"`
+	Total    *int64    `json:"total,omitempty"`
+}
+
 // User defines model for User.
 type User struct {
 	Errors           *[]UserError `json:"errors,omitempty"`
@@ -186,6 +365,19 @@ type UserError struct {
 	Id    *int64  `json:"id,omitempty"`
 }
 
+// GetLogAttachIdParams defines parameters for GetLogAttachId.
+type GetLogAttachIdParams struct {
+
+	// The byte offset to start reading the log from.
+	Offset *int64 `json:"offset,omitempty"`
+
+	// The most bytes of the log to read.
+	Limit *int64 `json:"limit,omitempty"`
+
+	// Start from the last N lines of the log, instead of the offset.
+	Tail *int64 `json:"tail,omitempty"`
+}
+
 // GetLoginParams defines parameters for GetLogin.
 type GetLoginParams struct {
 
@@ -196,6 +388,28 @@ type GetLoginParams struct {
 	State string `json:"state"`
 }
 
+// GetLogsSearchParams defines parameters for GetLogsSearch.
+type GetLogsSearchParams struct {
+
+	// The regular expression (RE2 syntax) to match lines against.
+	Pattern string `json:"pattern"`
+
+	// optional; the repository name to search the logs of.
+	Repository *string `json:"repository,omitempty"`
+
+	// optional; search logs written after this time. Defaults to a day before `until`.
+	Since *time.Time `json:"since,omitempty"`
+
+	// optional; search logs written before this time. Defaults to now.
+	Until *time.Time `json:"until,omitempty"`
+
+	// The number of lines to include before and after each match.
+	Context *int64 `json:"context,omitempty"`
+
+	// The most matches to return.
+	Limit *int64 `json:"limit,omitempty"`
+}
+
 // GetRepositoriesMyParams defines parameters for GetRepositoriesMy.
 type GetRepositoriesMyParams struct {
 
@@ -217,6 +431,23 @@ type GetRepositoriesVisibleParams struct {
 	Search *string `json:"search,omitempty"`
 }
 
+// GetRepositoriesOwnerRepoFlakyParams defines parameters for GetRepositoriesOwnerRepoFlaky.
+type GetRepositoriesOwnerRepoFlakyParams struct {
+
+	// The number of days, ending now, to look for flaky tests in.
+	Days *int64 `json:"days,omitempty"`
+}
+
+// GetRunRunIdTestsParams defines parameters for GetRunRunIdTests.
+type GetRunRunIdTestsParams struct {
+
+	// Only list the test cases with this status.
+	Status *GetRunRunIdTestsParamsStatus `json:"status,omitempty"`
+}
+
+// GetRunRunIdTestsParamsStatus defines parameters for GetRunRunIdTests.
+type GetRunRunIdTestsParamsStatus string
+
 // GetRunsParams defines parameters for GetRuns.
 type GetRunsParams struct {
 
@@ -296,6 +527,9 @@ type GetSubmitParams struct {
 
 	// Run all tests instead of relying on diff selection to pick them.
 	All *bool `json:"all,omitempty"`
+
+	// Queue priority for the submitted runs; higher goes first. If unset, the repository's configured priority is used. Raising it above the repository's priority requires the 'priority' capability.
+	Priority *int32 `json:"priority,omitempty"`
 }
 
 // GetTasksParams defines parameters for GetTasks.
@@ -629,6 +863,12 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetArtifactsRunId request
+	GetArtifactsRunId(ctx context.Context, runId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetArtifactsRunIdName request
+	GetArtifactsRunIdName(ctx context.Context, runId int64, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostCancelRunId request
 	PostCancelRunId(ctx context.Context, runId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -642,7 +882,10 @@ type ClientInterface interface {
 	GetErrors(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetLogAttachId request
-	GetLogAttachId(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetLogAttachId(ctx context.Context, id int64, params *GetLogAttachIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLogSectionsId request
+	GetLogSectionsId(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetLoggedin request
 	GetLoggedin(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -656,6 +899,9 @@ type ClientInterface interface {
 	// GetLogout request
 	GetLogout(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetLogsSearch request
+	GetLogsSearch(ctx context.Context, params *GetLogsSearchParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetRepositoriesCiAddOwnerRepo request
 	GetRepositoriesCiAddOwnerRepo(ctx context.Context, owner string, repo string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -680,9 +926,33 @@ type ClientInterface interface {
 	// GetRepositoriesVisible request
 	GetRepositoriesVisible(ctx context.Context, params *GetRepositoriesVisibleParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetRepositoriesOwnerRepoFlaky request
+	GetRepositoriesOwnerRepoFlaky(ctx context.Context, owner string, repo string, params *GetRepositoriesOwnerRepoFlakyParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRerunRunId request
+	PostRerunRunId(ctx context.Context, runId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetRunRunId request
 	GetRunRunId(ctx context.Context, runId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetRunRunIdTests request
+	GetRunRunIdTests(ctx context.Context, runId int64, params *GetRunRunIdTestsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRunRunIdTestsSummary request
+	GetRunRunIdTestsSummary(ctx context.Context, runId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRunners request
+	GetRunners(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRunnersCordonName request
+	PostRunnersCordonName(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRunnersDrainName request
+	PostRunnersDrainName(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRunnersUncordonName request
+	PostRunnersUncordonName(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetRuns request
 	GetRuns(ctx context.Context, params *GetRunsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -695,6 +965,9 @@ type ClientInterface interface {
 	// PostSubmissionIdCancel request
 	PostSubmissionIdCancel(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostSubmissionIdRerun request
+	PostSubmissionIdRerun(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetSubmissionIdRuns request
 	GetSubmissionIdRuns(ctx context.Context, id int64, params *GetSubmissionIdRunsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -719,6 +992,9 @@ type ClientInterface interface {
 	// GetTasksCount request
 	GetTasksCount(ctx context.Context, params *GetTasksCountParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostTasksRerunId request
+	PostTasksRerunId(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetTasksRunsId request
 	GetTasksRunsId(ctx context.Context, id int64, params *GetTasksRunsIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -738,6 +1014,30 @@ type ClientInterface interface {
 	GetUserProperties(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
+func (c *Client) GetArtifactsRunId(ctx context.Context, runId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetArtifactsRunIdRequest(c.Server, runId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetArtifactsRunIdName(ctx context.Context, runId int64, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetArtifactsRunIdNameRequest(c.Server, runId, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) PostCancelRunId(ctx context.Context, runId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewPostCancelRunIdRequest(c.Server, runId)
 	if err != nil {
@@ -786,8 +1086,20 @@ func (c *Client) GetErrors(ctx context.Context, reqEditors ...RequestEditorFn) (
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetLogAttachId(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetLogAttachIdRequest(c.Server, id)
+func (c *Client) GetLogAttachId(ctx context.Context, id int64, params *GetLogAttachIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLogAttachIdRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetLogSectionsId(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLogSectionsIdRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -846,6 +1158,18 @@ func (c *Client) GetLogout(ctx context.Context, reqEditors ...RequestEditorFn) (
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetLogsSearch(ctx context.Context, params *GetLogsSearchParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLogsSearchRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetRepositoriesCiAddOwnerRepo(ctx context.Context, owner string, repo string, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetRepositoriesCiAddOwnerRepoRequest(c.Server, owner, repo)
 	if err != nil {
@@ -942,6 +1266,30 @@ func (c *Client) GetRepositoriesVisible(ctx context.Context, params *GetReposito
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetRepositoriesOwnerRepoFlaky(ctx context.Context, owner string, repo string, params *GetRepositoriesOwnerRepoFlakyParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRepositoriesOwnerRepoFlakyRequest(c.Server, owner, repo, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRerunRunId(ctx context.Context, runId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRerunRunIdRequest(c.Server, runId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetRunRunId(ctx context.Context, runId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetRunRunIdRequest(c.Server, runId)
 	if err != nil {
@@ -954,6 +1302,78 @@ func (c *Client) GetRunRunId(ctx context.Context, runId int64, reqEditors ...Req
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetRunRunIdTests(ctx context.Context, runId int64, params *GetRunRunIdTestsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRunRunIdTestsRequest(c.Server, runId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRunRunIdTestsSummary(ctx context.Context, runId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRunRunIdTestsSummaryRequest(c.Server, runId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRunners(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRunnersRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRunnersCordonName(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRunnersCordonNameRequest(c.Server, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRunnersDrainName(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRunnersDrainNameRequest(c.Server, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRunnersUncordonName(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRunnersUncordonNameRequest(c.Server, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetRuns(ctx context.Context, params *GetRunsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetRunsRequest(c.Server, params)
 	if err != nil {
@@ -1002,6 +1422,18 @@ func (c *Client) PostSubmissionIdCancel(ctx context.Context, id int64, reqEditor
 	return c.Client.Do(req)
 }
 
+func (c *Client) PostSubmissionIdRerun(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSubmissionIdRerunRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetSubmissionIdRuns(ctx context.Context, id int64, params *GetSubmissionIdRunsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetSubmissionIdRunsRequest(c.Server, id, params)
 	if err != nil {
@@ -1098,6 +1530,18 @@ func (c *Client) GetTasksCount(ctx context.Context, params *GetTasksCountParams,
 	return c.Client.Do(req)
 }
 
+func (c *Client) PostTasksRerunId(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTasksRerunIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetTasksRunsId(ctx context.Context, id int64, params *GetTasksRunsIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetTasksRunsIdRequest(c.Server, id, params)
 	if err != nil {
@@ -1170,8 +1614,8 @@ func (c *Client) GetUserProperties(ctx context.Context, reqEditors ...RequestEdi
 	return c.Client.Do(req)
 }
 
-// NewPostCancelRunIdRequest generates requests for PostCancelRunId
-func NewPostCancelRunIdRequest(server string, runId int64) (*http.Request, error) {
+// NewGetArtifactsRunIdRequest generates requests for GetArtifactsRunId
+func NewGetArtifactsRunIdRequest(server string, runId int64) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1186,7 +1630,7 @@ func NewPostCancelRunIdRequest(server string, runId int64) (*http.Request, error
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/cancel/%s", pathParam0)
+	operationPath := fmt.Sprintf("/artifacts/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -1196,7 +1640,7 @@ func NewPostCancelRunIdRequest(server string, runId int64) (*http.Request, error
 
 	queryURL := serverURL.ResolveReference(&operationURL)
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1204,20 +1648,20 @@ func NewPostCancelRunIdRequest(server string, runId int64) (*http.Request, error
 	return req, nil
 }
 
-// NewDeleteCapabilitiesUsernameCapabilityRequest generates requests for DeleteCapabilitiesUsernameCapability
-func NewDeleteCapabilitiesUsernameCapabilityRequest(server string, username string, capability string) (*http.Request, error) {
+// NewGetArtifactsRunIdNameRequest generates requests for GetArtifactsRunIdName
+func NewGetArtifactsRunIdNameRequest(server string, runId int64, name string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "run_id", runtime.ParamLocationPath, runId)
 	if err != nil {
 		return nil, err
 	}
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "capability", runtime.ParamLocationPath, capability)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
@@ -1227,7 +1671,7 @@ func NewDeleteCapabilitiesUsernameCapabilityRequest(server string, username stri
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/capabilities/%s/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/artifacts/%s/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -1237,7 +1681,7 @@ func NewDeleteCapabilitiesUsernameCapabilityRequest(server string, username stri
 
 	queryURL := serverURL.ResolveReference(&operationURL)
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1245,20 +1689,13 @@ func NewDeleteCapabilitiesUsernameCapabilityRequest(server string, username stri
 	return req, nil
 }
 
-// NewPostCapabilitiesUsernameCapabilityRequest generates requests for PostCapabilitiesUsernameCapability
-func NewPostCapabilitiesUsernameCapabilityRequest(server string, username string, capability string) (*http.Request, error) {
-	var err error
+// NewPostCancelRunIdRequest generates requests for PostCancelRunId
+func NewPostCancelRunIdRequest(server string, runId int64) (*http.Request, error) {
+	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "capability", runtime.ParamLocationPath, capability)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "run_id", runtime.ParamLocationPath, runId)
 	if err != nil {
 		return nil, err
 	}
@@ -1268,7 +1705,7 @@ func NewPostCapabilitiesUsernameCapabilityRequest(server string, username string
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/capabilities/%s/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/cancel/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -1286,16 +1723,30 @@ func NewPostCapabilitiesUsernameCapabilityRequest(server string, username string
 	return req, nil
 }
 
-// NewGetErrorsRequest generates requests for GetErrors
-func NewGetErrorsRequest(server string) (*http.Request, error) {
+// NewDeleteCapabilitiesUsernameCapabilityRequest generates requests for DeleteCapabilitiesUsernameCapability
+func NewDeleteCapabilitiesUsernameCapabilityRequest(server string, username string, capability string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "capability", runtime.ParamLocationPath, capability)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/errors")
+	operationPath := fmt.Sprintf("/capabilities/%s/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -1305,7 +1756,7 @@ func NewGetErrorsRequest(server string) (*http.Request, error) {
 
 	queryURL := serverURL.ResolveReference(&operationURL)
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1313,13 +1764,20 @@ func NewGetErrorsRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetLogAttachIdRequest generates requests for GetLogAttachId
-func NewGetLogAttachIdRequest(server string, id int64) (*http.Request, error) {
+// NewPostCapabilitiesUsernameCapabilityRequest generates requests for PostCapabilitiesUsernameCapability
+func NewPostCapabilitiesUsernameCapabilityRequest(server string, username string, capability string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "capability", runtime.ParamLocationPath, capability)
 	if err != nil {
 		return nil, err
 	}
@@ -1329,7 +1787,7 @@ func NewGetLogAttachIdRequest(server string, id int64) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/log/attach/%s", pathParam0)
+	operationPath := fmt.Sprintf("/capabilities/%s/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -1339,7 +1797,7 @@ func NewGetLogAttachIdRequest(server string, id int64) (*http.Request, error) {
 
 	queryURL := serverURL.ResolveReference(&operationURL)
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1347,8 +1805,8 @@ func NewGetLogAttachIdRequest(server string, id int64) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetLoggedinRequest generates requests for GetLoggedin
-func NewGetLoggedinRequest(server string) (*http.Request, error) {
+// NewGetErrorsRequest generates requests for GetErrors
+func NewGetErrorsRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1356,7 +1814,7 @@ func NewGetLoggedinRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/loggedin")
+	operationPath := fmt.Sprintf("/errors")
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -1374,16 +1832,23 @@ func NewGetLoggedinRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetLoginRequest generates requests for GetLogin
-func NewGetLoginRequest(server string, params *GetLoginParams) (*http.Request, error) {
+// NewGetLogAttachIdRequest generates requests for GetLogAttachId
+func NewGetLogAttachIdRequest(server string, id int64, params *GetLogAttachIdParams) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/login")
+	operationPath := fmt.Sprintf("/log/attach/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -1395,28 +1860,52 @@ func NewGetLoginRequest(server string, params *GetLoginParams) (*http.Request, e
 
 	queryValues := queryURL.Query()
 
-	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "code", runtime.ParamLocationQuery, params.Code); err != nil {
-		return nil, err
-	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-		return nil, err
-	} else {
-		for k, v := range parsed {
-			for _, v2 := range v {
-				queryValues.Add(k, v2)
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
 			}
 		}
+
 	}
 
-	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "state", runtime.ParamLocationQuery, params.State); err != nil {
-		return nil, err
-	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-		return nil, err
-	} else {
-		for k, v := range parsed {
-			for _, v2 := range v {
-				queryValues.Add(k, v2)
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Tail != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tail", runtime.ParamLocationQuery, *params.Tail); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
 			}
 		}
+
 	}
 
 	queryURL.RawQuery = queryValues.Encode()
@@ -1429,16 +1918,23 @@ func NewGetLoginRequest(server string, params *GetLoginParams) (*http.Request, e
 	return req, nil
 }
 
-// NewGetLoginUpgradeRequest generates requests for GetLoginUpgrade
-func NewGetLoginUpgradeRequest(server string) (*http.Request, error) {
+// NewGetLogSectionsIdRequest generates requests for GetLogSectionsId
+func NewGetLogSectionsIdRequest(server string, id int64) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/login/upgrade")
+	operationPath := fmt.Sprintf("/log/sections/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -1456,8 +1952,8 @@ func NewGetLoginUpgradeRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetLogoutRequest generates requests for GetLogout
-func NewGetLogoutRequest(server string) (*http.Request, error) {
+// NewGetLoggedinRequest generates requests for GetLoggedin
+func NewGetLoggedinRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1465,7 +1961,7 @@ func NewGetLogoutRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/logout")
+	operationPath := fmt.Sprintf("/loggedin")
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -1483,30 +1979,16 @@ func NewGetLogoutRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetRepositoriesCiAddOwnerRepoRequest generates requests for GetRepositoriesCiAddOwnerRepo
-func NewGetRepositoriesCiAddOwnerRepoRequest(server string, owner string, repo string) (*http.Request, error) {
+// NewGetLoginRequest generates requests for GetLogin
+func NewGetLoginRequest(server string, params *GetLoginParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/repositories/ci/add/%s/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/login")
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -1516,46 +1998,33 @@ func NewGetRepositoriesCiAddOwnerRepoRequest(server string, owner string, repo s
 
 	queryURL := serverURL.ResolveReference(&operationURL)
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewGetRepositoriesCiDelOwnerRepoRequest generates requests for GetRepositoriesCiDelOwnerRepo
-func NewGetRepositoriesCiDelOwnerRepoRequest(server string, owner string, repo string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
+	queryValues := queryURL.Query()
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
-	if err != nil {
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "code", runtime.ParamLocationQuery, params.Code); err != nil {
 		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
-	if err != nil {
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "state", runtime.ParamLocationQuery, params.State); err != nil {
 		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
 	}
 
-	operationPath := fmt.Sprintf("/repositories/ci/del/%s/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = operationPath[1:]
-	}
-	operationURL := url.URL{
-		Path: operationPath,
-	}
-
-	queryURL := serverURL.ResolveReference(&operationURL)
+	queryURL.RawQuery = queryValues.Encode()
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
@@ -1565,8 +2034,8 @@ func NewGetRepositoriesCiDelOwnerRepoRequest(server string, owner string, repo s
 	return req, nil
 }
 
-// NewGetRepositoriesMyRequest generates requests for GetRepositoriesMy
-func NewGetRepositoriesMyRequest(server string, params *GetRepositoriesMyParams) (*http.Request, error) {
+// NewGetLoginUpgradeRequest generates requests for GetLoginUpgrade
+func NewGetLoginUpgradeRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1574,7 +2043,7 @@ func NewGetRepositoriesMyRequest(server string, params *GetRepositoriesMyParams)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/repositories/my")
+	operationPath := fmt.Sprintf("/login/upgrade")
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -1584,26 +2053,6 @@ func NewGetRepositoriesMyRequest(server string, params *GetRepositoriesMyParams)
 
 	queryURL := serverURL.ResolveReference(&operationURL)
 
-	queryValues := queryURL.Query()
-
-	if params.Search != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
-
-	queryURL.RawQuery = queryValues.Encode()
-
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1612,8 +2061,8 @@ func NewGetRepositoriesMyRequest(server string, params *GetRepositoriesMyParams)
 	return req, nil
 }
 
-// NewGetRepositoriesScanRequest generates requests for GetRepositoriesScan
-func NewGetRepositoriesScanRequest(server string) (*http.Request, error) {
+// NewGetLogoutRequest generates requests for GetLogout
+func NewGetLogoutRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1621,7 +2070,7 @@ func NewGetRepositoriesScanRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/repositories/scan")
+	operationPath := fmt.Sprintf("/logout")
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -1639,30 +2088,16 @@ func NewGetRepositoriesScanRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetRepositoriesSubAddOwnerRepoRequest generates requests for GetRepositoriesSubAddOwnerRepo
-func NewGetRepositoriesSubAddOwnerRepoRequest(server string, owner string, repo string) (*http.Request, error) {
+// NewGetLogsSearchRequest generates requests for GetLogsSearch
+func NewGetLogsSearchRequest(server string, params *GetLogsSearchParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/repositories/sub/add/%s/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/logs/search")
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -1672,6 +2107,102 @@ func NewGetRepositoriesSubAddOwnerRepoRequest(server string, owner string, repo
 
 	queryURL := serverURL.ResolveReference(&operationURL)
 
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pattern", runtime.ParamLocationQuery, params.Pattern); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if params.Repository != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "repository", runtime.ParamLocationQuery, *params.Repository); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Since != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Until != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until", runtime.ParamLocationQuery, *params.Until); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Context != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "context", runtime.ParamLocationQuery, *params.Context); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1680,8 +2211,8 @@ func NewGetRepositoriesSubAddOwnerRepoRequest(server string, owner string, repo
 	return req, nil
 }
 
-// NewGetRepositoriesSubDelOwnerRepoRequest generates requests for GetRepositoriesSubDelOwnerRepo
-func NewGetRepositoriesSubDelOwnerRepoRequest(server string, owner string, repo string) (*http.Request, error) {
+// NewGetRepositoriesCiAddOwnerRepoRequest generates requests for GetRepositoriesCiAddOwnerRepo
+func NewGetRepositoriesCiAddOwnerRepoRequest(server string, owner string, repo string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1703,7 +2234,7 @@ func NewGetRepositoriesSubDelOwnerRepoRequest(server string, owner string, repo
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/repositories/sub/del/%s/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/repositories/ci/add/%s/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -1721,16 +2252,57 @@ func NewGetRepositoriesSubDelOwnerRepoRequest(server string, owner string, repo
 	return req, nil
 }
 
-// NewGetRepositoriesSubscribedRequest generates requests for GetRepositoriesSubscribed
-func NewGetRepositoriesSubscribedRequest(server string, params *GetRepositoriesSubscribedParams) (*http.Request, error) {
+// NewGetRepositoriesCiDelOwnerRepoRequest generates requests for GetRepositoriesCiDelOwnerRepo
+func NewGetRepositoriesCiDelOwnerRepoRequest(server string, owner string, repo string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/repositories/subscribed")
+	operationPath := fmt.Sprintf("/repositories/ci/del/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRepositoriesMyRequest generates requests for GetRepositoriesMy
+func NewGetRepositoriesMyRequest(server string, params *GetRepositoriesMyParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/repositories/my")
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -1768,8 +2340,8 @@ func NewGetRepositoriesSubscribedRequest(server string, params *GetRepositoriesS
 	return req, nil
 }
 
-// NewGetRepositoriesVisibleRequest generates requests for GetRepositoriesVisible
-func NewGetRepositoriesVisibleRequest(server string, params *GetRepositoriesVisibleParams) (*http.Request, error) {
+// NewGetRepositoriesScanRequest generates requests for GetRepositoriesScan
+func NewGetRepositoriesScanRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1777,7 +2349,7 @@ func NewGetRepositoriesVisibleRequest(server string, params *GetRepositoriesVisi
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/repositories/visible")
+	operationPath := fmt.Sprintf("/repositories/scan")
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -1787,25 +2359,46 @@ func NewGetRepositoriesVisibleRequest(server string, params *GetRepositoriesVisi
 
 	queryURL := serverURL.ResolveReference(&operationURL)
 
-	queryValues := queryURL.Query()
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	if params.Search != nil {
+	return req, nil
+}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+// NewGetRepositoriesSubAddOwnerRepoRequest generates requests for GetRepositoriesSubAddOwnerRepo
+func NewGetRepositoriesSubAddOwnerRepoRequest(server string, owner string, repo string) (*http.Request, error) {
+	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
 	}
 
-	queryURL.RawQuery = queryValues.Encode()
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/repositories/sub/add/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
@@ -1815,13 +2408,20 @@ func NewGetRepositoriesVisibleRequest(server string, params *GetRepositoriesVisi
 	return req, nil
 }
 
-// NewGetRunRunIdRequest generates requests for GetRunRunId
-func NewGetRunRunIdRequest(server string, runId int64) (*http.Request, error) {
+// NewGetRepositoriesSubDelOwnerRepoRequest generates requests for GetRepositoriesSubDelOwnerRepo
+func NewGetRepositoriesSubDelOwnerRepoRequest(server string, owner string, repo string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "run_id", runtime.ParamLocationPath, runId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
 	if err != nil {
 		return nil, err
 	}
@@ -1831,7 +2431,7 @@ func NewGetRunRunIdRequest(server string, runId int64) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/run/%s", pathParam0)
+	operationPath := fmt.Sprintf("/repositories/sub/del/%s/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -1849,8 +2449,8 @@ func NewGetRunRunIdRequest(server string, runId int64) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetRunsRequest generates requests for GetRuns
-func NewGetRunsRequest(server string, params *GetRunsParams) (*http.Request, error) {
+// NewGetRepositoriesSubscribedRequest generates requests for GetRepositoriesSubscribed
+func NewGetRepositoriesSubscribedRequest(server string, params *GetRepositoriesSubscribedParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1858,7 +2458,7 @@ func NewGetRunsRequest(server string, params *GetRunsParams) (*http.Request, err
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/runs")
+	operationPath := fmt.Sprintf("/repositories/subscribed")
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -1870,9 +2470,9 @@ func NewGetRunsRequest(server string, params *GetRunsParams) (*http.Request, err
 
 	queryValues := queryURL.Query()
 
-	if params.Page != nil {
+	if params.Search != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -1886,41 +2486,40 @@ func NewGetRunsRequest(server string, params *GetRunsParams) (*http.Request, err
 
 	}
 
-	if params.PerPage != nil {
+	queryURL.RawQuery = queryValues.Encode()
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "perPage", runtime.ParamLocationQuery, *params.PerPage); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
-
-	if params.Repository != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "repository", runtime.ParamLocationQuery, *params.Repository); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
-
-	if params.Sha != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sha", runtime.ParamLocationQuery, *params.Sha); err != nil {
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRepositoriesVisibleRequest generates requests for GetRepositoriesVisible
+func NewGetRepositoriesVisibleRequest(server string, params *GetRepositoriesVisibleParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/repositories/visible")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	queryValues := queryURL.Query()
+
+	if params.Search != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -1944,16 +2543,30 @@ func NewGetRunsRequest(server string, params *GetRunsParams) (*http.Request, err
 	return req, nil
 }
 
-// NewGetRunsCountRequest generates requests for GetRunsCount
-func NewGetRunsCountRequest(server string, params *GetRunsCountParams) (*http.Request, error) {
+// NewGetRepositoriesOwnerRepoFlakyRequest generates requests for GetRepositoriesOwnerRepoFlaky
+func NewGetRepositoriesOwnerRepoFlakyRequest(server string, owner string, repo string, params *GetRepositoriesOwnerRepoFlakyParams) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "owner", runtime.ParamLocationPath, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "repo", runtime.ParamLocationPath, repo)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/runs/count")
+	operationPath := fmt.Sprintf("/repositories/%s/%s/flaky", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -1965,25 +2578,9 @@ func NewGetRunsCountRequest(server string, params *GetRunsCountParams) (*http.Re
 
 	queryValues := queryURL.Query()
 
-	if params.Repository != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "repository", runtime.ParamLocationQuery, *params.Repository); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
-
-	if params.Sha != nil {
+	if params.Days != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sha", runtime.ParamLocationQuery, *params.Sha); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "days", runtime.ParamLocationQuery, *params.Days); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -2007,13 +2604,13 @@ func NewGetRunsCountRequest(server string, params *GetRunsCountParams) (*http.Re
 	return req, nil
 }
 
-// NewGetSubmissionIdRequest generates requests for GetSubmissionId
-func NewGetSubmissionIdRequest(server string, id int64) (*http.Request, error) {
+// NewPostRerunRunIdRequest generates requests for PostRerunRunId
+func NewPostRerunRunIdRequest(server string, runId int64) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "run_id", runtime.ParamLocationPath, runId)
 	if err != nil {
 		return nil, err
 	}
@@ -2023,7 +2620,7 @@ func NewGetSubmissionIdRequest(server string, id int64) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/submission/%s", pathParam0)
+	operationPath := fmt.Sprintf("/rerun/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -2033,7 +2630,7 @@ func NewGetSubmissionIdRequest(server string, id int64) (*http.Request, error) {
 
 	queryURL := serverURL.ResolveReference(&operationURL)
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2041,13 +2638,13 @@ func NewGetSubmissionIdRequest(server string, id int64) (*http.Request, error) {
 	return req, nil
 }
 
-// NewPostSubmissionIdCancelRequest generates requests for PostSubmissionIdCancel
-func NewPostSubmissionIdCancelRequest(server string, id int64) (*http.Request, error) {
+// NewGetRunRunIdRequest generates requests for GetRunRunId
+func NewGetRunRunIdRequest(server string, runId int64) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "run_id", runtime.ParamLocationPath, runId)
 	if err != nil {
 		return nil, err
 	}
@@ -2057,7 +2654,7 @@ func NewPostSubmissionIdCancelRequest(server string, id int64) (*http.Request, e
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/submission/%s/cancel", pathParam0)
+	operationPath := fmt.Sprintf("/run/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -2067,7 +2664,7 @@ func NewPostSubmissionIdCancelRequest(server string, id int64) (*http.Request, e
 
 	queryURL := serverURL.ResolveReference(&operationURL)
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2075,13 +2672,13 @@ func NewPostSubmissionIdCancelRequest(server string, id int64) (*http.Request, e
 	return req, nil
 }
 
-// NewGetSubmissionIdRunsRequest generates requests for GetSubmissionIdRuns
-func NewGetSubmissionIdRunsRequest(server string, id int64, params *GetSubmissionIdRunsParams) (*http.Request, error) {
+// NewGetRunRunIdTestsRequest generates requests for GetRunRunIdTests
+func NewGetRunRunIdTestsRequest(server string, runId int64, params *GetRunRunIdTestsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "run_id", runtime.ParamLocationPath, runId)
 	if err != nil {
 		return nil, err
 	}
@@ -2091,7 +2688,7 @@ func NewGetSubmissionIdRunsRequest(server string, id int64, params *GetSubmissio
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/submission/%s/runs", pathParam0)
+	operationPath := fmt.Sprintf("/run/%s/tests", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -2103,9 +2700,9 @@ func NewGetSubmissionIdRunsRequest(server string, id int64, params *GetSubmissio
 
 	queryValues := queryURL.Query()
 
-	if params.Page != nil {
+	if params.Status != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -2119,23 +2716,41 @@ func NewGetSubmissionIdRunsRequest(server string, id int64, params *GetSubmissio
 
 	}
 
-	if params.PerPage != nil {
+	queryURL.RawQuery = queryValues.Encode()
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "perPage", runtime.ParamLocationQuery, *params.PerPage); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRunRunIdTestsSummaryRequest generates requests for GetRunRunIdTestsSummary
+func NewGetRunRunIdTestsSummaryRequest(server string, runId int64) (*http.Request, error) {
+	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "run_id", runtime.ParamLocationPath, runId)
+	if err != nil {
+		return nil, err
 	}
 
-	queryURL.RawQuery = queryValues.Encode()
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/run/%s/tests/summary", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
@@ -2145,13 +2760,40 @@ func NewGetSubmissionIdRunsRequest(server string, id int64, params *GetSubmissio
 	return req, nil
 }
 
-// NewGetSubmissionIdTasksRequest generates requests for GetSubmissionIdTasks
-func NewGetSubmissionIdTasksRequest(server string, id int64, params *GetSubmissionIdTasksParams) (*http.Request, error) {
+// NewGetRunnersRequest generates requests for GetRunners
+func NewGetRunnersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/runners")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostRunnersCordonNameRequest generates requests for PostRunnersCordonName
+func NewPostRunnersCordonNameRequest(server string, name string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
@@ -2161,7 +2803,7 @@ func NewGetSubmissionIdTasksRequest(server string, id int64, params *GetSubmissi
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/submission/%s/tasks", pathParam0)
+	operationPath := fmt.Sprintf("/runners/cordon/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -2171,43 +2813,75 @@ func NewGetSubmissionIdTasksRequest(server string, id int64, params *GetSubmissi
 
 	queryURL := serverURL.ResolveReference(&operationURL)
 
-	queryValues := queryURL.Query()
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	if params.Page != nil {
+	return req, nil
+}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+// NewPostRunnersDrainNameRequest generates requests for PostRunnersDrainName
+func NewPostRunnersDrainNameRequest(server string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
 
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
 
-	if params.PerPage != nil {
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "perPage", runtime.ParamLocationQuery, *params.PerPage); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	operationPath := fmt.Sprintf("/runners/drain/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
 
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	queryURL.RawQuery = queryValues.Encode()
+	return req, nil
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+// NewPostRunnersUncordonNameRequest generates requests for PostRunnersUncordonName
+func NewPostRunnersUncordonNameRequest(server string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/runners/uncordon/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2215,8 +2889,8 @@ func NewGetSubmissionIdTasksRequest(server string, id int64, params *GetSubmissi
 	return req, nil
 }
 
-// NewGetSubmissionsRequest generates requests for GetSubmissions
-func NewGetSubmissionsRequest(server string, params *GetSubmissionsParams) (*http.Request, error) {
+// NewGetRunsRequest generates requests for GetRuns
+func NewGetRunsRequest(server string, params *GetRunsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2224,7 +2898,7 @@ func NewGetSubmissionsRequest(server string, params *GetSubmissionsParams) (*htt
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/submissions")
+	operationPath := fmt.Sprintf("/runs")
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -2310,8 +2984,8 @@ func NewGetSubmissionsRequest(server string, params *GetSubmissionsParams) (*htt
 	return req, nil
 }
 
-// NewGetSubmissionsCountRequest generates requests for GetSubmissionsCount
-func NewGetSubmissionsCountRequest(server string, params *GetSubmissionsCountParams) (*http.Request, error) {
+// NewGetRunsCountRequest generates requests for GetRunsCount
+func NewGetRunsCountRequest(server string, params *GetRunsCountParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2319,7 +2993,7 @@ func NewGetSubmissionsCountRequest(server string, params *GetSubmissionsCountPar
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/submissions/count")
+	operationPath := fmt.Sprintf("/runs/count")
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -2373,16 +3047,23 @@ func NewGetSubmissionsCountRequest(server string, params *GetSubmissionsCountPar
 	return req, nil
 }
 
-// NewGetSubmitRequest generates requests for GetSubmit
-func NewGetSubmitRequest(server string, params *GetSubmitParams) (*http.Request, error) {
+// NewGetSubmissionIdRequest generates requests for GetSubmissionId
+func NewGetSubmissionIdRequest(server string, id int64) (*http.Request, error) {
 	var err error
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/submit")
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/submission/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -2392,50 +3073,6 @@ func NewGetSubmitRequest(server string, params *GetSubmitParams) (*http.Request,
 
 	queryURL := serverURL.ResolveReference(&operationURL)
 
-	queryValues := queryURL.Query()
-
-	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "repository", runtime.ParamLocationQuery, params.Repository); err != nil {
-		return nil, err
-	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-		return nil, err
-	} else {
-		for k, v := range parsed {
-			for _, v2 := range v {
-				queryValues.Add(k, v2)
-			}
-		}
-	}
-
-	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sha", runtime.ParamLocationQuery, params.Sha); err != nil {
-		return nil, err
-	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-		return nil, err
-	} else {
-		for k, v := range parsed {
-			for _, v2 := range v {
-				queryValues.Add(k, v2)
-			}
-		}
-	}
-
-	if params.All != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "all", runtime.ParamLocationQuery, *params.All); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
-
-	queryURL.RawQuery = queryValues.Encode()
-
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -2444,16 +3081,23 @@ func NewGetSubmitRequest(server string, params *GetSubmitParams) (*http.Request,
 	return req, nil
 }
 
-// NewGetTasksRequest generates requests for GetTasks
-func NewGetTasksRequest(server string, params *GetTasksParams) (*http.Request, error) {
+// NewPostSubmissionIdCancelRequest generates requests for PostSubmissionIdCancel
+func NewPostSubmissionIdCancelRequest(server string, id int64) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/tasks")
+	operationPath := fmt.Sprintf("/submission/%s/cancel", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -2463,75 +3107,7 @@ func NewGetTasksRequest(server string, params *GetTasksParams) (*http.Request, e
 
 	queryURL := serverURL.ResolveReference(&operationURL)
 
-	queryValues := queryURL.Query()
-
-	if params.Page != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
-
-	if params.PerPage != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "perPage", runtime.ParamLocationQuery, *params.PerPage); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
-
-	if params.Repository != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "repository", runtime.ParamLocationQuery, *params.Repository); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
-
-	if params.Sha != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sha", runtime.ParamLocationQuery, *params.Sha); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
-
-	queryURL.RawQuery = queryValues.Encode()
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2539,8 +3115,8 @@ func NewGetTasksRequest(server string, params *GetTasksParams) (*http.Request, e
 	return req, nil
 }
 
-// NewPostTasksCancelIdRequest generates requests for PostTasksCancelId
-func NewPostTasksCancelIdRequest(server string, id int64) (*http.Request, error) {
+// NewPostSubmissionIdRerunRequest generates requests for PostSubmissionIdRerun
+func NewPostSubmissionIdRerunRequest(server string, id int64) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2555,7 +3131,7 @@ func NewPostTasksCancelIdRequest(server string, id int64) (*http.Request, error)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/tasks/cancel/%s", pathParam0)
+	operationPath := fmt.Sprintf("/submission/%s/rerun", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -2573,16 +3149,23 @@ func NewPostTasksCancelIdRequest(server string, id int64) (*http.Request, error)
 	return req, nil
 }
 
-// NewGetTasksCountRequest generates requests for GetTasksCount
-func NewGetTasksCountRequest(server string, params *GetTasksCountParams) (*http.Request, error) {
+// NewGetSubmissionIdRunsRequest generates requests for GetSubmissionIdRuns
+func NewGetSubmissionIdRunsRequest(server string, id int64, params *GetSubmissionIdRunsParams) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/tasks/count")
+	operationPath := fmt.Sprintf("/submission/%s/runs", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -2594,9 +3177,9 @@ func NewGetTasksCountRequest(server string, params *GetTasksCountParams) (*http.
 
 	queryValues := queryURL.Query()
 
-	if params.Repository != nil {
+	if params.Page != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "repository", runtime.ParamLocationQuery, *params.Repository); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -2610,9 +3193,9 @@ func NewGetTasksCountRequest(server string, params *GetTasksCountParams) (*http.
 
 	}
 
-	if params.Sha != nil {
+	if params.PerPage != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sha", runtime.ParamLocationQuery, *params.Sha); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "perPage", runtime.ParamLocationQuery, *params.PerPage); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -2636,8 +3219,8 @@ func NewGetTasksCountRequest(server string, params *GetTasksCountParams) (*http.
 	return req, nil
 }
 
-// NewGetTasksRunsIdRequest generates requests for GetTasksRunsId
-func NewGetTasksRunsIdRequest(server string, id int64, params *GetTasksRunsIdParams) (*http.Request, error) {
+// NewGetSubmissionIdTasksRequest generates requests for GetSubmissionIdTasks
+func NewGetSubmissionIdTasksRequest(server string, id int64, params *GetSubmissionIdTasksParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2652,7 +3235,7 @@ func NewGetTasksRunsIdRequest(server string, id int64, params *GetTasksRunsIdPar
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/tasks/runs/%s", pathParam0)
+	operationPath := fmt.Sprintf("/submission/%s/tasks", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -2706,23 +3289,16 @@ func NewGetTasksRunsIdRequest(server string, id int64, params *GetTasksRunsIdPar
 	return req, nil
 }
 
-// NewGetTasksRunsIdCountRequest generates requests for GetTasksRunsIdCount
-func NewGetTasksRunsIdCountRequest(server string, id int64) (*http.Request, error) {
+// NewGetSubmissionsRequest generates requests for GetSubmissions
+func NewGetSubmissionsRequest(server string, params *GetSubmissionsParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/tasks/runs/%s/count", pathParam0)
+	operationPath := fmt.Sprintf("/submissions")
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -2732,38 +3308,43 @@ func NewGetTasksRunsIdCountRequest(server string, id int64) (*http.Request, erro
 
 	queryURL := serverURL.ResolveReference(&operationURL)
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	queryValues := queryURL.Query()
 
-	return req, nil
-}
+	if params.Page != nil {
 
-// NewGetTasksSubscribedRequest generates requests for GetTasksSubscribed
-func NewGetTasksSubscribedRequest(server string, params *GetTasksSubscribedParams) (*http.Request, error) {
-	var err error
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/tasks/subscribed")
-	if operationPath[0] == '/' {
-		operationPath = operationPath[1:]
-	}
-	operationURL := url.URL{
-		Path: operationPath,
-	}
+	if params.PerPage != nil {
 
-	queryURL := serverURL.ResolveReference(&operationURL)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "perPage", runtime.ParamLocationQuery, *params.PerPage); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	queryValues := queryURL.Query()
+	}
 
-	if params.Page != nil {
+	if params.Repository != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "repository", runtime.ParamLocationQuery, *params.Repository); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -2777,9 +3358,9 @@ func NewGetTasksSubscribedRequest(server string, params *GetTasksSubscribedParam
 
 	}
 
-	if params.PerPage != nil {
+	if params.Sha != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "perPage", runtime.ParamLocationQuery, *params.PerPage); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sha", runtime.ParamLocationQuery, *params.Sha); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -2803,8 +3384,8 @@ func NewGetTasksSubscribedRequest(server string, params *GetTasksSubscribedParam
 	return req, nil
 }
 
-// NewDeleteTokenRequest generates requests for DeleteToken
-func NewDeleteTokenRequest(server string) (*http.Request, error) {
+// NewGetSubmissionsCountRequest generates requests for GetSubmissionsCount
+func NewGetSubmissionsCountRequest(server string, params *GetSubmissionsCountParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2812,7 +3393,7 @@ func NewDeleteTokenRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/token")
+	operationPath := fmt.Sprintf("/submissions/count")
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -2822,32 +3403,41 @@ func NewDeleteTokenRequest(server string) (*http.Request, error) {
 
 	queryURL := serverURL.ResolveReference(&operationURL)
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
+	queryValues := queryURL.Query()
 
-// NewGetTokenRequest generates requests for GetToken
-func NewGetTokenRequest(server string) (*http.Request, error) {
-	var err error
+	if params.Repository != nil {
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "repository", runtime.ParamLocationQuery, *params.Repository); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	operationPath := fmt.Sprintf("/token")
-	if operationPath[0] == '/' {
-		operationPath = operationPath[1:]
 	}
-	operationURL := url.URL{
-		Path: operationPath,
+
+	if params.Sha != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sha", runtime.ParamLocationQuery, *params.Sha); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
 	}
 
-	queryURL := serverURL.ResolveReference(&operationURL)
+	queryURL.RawQuery = queryValues.Encode()
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
@@ -2857,8 +3447,8 @@ func NewGetTokenRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetUserPropertiesRequest generates requests for GetUserProperties
-func NewGetUserPropertiesRequest(server string) (*http.Request, error) {
+// NewGetSubmitRequest generates requests for GetSubmit
+func NewGetSubmitRequest(server string, params *GetSubmitParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2866,7 +3456,7 @@ func NewGetUserPropertiesRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/user/properties")
+	operationPath := fmt.Sprintf("/submit")
 	if operationPath[0] == '/' {
 		operationPath = operationPath[1:]
 	}
@@ -2876,174 +3466,1267 @@ func NewGetUserPropertiesRequest(server string) (*http.Request, error) {
 
 	queryURL := serverURL.ResolveReference(&operationURL)
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "repository", runtime.ParamLocationQuery, params.Repository); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
 	}
 
-	return req, nil
-}
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sha", runtime.ParamLocationQuery, params.Sha); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
+	if params.All != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "all", runtime.ParamLocationQuery, *params.All); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
 		}
+
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
+
+	if params.Priority != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "priority", runtime.ParamLocationQuery, *params.Priority); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
 		}
+
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	queryURL.RawQuery = queryValues.Encode()
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
-	}
+	return req, nil
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// PostCancelRunId request
-	PostCancelRunIdWithResponse(ctx context.Context, runId int64, reqEditors ...RequestEditorFn) (*PostCancelRunIdResponse, error)
+// NewGetTasksRequest generates requests for GetTasks
+func NewGetTasksRequest(server string, params *GetTasksParams) (*http.Request, error) {
+	var err error
 
-	// DeleteCapabilitiesUsernameCapability request
-	DeleteCapabilitiesUsernameCapabilityWithResponse(ctx context.Context, username string, capability string, reqEditors ...RequestEditorFn) (*DeleteCapabilitiesUsernameCapabilityResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostCapabilitiesUsernameCapability request
-	PostCapabilitiesUsernameCapabilityWithResponse(ctx context.Context, username string, capability string, reqEditors ...RequestEditorFn) (*PostCapabilitiesUsernameCapabilityResponse, error)
+	operationPath := fmt.Sprintf("/tasks")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
 
-	// GetErrors request
-	GetErrorsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetErrorsResponse, error)
+	queryURL := serverURL.ResolveReference(&operationURL)
 
-	// GetLogAttachId request
-	GetLogAttachIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetLogAttachIdResponse, error)
+	queryValues := queryURL.Query()
 
-	// GetLoggedin request
-	GetLoggedinWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLoggedinResponse, error)
+	if params.Page != nil {
 
-	// GetLogin request
-	GetLoginWithResponse(ctx context.Context, params *GetLoginParams, reqEditors ...RequestEditorFn) (*GetLoginResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	// GetLoginUpgrade request
-	GetLoginUpgradeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLoginUpgradeResponse, error)
+	}
 
-	// GetLogout request
-	GetLogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLogoutResponse, error)
+	if params.PerPage != nil {
 
-	// GetRepositoriesCiAddOwnerRepo request
-	GetRepositoriesCiAddOwnerRepoWithResponse(ctx context.Context, owner string, repo string, reqEditors ...RequestEditorFn) (*GetRepositoriesCiAddOwnerRepoResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "perPage", runtime.ParamLocationQuery, *params.PerPage); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	// GetRepositoriesCiDelOwnerRepo request
-	GetRepositoriesCiDelOwnerRepoWithResponse(ctx context.Context, owner string, repo string, reqEditors ...RequestEditorFn) (*GetRepositoriesCiDelOwnerRepoResponse, error)
+	}
 
-	// GetRepositoriesMy request
-	GetRepositoriesMyWithResponse(ctx context.Context, params *GetRepositoriesMyParams, reqEditors ...RequestEditorFn) (*GetRepositoriesMyResponse, error)
+	if params.Repository != nil {
 
-	// GetRepositoriesScan request
-	GetRepositoriesScanWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRepositoriesScanResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "repository", runtime.ParamLocationQuery, *params.Repository); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	// GetRepositoriesSubAddOwnerRepo request
-	GetRepositoriesSubAddOwnerRepoWithResponse(ctx context.Context, owner string, repo string, reqEditors ...RequestEditorFn) (*GetRepositoriesSubAddOwnerRepoResponse, error)
+	}
 
-	// GetRepositoriesSubDelOwnerRepo request
-	GetRepositoriesSubDelOwnerRepoWithResponse(ctx context.Context, owner string, repo string, reqEditors ...RequestEditorFn) (*GetRepositoriesSubDelOwnerRepoResponse, error)
+	if params.Sha != nil {
 
-	// GetRepositoriesSubscribed request
-	GetRepositoriesSubscribedWithResponse(ctx context.Context, params *GetRepositoriesSubscribedParams, reqEditors ...RequestEditorFn) (*GetRepositoriesSubscribedResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sha", runtime.ParamLocationQuery, *params.Sha); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	// GetRepositoriesVisible request
-	GetRepositoriesVisibleWithResponse(ctx context.Context, params *GetRepositoriesVisibleParams, reqEditors ...RequestEditorFn) (*GetRepositoriesVisibleResponse, error)
+	}
 
-	// GetRunRunId request
-	GetRunRunIdWithResponse(ctx context.Context, runId int64, reqEditors ...RequestEditorFn) (*GetRunRunIdResponse, error)
+	queryURL.RawQuery = queryValues.Encode()
 
-	// GetRuns request
-	GetRunsWithResponse(ctx context.Context, params *GetRunsParams, reqEditors ...RequestEditorFn) (*GetRunsResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetRunsCount request
-	GetRunsCountWithResponse(ctx context.Context, params *GetRunsCountParams, reqEditors ...RequestEditorFn) (*GetRunsCountResponse, error)
+	return req, nil
+}
 
-	// GetSubmissionId request
-	GetSubmissionIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetSubmissionIdResponse, error)
+// NewPostTasksCancelIdRequest generates requests for PostTasksCancelId
+func NewPostTasksCancelIdRequest(server string, id int64) (*http.Request, error) {
+	var err error
 
-	// PostSubmissionIdCancel request
-	PostSubmissionIdCancelWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostSubmissionIdCancelResponse, error)
+	var pathParam0 string
 
-	// GetSubmissionIdRuns request
-	GetSubmissionIdRunsWithResponse(ctx context.Context, id int64, params *GetSubmissionIdRunsParams, reqEditors ...RequestEditorFn) (*GetSubmissionIdRunsResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetSubmissionIdTasks request
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/cancel/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTasksCountRequest generates requests for GetTasksCount
+func NewGetTasksCountRequest(server string, params *GetTasksCountParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/count")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	queryValues := queryURL.Query()
+
+	if params.Repository != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "repository", runtime.ParamLocationQuery, *params.Repository); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Sha != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sha", runtime.ParamLocationQuery, *params.Sha); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostTasksRerunIdRequest generates requests for PostTasksRerunId
+func NewPostTasksRerunIdRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/rerun/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTasksRunsIdRequest generates requests for GetTasksRunsId
+func NewGetTasksRunsIdRequest(server string, id int64, params *GetTasksRunsIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/runs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	queryValues := queryURL.Query()
+
+	if params.Page != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.PerPage != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "perPage", runtime.ParamLocationQuery, *params.PerPage); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTasksRunsIdCountRequest generates requests for GetTasksRunsIdCount
+func NewGetTasksRunsIdCountRequest(server string, id int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/runs/%s/count", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTasksSubscribedRequest generates requests for GetTasksSubscribed
+func NewGetTasksSubscribedRequest(server string, params *GetTasksSubscribedParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks/subscribed")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	queryValues := queryURL.Query()
+
+	if params.Page != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.PerPage != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "perPage", runtime.ParamLocationQuery, *params.PerPage); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteTokenRequest generates requests for DeleteToken
+func NewDeleteTokenRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/token")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTokenRequest generates requests for GetToken
+func NewGetTokenRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/token")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUserPropertiesRequest generates requests for GetUserProperties
+func NewGetUserPropertiesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/user/properties")
+	if operationPath[0] == '/' {
+		operationPath = operationPath[1:]
+	}
+	operationURL := url.URL{
+		Path: operationPath,
+	}
+
+	queryURL := serverURL.ResolveReference(&operationURL)
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetArtifactsRunId request
+	GetArtifactsRunIdWithResponse(ctx context.Context, runId int64, reqEditors ...RequestEditorFn) (*GetArtifactsRunIdResponse, error)
+
+	// GetArtifactsRunIdName request
+	GetArtifactsRunIdNameWithResponse(ctx context.Context, runId int64, name string, reqEditors ...RequestEditorFn) (*GetArtifactsRunIdNameResponse, error)
+
+	// PostCancelRunId request
+	PostCancelRunIdWithResponse(ctx context.Context, runId int64, reqEditors ...RequestEditorFn) (*PostCancelRunIdResponse, error)
+
+	// DeleteCapabilitiesUsernameCapability request
+	DeleteCapabilitiesUsernameCapabilityWithResponse(ctx context.Context, username string, capability string, reqEditors ...RequestEditorFn) (*DeleteCapabilitiesUsernameCapabilityResponse, error)
+
+	// PostCapabilitiesUsernameCapability request
+	PostCapabilitiesUsernameCapabilityWithResponse(ctx context.Context, username string, capability string, reqEditors ...RequestEditorFn) (*PostCapabilitiesUsernameCapabilityResponse, error)
+
+	// GetErrors request
+	GetErrorsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetErrorsResponse, error)
+
+	// GetLogAttachId request
+	GetLogAttachIdWithResponse(ctx context.Context, id int64, params *GetLogAttachIdParams, reqEditors ...RequestEditorFn) (*GetLogAttachIdResponse, error)
+
+	// GetLogSectionsId request
+	GetLogSectionsIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetLogSectionsIdResponse, error)
+
+	// GetLoggedin request
+	GetLoggedinWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLoggedinResponse, error)
+
+	// GetLogin request
+	GetLoginWithResponse(ctx context.Context, params *GetLoginParams, reqEditors ...RequestEditorFn) (*GetLoginResponse, error)
+
+	// GetLoginUpgrade request
+	GetLoginUpgradeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLoginUpgradeResponse, error)
+
+	// GetLogout request
+	GetLogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLogoutResponse, error)
+
+	// GetLogsSearch request
+	GetLogsSearchWithResponse(ctx context.Context, params *GetLogsSearchParams, reqEditors ...RequestEditorFn) (*GetLogsSearchResponse, error)
+
+	// GetRepositoriesCiAddOwnerRepo request
+	GetRepositoriesCiAddOwnerRepoWithResponse(ctx context.Context, owner string, repo string, reqEditors ...RequestEditorFn) (*GetRepositoriesCiAddOwnerRepoResponse, error)
+
+	// GetRepositoriesCiDelOwnerRepo request
+	GetRepositoriesCiDelOwnerRepoWithResponse(ctx context.Context, owner string, repo string, reqEditors ...RequestEditorFn) (*GetRepositoriesCiDelOwnerRepoResponse, error)
+
+	// GetRepositoriesMy request
+	GetRepositoriesMyWithResponse(ctx context.Context, params *GetRepositoriesMyParams, reqEditors ...RequestEditorFn) (*GetRepositoriesMyResponse, error)
+
+	// GetRepositoriesScan request
+	GetRepositoriesScanWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRepositoriesScanResponse, error)
+
+	// GetRepositoriesSubAddOwnerRepo request
+	GetRepositoriesSubAddOwnerRepoWithResponse(ctx context.Context, owner string, repo string, reqEditors ...RequestEditorFn) (*GetRepositoriesSubAddOwnerRepoResponse, error)
+
+	// GetRepositoriesSubDelOwnerRepo request
+	GetRepositoriesSubDelOwnerRepoWithResponse(ctx context.Context, owner string, repo string, reqEditors ...RequestEditorFn) (*GetRepositoriesSubDelOwnerRepoResponse, error)
+
+	// GetRepositoriesSubscribed request
+	GetRepositoriesSubscribedWithResponse(ctx context.Context, params *GetRepositoriesSubscribedParams, reqEditors ...RequestEditorFn) (*GetRepositoriesSubscribedResponse, error)
+
+	// GetRepositoriesVisible request
+	GetRepositoriesVisibleWithResponse(ctx context.Context, params *GetRepositoriesVisibleParams, reqEditors ...RequestEditorFn) (*GetRepositoriesVisibleResponse, error)
+
+	// GetRepositoriesOwnerRepoFlaky request
+	GetRepositoriesOwnerRepoFlakyWithResponse(ctx context.Context, owner string, repo string, params *GetRepositoriesOwnerRepoFlakyParams, reqEditors ...RequestEditorFn) (*GetRepositoriesOwnerRepoFlakyResponse, error)
+
+	// PostRerunRunId request
+	PostRerunRunIdWithResponse(ctx context.Context, runId int64, reqEditors ...RequestEditorFn) (*PostRerunRunIdResponse, error)
+
+	// GetRunRunId request
+	GetRunRunIdWithResponse(ctx context.Context, runId int64, reqEditors ...RequestEditorFn) (*GetRunRunIdResponse, error)
+
+	// GetRunRunIdTests request
+	GetRunRunIdTestsWithResponse(ctx context.Context, runId int64, params *GetRunRunIdTestsParams, reqEditors ...RequestEditorFn) (*GetRunRunIdTestsResponse, error)
+
+	// GetRunRunIdTestsSummary request
+	GetRunRunIdTestsSummaryWithResponse(ctx context.Context, runId int64, reqEditors ...RequestEditorFn) (*GetRunRunIdTestsSummaryResponse, error)
+
+	// GetRunners request
+	GetRunnersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRunnersResponse, error)
+
+	// PostRunnersCordonName request
+	PostRunnersCordonNameWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*PostRunnersCordonNameResponse, error)
+
+	// PostRunnersDrainName request
+	PostRunnersDrainNameWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*PostRunnersDrainNameResponse, error)
+
+	// PostRunnersUncordonName request
+	PostRunnersUncordonNameWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*PostRunnersUncordonNameResponse, error)
+
+	// GetRuns request
+	GetRunsWithResponse(ctx context.Context, params *GetRunsParams, reqEditors ...RequestEditorFn) (*GetRunsResponse, error)
+
+	// GetRunsCount request
+	GetRunsCountWithResponse(ctx context.Context, params *GetRunsCountParams, reqEditors ...RequestEditorFn) (*GetRunsCountResponse, error)
+
+	// GetSubmissionId request
+	GetSubmissionIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetSubmissionIdResponse, error)
+
+	// PostSubmissionIdCancel request
+	PostSubmissionIdCancelWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostSubmissionIdCancelResponse, error)
+
+	// PostSubmissionIdRerun request
+	PostSubmissionIdRerunWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostSubmissionIdRerunResponse, error)
+
+	// GetSubmissionIdRuns request
+	GetSubmissionIdRunsWithResponse(ctx context.Context, id int64, params *GetSubmissionIdRunsParams, reqEditors ...RequestEditorFn) (*GetSubmissionIdRunsResponse, error)
+
+	// GetSubmissionIdTasks request
 	GetSubmissionIdTasksWithResponse(ctx context.Context, id int64, params *GetSubmissionIdTasksParams, reqEditors ...RequestEditorFn) (*GetSubmissionIdTasksResponse, error)
 
-	// GetSubmissions request
-	GetSubmissionsWithResponse(ctx context.Context, params *GetSubmissionsParams, reqEditors ...RequestEditorFn) (*GetSubmissionsResponse, error)
+	// GetSubmissions request
+	GetSubmissionsWithResponse(ctx context.Context, params *GetSubmissionsParams, reqEditors ...RequestEditorFn) (*GetSubmissionsResponse, error)
+
+	// GetSubmissionsCount request
+	GetSubmissionsCountWithResponse(ctx context.Context, params *GetSubmissionsCountParams, reqEditors ...RequestEditorFn) (*GetSubmissionsCountResponse, error)
+
+	// GetSubmit request
+	GetSubmitWithResponse(ctx context.Context, params *GetSubmitParams, reqEditors ...RequestEditorFn) (*GetSubmitResponse, error)
+
+	// GetTasks request
+	GetTasksWithResponse(ctx context.Context, params *GetTasksParams, reqEditors ...RequestEditorFn) (*GetTasksResponse, error)
+
+	// PostTasksCancelId request
+	PostTasksCancelIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostTasksCancelIdResponse, error)
+
+	// GetTasksCount request
+	GetTasksCountWithResponse(ctx context.Context, params *GetTasksCountParams, reqEditors ...RequestEditorFn) (*GetTasksCountResponse, error)
+
+	// PostTasksRerunId request
+	PostTasksRerunIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostTasksRerunIdResponse, error)
+
+	// GetTasksRunsId request
+	GetTasksRunsIdWithResponse(ctx context.Context, id int64, params *GetTasksRunsIdParams, reqEditors ...RequestEditorFn) (*GetTasksRunsIdResponse, error)
+
+	// GetTasksRunsIdCount request
+	GetTasksRunsIdCountWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetTasksRunsIdCountResponse, error)
+
+	// GetTasksSubscribed request
+	GetTasksSubscribedWithResponse(ctx context.Context, params *GetTasksSubscribedParams, reqEditors ...RequestEditorFn) (*GetTasksSubscribedResponse, error)
+
+	// DeleteToken request
+	DeleteTokenWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteTokenResponse, error)
+
+	// GetToken request
+	GetTokenWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTokenResponse, error)
+
+	// GetUserProperties request
+	GetUserPropertiesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserPropertiesResponse, error)
+}
+
+type GetArtifactsRunIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ArtifactList
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetArtifactsRunIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetArtifactsRunIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetArtifactsRunIdNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetArtifactsRunIdNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetArtifactsRunIdNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostCancelRunIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostCancelRunIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostCancelRunIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCapabilitiesUsernameCapabilityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCapabilitiesUsernameCapabilityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCapabilitiesUsernameCapabilityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostCapabilitiesUsernameCapabilityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostCapabilitiesUsernameCapabilityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostCapabilitiesUsernameCapabilityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetErrorsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]UserError
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetErrorsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetErrorsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLogAttachIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLogAttachIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLogAttachIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLogSectionsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LogSections
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLogSectionsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLogSectionsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLoggedinResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *string
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLoggedinResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLoggedinResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLoginUpgradeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLoginUpgradeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLoginUpgradeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLogoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *Error
+}
 
-	// GetSubmissionsCount request
-	GetSubmissionsCountWithResponse(ctx context.Context, params *GetSubmissionsCountParams, reqEditors ...RequestEditorFn) (*GetSubmissionsCountResponse, error)
+// Status returns HTTPResponse.Status
+func (r GetLogoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// GetSubmit request
-	GetSubmitWithResponse(ctx context.Context, params *GetSubmitParams, reqEditors ...RequestEditorFn) (*GetSubmitResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLogoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// GetTasks request
-	GetTasksWithResponse(ctx context.Context, params *GetTasksParams, reqEditors ...RequestEditorFn) (*GetTasksResponse, error)
+type GetLogsSearchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LogSearchResult
+	JSON500      *Error
+}
 
-	// PostTasksCancelId request
-	PostTasksCancelIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostTasksCancelIdResponse, error)
+// Status returns HTTPResponse.Status
+func (r GetLogsSearchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// GetTasksCount request
-	GetTasksCountWithResponse(ctx context.Context, params *GetTasksCountParams, reqEditors ...RequestEditorFn) (*GetTasksCountResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLogsSearchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// GetTasksRunsId request
-	GetTasksRunsIdWithResponse(ctx context.Context, id int64, params *GetTasksRunsIdParams, reqEditors ...RequestEditorFn) (*GetTasksRunsIdResponse, error)
+type GetRepositoriesCiAddOwnerRepoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *Error
+}
 
-	// GetTasksRunsIdCount request
-	GetTasksRunsIdCountWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetTasksRunsIdCountResponse, error)
+// Status returns HTTPResponse.Status
+func (r GetRepositoriesCiAddOwnerRepoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// GetTasksSubscribed request
-	GetTasksSubscribedWithResponse(ctx context.Context, params *GetTasksSubscribedParams, reqEditors ...RequestEditorFn) (*GetTasksSubscribedResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRepositoriesCiAddOwnerRepoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// DeleteToken request
-	DeleteTokenWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteTokenResponse, error)
+type GetRepositoriesCiDelOwnerRepoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRepositoriesCiDelOwnerRepoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRepositoriesCiDelOwnerRepoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRepositoriesMyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RepositoryList
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRepositoriesMyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRepositoriesMyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRepositoriesScanResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRepositoriesScanResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRepositoriesScanResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRepositoriesSubAddOwnerRepoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRepositoriesSubAddOwnerRepoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRepositoriesSubAddOwnerRepoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRepositoriesSubDelOwnerRepoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRepositoriesSubDelOwnerRepoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRepositoriesSubDelOwnerRepoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRepositoriesSubscribedResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RepositoryList
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRepositoriesSubscribedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRepositoriesSubscribedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRepositoriesVisibleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RepositoryList
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRepositoriesVisibleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRepositoriesVisibleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRepositoriesOwnerRepoFlakyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FlakyTestList
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRepositoriesOwnerRepoFlakyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRepositoriesOwnerRepoFlakyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostRerunRunIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Run
+	JSON500      *Error
+}
 
-	// GetToken request
-	GetTokenWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTokenResponse, error)
+// Status returns HTTPResponse.Status
+func (r PostRerunRunIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// GetUserProperties request
-	GetUserPropertiesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserPropertiesResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRerunRunIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type PostCancelRunIdResponse struct {
+type GetRunRunIdResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *Run
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r PostCancelRunIdResponse) Status() string {
+func (r GetRunRunIdResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3051,21 +4734,22 @@ func (r PostCancelRunIdResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostCancelRunIdResponse) StatusCode() int {
+func (r GetRunRunIdResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteCapabilitiesUsernameCapabilityResponse struct {
+type GetRunRunIdTestsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *TestCaseList
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteCapabilitiesUsernameCapabilityResponse) Status() string {
+func (r GetRunRunIdTestsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3073,21 +4757,22 @@ func (r DeleteCapabilitiesUsernameCapabilityResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteCapabilitiesUsernameCapabilityResponse) StatusCode() int {
+func (r GetRunRunIdTestsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type PostCapabilitiesUsernameCapabilityResponse struct {
+type GetRunRunIdTestsSummaryResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *TestSummary
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r PostCapabilitiesUsernameCapabilityResponse) Status() string {
+func (r GetRunRunIdTestsSummaryResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3095,22 +4780,22 @@ func (r PostCapabilitiesUsernameCapabilityResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostCapabilitiesUsernameCapabilityResponse) StatusCode() int {
+func (r GetRunRunIdTestsSummaryResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetErrorsResponse struct {
+type GetRunnersResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]UserError
+	JSON200      *RunnerList
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetErrorsResponse) Status() string {
+func (r GetRunnersResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3118,21 +4803,21 @@ func (r GetErrorsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetErrorsResponse) StatusCode() int {
+func (r GetRunnersResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetLogAttachIdResponse struct {
+type PostRunnersCordonNameResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetLogAttachIdResponse) Status() string {
+func (r PostRunnersCordonNameResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3140,22 +4825,21 @@ func (r GetLogAttachIdResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetLogAttachIdResponse) StatusCode() int {
+func (r PostRunnersCordonNameResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetLoggedinResponse struct {
+type PostRunnersDrainNameResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *string
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetLoggedinResponse) Status() string {
+func (r PostRunnersDrainNameResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3163,21 +4847,21 @@ func (r GetLoggedinResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetLoggedinResponse) StatusCode() int {
+func (r PostRunnersDrainNameResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetLoginResponse struct {
+type PostRunnersUncordonNameResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetLoginResponse) Status() string {
+func (r PostRunnersUncordonNameResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3185,21 +4869,22 @@ func (r GetLoginResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetLoginResponse) StatusCode() int {
+func (r PostRunnersUncordonNameResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetLoginUpgradeResponse struct {
+type GetRunsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *RunList
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetLoginUpgradeResponse) Status() string {
+func (r GetRunsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3207,21 +4892,22 @@ func (r GetLoginUpgradeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetLoginUpgradeResponse) StatusCode() int {
+func (r GetRunsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetLogoutResponse struct {
+type GetRunsCountResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *int64
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetLogoutResponse) Status() string {
+func (r GetRunsCountResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3229,21 +4915,22 @@ func (r GetLogoutResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetLogoutResponse) StatusCode() int {
+func (r GetRunsCountResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetRepositoriesCiAddOwnerRepoResponse struct {
+type GetSubmissionIdResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *ModelSubmission
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetRepositoriesCiAddOwnerRepoResponse) Status() string {
+func (r GetSubmissionIdResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3251,21 +4938,21 @@ func (r GetRepositoriesCiAddOwnerRepoResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetRepositoriesCiAddOwnerRepoResponse) StatusCode() int {
+func (r GetSubmissionIdResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetRepositoriesCiDelOwnerRepoResponse struct {
+type PostSubmissionIdCancelResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetRepositoriesCiDelOwnerRepoResponse) Status() string {
+func (r PostSubmissionIdCancelResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3273,22 +4960,22 @@ func (r GetRepositoriesCiDelOwnerRepoResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetRepositoriesCiDelOwnerRepoResponse) StatusCode() int {
+func (r PostSubmissionIdCancelResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetRepositoriesMyResponse struct {
+type PostSubmissionIdRerunResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *RepositoryList
+	JSON200      *RunList
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetRepositoriesMyResponse) Status() string {
+func (r PostSubmissionIdRerunResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3296,21 +4983,22 @@ func (r GetRepositoriesMyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetRepositoriesMyResponse) StatusCode() int {
+func (r PostSubmissionIdRerunResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetRepositoriesScanResponse struct {
+type GetSubmissionIdRunsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *RunList
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetRepositoriesScanResponse) Status() string {
+func (r GetSubmissionIdRunsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3318,21 +5006,22 @@ func (r GetRepositoriesScanResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetRepositoriesScanResponse) StatusCode() int {
+func (r GetSubmissionIdRunsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetRepositoriesSubAddOwnerRepoResponse struct {
+type GetSubmissionIdTasksResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *TaskList
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetRepositoriesSubAddOwnerRepoResponse) Status() string {
+func (r GetSubmissionIdTasksResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3340,21 +5029,22 @@ func (r GetRepositoriesSubAddOwnerRepoResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetRepositoriesSubAddOwnerRepoResponse) StatusCode() int {
+func (r GetSubmissionIdTasksResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetRepositoriesSubDelOwnerRepoResponse struct {
+type GetSubmissionsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *ModelSubmissionList
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetRepositoriesSubDelOwnerRepoResponse) Status() string {
+func (r GetSubmissionsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3362,22 +5052,22 @@ func (r GetRepositoriesSubDelOwnerRepoResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetRepositoriesSubDelOwnerRepoResponse) StatusCode() int {
+func (r GetSubmissionsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetRepositoriesSubscribedResponse struct {
+type GetSubmissionsCountResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *RepositoryList
+	JSON200      *int64
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetRepositoriesSubscribedResponse) Status() string {
+func (r GetSubmissionsCountResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3385,22 +5075,21 @@ func (r GetRepositoriesSubscribedResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetRepositoriesSubscribedResponse) StatusCode() int {
+func (r GetSubmissionsCountResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetRepositoriesVisibleResponse struct {
+type GetSubmitResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *RepositoryList
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetRepositoriesVisibleResponse) Status() string {
+func (r GetSubmitResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3408,22 +5097,22 @@ func (r GetRepositoriesVisibleResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetRepositoriesVisibleResponse) StatusCode() int {
+func (r GetSubmitResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetRunRunIdResponse struct {
+type GetTasksResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Run
+	JSON200      *TaskList
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetRunRunIdResponse) Status() string {
+func (r GetTasksResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3431,22 +5120,21 @@ func (r GetRunRunIdResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetRunRunIdResponse) StatusCode() int {
+func (r GetTasksResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetRunsResponse struct {
+type PostTasksCancelIdResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *RunList
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetRunsResponse) Status() string {
+func (r PostTasksCancelIdResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3454,14 +5142,14 @@ func (r GetRunsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetRunsResponse) StatusCode() int {
+func (r PostTasksCancelIdResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetRunsCountResponse struct {
+type GetTasksCountResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *int64
@@ -3469,7 +5157,7 @@ type GetRunsCountResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetRunsCountResponse) Status() string {
+func (r GetTasksCountResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3477,22 +5165,22 @@ func (r GetRunsCountResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetRunsCountResponse) StatusCode() int {
+func (r GetTasksCountResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetSubmissionIdResponse struct {
+type PostTasksRerunIdResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ModelSubmission
+	JSON200      *RunList
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetSubmissionIdResponse) Status() string {
+func (r PostTasksRerunIdResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3500,21 +5188,22 @@ func (r GetSubmissionIdResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetSubmissionIdResponse) StatusCode() int {
+func (r PostTasksRerunIdResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type PostSubmissionIdCancelResponse struct {
+type GetTasksRunsIdResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *RunList
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r PostSubmissionIdCancelResponse) Status() string {
+func (r GetTasksRunsIdResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3522,22 +5211,22 @@ func (r PostSubmissionIdCancelResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostSubmissionIdCancelResponse) StatusCode() int {
+func (r GetTasksRunsIdResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetSubmissionIdRunsResponse struct {
+type GetTasksRunsIdCountResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *RunList
+	JSON200      *int64
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetSubmissionIdRunsResponse) Status() string {
+func (r GetTasksRunsIdCountResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3545,14 +5234,14 @@ func (r GetSubmissionIdRunsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetSubmissionIdRunsResponse) StatusCode() int {
+func (r GetTasksRunsIdCountResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetSubmissionIdTasksResponse struct {
+type GetTasksSubscribedResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *TaskList
@@ -3560,7 +5249,7 @@ type GetSubmissionIdTasksResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetSubmissionIdTasksResponse) Status() string {
+func (r GetTasksSubscribedResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3568,22 +5257,21 @@ func (r GetSubmissionIdTasksResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetSubmissionIdTasksResponse) StatusCode() int {
+func (r GetTasksSubscribedResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetSubmissionsResponse struct {
+type DeleteTokenResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ModelSubmissionList
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetSubmissionsResponse) Status() string {
+func (r DeleteTokenResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3591,22 +5279,22 @@ func (r GetSubmissionsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetSubmissionsResponse) StatusCode() int {
+func (r DeleteTokenResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetSubmissionsCountResponse struct {
+type GetTokenResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *int64
+	JSON200      *string
 	JSON500      *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetSubmissionsCountResponse) Status() string {
+func (r GetTokenResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3614,580 +5302,849 @@ func (r GetSubmissionsCountResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetSubmissionsCountResponse) StatusCode() int {
+func (r GetTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserPropertiesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		AdditionalProperties map[string]interface{} `json:"-"`
+	}
+	JSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserPropertiesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserPropertiesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
-	return 0
+	return 0
+}
+
+// GetArtifactsRunIdWithResponse request returning *GetArtifactsRunIdResponse
+func (c *ClientWithResponses) GetArtifactsRunIdWithResponse(ctx context.Context, runId int64, reqEditors ...RequestEditorFn) (*GetArtifactsRunIdResponse, error) {
+	rsp, err := c.GetArtifactsRunId(ctx, runId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetArtifactsRunIdResponse(rsp)
+}
+
+// GetArtifactsRunIdNameWithResponse request returning *GetArtifactsRunIdNameResponse
+func (c *ClientWithResponses) GetArtifactsRunIdNameWithResponse(ctx context.Context, runId int64, name string, reqEditors ...RequestEditorFn) (*GetArtifactsRunIdNameResponse, error) {
+	rsp, err := c.GetArtifactsRunIdName(ctx, runId, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetArtifactsRunIdNameResponse(rsp)
+}
+
+// PostCancelRunIdWithResponse request returning *PostCancelRunIdResponse
+func (c *ClientWithResponses) PostCancelRunIdWithResponse(ctx context.Context, runId int64, reqEditors ...RequestEditorFn) (*PostCancelRunIdResponse, error) {
+	rsp, err := c.PostCancelRunId(ctx, runId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCancelRunIdResponse(rsp)
+}
+
+// DeleteCapabilitiesUsernameCapabilityWithResponse request returning *DeleteCapabilitiesUsernameCapabilityResponse
+func (c *ClientWithResponses) DeleteCapabilitiesUsernameCapabilityWithResponse(ctx context.Context, username string, capability string, reqEditors ...RequestEditorFn) (*DeleteCapabilitiesUsernameCapabilityResponse, error) {
+	rsp, err := c.DeleteCapabilitiesUsernameCapability(ctx, username, capability, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCapabilitiesUsernameCapabilityResponse(rsp)
+}
+
+// PostCapabilitiesUsernameCapabilityWithResponse request returning *PostCapabilitiesUsernameCapabilityResponse
+func (c *ClientWithResponses) PostCapabilitiesUsernameCapabilityWithResponse(ctx context.Context, username string, capability string, reqEditors ...RequestEditorFn) (*PostCapabilitiesUsernameCapabilityResponse, error) {
+	rsp, err := c.PostCapabilitiesUsernameCapability(ctx, username, capability, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCapabilitiesUsernameCapabilityResponse(rsp)
+}
+
+// GetErrorsWithResponse request returning *GetErrorsResponse
+func (c *ClientWithResponses) GetErrorsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetErrorsResponse, error) {
+	rsp, err := c.GetErrors(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetErrorsResponse(rsp)
+}
+
+// GetLogAttachIdWithResponse request returning *GetLogAttachIdResponse
+func (c *ClientWithResponses) GetLogAttachIdWithResponse(ctx context.Context, id int64, params *GetLogAttachIdParams, reqEditors ...RequestEditorFn) (*GetLogAttachIdResponse, error) {
+	rsp, err := c.GetLogAttachId(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLogAttachIdResponse(rsp)
+}
+
+// GetLogSectionsIdWithResponse request returning *GetLogSectionsIdResponse
+func (c *ClientWithResponses) GetLogSectionsIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetLogSectionsIdResponse, error) {
+	rsp, err := c.GetLogSectionsId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLogSectionsIdResponse(rsp)
 }
 
-type GetSubmitResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON500      *Error
+// GetLoggedinWithResponse request returning *GetLoggedinResponse
+func (c *ClientWithResponses) GetLoggedinWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLoggedinResponse, error) {
+	rsp, err := c.GetLoggedin(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLoggedinResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetSubmitResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetLoginWithResponse request returning *GetLoginResponse
+func (c *ClientWithResponses) GetLoginWithResponse(ctx context.Context, params *GetLoginParams, reqEditors ...RequestEditorFn) (*GetLoginResponse, error) {
+	rsp, err := c.GetLogin(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetLoginResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSubmitResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetLoginUpgradeWithResponse request returning *GetLoginUpgradeResponse
+func (c *ClientWithResponses) GetLoginUpgradeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLoginUpgradeResponse, error) {
+	rsp, err := c.GetLoginUpgrade(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetLoginUpgradeResponse(rsp)
 }
 
-type GetTasksResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TaskList
-	JSON500      *Error
+// GetLogoutWithResponse request returning *GetLogoutResponse
+func (c *ClientWithResponses) GetLogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLogoutResponse, error) {
+	rsp, err := c.GetLogout(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLogoutResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetTasksResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetLogsSearchWithResponse request returning *GetLogsSearchResponse
+func (c *ClientWithResponses) GetLogsSearchWithResponse(ctx context.Context, params *GetLogsSearchParams, reqEditors ...RequestEditorFn) (*GetLogsSearchResponse, error) {
+	rsp, err := c.GetLogsSearch(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetLogsSearchResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTasksResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetRepositoriesCiAddOwnerRepoWithResponse request returning *GetRepositoriesCiAddOwnerRepoResponse
+func (c *ClientWithResponses) GetRepositoriesCiAddOwnerRepoWithResponse(ctx context.Context, owner string, repo string, reqEditors ...RequestEditorFn) (*GetRepositoriesCiAddOwnerRepoResponse, error) {
+	rsp, err := c.GetRepositoriesCiAddOwnerRepo(ctx, owner, repo, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetRepositoriesCiAddOwnerRepoResponse(rsp)
 }
 
-type PostTasksCancelIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON500      *Error
+// GetRepositoriesCiDelOwnerRepoWithResponse request returning *GetRepositoriesCiDelOwnerRepoResponse
+func (c *ClientWithResponses) GetRepositoriesCiDelOwnerRepoWithResponse(ctx context.Context, owner string, repo string, reqEditors ...RequestEditorFn) (*GetRepositoriesCiDelOwnerRepoResponse, error) {
+	rsp, err := c.GetRepositoriesCiDelOwnerRepo(ctx, owner, repo, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRepositoriesCiDelOwnerRepoResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostTasksCancelIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetRepositoriesMyWithResponse request returning *GetRepositoriesMyResponse
+func (c *ClientWithResponses) GetRepositoriesMyWithResponse(ctx context.Context, params *GetRepositoriesMyParams, reqEditors ...RequestEditorFn) (*GetRepositoriesMyResponse, error) {
+	rsp, err := c.GetRepositoriesMy(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetRepositoriesMyResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostTasksCancelIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetRepositoriesScanWithResponse request returning *GetRepositoriesScanResponse
+func (c *ClientWithResponses) GetRepositoriesScanWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRepositoriesScanResponse, error) {
+	rsp, err := c.GetRepositoriesScan(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetRepositoriesScanResponse(rsp)
 }
 
-type GetTasksCountResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *int64
-	JSON500      *Error
+// GetRepositoriesSubAddOwnerRepoWithResponse request returning *GetRepositoriesSubAddOwnerRepoResponse
+func (c *ClientWithResponses) GetRepositoriesSubAddOwnerRepoWithResponse(ctx context.Context, owner string, repo string, reqEditors ...RequestEditorFn) (*GetRepositoriesSubAddOwnerRepoResponse, error) {
+	rsp, err := c.GetRepositoriesSubAddOwnerRepo(ctx, owner, repo, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRepositoriesSubAddOwnerRepoResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetTasksCountResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetRepositoriesSubDelOwnerRepoWithResponse request returning *GetRepositoriesSubDelOwnerRepoResponse
+func (c *ClientWithResponses) GetRepositoriesSubDelOwnerRepoWithResponse(ctx context.Context, owner string, repo string, reqEditors ...RequestEditorFn) (*GetRepositoriesSubDelOwnerRepoResponse, error) {
+	rsp, err := c.GetRepositoriesSubDelOwnerRepo(ctx, owner, repo, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetRepositoriesSubDelOwnerRepoResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTasksCountResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetRepositoriesSubscribedWithResponse request returning *GetRepositoriesSubscribedResponse
+func (c *ClientWithResponses) GetRepositoriesSubscribedWithResponse(ctx context.Context, params *GetRepositoriesSubscribedParams, reqEditors ...RequestEditorFn) (*GetRepositoriesSubscribedResponse, error) {
+	rsp, err := c.GetRepositoriesSubscribed(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetRepositoriesSubscribedResponse(rsp)
 }
 
-type GetTasksRunsIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *RunList
-	JSON500      *Error
+// GetRepositoriesVisibleWithResponse request returning *GetRepositoriesVisibleResponse
+func (c *ClientWithResponses) GetRepositoriesVisibleWithResponse(ctx context.Context, params *GetRepositoriesVisibleParams, reqEditors ...RequestEditorFn) (*GetRepositoriesVisibleResponse, error) {
+	rsp, err := c.GetRepositoriesVisible(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRepositoriesVisibleResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetTasksRunsIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetRepositoriesOwnerRepoFlakyWithResponse request returning *GetRepositoriesOwnerRepoFlakyResponse
+func (c *ClientWithResponses) GetRepositoriesOwnerRepoFlakyWithResponse(ctx context.Context, owner string, repo string, params *GetRepositoriesOwnerRepoFlakyParams, reqEditors ...RequestEditorFn) (*GetRepositoriesOwnerRepoFlakyResponse, error) {
+	rsp, err := c.GetRepositoriesOwnerRepoFlaky(ctx, owner, repo, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetRepositoriesOwnerRepoFlakyResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTasksRunsIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PostRerunRunIdWithResponse request returning *PostRerunRunIdResponse
+func (c *ClientWithResponses) PostRerunRunIdWithResponse(ctx context.Context, runId int64, reqEditors ...RequestEditorFn) (*PostRerunRunIdResponse, error) {
+	rsp, err := c.PostRerunRunId(ctx, runId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostRerunRunIdResponse(rsp)
 }
 
-type GetTasksRunsIdCountResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *int64
-	JSON500      *Error
+// GetRunRunIdWithResponse request returning *GetRunRunIdResponse
+func (c *ClientWithResponses) GetRunRunIdWithResponse(ctx context.Context, runId int64, reqEditors ...RequestEditorFn) (*GetRunRunIdResponse, error) {
+	rsp, err := c.GetRunRunId(ctx, runId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRunRunIdResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetTasksRunsIdCountResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetRunRunIdTestsWithResponse request returning *GetRunRunIdTestsResponse
+func (c *ClientWithResponses) GetRunRunIdTestsWithResponse(ctx context.Context, runId int64, params *GetRunRunIdTestsParams, reqEditors ...RequestEditorFn) (*GetRunRunIdTestsResponse, error) {
+	rsp, err := c.GetRunRunIdTests(ctx, runId, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetRunRunIdTestsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTasksRunsIdCountResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetRunRunIdTestsSummaryWithResponse request returning *GetRunRunIdTestsSummaryResponse
+func (c *ClientWithResponses) GetRunRunIdTestsSummaryWithResponse(ctx context.Context, runId int64, reqEditors ...RequestEditorFn) (*GetRunRunIdTestsSummaryResponse, error) {
+	rsp, err := c.GetRunRunIdTestsSummary(ctx, runId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetRunRunIdTestsSummaryResponse(rsp)
 }
 
-type GetTasksSubscribedResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TaskList
-	JSON500      *Error
+// GetRunnersWithResponse request returning *GetRunnersResponse
+func (c *ClientWithResponses) GetRunnersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRunnersResponse, error) {
+	rsp, err := c.GetRunners(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRunnersResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetTasksSubscribedResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PostRunnersCordonNameWithResponse request returning *PostRunnersCordonNameResponse
+func (c *ClientWithResponses) PostRunnersCordonNameWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*PostRunnersCordonNameResponse, error) {
+	rsp, err := c.PostRunnersCordonName(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostRunnersCordonNameResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTasksSubscribedResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PostRunnersDrainNameWithResponse request returning *PostRunnersDrainNameResponse
+func (c *ClientWithResponses) PostRunnersDrainNameWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*PostRunnersDrainNameResponse, error) {
+	rsp, err := c.PostRunnersDrainName(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostRunnersDrainNameResponse(rsp)
 }
 
-type DeleteTokenResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON500      *Error
+// PostRunnersUncordonNameWithResponse request returning *PostRunnersUncordonNameResponse
+func (c *ClientWithResponses) PostRunnersUncordonNameWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*PostRunnersUncordonNameResponse, error) {
+	rsp, err := c.PostRunnersUncordonName(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRunnersUncordonNameResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteTokenResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetRunsWithResponse request returning *GetRunsResponse
+func (c *ClientWithResponses) GetRunsWithResponse(ctx context.Context, params *GetRunsParams, reqEditors ...RequestEditorFn) (*GetRunsResponse, error) {
+	rsp, err := c.GetRuns(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetRunsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteTokenResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetRunsCountWithResponse request returning *GetRunsCountResponse
+func (c *ClientWithResponses) GetRunsCountWithResponse(ctx context.Context, params *GetRunsCountParams, reqEditors ...RequestEditorFn) (*GetRunsCountResponse, error) {
+	rsp, err := c.GetRunsCount(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetRunsCountResponse(rsp)
 }
 
-type GetTokenResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *string
-	JSON500      *Error
+// GetSubmissionIdWithResponse request returning *GetSubmissionIdResponse
+func (c *ClientWithResponses) GetSubmissionIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetSubmissionIdResponse, error) {
+	rsp, err := c.GetSubmissionId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSubmissionIdResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetTokenResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PostSubmissionIdCancelWithResponse request returning *PostSubmissionIdCancelResponse
+func (c *ClientWithResponses) PostSubmissionIdCancelWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostSubmissionIdCancelResponse, error) {
+	rsp, err := c.PostSubmissionIdCancel(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSubmissionIdCancelResponse(rsp)
+}
+
+// PostSubmissionIdRerunWithResponse request returning *PostSubmissionIdRerunResponse
+func (c *ClientWithResponses) PostSubmissionIdRerunWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostSubmissionIdRerunResponse, error) {
+	rsp, err := c.PostSubmissionIdRerun(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostSubmissionIdRerunResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTokenResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetSubmissionIdRunsWithResponse request returning *GetSubmissionIdRunsResponse
+func (c *ClientWithResponses) GetSubmissionIdRunsWithResponse(ctx context.Context, id int64, params *GetSubmissionIdRunsParams, reqEditors ...RequestEditorFn) (*GetSubmissionIdRunsResponse, error) {
+	rsp, err := c.GetSubmissionIdRuns(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetSubmissionIdRunsResponse(rsp)
 }
 
-type GetUserPropertiesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		AdditionalProperties map[string]interface{} `json:"-"`
+// GetSubmissionIdTasksWithResponse request returning *GetSubmissionIdTasksResponse
+func (c *ClientWithResponses) GetSubmissionIdTasksWithResponse(ctx context.Context, id int64, params *GetSubmissionIdTasksParams, reqEditors ...RequestEditorFn) (*GetSubmissionIdTasksResponse, error) {
+	rsp, err := c.GetSubmissionIdTasks(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	JSON500 *Error
+	return ParseGetSubmissionIdTasksResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetUserPropertiesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetSubmissionsWithResponse request returning *GetSubmissionsResponse
+func (c *ClientWithResponses) GetSubmissionsWithResponse(ctx context.Context, params *GetSubmissionsParams, reqEditors ...RequestEditorFn) (*GetSubmissionsResponse, error) {
+	rsp, err := c.GetSubmissions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetSubmissionsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetUserPropertiesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetSubmissionsCountWithResponse request returning *GetSubmissionsCountResponse
+func (c *ClientWithResponses) GetSubmissionsCountWithResponse(ctx context.Context, params *GetSubmissionsCountParams, reqEditors ...RequestEditorFn) (*GetSubmissionsCountResponse, error) {
+	rsp, err := c.GetSubmissionsCount(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetSubmissionsCountResponse(rsp)
 }
 
-// PostCancelRunIdWithResponse request returning *PostCancelRunIdResponse
-func (c *ClientWithResponses) PostCancelRunIdWithResponse(ctx context.Context, runId int64, reqEditors ...RequestEditorFn) (*PostCancelRunIdResponse, error) {
-	rsp, err := c.PostCancelRunId(ctx, runId, reqEditors...)
+// GetSubmitWithResponse request returning *GetSubmitResponse
+func (c *ClientWithResponses) GetSubmitWithResponse(ctx context.Context, params *GetSubmitParams, reqEditors ...RequestEditorFn) (*GetSubmitResponse, error) {
+	rsp, err := c.GetSubmit(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostCancelRunIdResponse(rsp)
+	return ParseGetSubmitResponse(rsp)
 }
 
-// DeleteCapabilitiesUsernameCapabilityWithResponse request returning *DeleteCapabilitiesUsernameCapabilityResponse
-func (c *ClientWithResponses) DeleteCapabilitiesUsernameCapabilityWithResponse(ctx context.Context, username string, capability string, reqEditors ...RequestEditorFn) (*DeleteCapabilitiesUsernameCapabilityResponse, error) {
-	rsp, err := c.DeleteCapabilitiesUsernameCapability(ctx, username, capability, reqEditors...)
+// GetTasksWithResponse request returning *GetTasksResponse
+func (c *ClientWithResponses) GetTasksWithResponse(ctx context.Context, params *GetTasksParams, reqEditors ...RequestEditorFn) (*GetTasksResponse, error) {
+	rsp, err := c.GetTasks(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteCapabilitiesUsernameCapabilityResponse(rsp)
+	return ParseGetTasksResponse(rsp)
 }
 
-// PostCapabilitiesUsernameCapabilityWithResponse request returning *PostCapabilitiesUsernameCapabilityResponse
-func (c *ClientWithResponses) PostCapabilitiesUsernameCapabilityWithResponse(ctx context.Context, username string, capability string, reqEditors ...RequestEditorFn) (*PostCapabilitiesUsernameCapabilityResponse, error) {
-	rsp, err := c.PostCapabilitiesUsernameCapability(ctx, username, capability, reqEditors...)
+// PostTasksCancelIdWithResponse request returning *PostTasksCancelIdResponse
+func (c *ClientWithResponses) PostTasksCancelIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostTasksCancelIdResponse, error) {
+	rsp, err := c.PostTasksCancelId(ctx, id, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostCapabilitiesUsernameCapabilityResponse(rsp)
+	return ParsePostTasksCancelIdResponse(rsp)
 }
 
-// GetErrorsWithResponse request returning *GetErrorsResponse
-func (c *ClientWithResponses) GetErrorsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetErrorsResponse, error) {
-	rsp, err := c.GetErrors(ctx, reqEditors...)
+// GetTasksCountWithResponse request returning *GetTasksCountResponse
+func (c *ClientWithResponses) GetTasksCountWithResponse(ctx context.Context, params *GetTasksCountParams, reqEditors ...RequestEditorFn) (*GetTasksCountResponse, error) {
+	rsp, err := c.GetTasksCount(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetErrorsResponse(rsp)
+	return ParseGetTasksCountResponse(rsp)
 }
 
-// GetLogAttachIdWithResponse request returning *GetLogAttachIdResponse
-func (c *ClientWithResponses) GetLogAttachIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetLogAttachIdResponse, error) {
-	rsp, err := c.GetLogAttachId(ctx, id, reqEditors...)
+// PostTasksRerunIdWithResponse request returning *PostTasksRerunIdResponse
+func (c *ClientWithResponses) PostTasksRerunIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostTasksRerunIdResponse, error) {
+	rsp, err := c.PostTasksRerunId(ctx, id, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetLogAttachIdResponse(rsp)
+	return ParsePostTasksRerunIdResponse(rsp)
 }
 
-// GetLoggedinWithResponse request returning *GetLoggedinResponse
-func (c *ClientWithResponses) GetLoggedinWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLoggedinResponse, error) {
-	rsp, err := c.GetLoggedin(ctx, reqEditors...)
+// GetTasksRunsIdWithResponse request returning *GetTasksRunsIdResponse
+func (c *ClientWithResponses) GetTasksRunsIdWithResponse(ctx context.Context, id int64, params *GetTasksRunsIdParams, reqEditors ...RequestEditorFn) (*GetTasksRunsIdResponse, error) {
+	rsp, err := c.GetTasksRunsId(ctx, id, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetLoggedinResponse(rsp)
+	return ParseGetTasksRunsIdResponse(rsp)
 }
 
-// GetLoginWithResponse request returning *GetLoginResponse
-func (c *ClientWithResponses) GetLoginWithResponse(ctx context.Context, params *GetLoginParams, reqEditors ...RequestEditorFn) (*GetLoginResponse, error) {
-	rsp, err := c.GetLogin(ctx, params, reqEditors...)
+// GetTasksRunsIdCountWithResponse request returning *GetTasksRunsIdCountResponse
+func (c *ClientWithResponses) GetTasksRunsIdCountWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetTasksRunsIdCountResponse, error) {
+	rsp, err := c.GetTasksRunsIdCount(ctx, id, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetLoginResponse(rsp)
+	return ParseGetTasksRunsIdCountResponse(rsp)
 }
 
-// GetLoginUpgradeWithResponse request returning *GetLoginUpgradeResponse
-func (c *ClientWithResponses) GetLoginUpgradeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLoginUpgradeResponse, error) {
-	rsp, err := c.GetLoginUpgrade(ctx, reqEditors...)
+// GetTasksSubscribedWithResponse request returning *GetTasksSubscribedResponse
+func (c *ClientWithResponses) GetTasksSubscribedWithResponse(ctx context.Context, params *GetTasksSubscribedParams, reqEditors ...RequestEditorFn) (*GetTasksSubscribedResponse, error) {
+	rsp, err := c.GetTasksSubscribed(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetLoginUpgradeResponse(rsp)
+	return ParseGetTasksSubscribedResponse(rsp)
 }
 
-// GetLogoutWithResponse request returning *GetLogoutResponse
-func (c *ClientWithResponses) GetLogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLogoutResponse, error) {
-	rsp, err := c.GetLogout(ctx, reqEditors...)
+// DeleteTokenWithResponse request returning *DeleteTokenResponse
+func (c *ClientWithResponses) DeleteTokenWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteTokenResponse, error) {
+	rsp, err := c.DeleteToken(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetLogoutResponse(rsp)
+	return ParseDeleteTokenResponse(rsp)
 }
 
-// GetRepositoriesCiAddOwnerRepoWithResponse request returning *GetRepositoriesCiAddOwnerRepoResponse
-func (c *ClientWithResponses) GetRepositoriesCiAddOwnerRepoWithResponse(ctx context.Context, owner string, repo string, reqEditors ...RequestEditorFn) (*GetRepositoriesCiAddOwnerRepoResponse, error) {
-	rsp, err := c.GetRepositoriesCiAddOwnerRepo(ctx, owner, repo, reqEditors...)
+// GetTokenWithResponse request returning *GetTokenResponse
+func (c *ClientWithResponses) GetTokenWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTokenResponse, error) {
+	rsp, err := c.GetToken(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetRepositoriesCiAddOwnerRepoResponse(rsp)
+	return ParseGetTokenResponse(rsp)
 }
 
-// GetRepositoriesCiDelOwnerRepoWithResponse request returning *GetRepositoriesCiDelOwnerRepoResponse
-func (c *ClientWithResponses) GetRepositoriesCiDelOwnerRepoWithResponse(ctx context.Context, owner string, repo string, reqEditors ...RequestEditorFn) (*GetRepositoriesCiDelOwnerRepoResponse, error) {
-	rsp, err := c.GetRepositoriesCiDelOwnerRepo(ctx, owner, repo, reqEditors...)
+// GetUserPropertiesWithResponse request returning *GetUserPropertiesResponse
+func (c *ClientWithResponses) GetUserPropertiesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserPropertiesResponse, error) {
+	rsp, err := c.GetUserProperties(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetRepositoriesCiDelOwnerRepoResponse(rsp)
+	return ParseGetUserPropertiesResponse(rsp)
 }
 
-// GetRepositoriesMyWithResponse request returning *GetRepositoriesMyResponse
-func (c *ClientWithResponses) GetRepositoriesMyWithResponse(ctx context.Context, params *GetRepositoriesMyParams, reqEditors ...RequestEditorFn) (*GetRepositoriesMyResponse, error) {
-	rsp, err := c.GetRepositoriesMy(ctx, params, reqEditors...)
+// ParseGetArtifactsRunIdResponse parses an HTTP response from a GetArtifactsRunIdWithResponse call
+func ParseGetArtifactsRunIdResponse(rsp *http.Response) (*GetArtifactsRunIdResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetRepositoriesMyResponse(rsp)
+
+	response := &GetArtifactsRunIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ArtifactList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// GetRepositoriesScanWithResponse request returning *GetRepositoriesScanResponse
-func (c *ClientWithResponses) GetRepositoriesScanWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRepositoriesScanResponse, error) {
-	rsp, err := c.GetRepositoriesScan(ctx, reqEditors...)
+// ParseGetArtifactsRunIdNameResponse parses an HTTP response from a GetArtifactsRunIdNameWithResponse call
+func ParseGetArtifactsRunIdNameResponse(rsp *http.Response) (*GetArtifactsRunIdNameResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetRepositoriesScanResponse(rsp)
+
+	response := &GetArtifactsRunIdNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// GetRepositoriesSubAddOwnerRepoWithResponse request returning *GetRepositoriesSubAddOwnerRepoResponse
-func (c *ClientWithResponses) GetRepositoriesSubAddOwnerRepoWithResponse(ctx context.Context, owner string, repo string, reqEditors ...RequestEditorFn) (*GetRepositoriesSubAddOwnerRepoResponse, error) {
-	rsp, err := c.GetRepositoriesSubAddOwnerRepo(ctx, owner, repo, reqEditors...)
+// ParsePostCancelRunIdResponse parses an HTTP response from a PostCancelRunIdWithResponse call
+func ParsePostCancelRunIdResponse(rsp *http.Response) (*PostCancelRunIdResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetRepositoriesSubAddOwnerRepoResponse(rsp)
+
+	response := &PostCancelRunIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// GetRepositoriesSubDelOwnerRepoWithResponse request returning *GetRepositoriesSubDelOwnerRepoResponse
-func (c *ClientWithResponses) GetRepositoriesSubDelOwnerRepoWithResponse(ctx context.Context, owner string, repo string, reqEditors ...RequestEditorFn) (*GetRepositoriesSubDelOwnerRepoResponse, error) {
-	rsp, err := c.GetRepositoriesSubDelOwnerRepo(ctx, owner, repo, reqEditors...)
+// ParseDeleteCapabilitiesUsernameCapabilityResponse parses an HTTP response from a DeleteCapabilitiesUsernameCapabilityWithResponse call
+func ParseDeleteCapabilitiesUsernameCapabilityResponse(rsp *http.Response) (*DeleteCapabilitiesUsernameCapabilityResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetRepositoriesSubDelOwnerRepoResponse(rsp)
+
+	response := &DeleteCapabilitiesUsernameCapabilityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// GetRepositoriesSubscribedWithResponse request returning *GetRepositoriesSubscribedResponse
-func (c *ClientWithResponses) GetRepositoriesSubscribedWithResponse(ctx context.Context, params *GetRepositoriesSubscribedParams, reqEditors ...RequestEditorFn) (*GetRepositoriesSubscribedResponse, error) {
-	rsp, err := c.GetRepositoriesSubscribed(ctx, params, reqEditors...)
+// ParsePostCapabilitiesUsernameCapabilityResponse parses an HTTP response from a PostCapabilitiesUsernameCapabilityWithResponse call
+func ParsePostCapabilitiesUsernameCapabilityResponse(rsp *http.Response) (*PostCapabilitiesUsernameCapabilityResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetRepositoriesSubscribedResponse(rsp)
+
+	response := &PostCapabilitiesUsernameCapabilityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// GetRepositoriesVisibleWithResponse request returning *GetRepositoriesVisibleResponse
-func (c *ClientWithResponses) GetRepositoriesVisibleWithResponse(ctx context.Context, params *GetRepositoriesVisibleParams, reqEditors ...RequestEditorFn) (*GetRepositoriesVisibleResponse, error) {
-	rsp, err := c.GetRepositoriesVisible(ctx, params, reqEditors...)
+// ParseGetErrorsResponse parses an HTTP response from a GetErrorsWithResponse call
+func ParseGetErrorsResponse(rsp *http.Response) (*GetErrorsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetRepositoriesVisibleResponse(rsp)
-}
 
-// GetRunRunIdWithResponse request returning *GetRunRunIdResponse
-func (c *ClientWithResponses) GetRunRunIdWithResponse(ctx context.Context, runId int64, reqEditors ...RequestEditorFn) (*GetRunRunIdResponse, error) {
-	rsp, err := c.GetRunRunId(ctx, runId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetErrorsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetRunRunIdResponse(rsp)
-}
 
-// GetRunsWithResponse request returning *GetRunsResponse
-func (c *ClientWithResponses) GetRunsWithResponse(ctx context.Context, params *GetRunsParams, reqEditors ...RequestEditorFn) (*GetRunsResponse, error) {
-	rsp, err := c.GetRuns(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []UserError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseGetRunsResponse(rsp)
+
+	return response, nil
 }
 
-// GetRunsCountWithResponse request returning *GetRunsCountResponse
-func (c *ClientWithResponses) GetRunsCountWithResponse(ctx context.Context, params *GetRunsCountParams, reqEditors ...RequestEditorFn) (*GetRunsCountResponse, error) {
-	rsp, err := c.GetRunsCount(ctx, params, reqEditors...)
+// ParseGetLogAttachIdResponse parses an HTTP response from a GetLogAttachIdWithResponse call
+func ParseGetLogAttachIdResponse(rsp *http.Response) (*GetLogAttachIdResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetRunsCountResponse(rsp)
-}
 
-// GetSubmissionIdWithResponse request returning *GetSubmissionIdResponse
-func (c *ClientWithResponses) GetSubmissionIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetSubmissionIdResponse, error) {
-	rsp, err := c.GetSubmissionId(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetLogAttachIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetSubmissionIdResponse(rsp)
-}
 
-// PostSubmissionIdCancelWithResponse request returning *PostSubmissionIdCancelResponse
-func (c *ClientWithResponses) PostSubmissionIdCancelWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostSubmissionIdCancelResponse, error) {
-	rsp, err := c.PostSubmissionIdCancel(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParsePostSubmissionIdCancelResponse(rsp)
+
+	return response, nil
 }
 
-// GetSubmissionIdRunsWithResponse request returning *GetSubmissionIdRunsResponse
-func (c *ClientWithResponses) GetSubmissionIdRunsWithResponse(ctx context.Context, id int64, params *GetSubmissionIdRunsParams, reqEditors ...RequestEditorFn) (*GetSubmissionIdRunsResponse, error) {
-	rsp, err := c.GetSubmissionIdRuns(ctx, id, params, reqEditors...)
+// ParseGetLogSectionsIdResponse parses an HTTP response from a GetLogSectionsIdWithResponse call
+func ParseGetLogSectionsIdResponse(rsp *http.Response) (*GetLogSectionsIdResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetSubmissionIdRunsResponse(rsp)
-}
 
-// GetSubmissionIdTasksWithResponse request returning *GetSubmissionIdTasksResponse
-func (c *ClientWithResponses) GetSubmissionIdTasksWithResponse(ctx context.Context, id int64, params *GetSubmissionIdTasksParams, reqEditors ...RequestEditorFn) (*GetSubmissionIdTasksResponse, error) {
-	rsp, err := c.GetSubmissionIdTasks(ctx, id, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetLogSectionsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetSubmissionIdTasksResponse(rsp)
-}
 
-// GetSubmissionsWithResponse request returning *GetSubmissionsResponse
-func (c *ClientWithResponses) GetSubmissionsWithResponse(ctx context.Context, params *GetSubmissionsParams, reqEditors ...RequestEditorFn) (*GetSubmissionsResponse, error) {
-	rsp, err := c.GetSubmissions(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LogSections
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseGetSubmissionsResponse(rsp)
+
+	return response, nil
 }
 
-// GetSubmissionsCountWithResponse request returning *GetSubmissionsCountResponse
-func (c *ClientWithResponses) GetSubmissionsCountWithResponse(ctx context.Context, params *GetSubmissionsCountParams, reqEditors ...RequestEditorFn) (*GetSubmissionsCountResponse, error) {
-	rsp, err := c.GetSubmissionsCount(ctx, params, reqEditors...)
+// ParseGetLoggedinResponse parses an HTTP response from a GetLoggedinWithResponse call
+func ParseGetLoggedinResponse(rsp *http.Response) (*GetLoggedinResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetSubmissionsCountResponse(rsp)
-}
 
-// GetSubmitWithResponse request returning *GetSubmitResponse
-func (c *ClientWithResponses) GetSubmitWithResponse(ctx context.Context, params *GetSubmitParams, reqEditors ...RequestEditorFn) (*GetSubmitResponse, error) {
-	rsp, err := c.GetSubmit(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetLoggedinResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetSubmitResponse(rsp)
-}
 
-// GetTasksWithResponse request returning *GetTasksResponse
-func (c *ClientWithResponses) GetTasksWithResponse(ctx context.Context, params *GetTasksParams, reqEditors ...RequestEditorFn) (*GetTasksResponse, error) {
-	rsp, err := c.GetTasks(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseGetTasksResponse(rsp)
+
+	return response, nil
 }
 
-// PostTasksCancelIdWithResponse request returning *PostTasksCancelIdResponse
-func (c *ClientWithResponses) PostTasksCancelIdWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*PostTasksCancelIdResponse, error) {
-	rsp, err := c.PostTasksCancelId(ctx, id, reqEditors...)
+// ParseGetLoginResponse parses an HTTP response from a GetLoginWithResponse call
+func ParseGetLoginResponse(rsp *http.Response) (*GetLoginResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostTasksCancelIdResponse(rsp)
-}
 
-// GetTasksCountWithResponse request returning *GetTasksCountResponse
-func (c *ClientWithResponses) GetTasksCountWithResponse(ctx context.Context, params *GetTasksCountParams, reqEditors ...RequestEditorFn) (*GetTasksCountResponse, error) {
-	rsp, err := c.GetTasksCount(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetLoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetTasksCountResponse(rsp)
-}
 
-// GetTasksRunsIdWithResponse request returning *GetTasksRunsIdResponse
-func (c *ClientWithResponses) GetTasksRunsIdWithResponse(ctx context.Context, id int64, params *GetTasksRunsIdParams, reqEditors ...RequestEditorFn) (*GetTasksRunsIdResponse, error) {
-	rsp, err := c.GetTasksRunsId(ctx, id, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseGetTasksRunsIdResponse(rsp)
+
+	return response, nil
 }
 
-// GetTasksRunsIdCountWithResponse request returning *GetTasksRunsIdCountResponse
-func (c *ClientWithResponses) GetTasksRunsIdCountWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*GetTasksRunsIdCountResponse, error) {
-	rsp, err := c.GetTasksRunsIdCount(ctx, id, reqEditors...)
+// ParseGetLoginUpgradeResponse parses an HTTP response from a GetLoginUpgradeWithResponse call
+func ParseGetLoginUpgradeResponse(rsp *http.Response) (*GetLoginUpgradeResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetTasksRunsIdCountResponse(rsp)
-}
 
-// GetTasksSubscribedWithResponse request returning *GetTasksSubscribedResponse
-func (c *ClientWithResponses) GetTasksSubscribedWithResponse(ctx context.Context, params *GetTasksSubscribedParams, reqEditors ...RequestEditorFn) (*GetTasksSubscribedResponse, error) {
-	rsp, err := c.GetTasksSubscribed(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetLoginUpgradeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetTasksSubscribedResponse(rsp)
-}
 
-// DeleteTokenWithResponse request returning *DeleteTokenResponse
-func (c *ClientWithResponses) DeleteTokenWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteTokenResponse, error) {
-	rsp, err := c.DeleteToken(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseDeleteTokenResponse(rsp)
+
+	return response, nil
 }
 
-// GetTokenWithResponse request returning *GetTokenResponse
-func (c *ClientWithResponses) GetTokenWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTokenResponse, error) {
-	rsp, err := c.GetToken(ctx, reqEditors...)
+// ParseGetLogoutResponse parses an HTTP response from a GetLogoutWithResponse call
+func ParseGetLogoutResponse(rsp *http.Response) (*GetLogoutResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetTokenResponse(rsp)
-}
 
-// GetUserPropertiesWithResponse request returning *GetUserPropertiesResponse
-func (c *ClientWithResponses) GetUserPropertiesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserPropertiesResponse, error) {
-	rsp, err := c.GetUserProperties(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetLogoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseGetUserPropertiesResponse(rsp)
+
+	return response, nil
 }
 
-// ParsePostCancelRunIdResponse parses an HTTP response from a PostCancelRunIdWithResponse call
-func ParsePostCancelRunIdResponse(rsp *http.Response) (*PostCancelRunIdResponse, error) {
+// ParseGetLogsSearchResponse parses an HTTP response from a GetLogsSearchWithResponse call
+func ParseGetLogsSearchResponse(rsp *http.Response) (*GetLogsSearchResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostCancelRunIdResponse{
+	response := &GetLogsSearchResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LogSearchResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4200,15 +6157,15 @@ func ParsePostCancelRunIdResponse(rsp *http.Response) (*PostCancelRunIdResponse,
 	return response, nil
 }
 
-// ParseDeleteCapabilitiesUsernameCapabilityResponse parses an HTTP response from a DeleteCapabilitiesUsernameCapabilityWithResponse call
-func ParseDeleteCapabilitiesUsernameCapabilityResponse(rsp *http.Response) (*DeleteCapabilitiesUsernameCapabilityResponse, error) {
+// ParseGetRepositoriesCiAddOwnerRepoResponse parses an HTTP response from a GetRepositoriesCiAddOwnerRepoWithResponse call
+func ParseGetRepositoriesCiAddOwnerRepoResponse(rsp *http.Response) (*GetRepositoriesCiAddOwnerRepoResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteCapabilitiesUsernameCapabilityResponse{
+	response := &GetRepositoriesCiAddOwnerRepoResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -4226,15 +6183,15 @@ func ParseDeleteCapabilitiesUsernameCapabilityResponse(rsp *http.Response) (*Del
 	return response, nil
 }
 
-// ParsePostCapabilitiesUsernameCapabilityResponse parses an HTTP response from a PostCapabilitiesUsernameCapabilityWithResponse call
-func ParsePostCapabilitiesUsernameCapabilityResponse(rsp *http.Response) (*PostCapabilitiesUsernameCapabilityResponse, error) {
+// ParseGetRepositoriesCiDelOwnerRepoResponse parses an HTTP response from a GetRepositoriesCiDelOwnerRepoWithResponse call
+func ParseGetRepositoriesCiDelOwnerRepoResponse(rsp *http.Response) (*GetRepositoriesCiDelOwnerRepoResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostCapabilitiesUsernameCapabilityResponse{
+	response := &GetRepositoriesCiDelOwnerRepoResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -4252,22 +6209,22 @@ func ParsePostCapabilitiesUsernameCapabilityResponse(rsp *http.Response) (*PostC
 	return response, nil
 }
 
-// ParseGetErrorsResponse parses an HTTP response from a GetErrorsWithResponse call
-func ParseGetErrorsResponse(rsp *http.Response) (*GetErrorsResponse, error) {
+// ParseGetRepositoriesMyResponse parses an HTTP response from a GetRepositoriesMyWithResponse call
+func ParseGetRepositoriesMyResponse(rsp *http.Response) (*GetRepositoriesMyResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetErrorsResponse{
+	response := &GetRepositoriesMyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []UserError
+		var dest RepositoryList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4285,15 +6242,15 @@ func ParseGetErrorsResponse(rsp *http.Response) (*GetErrorsResponse, error) {
 	return response, nil
 }
 
-// ParseGetLogAttachIdResponse parses an HTTP response from a GetLogAttachIdWithResponse call
-func ParseGetLogAttachIdResponse(rsp *http.Response) (*GetLogAttachIdResponse, error) {
+// ParseGetRepositoriesScanResponse parses an HTTP response from a GetRepositoriesScanWithResponse call
+func ParseGetRepositoriesScanResponse(rsp *http.Response) (*GetRepositoriesScanResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetLogAttachIdResponse{
+	response := &GetRepositoriesScanResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -4311,27 +6268,20 @@ func ParseGetLogAttachIdResponse(rsp *http.Response) (*GetLogAttachIdResponse, e
 	return response, nil
 }
 
-// ParseGetLoggedinResponse parses an HTTP response from a GetLoggedinWithResponse call
-func ParseGetLoggedinResponse(rsp *http.Response) (*GetLoggedinResponse, error) {
+// ParseGetRepositoriesSubAddOwnerRepoResponse parses an HTTP response from a GetRepositoriesSubAddOwnerRepoWithResponse call
+func ParseGetRepositoriesSubAddOwnerRepoResponse(rsp *http.Response) (*GetRepositoriesSubAddOwnerRepoResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetLoggedinResponse{
+	response := &GetRepositoriesSubAddOwnerRepoResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest string
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4344,15 +6294,15 @@ func ParseGetLoggedinResponse(rsp *http.Response) (*GetLoggedinResponse, error)
 	return response, nil
 }
 
-// ParseGetLoginResponse parses an HTTP response from a GetLoginWithResponse call
-func ParseGetLoginResponse(rsp *http.Response) (*GetLoginResponse, error) {
+// ParseGetRepositoriesSubDelOwnerRepoResponse parses an HTTP response from a GetRepositoriesSubDelOwnerRepoWithResponse call
+func ParseGetRepositoriesSubDelOwnerRepoResponse(rsp *http.Response) (*GetRepositoriesSubDelOwnerRepoResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetLoginResponse{
+	response := &GetRepositoriesSubDelOwnerRepoResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -4370,20 +6320,27 @@ func ParseGetLoginResponse(rsp *http.Response) (*GetLoginResponse, error) {
 	return response, nil
 }
 
-// ParseGetLoginUpgradeResponse parses an HTTP response from a GetLoginUpgradeWithResponse call
-func ParseGetLoginUpgradeResponse(rsp *http.Response) (*GetLoginUpgradeResponse, error) {
+// ParseGetRepositoriesSubscribedResponse parses an HTTP response from a GetRepositoriesSubscribedWithResponse call
+func ParseGetRepositoriesSubscribedResponse(rsp *http.Response) (*GetRepositoriesSubscribedResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetLoginUpgradeResponse{
+	response := &GetRepositoriesSubscribedResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RepositoryList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4396,20 +6353,27 @@ func ParseGetLoginUpgradeResponse(rsp *http.Response) (*GetLoginUpgradeResponse,
 	return response, nil
 }
 
-// ParseGetLogoutResponse parses an HTTP response from a GetLogoutWithResponse call
-func ParseGetLogoutResponse(rsp *http.Response) (*GetLogoutResponse, error) {
+// ParseGetRepositoriesVisibleResponse parses an HTTP response from a GetRepositoriesVisibleWithResponse call
+func ParseGetRepositoriesVisibleResponse(rsp *http.Response) (*GetRepositoriesVisibleResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetLogoutResponse{
+	response := &GetRepositoriesVisibleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RepositoryList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4422,20 +6386,27 @@ func ParseGetLogoutResponse(rsp *http.Response) (*GetLogoutResponse, error) {
 	return response, nil
 }
 
-// ParseGetRepositoriesCiAddOwnerRepoResponse parses an HTTP response from a GetRepositoriesCiAddOwnerRepoWithResponse call
-func ParseGetRepositoriesCiAddOwnerRepoResponse(rsp *http.Response) (*GetRepositoriesCiAddOwnerRepoResponse, error) {
+// ParseGetRepositoriesOwnerRepoFlakyResponse parses an HTTP response from a GetRepositoriesOwnerRepoFlakyWithResponse call
+func ParseGetRepositoriesOwnerRepoFlakyResponse(rsp *http.Response) (*GetRepositoriesOwnerRepoFlakyResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetRepositoriesCiAddOwnerRepoResponse{
+	response := &GetRepositoriesOwnerRepoFlakyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FlakyTestList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4448,20 +6419,27 @@ func ParseGetRepositoriesCiAddOwnerRepoResponse(rsp *http.Response) (*GetReposit
 	return response, nil
 }
 
-// ParseGetRepositoriesCiDelOwnerRepoResponse parses an HTTP response from a GetRepositoriesCiDelOwnerRepoWithResponse call
-func ParseGetRepositoriesCiDelOwnerRepoResponse(rsp *http.Response) (*GetRepositoriesCiDelOwnerRepoResponse, error) {
+// ParsePostRerunRunIdResponse parses an HTTP response from a PostRerunRunIdWithResponse call
+func ParsePostRerunRunIdResponse(rsp *http.Response) (*PostRerunRunIdResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetRepositoriesCiDelOwnerRepoResponse{
+	response := &PostRerunRunIdResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Run
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4474,22 +6452,22 @@ func ParseGetRepositoriesCiDelOwnerRepoResponse(rsp *http.Response) (*GetReposit
 	return response, nil
 }
 
-// ParseGetRepositoriesMyResponse parses an HTTP response from a GetRepositoriesMyWithResponse call
-func ParseGetRepositoriesMyResponse(rsp *http.Response) (*GetRepositoriesMyResponse, error) {
+// ParseGetRunRunIdResponse parses an HTTP response from a GetRunRunIdWithResponse call
+func ParseGetRunRunIdResponse(rsp *http.Response) (*GetRunRunIdResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetRepositoriesMyResponse{
+	response := &GetRunRunIdResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest RepositoryList
+		var dest Run
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4507,20 +6485,27 @@ func ParseGetRepositoriesMyResponse(rsp *http.Response) (*GetRepositoriesMyRespo
 	return response, nil
 }
 
-// ParseGetRepositoriesScanResponse parses an HTTP response from a GetRepositoriesScanWithResponse call
-func ParseGetRepositoriesScanResponse(rsp *http.Response) (*GetRepositoriesScanResponse, error) {
+// ParseGetRunRunIdTestsResponse parses an HTTP response from a GetRunRunIdTestsWithResponse call
+func ParseGetRunRunIdTestsResponse(rsp *http.Response) (*GetRunRunIdTestsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetRepositoriesScanResponse{
+	response := &GetRunRunIdTestsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TestCaseList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4533,20 +6518,27 @@ func ParseGetRepositoriesScanResponse(rsp *http.Response) (*GetRepositoriesScanR
 	return response, nil
 }
 
-// ParseGetRepositoriesSubAddOwnerRepoResponse parses an HTTP response from a GetRepositoriesSubAddOwnerRepoWithResponse call
-func ParseGetRepositoriesSubAddOwnerRepoResponse(rsp *http.Response) (*GetRepositoriesSubAddOwnerRepoResponse, error) {
+// ParseGetRunRunIdTestsSummaryResponse parses an HTTP response from a GetRunRunIdTestsSummaryWithResponse call
+func ParseGetRunRunIdTestsSummaryResponse(rsp *http.Response) (*GetRunRunIdTestsSummaryResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetRepositoriesSubAddOwnerRepoResponse{
+	response := &GetRunRunIdTestsSummaryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TestSummary
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4559,20 +6551,27 @@ func ParseGetRepositoriesSubAddOwnerRepoResponse(rsp *http.Response) (*GetReposi
 	return response, nil
 }
 
-// ParseGetRepositoriesSubDelOwnerRepoResponse parses an HTTP response from a GetRepositoriesSubDelOwnerRepoWithResponse call
-func ParseGetRepositoriesSubDelOwnerRepoResponse(rsp *http.Response) (*GetRepositoriesSubDelOwnerRepoResponse, error) {
+// ParseGetRunnersResponse parses an HTTP response from a GetRunnersWithResponse call
+func ParseGetRunnersResponse(rsp *http.Response) (*GetRunnersResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetRepositoriesSubDelOwnerRepoResponse{
+	response := &GetRunnersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RunnerList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4585,27 +6584,20 @@ func ParseGetRepositoriesSubDelOwnerRepoResponse(rsp *http.Response) (*GetReposi
 	return response, nil
 }
 
-// ParseGetRepositoriesSubscribedResponse parses an HTTP response from a GetRepositoriesSubscribedWithResponse call
-func ParseGetRepositoriesSubscribedResponse(rsp *http.Response) (*GetRepositoriesSubscribedResponse, error) {
+// ParsePostRunnersCordonNameResponse parses an HTTP response from a PostRunnersCordonNameWithResponse call
+func ParsePostRunnersCordonNameResponse(rsp *http.Response) (*PostRunnersCordonNameResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetRepositoriesSubscribedResponse{
+	response := &PostRunnersCordonNameResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest RepositoryList
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4618,27 +6610,20 @@ func ParseGetRepositoriesSubscribedResponse(rsp *http.Response) (*GetRepositorie
 	return response, nil
 }
 
-// ParseGetRepositoriesVisibleResponse parses an HTTP response from a GetRepositoriesVisibleWithResponse call
-func ParseGetRepositoriesVisibleResponse(rsp *http.Response) (*GetRepositoriesVisibleResponse, error) {
+// ParsePostRunnersDrainNameResponse parses an HTTP response from a PostRunnersDrainNameWithResponse call
+func ParsePostRunnersDrainNameResponse(rsp *http.Response) (*PostRunnersDrainNameResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetRepositoriesVisibleResponse{
+	response := &PostRunnersDrainNameResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest RepositoryList
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4651,27 +6636,20 @@ func ParseGetRepositoriesVisibleResponse(rsp *http.Response) (*GetRepositoriesVi
 	return response, nil
 }
 
-// ParseGetRunRunIdResponse parses an HTTP response from a GetRunRunIdWithResponse call
-func ParseGetRunRunIdResponse(rsp *http.Response) (*GetRunRunIdResponse, error) {
+// ParsePostRunnersUncordonNameResponse parses an HTTP response from a PostRunnersUncordonNameWithResponse call
+func ParsePostRunnersUncordonNameResponse(rsp *http.Response) (*PostRunnersUncordonNameResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer rsp.Body.Close()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetRunRunIdResponse{
+	response := &PostRunnersUncordonNameResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Run
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4809,6 +6787,39 @@ func ParsePostSubmissionIdCancelResponse(rsp *http.Response) (*PostSubmissionIdC
 	return response, nil
 }
 
+// ParsePostSubmissionIdRerunResponse parses an HTTP response from a PostSubmissionIdRerunWithResponse call
+func ParsePostSubmissionIdRerunResponse(rsp *http.Response) (*PostSubmissionIdRerunResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSubmissionIdRerunResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RunList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetSubmissionIdRunsResponse parses an HTTP response from a GetSubmissionIdRunsWithResponse call
 func ParseGetSubmissionIdRunsResponse(rsp *http.Response) (*GetSubmissionIdRunsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
@@ -5059,6 +7070,39 @@ func ParseGetTasksCountResponse(rsp *http.Response) (*GetTasksCountResponse, err
 	return response, nil
 }
 
+// ParsePostTasksRerunIdResponse parses an HTTP response from a PostTasksRerunIdWithResponse call
+func ParsePostTasksRerunIdResponse(rsp *http.Response) (*PostTasksRerunIdResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTasksRerunIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RunList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetTasksRunsIdResponse parses an HTTP response from a GetTasksRunsIdWithResponse call
 func ParseGetTasksRunsIdResponse(rsp *http.Response) (*GetTasksRunsIdResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
@@ -5254,6 +7298,12 @@ func ParseGetUserPropertiesResponse(rsp *http.Response) (*GetUserPropertiesRespo
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List the artifacts of a run
+	// (GET /artifacts/{run_id})
+	GetArtifactsRunId(ctx echo.Context, runId int64) error
+	// Download an artifact of a run
+	// (GET /artifacts/{run_id}/{name})
+	GetArtifactsRunIdName(ctx echo.Context, runId int64, name string) error
 	// Cancel by Run ID
 	// (POST /cancel/{run_id})
 	PostCancelRunId(ctx echo.Context, runId int64) error
@@ -5268,7 +7318,10 @@ type ServerInterface interface {
 	GetErrors(ctx echo.Context) error
 	// Attach to a running log
 	// (GET /log/attach/{id})
-	GetLogAttachId(ctx echo.Context, id int64) error
+	GetLogAttachId(ctx echo.Context, id int64, params GetLogAttachIdParams) error
+	// Get the sections of a log
+	// (GET /log/sections/{id})
+	GetLogSectionsId(ctx echo.Context, id int64) error
 	// Check logged in state
 	// (GET /loggedin)
 	GetLoggedin(ctx echo.Context) error
@@ -5281,6 +7334,9 @@ type ServerInterface interface {
 	// Log out of the system
 	// (GET /logout)
 	GetLogout(ctx echo.Context) error
+	// Search the logs of finished runs
+	// (GET /logs/search)
+	GetLogsSearch(ctx echo.Context, params GetLogsSearchParams) error
 	// Add a specific repository to CI.
 	// (GET /repositories/ci/add/{owner}/{repo})
 	GetRepositoriesCiAddOwnerRepo(ctx echo.Context, owner string, repo string) error
@@ -5305,9 +7361,33 @@ type ServerInterface interface {
 	// Fetch all the repositories the user can view.
 	// (GET /repositories/visible)
 	GetRepositoriesVisible(ctx echo.Context, params GetRepositoriesVisibleParams) error
+	// List the flaky tests of a repository
+	// (GET /repositories/{owner}/{repo}/flaky)
+	GetRepositoriesOwnerRepoFlaky(ctx echo.Context, owner string, repo string, params GetRepositoriesOwnerRepoFlakyParams) error
+	// Re-run by Run ID
+	// (POST /rerun/{run_id})
+	PostRerunRunId(ctx echo.Context, runId int64) error
 	// Get a run by ID
 	// (GET /run/{run_id})
 	GetRunRunId(ctx echo.Context, runId int64) error
+	// List the test cases of a run
+	// (GET /run/{run_id}/tests)
+	GetRunRunIdTests(ctx echo.Context, runId int64, params GetRunRunIdTestsParams) error
+	// Summarize the test cases of a run
+	// (GET /run/{run_id}/tests/summary)
+	GetRunRunIdTestsSummary(ctx echo.Context, runId int64) error
+	// List the runners
+	// (GET /runners)
+	GetRunners(ctx echo.Context) error
+	// Cordon a runner
+	// (POST /runners/cordon/{name})
+	PostRunnersCordonName(ctx echo.Context, name string) error
+	// Drain a runner
+	// (POST /runners/drain/{name})
+	PostRunnersDrainName(ctx echo.Context, name string) error
+	// Uncordon a runner
+	// (POST /runners/uncordon/{name})
+	PostRunnersUncordonName(ctx echo.Context, name string) error
 	// Obtain the run list for the user
 	// (GET /runs)
 	GetRuns(ctx echo.Context, params GetRunsParams) error
@@ -5320,6 +7400,9 @@ type ServerInterface interface {
 	// Cancel a submission by ID
 	// (POST /submission/{id}/cancel)
 	PostSubmissionIdCancel(ctx echo.Context, id int64) error
+	// Re-run a submission by ID
+	// (POST /submission/{id}/rerun)
+	PostSubmissionIdRerun(ctx echo.Context, id int64) error
 	// Get submission runs by ID
 	// (GET /submission/{id}/runs)
 	GetSubmissionIdRuns(ctx echo.Context, id int64, params GetSubmissionIdRunsParams) error
@@ -5344,6 +7427,9 @@ type ServerInterface interface {
 	// Count the Tasks
 	// (GET /tasks/count)
 	GetTasksCount(ctx echo.Context, params GetTasksCountParams) error
+	// Re-run by Task ID
+	// (POST /tasks/rerun/{id})
+	PostTasksRerunId(ctx echo.Context, id int64) error
 	// Obtain the run list based on the task ID.
 	// (GET /tasks/runs/{id})
 	GetTasksRunsId(ctx echo.Context, id int64, params GetTasksRunsIdParams) error
@@ -5364,9 +7450,57 @@ type ServerInterface interface {
 	GetUserProperties(ctx echo.Context) error
 }
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// GetArtifactsRunId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetArtifactsRunId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "run_id" -------------
+	var runId int64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "run_id", runtime.ParamLocationPath, ctx.Param("run_id"), &runId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter run_id: %s", err))
+	}
+
+	ctx.Set(TokenScopes, []string{""})
+
+	ctx.Set(SessionScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetArtifactsRunId(ctx, runId)
+	return err
+}
+
+// GetArtifactsRunIdName converts echo context to params.
+func (w *ServerInterfaceWrapper) GetArtifactsRunIdName(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "run_id" -------------
+	var runId int64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "run_id", runtime.ParamLocationPath, ctx.Param("run_id"), &runId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter run_id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(TokenScopes, []string{""})
+
+	ctx.Set(SessionScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetArtifactsRunIdName(ctx, runId, name)
+	return err
 }
 
 // PostCancelRunId converts echo context to params.
@@ -5471,8 +7605,51 @@ func (w *ServerInterfaceWrapper) GetLogAttachId(ctx echo.Context) error {
 
 	ctx.Set(SessionScopes, []string{""})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLogAttachIdParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "tail" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tail", ctx.QueryParams(), &params.Tail)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tail: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetLogAttachId(ctx, id, params)
+	return err
+}
+
+// GetLogSectionsId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLogSectionsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(TokenScopes, []string{""})
+
+	ctx.Set(SessionScopes, []string{""})
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GetLogAttachId(ctx, id)
+	err = w.Handler.GetLogSectionsId(ctx, id)
 	return err
 }
 
@@ -5542,6 +7719,63 @@ func (w *ServerInterfaceWrapper) GetLogout(ctx echo.Context) error {
 	return err
 }
 
+// GetLogsSearch converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLogsSearch(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(TokenScopes, []string{""})
+
+	ctx.Set(SessionScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLogsSearchParams
+	// ------------- Required query parameter "pattern" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "pattern", ctx.QueryParams(), &params.Pattern)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pattern: %s", err))
+	}
+
+	// ------------- Optional query parameter "repository" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "repository", ctx.QueryParams(), &params.Repository)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter repository: %s", err))
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", ctx.QueryParams(), &params.Until)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
+	}
+
+	// ------------- Optional query parameter "context" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "context", ctx.QueryParams(), &params.Context)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter context: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetLogsSearch(ctx, params)
+	return err
+}
+
 // GetRepositoriesCiAddOwnerRepo converts echo context to params.
 func (w *ServerInterfaceWrapper) GetRepositoriesCiAddOwnerRepo(ctx echo.Context) error {
 	var err error
@@ -5733,6 +7967,63 @@ func (w *ServerInterfaceWrapper) GetRepositoriesVisible(ctx echo.Context) error
 	return err
 }
 
+// GetRepositoriesOwnerRepoFlaky converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRepositoriesOwnerRepoFlaky(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "owner", runtime.ParamLocationPath, ctx.Param("owner"), &owner)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter owner: %s", err))
+	}
+
+	// ------------- Path parameter "repo" -------------
+	var repo string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "repo", runtime.ParamLocationPath, ctx.Param("repo"), &repo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter repo: %s", err))
+	}
+
+	ctx.Set(TokenScopes, []string{""})
+
+	ctx.Set(SessionScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRepositoriesOwnerRepoFlakyParams
+	// ------------- Optional query parameter "days" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "days", ctx.QueryParams(), &params.Days)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter days: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetRepositoriesOwnerRepoFlaky(ctx, owner, repo, params)
+	return err
+}
+
+// PostRerunRunId converts echo context to params.
+func (w *ServerInterfaceWrapper) PostRerunRunId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "run_id" -------------
+	var runId int64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "run_id", runtime.ParamLocationPath, ctx.Param("run_id"), &runId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter run_id: %s", err))
+	}
+
+	ctx.Set(TokenScopes, []string{""})
+
+	ctx.Set(SessionScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostRerunRunId(ctx, runId)
+	return err
+}
+
 // GetRunRunId converts echo context to params.
 func (w *ServerInterfaceWrapper) GetRunRunId(ctx echo.Context) error {
 	var err error
@@ -5753,6 +8044,128 @@ func (w *ServerInterfaceWrapper) GetRunRunId(ctx echo.Context) error {
 	return err
 }
 
+// GetRunRunIdTests converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRunRunIdTests(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "run_id" -------------
+	var runId int64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "run_id", runtime.ParamLocationPath, ctx.Param("run_id"), &runId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter run_id: %s", err))
+	}
+
+	ctx.Set(TokenScopes, []string{""})
+
+	ctx.Set(SessionScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRunRunIdTestsParams
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetRunRunIdTests(ctx, runId, params)
+	return err
+}
+
+// GetRunRunIdTestsSummary converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRunRunIdTestsSummary(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "run_id" -------------
+	var runId int64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "run_id", runtime.ParamLocationPath, ctx.Param("run_id"), &runId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter run_id: %s", err))
+	}
+
+	ctx.Set(TokenScopes, []string{""})
+
+	ctx.Set(SessionScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetRunRunIdTestsSummary(ctx, runId)
+	return err
+}
+
+// GetRunners converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRunners(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(TokenScopes, []string{""})
+
+	ctx.Set(SessionScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetRunners(ctx)
+	return err
+}
+
+// PostRunnersCordonName converts echo context to params.
+func (w *ServerInterfaceWrapper) PostRunnersCordonName(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(TokenScopes, []string{""})
+
+	ctx.Set(SessionScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostRunnersCordonName(ctx, name)
+	return err
+}
+
+// PostRunnersDrainName converts echo context to params.
+func (w *ServerInterfaceWrapper) PostRunnersDrainName(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(TokenScopes, []string{""})
+
+	ctx.Set(SessionScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostRunnersDrainName(ctx, name)
+	return err
+}
+
+// PostRunnersUncordonName converts echo context to params.
+func (w *ServerInterfaceWrapper) PostRunnersUncordonName(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(TokenScopes, []string{""})
+
+	ctx.Set(SessionScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostRunnersUncordonName(ctx, name)
+	return err
+}
+
 // GetRuns converts echo context to params.
 func (w *ServerInterfaceWrapper) GetRuns(ctx echo.Context) error {
 	var err error
@@ -5865,6 +8278,26 @@ func (w *ServerInterfaceWrapper) PostSubmissionIdCancel(ctx echo.Context) error
 	return err
 }
 
+// PostSubmissionIdRerun converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSubmissionIdRerun(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(TokenScopes, []string{""})
+
+	ctx.Set(SessionScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostSubmissionIdRerun(ctx, id)
+	return err
+}
+
 // GetSubmissionIdRuns converts echo context to params.
 func (w *ServerInterfaceWrapper) GetSubmissionIdRuns(ctx echo.Context) error {
 	var err error
@@ -6040,6 +8473,13 @@ func (w *ServerInterfaceWrapper) GetSubmit(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter all: %s", err))
 	}
 
+	// ------------- Optional query parameter "priority" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "priority", ctx.QueryParams(), &params.Priority)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter priority: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.GetSubmit(ctx, params)
 	return err
@@ -6137,6 +8577,26 @@ func (w *ServerInterfaceWrapper) GetTasksCount(ctx echo.Context) error {
 	return err
 }
 
+// PostTasksRerunId converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTasksRerunId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(TokenScopes, []string{""})
+
+	ctx.Set(SessionScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostTasksRerunId(ctx, id)
+	return err
+}
+
 // GetTasksRunsId converts echo context to params.
 func (w *ServerInterfaceWrapper) GetTasksRunsId(ctx echo.Context) error {
 	var err error
@@ -6285,15 +8745,19 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/artifacts/:run_id", wrapper.GetArtifactsRunId)
+	router.GET(baseURL+"/artifacts/:run_id/:name", wrapper.GetArtifactsRunIdName)
 	router.POST(baseURL+"/cancel/:run_id", wrapper.PostCancelRunId)
 	router.DELETE(baseURL+"/capabilities/:username/:capability", wrapper.DeleteCapabilitiesUsernameCapability)
 	router.POST(baseURL+"/capabilities/:username/:capability", wrapper.PostCapabilitiesUsernameCapability)
 	router.GET(baseURL+"/errors", wrapper.GetErrors)
 	router.GET(baseURL+"/log/attach/:id", wrapper.GetLogAttachId)
+	router.GET(baseURL+"/log/sections/:id", wrapper.GetLogSectionsId)
 	router.GET(baseURL+"/loggedin", wrapper.GetLoggedin)
 	router.GET(baseURL+"/login", wrapper.GetLogin)
 	router.GET(baseURL+"/login/upgrade", wrapper.GetLoginUpgrade)
 	router.GET(baseURL+"/logout", wrapper.GetLogout)
+	router.GET(baseURL+"/logs/search", wrapper.GetLogsSearch)
 	router.GET(baseURL+"/repositories/ci/add/:owner/:repo", wrapper.GetRepositoriesCiAddOwnerRepo)
 	router.GET(baseURL+"/repositories/ci/del/:owner/:repo", wrapper.GetRepositoriesCiDelOwnerRepo)
 	router.GET(baseURL+"/repositories/my", wrapper.GetRepositoriesMy)
@@ -6302,11 +8766,20 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/repositories/sub/del/:owner/:repo", wrapper.GetRepositoriesSubDelOwnerRepo)
 	router.GET(baseURL+"/repositories/subscribed", wrapper.GetRepositoriesSubscribed)
 	router.GET(baseURL+"/repositories/visible", wrapper.GetRepositoriesVisible)
+	router.GET(baseURL+"/repositories/:owner/:repo/flaky", wrapper.GetRepositoriesOwnerRepoFlaky)
+	router.POST(baseURL+"/rerun/:run_id", wrapper.PostRerunRunId)
 	router.GET(baseURL+"/run/:run_id", wrapper.GetRunRunId)
+	router.GET(baseURL+"/run/:run_id/tests", wrapper.GetRunRunIdTests)
+	router.GET(baseURL+"/run/:run_id/tests/summary", wrapper.GetRunRunIdTestsSummary)
+	router.GET(baseURL+"/runners", wrapper.GetRunners)
+	router.POST(baseURL+"/runners/cordon/:name", wrapper.PostRunnersCordonName)
+	router.POST(baseURL+"/runners/drain/:name", wrapper.PostRunnersDrainName)
+	router.POST(baseURL+"/runners/uncordon/:name", wrapper.PostRunnersUncordonName)
 	router.GET(baseURL+"/runs", wrapper.GetRuns)
 	router.GET(baseURL+"/runs/count", wrapper.GetRunsCount)
 	router.GET(baseURL+"/submission/:id", wrapper.GetSubmissionId)
 	router.POST(baseURL+"/submission/:id/cancel", wrapper.PostSubmissionIdCancel)
+	router.POST(baseURL+"/submission/:id/rerun", wrapper.PostSubmissionIdRerun)
 	router.GET(baseURL+"/submission/:id/runs", wrapper.GetSubmissionIdRuns)
 	router.GET(baseURL+"/submission/:id/tasks", wrapper.GetSubmissionIdTasks)
 	router.GET(baseURL+"/submissions", wrapper.GetSubmissions)
@@ -6315,6 +8788,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/tasks", wrapper.GetTasks)
 	router.POST(baseURL+"/tasks/cancel/:id", wrapper.PostTasksCancelId)
 	router.GET(baseURL+"/tasks/count", wrapper.GetTasksCount)
+	router.POST(baseURL+"/tasks/rerun/:id", wrapper.PostTasksRerunId)
 	router.GET(baseURL+"/tasks/runs/:id", wrapper.GetTasksRunsId)
 	router.GET(baseURL+"/tasks/runs/:id/count", wrapper.GetTasksRunsIdCount)
 	router.GET(baseURL+"/tasks/subscribed", wrapper.GetTasksSubscribed)
@@ -6327,85 +8801,130 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xdW3MbN5b+K2e5W+VkhiaVZGcfnCev7Xi061m7JHm2tsYpFdh9yIaJBjoAmgrXpf8+",
-	"dQ7QF5LdZNNSIlvhi02x0bgcfN+54cJPo8TkhdGovRs9+zRySYa54I+vrDWWPhTWFGi9RP4a6Wv+JD3m",
-	"/MGvCxw9GzlvpV6MbsfVF8Jasaa/lVlQuRTnolR+9MzbEutSM2MUCj26bV40s4+YeHrzbyZFdVnOcumc",
-	"NHq3OzPh8NrinD7/G/8/+tdpM6ZpHND0AudUXyJ0ggrTVrfr9sejxKLwmF4LT8/nxub0aZQKj0+9zHE0",
-	"3h3rXGrpsv0v6VIpMVO4NfKmkgxFesQoZLrRlNT+P/696ZvUHhdoqaAttbtOTKn9wBecF9bfcTDOC1/y",
-	"5PQUbUncC7c8rodeJkv014NFUDq0h8T6nsoMwd8b6fwG9PfVuo3dDmJchCnfhPTw2cX5tRY5dnLQYmGc",
-	"9MauD4OqLkmzl4mO+rpkQ++9MHouF7tjWCgzE+qaIGPKoVNrVmitTPH6lxJL7KZoXaZV9W6p7QoasdwY",
-	"u0ylPWKIjRA3hyhKb66jxujuRSodob/n6UL6rJxxTWkqvTRaqHetFjYo03RpMDp6kVFYuRK+U777ZXAU",
-	"+jdRtQt8Z0qbBEluyjUpys5up9ItOx9IU3SboRzzOHNDprrssC4PZhCOnmX8VeQFVTjK109tqbt6ZoW+",
-	"Djb0sA5H76VeHJ7lUl9WRR/IfBzq4hWV6Zvy4yBddivxlgx2EWTyXOj0OH9J5mKBPZD2IhVeHK02erVB",
-	"v6q0bY7up3pVkO3zcJXfNSlXcUq3xPhl+m2DaVoIn3ULudRuqG82kJMkwYckpdtw14/ykPoAcRRNA+F3",
-	"WbUhlw6iVp7MIasWfR4ySSGgue7na1Win2VVic9jW/V2i3UpusTKwrP8Rz5DiIUgFvoRpAYttHGYGJ26",
-	"0XgI+lCvjlNibVW1M6k5efyFkdrvZUW3ijvKJO00fZwH+D6GDweD4EPxRQinu5T9UB2ihPPXLhFaIwWL",
-	"hXGfz2dvlqiPNiIUS/UYkj7Z7csibPotIsl8qRdjmInZ+l9Gvdq2fuO78edYGPpK6rnZpcrzd+cwNxaI",
-	"MjRSoFrsXCQIDu1KJvgjP4t/gM+EB+kglRYTr9Zg0RVGOzlTyBUVFh1qQiIQEcAbrtdNPuirTLqmonUh",
-	"E6GohlI7EJ6bmRmboh2D0CkoXKEVC6R3WFVCYsxSogNjQZQ+o2YSQQMBnloXeneDsECNVvjQo9A8nHsQ",
-	"yhnq/HafM6FTRT2mLojEg5mDoRa4HywWtrbcUmZNuchAegfKLKSGzJglDa+UbpW0xuWFWjoaP6sj4QU9",
-	"pgqNz9BWguASIiECKelCvQsj1Bikh9SgA208OLFCEHrtM+qmMqEFYyER1q6BLBVOPrADLD0ji3szGo9W",
-	"aINVGn03OZuccThZoBaFHD0b/TA5m/wwCpaaIToNPsf0ky31tUxvGcTGdejYF1yQx2ZLDbM1nL8kqEgH",
-	"N1IpEmMZxGBlslQIM5EsefhJ8+ZNZhQCObRjcFInSINOhAZtQBm9IDGVSYKYkvoWeg03Yj35oN8pFA5h",
-	"iVjQg1zqlMTovCkYTmP6V6N1kJfOgyTu5Kg9iKr1wiiFNoiMOMqTe56Ono3eGefD4C5K+oakY0WOHkn5",
-	"/WNbDlcZwvlLQkwlCm/AorcSV6SVJBViT6jyR0dBtiNyNn8ppSUHL+ieoDqHeZE/s69KMA7K5fuzs91J",
-	"evvfNN9/CY8Soz0G2yOKQkXqTD+64LM0je/T6lGj37IZbjd1TnpDCwWXaFdooSpILlxSWunXLLyog//x",
-	"8+340yjymv/8mXyoPBcUu1bgmq3hotRw/nI0Hv36NBGFmEnFNUXfmOuf1g8kuumnSl/fTj81b9wG2SgM",
-	"KYDNrl9gboheQK8RPqu3YG5NDgIKa1YyxagKzl9O4CLMnGv0ZkYMpb+e5CaV8/Uz+vZJq7LJDtJecn9e",
-	"tHr/Pvb9RTPUAfCLvQrA46FQP7aGEaDegcbavu3D447h6+oH1VIRodV63a3eLiTt4Q7vxCAKXG125ka4",
-	"oFKcm5dsfbhr6e/Gk+ca2A8AkySltZgCeSN6MYH/NOkaMuHic4uuVH6yS6E2a8ZtRm1QqBfUu1xqAZbD",
-	"tk59/zxNOxlCtvi3IkhQxfdID5Gmu+h8UGaINP0iaSHS9BGSohvEBxhBJqaJfRbYQY5o8yqz79hVCe/w",
-	"nFMUA+SzfeO+DURw6Jk7a1Pu4v41+lehwe6pHDwZdw3UumaqHphFX1r9kCj5PHhsqckwZXFQYbaVWUyF",
-	"9yLJpp+iE9w57T+x8lvIFZKTMoY5+6E032bBrq6ck0NLEUeptaQ4L1RLzJeei3CiCiwmKFdVCKKERwJM",
-	"kGbwQqTvclZfo39jFs+50jv6qnXH58ZOuhXSvTut3519tyvVV86LmeI8JdzgzJlkif7r9GOf17MtKgiQ",
-	"iHcVjjILx982A342sijSGpALTKXuReLfhZIpBbxxEheYPpUaQgazmnBSZxOoiroY17dDaxALIbULsbis",
-	"JEGx60w48t/O561MgYstgdRjEPBfl2//B4IVohY/jAgfH0YhHJxRU9r/GILfG+konI1BtsWQTYDSqrp0",
-	"IZzDlHUkKU6utPQkytk62FElUe8hRRDYHRXotm3dgcsrycF8PdiYSnl/8aaK/MMYk0wohXqBky9VXTbB",
-	"V4bJspnakFyocbgHhH8VOlVYp4soeJdp7fdsywHOWTPKvDDWC81Ty8kjWwUwAZIfhQUx92hDcoHAQO/l",
-	"IsUxiN3kkLCMT04I5EKLBdJ/68b9UsrRsLi25+/O+xHE8DmoUBOTIoR1bYZ4SHRw2gtupM/qVun7Mdxw",
-	"79gsaH709nnps+8Bf00yoRdIb67abCZ9i863vMRfSrTrlpto0ntwUXmW4RsrdGpy+f+YRip/Sz3mcdWD",
-	"MVYuJCkGJfUy5nykA0wyg2l7+M7Qy4nQMC8t80SmqL2crxt91DusALu7OL4/nH3fFewHZROyhiB0yMYV",
-	"hBOpU+Ig1r5wBZqQ6JuXmt+bwE9GKXMTp2ejPs7jYaOIpabgiOqawHlVWUxaSgcfRtMPo3HIW+YodJVT",
-	"3PTHSUhfutZ4YxZkMYLOc2vnMW/pjGlZLKxIsVd3cG44FgqWqUAbl8g2DBh8c5PJJANbBZi8hkWOB4mU",
-	"XaWaVWQ0nPT4Lc80T1mzQwgEi3dMKGX5N6sCrbajFpFFSU5Z8zprGnLfCN2tN41GJoNYCcmrEfvUy/so",
-	"lCG4vaozqzPsAl7QQF8pUoJuidOftqe+xlBc6OsEzwujV6jJHVBrSBQKu2MWJvB/pgzy0xiMQ2AaezwT",
-	"eFll2vn1aKzCqkL/DFKf7l3nTL7qeIoml/y0yNi2ImhzZ5rIqUjT6Sdzo9HeTj/Rw/5A63Vcz3EgeLkF",
-	"HCYWQwBVmMDNJtX0xLVpztCKwRaV4PffX7yZwAUHsA6+PzsDoyv5j+EvZ2fw5yiBHJ2jaSLdIqQqLY7J",
-	"x5MxhGqaIaEJRS77GmaIOmRQaLpf9LkYFy2BvJDP0/QtCYO+PeR3sNTqOK7uBEWg1nkoKKQ088orafWS",
-	"E1GuTDLynJ6glcvsCTki4eN0bsyTvmwUt3k3P6OdBmv3OniMidEpZELNqyKHuk+93ep8by7NBqnecxbt",
-	"a11fCXkwV2Ai5zJpSzjgtTcjlkh+xK08dYkpatl2UjxFNZTi/0uqmShG3CJF3OJPRawfmwVRVliNvq+Z",
-	"PYhqL1GdqHai2u9CtbAO43roxv7qfREuX/eyqzJ1Akj4brK5vzms4ijVoAVEtWjj4MZKj9FbBm8mh/j1",
-	"t4OrMg6FTbIqVzRbQ3DpvYG5VB4rv8P1RYb8+ugzIHUvuNnaG94BoK8Vqz+hJ75HINC8UwSzGfS0NwtN",
-	"OkBIAdEeGIrUbeokqlMxBqvQ7fm7c6g2Bo4BJ4vJGF6H6OYQ9C6p8cesTWiAW/NRic1ibjhCDZLrmppy",
-	"dozTfVnO6M8ZcTQXS4zbq+ilJw54cyfwAhOIoiAbLDWsTWkhMznCSuLNMBe7ca0HGO/LcvYHcJQfotsn",
-	"s71BtAh+jIs3jYirdZwX5z0UO8Lpfa9di2S2chX0GvBX6XgTZSxQhMRjXG64Eetx9JM5yTWzZom8Y9A2",
-	"hJvA23qtpfWEqFfFwkzAYaz7A/jMJ9Y9NOsaPmC19651pLOTbqF0+rmer5lvOb7t5c2m+iF+72XTmZP/",
-	"+1XCjxFBcGhNfBtvHQhcSd5G/psEXuRCHRF3/T125QS+RxB8bfj4tU4i758d64jEUm/s1O9DYNjfI3gz",
-	"9c5G/eAXcBvzUikuFM6NgNSJKlOCieCCiqXnMlm4bjSW+mvcM38/wCz1Y0Lja/Rhz1IATI23/j2QtfKM",
-	"c+rGYIqwKqrWUbdEjdNynXiRqfrrz5d/fT6Bd2IhdVjOJYFZo8KmjkNLqhfUuwPIK3bqfgY3FNXyWlx7",
-	"N1zcncHab9KjC+mlDU1YX/ZyNuiY1pDeZeYm7F8JoTbfGuLIzxTc596uoX3X27vvzj6vf9V8/ri9+MUO",
-	"sDewwLB1jG842dhIuNW9lld3nIu+0QOXiaNadZl4OMMVz90/Ih3xduZFpAkpClU5NJW5apTGtL7spmf9",
-	"vtT+rnqjXylw9bua4W64fAh8Habso8HWJiQCkpoz9vu3Zbc8nuac/WHHp1X2M/2fpoZjfaBmaIddoS/a",
-	"Ddq93eCRuURuC1Gd0IzHaA+ent0P0HhUNQakT4N5i6k7aZkY3Wem2jgMLd0FjUlVw++IxUd1jHUoaPa6",
-	"151K7YkLp+f36TYV01xckDdDNW7mQS02xKd+QE02Pvn3d/DvT+7u0fq/he6GeN1sZnV9NJ2Dkh/C51Dy",
-	"WEJfca9OjD4x+liS1VdSPV5Kt8i3zelBTK6o2RDO/V4Bxynz9ZtmvrbyXbxSzAtBfCAx7HbB9L6zXVWO",
-	"y1iYWaHDKs3B1h4yy9V1dfBjWyCMSqF1PKWlJg5kulrKggtuaYt4EEz4JJy0C9mvQ9TvyW+dEHzKo8U8",
-	"Wg9k/f7jeHxirl6R9gaK0mUg4KOZgdTOo0gJv/Qt31zAh9BoqueSz2sLKEo2Y3yAlc9DW7lYoA11VCeA",
-	"63UdMMHyeKnXL87h/TknO168Od9/1vsyDOWO+Pfo/ED832GLUR8fQut76XCHVi9KHVbm0HnXnjqLas0n",
-	"6zWkcj4Hh6o5plbIZEnzkfcJRSjVRdPmYu0vLblzTwfe+u+XeYeWdAsIclxKobZipwDs3b3+kYxMzP1h",
-	"41W9zMW+pq13l1SuJ3np0ZuM1iQxNkxCWt1IEDw7eCWSLHyGXKzZ7xJSt4LJSOW4/mJLFS4rVDKXnu8p",
-	"jLPlKiY7b41e8KVaiclz1Gl10tIsqxseP4zY8H0YAeF5JRRVUC0bOQTUKV+R6upzuWtT8saH5ha/Vhdz",
-	"YxEcPVr3qIdBEe/JOz6tCz+Mx/wYw+rWwjDJPiqnw4u6fKA+E5OWJqwvJD3iMlIXb6Xjtjma77nmk1VD",
-	"ePfYBTuu+8GW6h7bLZ9stQ5d8xnxsDe+agwwZ1jqGCuogHjNRlQLcbtjYiWBUVQXS5CpmSHoaj/ktwzL",
-	"mLuRnlRsnJ99BmdQTNZorfaRwLbV/c3UJKnIIS2dQrHfYEtDcElaoOY9Mnv3NFwFn6Os8v9a1R6bewZ/",
-	"AufL+Rz+FO+W/ljq5R5wXpTaHVZ4fkPh8Q8MnHL+p1W8R7hpbSYcpnX2IdiiSSc/D5if19G01HaHvZGe",
-	"MKxqqD4kSTHOYcsSyDs457fJ4MkXuqXoD7uzrYWLmEPrw+CAs05XzQV5VazsCNwy2YjqVxj2aKL20sbG",
-	"EmOLMkKVL350nEHg5WU+1Ns+h8is4aB/NwHBnd2H3+HHpE5m4LT0+3vYgc0dFUyBGSoT6LhxEGdtSj6K",
-	"sHUwMLC0+nWZvp8Z4IvA8VcPr19dQSge9/nxL3mBAI03YDROoLns7WPJaT6+y737CuDwKwJX3PrXHi12",
-	"3mDP0ZdDH/RQXByoj8XRq7fjPfow/BoJX/5H01fqOXqPfMd4VUf7JjrhQjthzaPKP9ZrGKSc48z5rE/N",
-	"7ZmL+7t5tvIaWl3i+LVKlxbCRdUzQ2F5AYcKSQ0ZihRtyJJUF3PGAfIbvGOhupzz4XLzR9w6F7bmdkKD",
-	"qEljmW79Vmuf+yZ10LWk18WMrxwmhy5cN8VSGddHvauDDqTGe7Dw3qFt/cjUHUFxxA9XdUo4bjOJgSM5",
-	"HAzzRjRf7vWRxy/GDJvN0LWBBuT2nwEAAP//lzheNzt8AAA=",
+	"H4sIAAAAAAAC/+x9bXPbONLgX8HpriqTXUV2Znb3g/Mpl2RmfJedyWMnu/XUZsoDkS0RMQlwAdCKNpX/",
+	"/lR3AyQlkRJl2XHicT45Il4aQL+h3/BplJiiNBq0d6OTTyOXZFBI+vO59WomE49/l9aUYL0C+pIY7UH7",
+	"C78sAf8PH2VR5jA6GXn46I8yX+Sj8Yi/jpy3Ss9Hn8ejxIL0kF5IGnJmbIF/jVLp4YlXBXT10bJYmyIx",
+	"V2DlHCZ901gojaUpUnCJVaVXRo9ORm8zEDypMDPhMxAenBfcnP4vw4qFcmOhZkLhX8JomIzGI9BVMTr5",
+	"1+hDpZUfjUdz8wT7P/ngjB791gVHpS9UurJUpf3f/tLArLSHOVhs7DL5/V//1g10Bh+fgE5MCqngdsJV",
+	"RVxEOIxJ11Y49R/oHhO/rI0glBbTpQeHQ+0E+XP9k5l+gMTjdBFjXitH+688FIQw/8fCbHQy+t9HDbId",
+	"BUw7ip1GzYjSWrnE/7+y1thN/AP82a3MsLH09aFyM+edmMkq96MTbyuoW02NyUHq7lX9mMvL5VtwHYQA",
+	"HyGpcFdd9y4jVrsG1UrpHKTCWDGTKod00EaPR9i4sjBsDh4Z56BdGj5JrsoS0u45zn9+3ppianwW1yJ1",
+	"Wk+pB061SdO4u7+AXxh72YnGibF9eJxJS4i8CqKVWhgtFhlYEKoP5LGYWVOIY+GNeDp5r9vgp6aa5i2W",
+	"pKtiWpOqG7JNDMPAPXGV8tCByFsRci86q3t1UcdrM/+79Em2ieJy5sHuR2pTmIXz2oM8le45YfwiePcj",
+	"wyoQVLFQPlOafsjNfCycR/6t50J68XTgtu/Fo1G4DTyi12Z+DtIm2Rk4Yjfr20pLADf49OoD6tg7byud",
+	"oGTt2EBbAUoy2iRVKC8W0gkLMskgFXxQQuZ53EUnFkgxjmBn3jGERdJyE55zfaVpZWX8snm68Ws8WsfD",
+	"CKXxT6NT90zoKs/FIlM5BInsvMpzMQU87IVV3oOedNIu9pT45yq7b2h5prRyWa2TrML3zwz0ClCgU0iv",
+	"A09Lw+kBqaGMHPTcZz20QN869mq43N6T1MIcj5yYKes8NbkeqWHPHr7ZTEmNhFrZ9mtLlWml8rRLnpjZ",
+	"zEGPhog7KbjBtj0YytZxo/ZSebeTl+tSxnG5HnYQv5mLTDoREZ7wVGlkm6a9SCcWiMvaeJFkUs+hiwWM",
+	"R64FzlD+FZawycG6lvx3k0J+Xk0L5VwnW5lKBxc04faJz2CG4yVSJ5Azi9xcznVuJmus43rEnoFM91jF",
+	"YFFlK+0uElNpP7DDAETduRjnpa/ocHqatnbcS3e5H4ReJZfgh0vryoHdta3vHNhB+LeXprXWt0tkn8Fs",
+	"E6WHny7MLiK767z+OuWNXe5Gqrolq7UDlRvs98LomZpvrmGem6nMLxBlTDX0aPFGb1UKF/+uoIJuEq3b",
+	"tIbebLU+QLMteLdIld1jic0mrmnElTcXgWN0Q5Eqh9jf83WufFZNaaQ0VV4ZLfM3rRlWSKYBaTB29GJG",
+	"adWV9J37u30P9sL+VazaRHxnKptAlyArq06wU+UuOz8oU3ZfLgoowskNOeqqQ7pI76EoO1SERaaSTITP",
+	"UUGwFSosypHd6Dq60bXkD1sFLixI16VdL7JlDVy87qqZuNRmoU9E6D0WSs+svCBbwVgE2hLfKS/gYwKA",
+	"JiflXfzweCzgY6kspNRkIZXHBqyuEe2JmbHCGyNyo+ePhbG8HzwBdUpMlaekYExBBLEjjE5AJLlUBaSP",
+	"+Sa+U9zciADem6oa9bJYPrGV7jqa0sKVMpW7CHgShNbq+eCWnb6MKMQnVCMWYROenAVvFbA5UuplJz71",
+	"LLItMKS+YBzZLcTBI/buJvNKn8emd6Q/7ALxLbbpo/n9eFrVLcVbe9CllxdSp/uZQVQh59DD07xMpZd7",
+	"y41ecdAvK22bSW/n9bEhrmUPmd9zKBps10ba1OheWWul0gh359dcTiHf11Isnb/IQFo/heu4Kro3epvC",
+	"NlfOgz34msgbuC9i66Ber+3C20Bga2fxdV6jBnPxUrJppeMQ0BRoZgNYNbIeZtD0lwUUAwdz6Iov0wPW",
+	"MJRD4wneJYt2K7f3vS5MXdiNy9kLt5n9d2P2NrYdLza7lNxwBfo8jk6li37uHVv089zY4nq8N/Zu8eBN",
+	"FA6NojL3TCgttNQmGFqHqaqgr/Zjp23BtXGoham0L43SvpsqA1V0C7y9FJSNqfe7EKLj5IV0cKh9mzxD",
+	"jXF7MszlNJi/FeBcwMFNWILOT0r5pSpFaNwGbTLMB4+7EXfEjQ72fddMJfrY2VM34ksO/eEu2T05Zudv",
+	"p8O99qE1gPJde5KY4sgrvUzUUaKeyDkhSjodJlbjQvdjPqFTJwMC58+ropBd1oXt6NTy+8eGrn1+bn/c",
+	"Cm7igWcVDuTk0+GOa0SrGni2rPMS+I7d6cgeznYCCg02l+6Brpe1p3xAa2+8zK+tE79zMCz+YZeVkyMp",
+	"um4cQxdCarFLpNaAJuvSuOurEd5cgt77JlM5sD1adN/ebQsgWeUUMsl8hf6QqZwu/9eoV8msezy9VpAM",
+	"/qT0zGySxPM3p2w8yUDgSgWOYmcyAeHAXqkEntG38B/hM0nOx1RZSHy+FBZcabRT05ytMKUFB5pMUSh/",
+	"hTc0rpu8129Re60HWpYqkTmOUGknJMdCTY1NwY4pWiIHDrnCPqShicSYS4XUa4WsfIbTJCzn6GgdQ7cA",
+	"MQcNVnqGiKcXp17I3BkEfh3mTOo0R4gRBJmQhc3gDAQHbQtdMmimzJpqnpF9KjdzpUVmzCUur1LuKmmt",
+	"y8v80uH6iW9KL/EzDmh8BjZuBLWQCfnTlONx50bmY6G8SA04Mlo5eQWo5/sMwcwNz2CsSKS1S4GyDNiA",
+	"5ZUnzCJoRuPRFVhWhkdPJ8eTYzJql6BlqUYnox8mx5MfRnxBIRQ9isFo7ugTs6fP+PO8y2+JkskFNpqH",
+	"YCBbaVGVuZFowpOujm1zY+EM2dymS2LBExEjsLARCAuFucI+aMGjIIs43iPaZ14cUhMdw2k6Ohn9BL4e",
+	"5KzC33AlVhbgwbrRyb+6+H9zo0JYvRG5cqtxeCgdiOtjF9yZUVRGIscejyz8u1Ikv5hrMNMbxnB/G48C",
+	"AjJb+P74uBXeiH/KsswDZh99CGbWZoYhYW14Nkz2qzvw6/9HBPjrDc4YWPzmVKfaI+PMxTnYK7AiNiQ3",
+	"bmWVX9IJBab8r98+jz+NAqHTf39D1SqoK6PXXYckpGBT6McniSzlVOU06KhuQ7N1oPTRJzzPfsw+B526",
+	"lSDFgDJxKEZQJNXYAE95IhDBXr2Vc6HcSvtHrhVCOQiVf5EFXAOd2zBOAWnJCW9uE5vHfUrW+p71AKF5",
+	"of0grMvc/cjHJB78E+ctyGIVqeulTZVGJNvUyu8N9bw0C408WUjdoMdg+mHr24o8KI3rIJsX1LBGxulS",
+	"nL58xjYrCu+Qia9YMlqVXOYgpjK5JImYND0XmcnZ2jUWTumEop0SqYVm7w5Y4aoEnURCaRSJYiGXk/f6",
+	"TQ7SgbgEKPFDoXQqvBHOm1KwpcyS1dGJonJeKFSnCqRcGWcvTZ6D7aLONwavVdjo+mKGXSpX8OUFy/3A",
+	"4YBc06U4q7Q4fbmJtnyMEWfDBwXu6FNU4T8ffWp6fOa96Q5iOiN1REjiZKloenHsrhSlNVcKlRwcW5y+",
+	"nIgzPjnXqNIZKm34v0eFSdVseYK/PmoNNtnAtJcEz4sW9O8C7C+apQ5AvwAVI15hAhxry2BU78DGuF97",
+	"8eWdgqA1ew1WLwhJe7kHC4dNwFrAYGwqsRTnZhVdSFgX/WJ08lyzsUGYJKmsRS04TZWeT8T/NemSAuj4",
+	"u6Ww3skmCbWpZtymqBUS6kXqTVpqISyZNTr5/fM07aQQY2+PQJgV3yB5yDTdxM47pQyZpl8lWcg0vYdE",
+	"0Y3EOygCRUxjDuu5Q5DMi2LfkarCfTg0VjrUa/L8O/eYCcGBJ9pZmmrSdUF4xRMeeHs81HbXdVL1wiz4",
+	"yuq7xJLroccam+QjC4vi087N/Eh6L5Ps6NM2o8iPxPzm6gpQSRmLGemhIRQaVd06wQ/VUQqF5mGR8pWn",
+	"JuQyFRYSUFfRKpVL8uHE6yZpIcpPxCvsGl0qZAxiA9G8bqp0klcpOLqutuPMNzJZDNvPpEhyhT3ZIp4b",
+	"FzonRusQ/I8KuYUAOQFTJz3lMMPLxaznmvvazJ9TtwP16HpTZ8b2XC1v527b3kJv6sOSaX1UZs7qVYTq",
+	"3xXYZQMW9x3dACiFcZ6TMOIm4dy0STLtm54Scg6d/ZxWTQdfM7NfQh5FA8pYKO08yDT+xkvvg8xLlW8H",
+	"rJAfVYHOuqfH+G88KpTmH44H3IeeHj/dJNhXzstpHrITYOoMxnt/m1ek5zUjkZG74ClsyjJMuaJfmwWf",
+	"jBBnGl4XUy22c7uzSjtRSHtJp+s8lPH4lQ2JXcpn4veTk7k1VXly8r46Pv4hwfOmv+B3Yni/n5yATkOT",
+	"3xmN0JimokRhkal0Ch95fOOa3JFxbS5WlqnTYgqJi8Z//tzi4DQnN6/dmRPx2sw7c6oCCKvpKjNTIaM2",
+	"YiZtP6OL6TM3werqqc3sS7C+2zRSt3bmPlnZfgK/cVJyCwFGWptDqnQvif1D5gq9nPHQ55A+UVpw9EJE",
+	"BtRKJyI2dQGMttNMyLlUOhjRVVw2eqUwpWnyXp/OWj5AF2YSSo+FFP/v/NdfBF8mcMb3I8Sn9yO26mE8",
+	"NWj/jN1aC+VASB3cZxbYTygqm9etQ0L0jLxXOfndTEWydMqx46x+9JMVb9iBCLrT2PtK4XqaxQYn6buz",
+	"19Gnx2tMMpljhiRMvlatt7GhZZBcNkfLbsMaD7cg4c/oIIXaEQxWOJXW19f1fUBXq3JoYjXWS01HS25h",
+	"G+1QjJIfpBWU6M02YkQG7FfIFMZCbrp9pSX8JLtuITVqvAXequpbdJ6HLE7lxPM3p/0YpPQQhpyYFATH",
+	"8hCKs72aHNqNhxJnxd/H6HdGaaN8TE349Xnls+8xpYFyGrHnVZuakT9j9NN73aMQIQCHWxrolMV3VurU",
+	"FOo/kAZSfowQ07rqxRir5goZQ6705bOYXSIgyQyk7eU7g53xFjCrLNGJSkF7NVs2/Kh3WYx2h9gvfjj+",
+	"vstmy8yG4wGE1OxnLxFPlE6RBqE2abTEqMJFaOo3ET+aPDeLcDwr48WM11a30hocayJO42BM57hn70dH",
+	"70djvlEVIHWMFlg1q+Amfe1c47WZC6UDz3NL56Fo8YyjqpxbmUIv7yA1LjRiyVSCDTG3KwJMfMcXThvt",
+	"hBQUawve0vquQVSFQsMpD4/ppOnImgxEIWl7WzfaOt6nNXfgIqqs8G7ddCdOg8odYnerp6EccSfklVQU",
+	"Z7SNvbwLmzIEb9/WDrIpdCFeiCb8NjGFeUs4/rR99DUOhcjhTuR5YfQVaAUaQ42SHKTdEAsT8d+m4v3T",
+	"wMKBKY00nol4GWNoqHsQVhwv1H+CCNON85zJN20We21YTzOz1vHWh+iOuHpHv2GsNoSRkYAqkVC+orAw",
+	"r3JpMcfPhqNtzFKudSeqYzTragJswZJioXRqFtRUFTAWGhZUlUhZ5yfihcmNJWnOOoTzlmIpW/YLnCjU",
+	"JfEZLKkZgQhpP5I4LrYyRJXoWON3Z6++F26pvfz4mHmRT7KwO0FZ7zOUlNJ7sPow1cCUzNieBZ5Tc0+c",
+	"BAHiA20fRB88TefRNUEIc7HJINy7W6ohnupEvORMAo6YE6lcxiP7vdJe5b/3gUdhBN2Gpa25TvuBW6NP",
+	"J7zaLPrAI+BvALyumibeRPtvhA9FG+8skOkYsW7Sq39qqj7Uhq0uZXY8PshsydTlgp2jsnq4vbIG4enx",
+	"ICBu3ZbRKrl0j+wZ5xv033Be5MbbzBptbeooUUcyTY8+mYUG+/noE37styn+FGJ3nZAUWiscJBbYM1Ia",
+	"1tYaH/Ij12ZdISwvGvi4/7uz1+h8Zjvi98fHwugokcfir8fH4s9BJtaZKbpJVDc21rJpTYNiVOZoMEUW",
+	"BJpdo4jKL/ounWetDXmhnqfpr7gZ+Osu8UG7VkvBGohxSF4opSWZHO6p63zcVWjvdOIRWHWZPRJKhz+P",
+	"ZsY86nMz05yHiZe2f7sNdbCQGZ2KTOaz2GQX+AjtGvC9TnLLu3rD7vFvlYzZwe1KSNRMJe0dZnztdXUn",
+	"ij7RLE9cYsp6bztJPMUYwWEk/k9U1pHEkLa08W36iYT1rAl+JxW2uQHUlD2I1F5C/kBqD6T2RUiNA6xc",
+	"D7nRbeOmCK5Y9jvlgqiTFJTuJqsVdTg8K9RepCGFjNFYrMxCsJ+EwPGt9PX3neFWQVUO3oPpMtzhvMGs",
+	"EQ/xJur6dPd4xbqpgPD98GZ17+6Tdvcj4HUzIgKeO9q0Vs1g7cSwSQcSukTqLWgoQxLFypiUblNfvDH9",
+	"LOaejwVM5pOx+IntXbtQ7xwnv8/c5JyDbNrnEbfNQmE81DvXdTTVdB+l+7ya4n+nSKOFvISQSoedHrlQ",
+	"Y4kix4QsS5TBSmOcmhWZKUBcKVgMU7Eb1XqA8D6vpn8ARfkuwH4Q2yuEFpAfQuhMs8UxiubFaQ+J7aH0",
+	"vtOuRWQ2qgp6KeCjcpQwGxqU7IoKDuiFXI6DnkwG06k1l0DZobYhuIn4tfa+t74g6cW7MBHgMKr7A+jM",
+	"D1R311TX0APEpBrbKuLYRW7cOr2u5mtma4pvO+ClGX6I3nveAPOg/367GcWIDq2Db+NbBwZeKSoZcCsX",
+	"L1Sh9rh3/SOA8oB89+DytaLj1zwJtX9SrDswcVXlOJrhIxsD6jS0C930PqdCLR1KufOfn48xrp/VkOhr",
+	"tZWmAhyJNY7zAupqoeTYIWCi6/U5zYnp74mxENPi6+dTfHy+RG08nuKEqXyCt4vwRswA1aVWWujVkT+Y",
+	"tS9Y+oaC3WHluzG9ZYdrMpVLNxagKWNCm8WYYzbMJbFIxp9YVqrPK4hj9DgF/3LXPsHVl3LuYxWO9hlx",
+	"HYENzc1WekDJgP8iy4LEgI318tZtXyMiqvJc+nIiftX5sp0U1erI7AlZ5xRCjcy+hP4zBPGQfP5Qh/lb",
+	"LBNDlYU3kYXIFBa4xG/VAP8kVJ7oKxJA5UF9QNI1FO1T6jgJQ4qzrqIWrewQTJOlRlx2K0ReUJATNcxp",
+	"41ymStet4B2Gj3dVX+I2MfJbzgWRTRWUTXw7IuY5UGcTiXRAD2i1YtZaz0juUXuLqlWSwkdVuN7rbYj4",
+	"lmA8qKhWC/zbraq1oXOQlOiCI0S7KxeSaCZbwtSrVSXjkEqht0o+K9VC76PGsYJHTFp9RHVUd+8NKq70",
+	"JnW1EHi6DKgxrinFteqFmtnGbSq8rDmAnGIJ1utQFS8M31D9oqR124gbt+Q+xc71n9Qa8mqw28VAYybg",
+	"xuyZo4oRTQ3/JoWHfHXjtTqKynKKdv2+AOF1vNrXEc/xzQNhrIgvHPTjNEF+uypBfFjgPnI0W+9gbzRI",
+	"G0eO+HBapQu7b1Tn3pRU0JQu16zM11VIeayJoLztGMHIiUxzC47D46mAA+IP9F6dGKQXBNHQSoUrdgsa",
+	"4HYLAt6Tsme0x0I2WzYMW4h8dyJLGL05Eg4Pd5dCUdYmfFSeX0mi+h8ch4dNV1513IEmLxGUByy51QKP",
+	"uMX7I0mlBzKVF3XcZRLwUacsIUJ2aRRriEIx607FGjbgQo1nSsjajizvAkgP+HK7vtdkf74yXE1xYxFz",
+	"ZfJlcDUFB1TLHm1s639/Pv/5+US8kZh+7Dm7T3trchZJu3IuzzgdYSu2lBtjn4gF4ikl67XLbTQvy9ll",
+	"fwrWHG4yL6YLuswsOMGdI68SvrbgzZ5g7gUN7Jte6J4eXw++ndli81D9gp5YXalMcuPJYsGFtNesLpN3",
+	"58cM777dIy3216mXqlYcQlBnK1q0YRpH9Wu7/XfxQ/lGP1Og4Tc5w2F4eRf4tZtk7w1uraIEY1LzqteO",
+	"glSNtb552Wu30b7V9pq2+2aEfe33zdJ2m/G/alPO5ntq98yc79YwqhM1Q7n0nVXStyNoEhpxfNITFm9N",
+	"2TIkjO7auG085JkOwcYkjvAFcfFelSsfijTkL+/HmeDXJBWbUIHuW7WHvIVJqzgypnr4sTBlCiXoFHSi",
+	"wIkp+AUA8cBiNyqRs/wwvtbvLv/aHZN9ylvLXe6+aX95B5ZudZxvYO+2y2GnSH4UTALbJHMeYnapIVmy",
+	"m0vSThk85EZ4h3J4/HA7/UoLOtzDyxpqLy3sbgivm5pJwuxNztRrED1zy30J+i1B9UDRDxS9t4s5PuF8",
+	"f0m6RXzrND2IkiNpNgTnvtR1+cFue6t22zVrLcWfUy4B1dvl1F1Ib9pWGy20xoqplTrJBs12lzbaNePF",
+	"vYw6CEyhVX2xxSZ22GlbzIIarnGLUOeUStiFF0E92F2k32OdfcDgBytwsAL3oKzfXm2WCsLW6XXeiLJy",
+	"mZDig5m2X6TAX8k2QjVW8ahnisqRS1FWeR7rM+N3b9V8DpbHiAWua69kzJrySi9fnIp3p2SGefH6dHsp",
+	"83NeyoH478H5gfh/QAZPHz3w7FvJ4YBZz6LRK2QD1UdnIaeHbowWqZrNhIO8qcJaquSytmt1wSXzvItM",
+	"p8bkIHUXIJynUlplbHzirL5heB8K4j0TmZpnYMXcgIsZcKczUWkHfrzmOH0U6hvPKwtpM7JyVOJ8Is6k",
+	"crhC5YWcxgf8VrrXfWz7YbVH8ef1d9Q6VY7QtvfVlR++X3t1pfXkyhP671dv1r2hWrj9L4i9AYtbJiQq",
+	"fZXM1+6dzBS2G9S2X7nf1g5u0tNtnWYc1Xa84QRNPEjixFg+hDSG/rFWzO9F0d+ikEvSWaXSrYt4YIPB",
+	"82qrnGt0UhXO9ovHLnJB563Rc3o2MTFFATqNRZjNZXzW/f2IlIb3I4GoeiVzHCBSkQMBOi2Nwt9i8NDS",
+	"VJTG1bzT2gKxMBaEw0/LHtY6yFrwcLN4iAi5m9vGfTRJtEJCcO8Dc9odzkG19jM5aXHC+snpPZ6bduHd",
+	"UZqbLCE9gYbEGrjvvq56GvvOnPT37R1nklq7HnIO+LD1btoIYLJO1fdT6htf4AhsgbFQJFYhMsr45kTI",
+	"GNaxMMZjQstg91IeWWw4n20CZ9B9tuFa7dqQbal7a2wSWeSQmR6usbcQzMQqSQupQ478dh5nyuVqNjz2",
+	"5Uc2OGue/m9mpJq51TiAWLZvJfxaRG2S38GfQjuTnr30YTjIZ+O6ehjTkvIhiMAJo3kAbUIyUn8KXM11",
+	"KYrg+kz3IYLga86472XnGxcdjgvdGsf3NmayBTUCc3nDXcWdiD8J56vZTPyJ35wTHyp9uYUtYyzAbqzz",
+	"K1iHHR88hQ++//sYqI0vPtaVnjyT7aSTPncoXvHFy1rjspXuNUDEieo6sVIP0amYeAd7ClYp+Gt9HfUP",
+	"G83dwotgee/DwQHlHt82r0ZGK5FD5FbJij3rilOcBWivbJgsMbasAqripltHtjMKSqG6xu1SrEQ1ZO7a",
+	"NL0RsNvwd3ilyAcx8BAw8iXkwArqMglMgcoFEI61ahGiEZYeTFutjcpUytPiyeXgoVuF0/DRi59evRXc",
+	"PMS2W5A+Fv3CLHPRvID4oXI+1EYWqtNv9pKme0uzf+t2ko7HI4LdwYFnPhRcinVlUOz6ebyFH9I28ouY",
+	"eHyVnoHnEhH1GO3nGaXjedhTGi3vteczA56TOGMfm9tyFjf3HPNZ6yH4ABJZbqKjoJQusJ4pSAs2NFJa",
+	"ZCBTsGwfjK/VhgVSD4pzii/W3p1Xao+nGDkdpRM1kDRxLUelxYPyCtxW9U1p5rVkM5iaKih0IdMfRxrX",
+	"NSNjct+WylHvHNg3zcwHIkXz+mprzKCuBYRhr1fPDvPHeHFEhYPQvNmar/dN1f3dkMNOc/R5Y/h+AfL5",
+	"fwYAKoxoiqi/AAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
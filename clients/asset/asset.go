@@ -2,14 +2,20 @@ package asset
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"fmt"
+	"hash"
 	"io"
+	"time"
 
 	transport "github.com/erikh/go-transport"
 	"github.com/tinyci/ci-agents/ci-gen/grpc/services/asset"
-	"github.com/tinyci/ci-agents/ci-gen/grpc/types"
 	"github.com/tinyci/ci-agents/utils"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Client is a handle into the asset client.
@@ -79,42 +85,286 @@ func (c *Client) Write(ctx context.Context, id int64, f io.Reader) error {
 		}
 
 		if done {
-			if err := s.CloseSend(); err != nil {
-				return err
-			}
-
-			return nil
+			// wait for the log to be stored, so errors from the store reach us
+			_, err := s.CloseAndRecv()
+			return err
 		}
 	}
 }
 
+// MaxTail is the most lines of a log which can be tailed.
+const MaxTail = 100000
+
+// LogOptions select the part of a log to read. The zero value reads all of it.
+type LogOptions struct {
+	Offset int64 // Byte offset to start reading from
+	Limit  int64 // Most bytes to read; 0 reads to the end of the log
+	Tail   int64 // Start from the last N lines instead of the offset; at most MaxTail
+}
+
+const (
+	readRetries   = 5
+	readRetryWait = time.Second
+)
+
+// Read reads the log at id, writing it to w.
 func (c *Client) Read(ctx context.Context, id int64, w io.Writer) error {
-	as, err := c.ac.GetLog(ctx, &types.IntID{ID: id}, grpc.WaitForReady(false))
-	if err != nil {
+	return c.ReadRange(ctx, id, LogOptions{}, w)
+}
+
+// ReadRange reads the part of the log at id selected by the options, writing
+// it to w.
+func (c *Client) ReadRange(ctx context.Context, id int64, opts LogOptions, w io.Writer) error {
+	return c.ReadChunks(ctx, id, opts, func(chunk *asset.LogChunk) error {
+		_, err := w.Write(chunk.Chunk)
 		return err
+	})
+}
+
+// ReadChunks reads the part of the log at id selected by the options, calling
+// fn with each chunk as it arrives. If the connection to the assetsvc is lost
+// part way through, reading resumes from the end of the last chunk received.
+func (c *Client) ReadChunks(ctx context.Context, id int64, opts LogOptions, fn func(*asset.LogChunk) error) error {
+	var (
+		tries   int
+		resumed bool
+	)
+
+	for {
+		progress, err := c.readChunks(ctx, id, &opts, fn)
+		if progress {
+			resumed = true
+			tries = 0
+		}
+
+		// failing to connect in the first place is reported straight away.
+		if err == nil || !resumed || status.Code(err) != codes.Unavailable {
+			return err
+		}
+
+		tries++
+		if tries > readRetries {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Duration(tries) * readRetryWait):
+		}
+	}
+}
+
+// readChunks makes one attempt at reading the log, moving opts past each chunk
+// it receives. It returns true if any of the log was received.
+func (c *Client) readChunks(ctx context.Context, id int64, opts *LogOptions, fn func(*asset.LogChunk) error) (bool, error) {
+	as, err := c.ac.GetLog(ctx, &asset.LogRequest{ID: id, Offset: opts.Offset, Limit: opts.Limit, Tail: opts.Tail}, grpc.WaitForReady(false))
+	if err != nil {
+		return false, err
 	}
 
 	md, err := as.Header()
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	errs := md.Get("errors")
 	if len(errs) > 0 {
-		return errors.New(errs[0])
+		return false, errors.New(errs[0])
 	}
 
+	var progress bool
+
 	for {
 		chunk, err := as.Recv()
 		if err != nil {
 			if err == io.EOF {
-				return nil
+				return progress, nil
 			}
-			return err
+			return progress, err
 		}
 
-		if _, err := w.Write(chunk.Chunk); err != nil {
-			return err
+		if err := fn(chunk); err != nil {
+			return progress, err
+		}
+
+		if chunk.Complete {
+			continue
+		}
+
+		progress = true
+		opts.Offset = chunk.Offset + int64(len(chunk.Chunk))
+		opts.Tail = 0
+
+		if opts.Limit > 0 {
+			opts.Limit -= int64(len(chunk.Chunk))
+			if opts.Limit <= 0 {
+				return progress, nil
+			}
+		}
+	}
+}
+
+// PurgeRunLogs removes the log and the artifacts for the run. It returns the
+// IDs of the runs whose logs or artifacts were removed.
+func (c *Client) PurgeRunLogs(ctx context.Context, id int64) ([]int64, error) {
+	return c.purge(ctx, &asset.PurgeRequest{RunID: id})
+}
+
+// PurgeTaskLogs removes the logs and artifacts for all the runs in the task.
+func (c *Client) PurgeTaskLogs(ctx context.Context, id int64) ([]int64, error) {
+	return c.purge(ctx, &asset.PurgeRequest{TaskID: id})
+}
+
+// PurgeSubmissionLogs removes the logs and artifacts for all the runs in the
+// submission.
+func (c *Client) PurgeSubmissionLogs(ctx context.Context, id int64) ([]int64, error) {
+	return c.purge(ctx, &asset.PurgeRequest{SubmissionID: id})
+}
+
+func (c *Client) purge(ctx context.Context, req *asset.PurgeRequest) ([]int64, error) {
+	res, err := c.ac.PurgeLogs(ctx, req, grpc.WaitForReady(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return res.RunIDs, nil
+}
+
+// SearchLogs finds the lines matching the pattern in the finished logs. The
+// request's zero values select the defaults: the last day of logs across all
+// repositories, with no context and at most 100 matches.
+func (c *Client) SearchLogs(ctx context.Context, req *asset.SearchRequest) (*asset.SearchResponse, error) {
+	return c.ac.SearchLogs(ctx, req, grpc.WaitForReady(true))
+}
+
+// LogSections returns the index of the sections of the log.
+func (c *Client) LogSections(ctx context.Context, id int64) (*asset.LogSections, error) {
+	return c.ac.GetLogSections(ctx, &asset.LogSectionsRequest{ID: id}, grpc.WaitForReady(true))
+}
+
+const artifactChunkSize = 64 * 1024
+
+// PutArtifact stores an artifact of a run with the content read from r. The
+// info must carry the run ID and name; the content type is guessed from the
+// name if it is empty, and the content is checked against the sha256 sum if
+// one is given. It returns the info of the stored artifact.
+func (c *Client) PutArtifact(ctx context.Context, info *asset.ArtifactInfo, r io.Reader) (*asset.ArtifactInfo, error) {
+	s, err := c.ac.PutArtifact(ctx, grpc.WaitForReady(true))
+	if err != nil {
+		return nil, err
+	}
+
+	h := sha256.New()
+	r = io.TeeReader(r, h)
+
+	msg := &asset.ArtifactSend{Info: info}
+	buf := make([]byte, artifactChunkSize)
+
+	for {
+		n, err := r.Read(buf)
+		if err != nil && err != io.EOF {
+			s.CloseSend() // #nosec
+			return nil, err
+		}
+
+		msg.Chunk = buf[:n]
+		if n > 0 || msg.Info != nil {
+			if err := s.Send(msg); err == io.EOF {
+				// the server gave up; its error comes from CloseAndRecv.
+				break
+			} else if err != nil {
+				return nil, err
+			}
+		}
+
+		if err == io.EOF {
+			break
+		}
+
+		msg = &asset.ArtifactSend{}
+	}
+
+	stored, err := s.CloseAndRecv()
+	if err != nil {
+		return nil, err
+	}
+
+	if sum := hex.EncodeToString(h.Sum(nil)); sum != stored.Sha256 {
+		return nil, fmt.Errorf("the stored artifact's sha256 sum %s does not match %s", stored.Sha256, sum)
+	}
+
+	return stored, nil
+}
+
+// OpenArtifact reads an artifact of a run, returning its info and its
+// content. Reading the content fails at the end if it does not match the
+// artifact's sha256 sum. The content must be closed when done.
+func (c *Client) OpenArtifact(ctx context.Context, runID int64, name string) (*asset.ArtifactInfo, io.ReadCloser, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	s, err := c.ac.GetArtifact(ctx, &asset.ArtifactRequest{RunID: runID, Name: name}, grpc.WaitForReady(true))
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+
+	msg, err := s.Recv()
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+
+	if msg.Info == nil {
+		cancel()
+		return nil, nil, errors.New("the artifact was sent without its info")
+	}
+
+	return msg.Info, &artifactReader{s: s, cancel: cancel, hash: sha256.New(), sum: msg.Info.Sha256, buf: msg.Chunk}, nil
+}
+
+// artifactReader reads the content of an artifact from a GetArtifact stream.
+type artifactReader struct {
+	s      asset.Asset_GetArtifactClient
+	cancel context.CancelFunc
+	hash   hash.Hash
+	sum    string
+	buf    []byte
+}
+
+func (ar *artifactReader) Read(p []byte) (int, error) {
+	for len(ar.buf) == 0 {
+		msg, err := ar.s.Recv()
+		if err == io.EOF {
+			if sum := hex.EncodeToString(ar.hash.Sum(nil)); sum != ar.sum {
+				return 0, fmt.Errorf("the artifact's sha256 sum %s does not match %s", sum, ar.sum)
+			}
+
+			return 0, io.EOF
+		} else if err != nil {
+			return 0, err
 		}
+
+		ar.buf = msg.Chunk
+	}
+
+	n := copy(p, ar.buf)
+	ar.hash.Write(p[:n]) // #nosec
+	ar.buf = ar.buf[n:]
+	return n, nil
+}
+
+func (ar *artifactReader) Close() error {
+	ar.cancel()
+	return nil
+}
+
+// ListArtifacts lists the artifacts of a run, sorted by name.
+func (c *Client) ListArtifacts(ctx context.Context, runID int64) ([]*asset.ArtifactInfo, error) {
+	res, err := c.ac.ListArtifacts(ctx, &asset.ArtifactListRequest{RunID: runID}, grpc.WaitForReady(true))
+	if err != nil {
+		return nil, err
 	}
+
+	return res.Artifacts, nil
 }
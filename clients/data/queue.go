@@ -2,16 +2,20 @@ package data
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/tinyci/ci-agents/ci-gen/grpc/services/data"
 	"github.com/tinyci/ci-agents/ci-gen/grpc/types"
+	topTypes "github.com/tinyci/ci-agents/types"
 	"google.golang.org/grpc"
 )
 
 // NextQueueItem return the next queue item. The runningOn is a hostname which
 // is provided for tracking purposes. It should be unique (but, is ultimately not necessary).
-func (c *Client) NextQueueItem(ctx context.Context, queueName, runningOn string) (*types.QueueItem, error) {
-	item, err := c.client.QueueNext(ctx, &types.QueueRequest{QueueName: queueName, RunningOn: runningOn}, grpc.WaitForReady(false))
+// Only runs whose runs_on labels are all among the given labels are returned.
+func (c *Client) NextQueueItem(ctx context.Context, queueName, runningOn string, labels ...string) (*types.QueueItem, error) {
+	item, err := c.client.QueueNext(ctx, &types.QueueRequest{QueueName: queueName, RunningOn: runningOn, Labels: labels}, grpc.WaitForReady(false))
 	if err != nil {
 		return nil, err
 	}
@@ -19,9 +23,56 @@ func (c *Client) NextQueueItem(ctx context.Context, queueName, runningOn string)
 	return item, nil
 }
 
-// PutStatus returns the status of the run.
-func (c *Client) PutStatus(ctx context.Context, runID int64, status bool, msg string) error {
-	_, err := c.client.PutStatus(ctx, &types.Status{AdditionalMessage: msg, Id: runID, Status: status}, grpc.WaitForReady(true))
+// NextQueueItems claims up to count of the next queue items, for runners which
+// can run several at once; see NextQueueItem.
+func (c *Client) NextQueueItems(ctx context.Context, queueName, runningOn string, count int64, labels ...string) ([]*types.QueueItem, error) {
+	list, err := c.client.QueueNextItems(ctx, &types.QueueRequest{QueueName: queueName, RunningOn: runningOn, Labels: labels, Count: count}, grpc.WaitForReady(false))
+	if err != nil {
+		return nil, err
+	}
+
+	return list.Items, nil
+}
+
+// ListExpiredQueueItems lists the items of the named queue which could have
+// been handed out for longer than the ttl, which is rounded down to seconds.
+// Time spent waiting on dependencies or concurrency groups does not count.
+func (c *Client) ListExpiredQueueItems(ctx context.Context, queueName string, ttl time.Duration) ([]*types.QueueItem, error) {
+	list, err := c.client.QueueListExpired(ctx, &data.QueueExpiry{QueueName: queueName, Ttl: int64(ttl / time.Second)}, grpc.WaitForReady(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return list.Items, nil
+}
+
+// WatchQueue calls fn whenever items may be waiting in the named queue, or the
+// other queues named by the labels, and once when the watch is in place. It
+// returns when ctx is canceled, the stream ends or fn returns an error.
+func (c *Client) WatchQueue(ctx context.Context, queueName string, fn func() error, labels ...string) error {
+	stream, err := c.client.QueueWatch(ctx, &types.QueueRequest{QueueName: queueName, Labels: labels}, grpc.WaitForReady(true))
+	if err != nil {
+		return err
+	}
+
+	for {
+		if _, err := stream.Recv(); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+
+		if err := fn(); err != nil {
+			return err
+		}
+	}
+}
+
+// PutStatus returns the status of the run. The reason is why a failed run
+// failed, see types.RetryPolicy; it may be empty.
+func (c *Client) PutStatus(ctx context.Context, runID int64, status bool, reason, msg string) error {
+	_, err := c.client.PutStatus(ctx, &types.Status{AdditionalMessage: msg, Id: runID, Status: status, Reason: reason}, grpc.WaitForReady(true))
 	return err
 }
 
@@ -34,12 +85,38 @@ func (c *Client) PutQueue(ctx context.Context, qis []*types.QueueItem) ([]*types
 	return []*types.QueueItem(ret.Items), nil
 }
 
+// WatchCancel blocks until the run is canceled, returning true, or its task
+// finishes otherwise, returning false.
+func (c *Client) WatchCancel(ctx context.Context, id int64) (bool, error) {
+	stream, err := c.client.WatchCancel(ctx, &types.IntID{ID: id}, grpc.WaitForReady(true))
+	if err != nil {
+		return false, err
+	}
+
+	s, err := stream.Recv()
+	if err != nil {
+		if err == io.EOF {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return s.Status, nil
+}
+
 // SetCancel cancels a run, and any other task-level runs.
 func (c *Client) SetCancel(ctx context.Context, id int64) error {
 	_, err := c.client.SetCancel(ctx, &types.IntID{ID: id}, grpc.WaitForReady(true))
 	return err
 }
 
+// TimeOutRun cancels a run which exceeded its timeout, and fails it with the
+// message. The run is retried if its retry policy allows.
+func (c *Client) TimeOutRun(ctx context.Context, runID int64, msg string) error {
+	_, err := c.client.TimeOutRun(ctx, &types.Status{AdditionalMessage: msg, Id: runID, Reason: topTypes.FailureReasonTimeout}, grpc.WaitForReady(true))
+	return err
+}
+
 // GetCancel returns the state for the run.
 func (c *Client) GetCancel(ctx context.Context, id int64) (bool, error) {
 	status, err := c.client.GetCancel(ctx, &types.IntID{ID: id}, grpc.WaitForReady(true))
@@ -3,6 +3,7 @@ package data
 import (
 	"context"
 
+	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/tinyci/ci-agents/ci-gen/grpc/services/data"
 	"github.com/tinyci/ci-agents/ci-gen/grpc/types"
 	"google.golang.org/grpc"
@@ -32,3 +33,50 @@ func (c *Client) GetRun(ctx context.Context, id int64) (*types.Run, error) {
 func (c *Client) GetRunUI(ctx context.Context, id int64) (*types.Run, error) {
 	return c.client.GetRunUI(ctx, &types.IntID{ID: id}, grpc.WaitForReady(true))
 }
+
+// RunIDsForTask lists the IDs of the runs in the task.
+func (c *Client) RunIDsForTask(ctx context.Context, taskID int64) ([]int64, error) {
+	ids, err := c.client.ListRunIDs(ctx, &data.RunIDsRequest{TaskID: taskID}, grpc.WaitForReady(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return ids.Ids, nil
+}
+
+// RunIDsForSubmission lists the IDs of the runs in the submission.
+func (c *Client) RunIDsForSubmission(ctx context.Context, subID int64) ([]int64, error) {
+	ids, err := c.client.ListRunIDs(ctx, &data.RunIDsRequest{SubmissionID: subID}, grpc.WaitForReady(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return ids.Ids, nil
+}
+
+// RunRepositories maps the runs to the IDs of the repositories they were
+// submitted against.
+func (c *Client) RunRepositories(ctx context.Context, runIDs []int64) (map[int64]int64, error) {
+	repos, err := c.client.GetRunRepositories(ctx, &data.RunIDs{Ids: runIDs}, grpc.WaitForReady(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return repos.Repositories, nil
+}
+
+// RerunRun queues a new attempt of the finished run, returning it.
+func (c *Client) RerunRun(ctx context.Context, id int64) (*types.Run, error) {
+	return c.client.RerunRun(ctx, &types.IntID{ID: id}, grpc.WaitForReady(true))
+}
+
+// ListTimedOutRuns lists the unfinished runs which have been running for longer
+// than their timeout.
+func (c *Client) ListTimedOutRuns(ctx context.Context) ([]*types.Run, error) {
+	list, err := c.client.ListTimedOutRuns(ctx, &empty.Empty{}, grpc.WaitForReady(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return list.List, nil
+}
@@ -0,0 +1,29 @@
+package data
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/tinyci/ci-agents/ci-gen/grpc/types"
+	"google.golang.org/grpc"
+)
+
+// RunnerHeartbeat registers the runner, or records that it is still alive.
+func (c *Client) RunnerHeartbeat(ctx context.Context, r *types.Runner) (*types.Runner, error) {
+	return c.client.RunnerHeartbeat(ctx, r, grpc.WaitForReady(true))
+}
+
+// ListRunners lists all registered runners.
+func (c *Client) ListRunners(ctx context.Context) ([]*types.Runner, error) {
+	list, err := c.client.ListRunners(ctx, &empty.Empty{}, grpc.WaitForReady(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return list.Runners, nil
+}
+
+// SetRunnerState cordons, drains or uncordons the named runner.
+func (c *Client) SetRunnerState(ctx context.Context, name string, cordoned, draining bool) (*types.Runner, error) {
+	return c.client.SetRunnerState(ctx, &types.RunnerState{Name: name, Cordoned: cordoned, Draining: draining}, grpc.WaitForReady(true))
+}
@@ -52,3 +52,9 @@ func (c *Client) CancelSubmission(ctx context.Context, id int64) error {
 
 	return nil
 }
+
+// RerunSubmission re-runs all tasks of the finished submission, returning the
+// new runs.
+func (c *Client) RerunSubmission(ctx context.Context, id int64) (*types.RunList, error) {
+	return c.client.RerunSubmission(ctx, &types.IntID{ID: id})
+}
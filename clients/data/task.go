@@ -59,8 +59,21 @@ func (c *Client) CountRunsForTask(ctx context.Context, taskID int64) (int64, err
 	return count.Count, nil
 }
 
+// CancelConcurrentTasks cancels the older tasks in the concurrency groups the
+// task asks to cancel in progress.
+func (c *Client) CancelConcurrentTasks(ctx context.Context, id int64) error {
+	_, err := c.client.CancelConcurrentTasks(ctx, &types.IntID{ID: id}, grpc.WaitForReady(true))
+	return err
+}
+
 // CancelTask cancels a task by id.
 func (c *Client) CancelTask(ctx context.Context, id int64) error {
 	_, err := c.client.CancelTask(ctx, &types.IntID{ID: id})
 	return err
 }
+
+// RerunTask copies the finished task and queues its runs again, returning the
+// new runs.
+func (c *Client) RerunTask(ctx context.Context, id int64) (*types.RunList, error) {
+	return c.client.RerunTask(ctx, &types.IntID{ID: id}, grpc.WaitForReady(true))
+}
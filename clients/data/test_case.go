@@ -0,0 +1,42 @@
+package data
+
+import (
+	"context"
+
+	"github.com/tinyci/ci-agents/ci-gen/grpc/services/data"
+	"github.com/tinyci/ci-agents/ci-gen/grpc/types"
+	"google.golang.org/grpc"
+)
+
+// AddTestCases records the test cases read from a test report of the run.
+func (c *Client) AddTestCases(ctx context.Context, runID int64, cases []*types.TestCase) error {
+	_, err := c.client.AddTestCases(ctx, &types.TestCaseList{RunID: runID, TestCases: cases}, grpc.WaitForReady(true))
+	return err
+}
+
+// ListTestCases lists the test cases of the run. If a status is given, only
+// the test cases with it are listed.
+func (c *Client) ListTestCases(ctx context.Context, runID int64, status string) ([]*types.TestCase, error) {
+	list, err := c.client.ListTestCases(ctx, &data.TestCaseRequest{RunID: runID, Status: status}, grpc.WaitForReady(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return list.TestCases, nil
+}
+
+// GetTestSummary counts the test cases of the run by status.
+func (c *Client) GetTestSummary(ctx context.Context, runID int64) (*types.TestSummary, error) {
+	return c.client.GetTestSummary(ctx, &types.IntID{ID: runID}, grpc.WaitForReady(true))
+}
+
+// FlakyTests lists the tests of the repository which both passed and failed on
+// the same SHA in the last number of days; 0 uses the datasvc's default.
+func (c *Client) FlakyTests(ctx context.Context, repository string, days int64) ([]*types.FlakyTest, error) {
+	list, err := c.client.FlakyTests(ctx, &data.FlakyTestRequest{Repository: repository, Days: days}, grpc.WaitForReady(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return list.Tests, nil
+}
@@ -0,0 +1,150 @@
+package github
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/github"
+	"github.com/tinyci/ci-agents/utils"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// appJWTLifetime is how long the JWTs the app authenticates with are valid;
+	// github accepts at most ten minutes.
+	appJWTLifetime = 9 * time.Minute
+	// appTokenSlack is how long before they expire installation tokens are
+	// renewed, so they don't expire during a request.
+	appTokenSlack = 5 * time.Minute
+)
+
+// App is a GitHub App; github only lets apps write check runs. Its clients
+// act as the installation of the app on a repository, whose tokens are
+// cached until shortly before they expire.
+type App struct {
+	ID int64
+
+	key    *rsa.PrivateKey
+	mutex  sync.Mutex
+	tokens map[string]*github.InstallationToken // by repository full name
+}
+
+// NewApp creates an App from its ID and its PEM encoded private key.
+func NewApp(id int64, pemKey []byte) (*App, error) {
+	block, _ := pem.Decode(pemKey)
+	if block == nil {
+		return nil, errors.New("no PEM encoded private key found for the github app")
+	}
+
+	key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		parsed, pkcs8Err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if pkcs8Err != nil {
+			return nil, utils.WrapError(err, "parsing the github app's private key")
+		}
+
+		var ok bool
+		if key, ok = parsed.(*rsa.PrivateKey); !ok {
+			return nil, errors.New("the github app's private key is not an RSA key")
+		}
+	}
+
+	return &App{ID: id, key: key, tokens: map[string]*github.InstallationToken{}}, nil
+}
+
+// jwt signs the token the app authenticates as itself with.
+func (a *App) jwt(now time.Time) (string, error) {
+	enc := base64.RawURLEncoding
+
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+
+	claims, err := json.Marshal(map[string]interface{}{
+		// backdated, in case github's clock is behind ours.
+		"iat": now.Add(-time.Minute).Unix(),
+		"exp": now.Add(appJWTLifetime).Unix(),
+		"iss": a.ID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	payload := enc.EncodeToString(header) + "." + enc.EncodeToString(claims)
+	sum := sha256.Sum256([]byte(payload))
+
+	sig, err := rsa.SignPKCS1v15(rand.Reader, a.key, crypto.SHA256, sum[:])
+	if err != nil {
+		return "", err
+	}
+
+	return payload + "." + enc.EncodeToString(sig), nil
+}
+
+// jwtTransport authenticates requests as the app itself.
+type jwtTransport struct {
+	app *App
+}
+
+func (jt *jwtTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := jt.app.jwt(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// installationToken returns a token of the app's installation on the
+// repository.
+func (a *App) installationToken(ctx context.Context, owner, repo string) (string, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	name := owner + "/" + repo
+
+	if t, ok := a.tokens[name]; ok && time.Now().Add(appTokenSlack).Before(t.GetExpiresAt()) {
+		return t.GetToken(), nil
+	}
+
+	client := github.NewClient(&http.Client{Transport: &jwtTransport{app: a}})
+
+	inst, _, err := client.Apps.FindRepositoryInstallation(ctx, owner, repo)
+	if err != nil {
+		return "", utils.WrapError(err, "the github app is not installed on %s", name)
+	}
+
+	t, _, err := client.Apps.CreateInstallationToken(ctx, inst.GetID())
+	if err != nil {
+		return "", utils.WrapError(err, "creating a token for the github app's installation on %s", name)
+	}
+
+	a.tokens[name] = t
+	return t.GetToken(), nil
+}
+
+// Client returns a client acting as the app's installation on the repository.
+// Only its clients can report check runs.
+func (a *App) Client(ctx context.Context, owner, repo string) (Client, error) {
+	token, err := a.installationToken(ctx, owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	tc := oauth2.NewClient(context.Background(), oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token}))
+	return &HTTPClient{github: github.NewClient(tc), appID: a.ID}, nil
+}
@@ -0,0 +1,188 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/go-github/github"
+)
+
+// Statuses and conclusions of check runs.
+const (
+	CheckQueued     = "queued"
+	CheckInProgress = "in_progress"
+	CheckCompleted  = "completed"
+
+	CheckSuccess   = "success"
+	CheckFailure   = "failure"
+	CheckCancelled = "cancelled"
+	CheckTimedOut  = "timed_out"
+	CheckSkipped   = "skipped"
+)
+
+// CheckRerunAction identifies the "Re-run" button of failed check runs. GitHub
+// sends a check_run event with the requested_action action when it is pressed.
+const CheckRerunAction = "rerun"
+
+const (
+	// maxCheckAnnotations is the most annotations github accepts per request.
+	maxCheckAnnotations = 50
+	// maxCheckSummary is the longest summary github accepts.
+	maxCheckSummary = 65535
+	// checksMediaType enables the Checks API on older github enterprise servers.
+	checksMediaType = "application/vnd.github.antiope-preview+json"
+)
+
+// CheckRun is the state of a run reported through the Checks API.
+type CheckRun struct {
+	Name        string
+	SHA         string
+	URL         string
+	ExternalID  string // tinyCI's ID for the run; github sends it back in check_run events
+	Status      string // queued, in_progress or completed
+	Conclusion  string // required once completed
+	Title       string
+	Summary     string // markdown
+	Annotations []*CheckAnnotation
+	Rerun       bool // offer the "Re-run" button
+}
+
+// CheckAnnotation points out a line of a file in the output of a check run.
+type CheckAnnotation struct {
+	Path    string
+	Line    int
+	Level   string // notice, warning or failure
+	Title   string
+	Message string
+}
+
+type checkRunRequest struct {
+	Name        string            `json:"name"`
+	HeadSHA     string            `json:"head_sha,omitempty"`
+	DetailsURL  string            `json:"details_url,omitempty"`
+	ExternalID  string            `json:"external_id,omitempty"`
+	Status      string            `json:"status"`
+	Conclusion  string            `json:"conclusion,omitempty"`
+	StartedAt   *time.Time        `json:"started_at,omitempty"`
+	CompletedAt *time.Time        `json:"completed_at,omitempty"`
+	Output      *checkRunOutput   `json:"output,omitempty"`
+	Actions     []*checkRunAction `json:"actions,omitempty"`
+}
+
+type checkRunOutput struct {
+	Title       string                `json:"title"`
+	Summary     string                `json:"summary"`
+	Annotations []*checkRunAnnotation `json:"annotations,omitempty"`
+}
+
+type checkRunAnnotation struct {
+	Path            string `json:"path"`
+	StartLine       int    `json:"start_line"`
+	EndLine         int    `json:"end_line"`
+	AnnotationLevel string `json:"annotation_level"`
+	Title           string `json:"title,omitempty"`
+	Message         string `json:"message"`
+}
+
+type checkRunAction struct {
+	Label       string `json:"label"`
+	Description string `json:"description"`
+	Identifier  string `json:"identifier"`
+}
+
+// request converts the check run to the body github expects; the go-github
+// version we use predates the current annotation and action fields.
+func (cr *CheckRun) request(now time.Time) *checkRunRequest {
+	req := &checkRunRequest{
+		Name:       cr.Name,
+		HeadSHA:    cr.SHA,
+		DetailsURL: cr.URL,
+		ExternalID: cr.ExternalID,
+		Status:     cr.Status,
+	}
+
+	switch cr.Status {
+	case CheckInProgress:
+		req.StartedAt = &now
+	case CheckCompleted:
+		req.Conclusion = cr.Conclusion
+		req.CompletedAt = &now
+	}
+
+	if cr.Title != "" {
+		summary := cr.Summary
+		if len(summary) > maxCheckSummary {
+			// cut on a rune boundary, so the summary stays valid UTF-8.
+			n := maxCheckSummary
+			for n > 0 && !utf8.RuneStart(summary[n]) {
+				n--
+			}
+
+			summary = summary[:n]
+		}
+
+		req.Output = &checkRunOutput{Title: cr.Title, Summary: summary}
+
+		for i, a := range cr.Annotations {
+			if i == maxCheckAnnotations {
+				break
+			}
+
+			req.Output.Annotations = append(req.Output.Annotations, &checkRunAnnotation{
+				Path:            a.Path,
+				StartLine:       a.Line,
+				EndLine:         a.Line,
+				AnnotationLevel: a.Level,
+				Title:           a.Title,
+				Message:         a.Message,
+			})
+		}
+	}
+
+	if cr.Rerun {
+		req.Actions = []*checkRunAction{{Label: "Re-run", Description: "Run this again", Identifier: CheckRerunAction}}
+	}
+
+	return req
+}
+
+// ReportCheckRun creates the check run for the sha for the given repo on
+// github, or updates the app's check run of the same name if there is one
+// already. Only the clients of a GitHub App can report check runs; see
+// App.Client.
+func (c *HTTPClient) ReportCheckRun(ctx context.Context, owner, repo string, cr *CheckRun) error {
+	if Readonly {
+		return nil
+	}
+
+	if c.appID == 0 {
+		return errors.New("check runs can only be reported by a github app")
+	}
+
+	existing, _, err := c.github.Checks.ListCheckRunsForRef(ctx, owner, repo, cr.SHA, &github.ListCheckRunsOptions{CheckName: github.String(cr.Name)})
+	if err != nil {
+		return err
+	}
+
+	method, u := "POST", fmt.Sprintf("repos/%v/%v/check-runs", owner, repo)
+	for _, run := range existing.CheckRuns {
+		// other apps may have check runs of the same name.
+		if run.GetApp().GetID() == c.appID {
+			method, u = "PATCH", fmt.Sprintf("repos/%v/%v/check-runs/%d", owner, repo, run.GetID())
+			break
+		}
+	}
+
+	req, err := c.github.NewRequest(method, u, cr.request(time.Now()))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Accept", checksMediaType)
+
+	_, err = c.github.Do(ctx, req, nil)
+	return err
+}
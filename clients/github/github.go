@@ -40,12 +40,15 @@ type Client interface {
 	StartedStatus(context.Context, string, string, string, string, string) error
 	ErrorStatus(context.Context, string, string, string, string, string, error) error
 	FinishedStatus(context.Context, string, string, string, string, string, bool, string) error
+	SkippedStatus(context.Context, string, string, string, string, string, string) error
 	ClearStates(context.Context, string, string) error
+	ReportCheckRun(context.Context, string, string, *CheckRun) error
 }
 
 // HTTPClient encapsulates the "real world", or http client.
 type HTTPClient struct {
 	github *github.Client
+	appID  int64 // set for the clients of a GitHub App; see App.Client
 }
 
 // NewClientFromAccessToken turns an accessToken into a new Client.
@@ -138,6 +141,24 @@ func (c *HTTPClient) FinishedStatus(ctx context.Context, owner, repo, name, sha,
 	return err
 }
 
+// SkippedStatus marks the run as successful on github without it having run,
+// so that required checks are not left pending.
+func (c *HTTPClient) SkippedStatus(ctx context.Context, owner, repo, name, sha, url, reason string) error {
+	if Readonly {
+		return nil
+	}
+
+	_, _, err := c.github.Repositories.CreateStatus(ctx, owner, repo, sha, &github.RepoStatus{
+		TargetURL: github.String(url),
+		State:     github.String("success"),
+		// github statuses cap at 140c
+		Description: capStatus(fmt.Sprintf("The run was skipped: %s", reason)),
+		Context:     github.String(name),
+	})
+
+	return err
+}
+
 // SetupHook sets up the pr webhook in github.
 func (c *HTTPClient) SetupHook(ctx context.Context, owner, repo, configAddress, hookSecret string) error {
 	if Readonly {
@@ -146,7 +167,7 @@ func (c *HTTPClient) SetupHook(ctx context.Context, owner, repo, configAddress,
 
 	_, _, err := c.github.Repositories.CreateHook(ctx, owner, repo, &github.Hook{
 		URL:    github.String(configAddress),
-		Events: []string{"push", "pull_request"},
+		Events: []string{"push", "pull_request", "check_run"},
 		Active: github.Bool(true),
 		Config: map[string]interface{}{
 			"url":          configAddress,
@@ -28,6 +28,9 @@ const (
 type Message struct {
 	Type    string `json:"type"`
 	Payload string `json:"payload"`
+	// Offset is the byte offset of the payload within the log it is part of,
+	// if any. Clients use it to resume reading after losing the connection.
+	Offset *int64 `json:"offset,omitempty"`
 }
 
 // ReadWrapper is a read-only wrapper for jsonbuffer.
@@ -77,6 +80,12 @@ func (w *WriteWrapper) Send(message string) error {
 	return nil
 }
 
+// SendAt is like Send, but for a payload which is part of a log, recording
+// its offset within it.
+func (w *WriteWrapper) SendAt(message string, offset int64) error {
+	return w.enc.Encode(Message{Type: TypeMessage, Payload: message, Offset: &offset})
+}
+
 // SendError is like Send, but for errors.
 func (w *WriteWrapper) SendError(err error) error {
 	return w.enc.Encode(Message{Type: TypeError, Payload: err.Error()})
@@ -111,6 +120,25 @@ func (w *ReadWrapper) Recv() (string, error) {
 	}
 }
 
+// RecvMessage reads a single message from the reader and returns it, so that
+// its offset may be inspected. Error messages are returned as errors, and
+// io.EOF is returned once there are no more messages.
+func (w *ReadWrapper) RecvMessage() (*Message, error) {
+	var msg Message
+	if err := w.dec.Decode(&msg); err != nil {
+		return nil, err
+	}
+
+	switch msg.Type {
+	case TypeMessage:
+		return &msg, nil
+	case TypeError:
+		return nil, errors.New(msg.Payload)
+	default:
+		return nil, fmt.Errorf("invalid type %v", msg.Type)
+	}
+}
+
 // Write is Send that conforms to the io.Writer spec. Each buffer will be sent as a single Message.
 func (w *WriteWrapper) Write(buf []byte) (int, error) {
 	err := w.Send(string(buf))
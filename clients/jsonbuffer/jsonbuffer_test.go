@@ -109,3 +109,29 @@ func (ws *jsonbufferSuite) TestStream2(c *check.C) {
 	c.Assert(len(content), check.Equals, 1024*1024)
 	c.Assert(content, check.DeepEquals, byt)
 }
+
+func (ws *jsonbufferSuite) TestOffsets(c *check.C) {
+	buf := bytes.NewBuffer(nil)
+	w := NewWrapper(buf)
+
+	c.Assert(w.SendAt("hello, ", 10), check.IsNil)
+	c.Assert(w.Send("world"), check.IsNil)
+	c.Assert(w.SendError(errors.New("this is a test error")), check.IsNil)
+
+	msg, err := w.RecvMessage()
+	c.Assert(err, check.IsNil)
+	c.Assert(msg.Payload, check.Equals, "hello, ")
+	c.Assert(msg.Offset, check.NotNil)
+	c.Assert(*msg.Offset, check.Equals, int64(10))
+
+	msg, err = w.RecvMessage()
+	c.Assert(err, check.IsNil)
+	c.Assert(msg.Payload, check.Equals, "world")
+	c.Assert(msg.Offset, check.IsNil)
+
+	_, err = w.RecvMessage()
+	c.Assert(err, check.ErrorMatches, "^this is a test error$")
+
+	_, err = w.RecvMessage()
+	c.Assert(err, check.Equals, io.EOF)
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/tinyci/ci-agents/ci-gen/grpc/types"
 	"github.com/tinyci/ci-agents/utils"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Client is the queue client.
@@ -69,9 +71,115 @@ func (c *Client) SetCancel(ctx context.Context, id int64) error {
 	return nil
 }
 
-// NextQueueItem returns the next item in the queue.
-func (c *Client) NextQueueItem(ctx context.Context, queueName, hostname string) (*types.QueueItem, error) {
-	return c.client.NextQueueItem(ctx, &types.QueueRequest{QueueName: queueName, RunningOn: hostname}, grpc.WaitForReady(false))
+// WatchCancel blocks until the run is canceled, returning true, or its task
+// finishes otherwise, returning false. Runners use it instead of polling
+// GetCancel; cancel ctx once the run is done.
+func (c *Client) WatchCancel(ctx context.Context, id int64) (bool, error) {
+	stream, err := c.client.WatchCancel(ctx, &types.IntID{ID: id}, grpc.WaitForReady(true))
+	if err != nil {
+		return false, err
+	}
+
+	s, err := stream.Recv()
+	if err != nil {
+		if err == io.EOF {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return s.Status, nil
+}
+
+// NextQueueItem returns the next item in the queue. The labels describe the
+// runner, e.g. `arch=arm64`; runs requiring labels not in this list are not
+// returned. The queue is one of the labels, and labels naming other queues,
+// e.g. `queue=gpu`, have the runner handed their items as well.
+func (c *Client) NextQueueItem(ctx context.Context, queueName, hostname string, labels ...string) (*types.QueueItem, error) {
+	return c.client.NextQueueItem(ctx, &types.QueueRequest{QueueName: queueName, RunningOn: hostname, Labels: labels}, grpc.WaitForReady(false))
+}
+
+// NextQueueItems returns up to count of the next items in the queue, for
+// runners with several slots. Fewer may be returned even if more are queued;
+// see NextQueueItem for the labels.
+func (c *Client) NextQueueItems(ctx context.Context, queueName, hostname string, count int64, labels ...string) ([]*types.QueueItem, error) {
+	list, err := c.client.NextQueueItems(ctx, &types.QueueRequest{QueueName: queueName, RunningOn: hostname, Labels: labels, Count: count}, grpc.WaitForReady(false))
+	if err != nil {
+		return nil, err
+	}
+
+	return list.Items, nil
+}
+
+// WatchQueue calls fn whenever items may be waiting in the named queue, or the
+// other queues named by the labels, and once when the watch is in place; fn
+// would then claim them with NextQueueItem(s). It returns when ctx is
+// canceled, the stream ends or fn returns an error.
+func (c *Client) WatchQueue(ctx context.Context, queueName string, fn func() error, labels ...string) error {
+	stream, err := c.client.WatchQueue(ctx, &types.QueueRequest{QueueName: queueName, Labels: labels}, grpc.WaitForReady(true))
+	if err != nil {
+		return err
+	}
+
+	for {
+		if _, err := stream.Recv(); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+
+		if err := fn(); err != nil {
+			return err
+		}
+	}
+}
+
+// WaitQueueItems blocks until it can claim up to count items of the queues
+// the runner serves, instead of polling NextQueueItems. It returns the error
+// of ctx if it is canceled first; see NextQueueItem for the labels.
+func (c *Client) WaitQueueItems(ctx context.Context, queueName, hostname string, count int64, labels ...string) ([]*types.QueueItem, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	wake := make(chan struct{}, 1)
+	errs := make(chan error, 1)
+
+	go func() {
+		err := c.WatchQueue(ctx, queueName, func() error {
+			select {
+			case wake <- struct{}{}:
+			default: // a wakeup is already pending
+			}
+			return nil
+		}, labels...)
+		if err == nil {
+			err = io.ErrUnexpectedEOF
+		}
+
+		errs <- err
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case err := <-errs:
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, err
+		case <-wake:
+			items, err := c.NextQueueItems(ctx, queueName, hostname, count, labels...)
+			if err == nil {
+				return items, nil
+			}
+
+			if status.Code(err) != codes.NotFound {
+				return nil, err
+			}
+		}
+	}
 }
 
 // SetStatus completes the run by returning its status back to the system.
@@ -83,3 +191,44 @@ func (c *Client) SetStatus(ctx context.Context, id int64, status bool) error {
 
 	return nil
 }
+
+// SetFailure fails the run, giving the reason it failed: one of
+// types.FailureReasonFailure, types.FailureReasonTests,
+// types.FailureReasonInfraError or types.FailureReasonTimeout. Runs whose retry
+// policy covers the reason are attempted again. Only runs failed for their
+// tests can pass because of quarantined tests.
+func (c *Client) SetFailure(ctx context.Context, id int64, reason string) error {
+	_, err := c.client.PutStatus(ctx, &types.Status{Id: id, Status: false, Reason: reason}, grpc.WaitForReady(true))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RerunRun queues a new attempt of the finished run, returning it.
+func (c *Client) RerunRun(ctx context.Context, id int64) (*types.Run, error) {
+	return c.client.RerunRun(ctx, &types.IntID{ID: id}, grpc.WaitForReady(true))
+}
+
+// RerunTask copies the finished task and queues its runs again, returning the
+// new runs.
+func (c *Client) RerunTask(ctx context.Context, id int64) ([]*types.Run, error) {
+	list, err := c.client.RerunTask(ctx, &types.IntID{ID: id}, grpc.WaitForReady(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return list.List, nil
+}
+
+// RerunSubmission re-runs all tasks of the finished submission, returning the
+// new runs.
+func (c *Client) RerunSubmission(ctx context.Context, id int64) ([]*types.Run, error) {
+	list, err := c.client.RerunSubmission(ctx, &types.IntID{ID: id}, grpc.WaitForReady(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return list.List, nil
+}
@@ -0,0 +1,38 @@
+package queue
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/tinyci/ci-agents/ci-gen/grpc/types"
+	"google.golang.org/grpc"
+)
+
+// RegisterRunner registers the runner with the queuesvc. The name should be
+// the hostname passed to NextQueueItem.
+func (c *Client) RegisterRunner(ctx context.Context, name, queueName string, labels []string) (*types.Runner, error) {
+	return c.client.RegisterRunner(ctx, &types.Runner{Name: name, QueueName: queueName, Labels: labels}, grpc.WaitForReady(true))
+}
+
+// Heartbeat tells the queuesvc the runner is still alive. Runners which stop
+// sending heartbeats have their runs re-queued. The returned runner reports
+// whether it has been cordoned or drained; draining runners should exit once
+// their current runs have finished.
+func (c *Client) Heartbeat(ctx context.Context, name, queueName string, labels []string) (*types.Runner, error) {
+	return c.client.Heartbeat(ctx, &types.Runner{Name: name, QueueName: queueName, Labels: labels}, grpc.WaitForReady(false))
+}
+
+// ListRunners lists all registered runners.
+func (c *Client) ListRunners(ctx context.Context) ([]*types.Runner, error) {
+	list, err := c.client.ListRunners(ctx, &empty.Empty{}, grpc.WaitForReady(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return list.Runners, nil
+}
+
+// SetRunnerState cordons, drains or uncordons the named runner.
+func (c *Client) SetRunnerState(ctx context.Context, name string, cordoned, draining bool) (*types.Runner, error) {
+	return c.client.SetRunnerState(ctx, &types.RunnerState{Name: name, Cordoned: cordoned, Draining: draining}, grpc.WaitForReady(true))
+}
@@ -19,6 +19,8 @@ func (c *Client) Submit(ctx context.Context, sub *types.Submission) error {
 		SubmittedBy: sub.SubmittedBy,
 		Manual:      sub.Manual,
 		TicketID:    sub.TicketID,
+		Event:       sub.Event,
+		Priority:    sub.Priority,
 	}, grpc.WaitForReady(true))
 	return err
 }
@@ -7,9 +7,11 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	transport "github.com/erikh/go-transport"
 	"github.com/tinyci/ci-agents/ci-gen/openapi/services/uisvc"
@@ -110,9 +112,12 @@ func (c *Client) Errors(ctx context.Context) ([]*uisvc.UserError, error) {
 	return ue, json.NewDecoder(resp.Body).Decode(&ue)
 }
 
-// Submit submits a request to test a repository to tinyCI.
-func (c *Client) Submit(ctx context.Context, repository, sha string, all bool) error {
-	resp, err := c.client.GetSubmit(ctx, &uisvc.GetSubmitParams{All: &all, Repository: repository, Sha: sha})
+// Submit submits a request to test a repository to tinyCI. A nil priority
+// uses the repository's configured priority.
+func (c *Client) Submit(ctx context.Context, repository, sha string, all bool, priority *int32) error {
+	params := &uisvc.GetSubmitParams{All: &all, Repository: repository, Sha: sha, Priority: priority}
+
+	resp, err := c.client.GetSubmit(ctx, params)
 	if err != nil {
 		return err
 	}
@@ -120,9 +125,67 @@ func (c *Client) Submit(ctx context.Context, repository, sha string, all bool) e
 	return resp.Body.Close()
 }
 
+// LogOptions select the part of a log to attach to. The zero value attaches
+// to all of it.
+type LogOptions struct {
+	Offset int64 // Byte offset to start reading from
+	Limit  int64 // Most bytes to read; 0 reads to the end of the log
+	Tail   int64 // Start from the last N lines instead of the offset
+}
+
+const (
+	logAttachRetries   = 5
+	logAttachRetryWait = time.Second
+)
+
 // LogAttach attaches to a and retrieves it's output. Attach will block the
 // stream assuming that that job is not completed.
 func (c *Client) LogAttach(ctx context.Context, id int64, w io.WriteCloser) error {
+	return c.LogAttachRange(ctx, id, LogOptions{}, w)
+}
+
+// LogAttachRange is LogAttach for the part of the log selected by the
+// options. If the connection drops part way through, it reattaches from the
+// end of the content it has already written.
+func (c *Client) LogAttachRange(ctx context.Context, id int64, opts LogOptions, w io.WriteCloser) error {
+	var (
+		tries   int
+		resumed bool
+	)
+
+	for {
+		progress, done, err := c.logAttach(id, &opts, w)
+		if progress {
+			resumed = true
+			tries = 0
+		}
+
+		// failing to attach in the first place is reported straight away.
+		if done || !resumed {
+			return err
+		}
+
+		tries++
+		if tries > logAttachRetries {
+			if err == nil {
+				err = errors.New("lost the connection to the log")
+			}
+
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Duration(tries) * logAttachRetryWait):
+		}
+	}
+}
+
+// logAttach attaches once, moving opts past the content it writes. progress
+// is true if any of the log was written; done is true if the log was read to
+// the end or limit, or the server reported an error.
+func (c *Client) logAttach(id int64, opts *LogOptions, w io.Writer) (progress, done bool, err error) {
 	baseURL := *c.baseURL
 
 	baseURL.Scheme = "ws"
@@ -134,21 +197,170 @@ func (c *Client) LogAttach(ctx context.Context, id int64, w io.WriteCloser) erro
 	headers.Add("Authorization", c.token)
 
 	baseURL.Path += fmt.Sprintf("/log/attach/%d", id)
+
+	query := url.Values{}
+	if opts.Offset > 0 {
+		query.Set("offset", fmt.Sprintf("%d", opts.Offset))
+	}
+
+	if opts.Limit > 0 {
+		query.Set("limit", fmt.Sprintf("%d", opts.Limit))
+	}
+
+	if opts.Tail > 0 {
+		query.Set("tail", fmt.Sprintf("%d", opts.Tail))
+	}
+
+	baseURL.RawQuery = query.Encode()
+
 	conf, err := websocket.NewConfig(baseURL.String(), baseURL.String())
 	if err != nil {
-		return err
+		return false, true, err
 	}
 
 	conf.Header = headers
 	conn, err := websocket.DialConfig(conf)
+	if err != nil {
+		return false, false, err
+	}
+	defer conn.Close()
+
+	r := jsonbuffer.NewReadWrapper(conn)
+
+	// the end of the log is marked by a message without an offset.
+	var complete bool
+
+	for {
+		msg, err := r.RecvMessage()
+		if err == io.EOF {
+			return progress, complete, nil
+		} else if err != nil {
+			// errors sent by the server are final; anything else is the connection.
+			_, connErr := err.(net.Error)
+			return progress, !connErr && !errors.Is(err, io.ErrUnexpectedEOF), err
+		}
+
+		if _, err := w.Write([]byte(msg.Payload)); err != nil {
+			return progress, true, err
+		}
+
+		complete = msg.Offset == nil
+		if complete {
+			continue
+		}
+
+		progress = true
+		opts.Offset = *msg.Offset + int64(len(msg.Payload))
+		opts.Tail = 0
+
+		if opts.Limit > 0 {
+			opts.Limit -= int64(len(msg.Payload))
+			if opts.Limit <= 0 {
+				return progress, true, nil
+			}
+		}
+	}
+}
+
+// LogSections returns the index of the sections of the log for the run.
+func (c *Client) LogSections(ctx context.Context, id int64) (*uisvc.LogSections, error) {
+	resp, err := c.client.GetLogSectionsId(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	ret := &uisvc.LogSections{}
+	return ret, json.NewDecoder(resp.Body).Decode(ret)
+}
+
+// LogSearch finds the lines matching the pattern in the logs of finished
+// runs. Empty values in the params select the defaults of the server: the
+// last day of logs in all repositories.
+func (c *Client) LogSearch(ctx context.Context, params uisvc.GetLogsSearchParams) (*uisvc.LogSearchResult, error) {
+	resp, err := c.client.GetLogsSearch(ctx, &params)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	ret := &uisvc.LogSearchResult{}
+	return ret, json.NewDecoder(resp.Body).Decode(ret)
+}
+
+// Artifacts lists the artifacts of the run.
+func (c *Client) Artifacts(ctx context.Context, runID int64) (uisvc.ArtifactList, error) {
+	resp, err := c.client.GetArtifactsRunId(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	ret := uisvc.ArtifactList{}
+	return ret, json.NewDecoder(resp.Body).Decode(&ret)
+}
+
+// DownloadArtifact writes the content of the run's artifact to w.
+func (c *Client) DownloadArtifact(ctx context.Context, runID int64, name string, w io.Writer) error {
+	resp, err := c.client.GetArtifactsRunIdName(ctx, runID, name)
 	if err != nil {
 		return err
 	}
+	defer resp.Body.Close()
 
-	_, err = io.Copy(w, jsonbuffer.NewReadWrapper(conn))
+	_, err = io.Copy(w, resp.Body)
 	return err
 }
 
+// TestCases lists the test cases of the run, optionally only those with the
+// status.
+func (c *Client) TestCases(ctx context.Context, runID int64, status string) (uisvc.TestCaseList, error) {
+	params := &uisvc.GetRunRunIdTestsParams{}
+	if status != "" {
+		s := uisvc.GetRunRunIdTestsParamsStatus(status)
+		params.Status = &s
+	}
+
+	resp, err := c.client.GetRunRunIdTests(ctx, runID, params)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	ret := uisvc.TestCaseList{}
+	return ret, json.NewDecoder(resp.Body).Decode(&ret)
+}
+
+// TestSummary counts the test cases of the run by status.
+func (c *Client) TestSummary(ctx context.Context, runID int64) (*uisvc.TestSummary, error) {
+	resp, err := c.client.GetRunRunIdTestsSummary(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	ret := &uisvc.TestSummary{}
+	return ret, json.NewDecoder(resp.Body).Decode(ret)
+}
+
+// FlakyTests lists the tests of the repository which both passed and failed
+// on the same SHA in the last number of days; 0 uses the server's default.
+func (c *Client) FlakyTests(ctx context.Context, owner, repo string, days int64) (uisvc.FlakyTestList, error) {
+	params := &uisvc.GetRepositoriesOwnerRepoFlakyParams{}
+	if days > 0 {
+		params.Days = &days
+	}
+
+	resp, err := c.client.GetRepositoriesOwnerRepoFlaky(ctx, owner, repo, params)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	ret := uisvc.FlakyTestList{}
+	return ret, json.NewDecoder(resp.Body).Decode(&ret)
+}
+
 // LoadRepositories loads your repos from github and returns the objects tinyci recorded.
 func (c *Client) LoadRepositories(ctx context.Context, search *string) ([]*uisvc.Repository, error) {
 	resp, err := c.client.GetRepositoriesScan(ctx)
@@ -344,6 +556,44 @@ func (c *Client) CancelRun(ctx context.Context, id int64) error {
 	return resp.Body.Close()
 }
 
+// RerunRun queues a new attempt of the finished run by id, returning it.
+func (c *Client) RerunRun(ctx context.Context, id int64) (*uisvc.Run, error) {
+	resp, err := c.client.PostRerunRunId(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	ret := &uisvc.Run{}
+	return ret, json.NewDecoder(resp.Body).Decode(ret)
+}
+
+// RerunTask copies the finished task into a new task and queues its runs
+// again, returning the new runs.
+func (c *Client) RerunTask(ctx context.Context, id int64) ([]*uisvc.Run, error) {
+	resp, err := c.client.PostTasksRerunId(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	ret := []*uisvc.Run{}
+	return ret, json.NewDecoder(resp.Body).Decode(&ret)
+}
+
+// RerunSubmission re-runs all tasks of the finished submission, returning the
+// new runs.
+func (c *Client) RerunSubmission(ctx context.Context, id int64) ([]*uisvc.Run, error) {
+	resp, err := c.client.PostSubmissionIdRerun(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	ret := []*uisvc.Run{}
+	return ret, json.NewDecoder(resp.Body).Decode(&ret)
+}
+
 // AddCapability adds a capability for a user. Must have the modify:user capability to interact.
 func (c *Client) AddCapability(ctx context.Context, username string, capability topTypes.Capability) error {
 	resp, err := c.client.PostCapabilitiesUsernameCapability(ctx, string(capability), username)
@@ -455,3 +705,44 @@ func (c *Client) RunsForSubmission(ctx context.Context, id int64, page, perPage
 	runs := []*uisvc.Run{}
 	return runs, json.NewDecoder(resp.Body).Decode(&runs)
 }
+
+// Runners lists the runners which have registered with the queue. Must have
+// the modify:ci capability to interact.
+func (c *Client) Runners(ctx context.Context) ([]*uisvc.Runner, error) {
+	resp, err := c.client.GetRunners(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	runners := []*uisvc.Runner{}
+	return runners, json.NewDecoder(resp.Body).Decode(&runners)
+}
+
+// CordonRunner stops the named runner from receiving new runs.
+func (c *Client) CordonRunner(ctx context.Context, name string) error {
+	resp, err := c.client.PostRunnersCordonName(ctx, name)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// DrainRunner cordons the named runner and asks it to exit once its current
+// run has finished.
+func (c *Client) DrainRunner(ctx context.Context, name string) error {
+	resp, err := c.client.PostRunnersDrainName(ctx, name)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// UncordonRunner returns the named runner to service.
+func (c *Client) UncordonRunner(ctx context.Context, name string) error {
+	resp, err := c.client.PostRunnersUncordonName(ctx, name)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
@@ -26,9 +26,12 @@ var servers = []*cmdlib.GRPCServer{
 	{
 		Name:           "assetsvc",
 		Description:    "Asset & Log management for tinyCI",
+		UseDB:          true,
 		DefaultService: config.DefaultServices.Asset,
 		RegisterService: func(s *grpc.Server, h *grpcHandler.H) error {
-			asset.RegisterAssetServer(s, &assetsvc.AssetServer{H: h})
+			as := &assetsvc.AssetServer{H: h}
+			asset.RegisterAssetServer(s, as)
+			h.Background(as.SweepLogs)
 			return nil
 		},
 	},
@@ -53,7 +56,9 @@ var servers = []*cmdlib.GRPCServer{
 				return err
 			}
 
-			data.RegisterDataServer(s, &datasvc.DataServer{H: h, C: protoconv.New(db)})
+			ds := &datasvc.DataServer{H: h, C: protoconv.New(db)}
+			data.RegisterDataServer(s, ds)
+			h.Background(ds.ReapRunners)
 			return nil
 		},
 	},
@@ -80,7 +85,9 @@ var servers = []*cmdlib.GRPCServer{
 		Description:    "Queue & Run management for tinyCI",
 		DefaultService: config.DefaultServices.Queue,
 		RegisterService: func(s *grpc.Server, h *grpcHandler.H) error {
-			queue.RegisterQueueServer(s, &queuesvc.QueueServer{H: h})
+			qs := &queuesvc.QueueServer{H: h}
+			queue.RegisterQueueServer(s, qs)
+			h.Background(qs.Supervise)
 			return nil
 		},
 	},
@@ -177,6 +177,10 @@ You can also specify the TINYCLI_CONFIG environment variable.
 					Name:  "all, a",
 					Usage: "For a test of all task dirs, not just diff-affected ones",
 				},
+				&cli.IntFlag{
+					Name:  "priority, p",
+					Usage: "Queue priority for the submitted runs, from -100 to 100; higher goes first. Defaults to the repository's priority; raising it requires the priority capability",
+				},
 			},
 		},
 		{
@@ -261,6 +265,149 @@ You can also specify the TINYCLI_CONFIG environment variable.
 			Usage:       "Show a log by Run ID",
 			ArgsUsage:   "[run id]",
 			Action:      log,
+			Flags: []cli.Flag{
+				&cli.Int64Flag{
+					Name:  "offset, o",
+					Usage: "The byte offset to start showing the log from",
+				},
+				&cli.Int64Flag{
+					Name:  "limit, l",
+					Usage: "The most bytes of the log to show",
+				},
+				&cli.Int64Flag{
+					Name:  "tail, t",
+					Usage: "Show only the last N lines of the log, and anything written after them",
+				},
+				&cli.StringFlag{
+					Name:  "section, s",
+					Usage: "Show only the named section of the log, as marked by ::group::<name> and ::endgroup:: lines",
+				},
+				&cli.BoolFlag{
+					Name:  "sections",
+					Usage: "List the sections of the log and their durations instead of showing it",
+				},
+			},
+		},
+		{
+			Name:        "logs",
+			Description: "Work with the logs of many runs",
+			Usage:       "Work with the logs of many runs",
+			Subcommands: []*cli.Command{
+				{
+					Name:        "search",
+					Aliases:     []string{"s"},
+					Description: "Search the logs of finished runs for lines matching a regular expression",
+					Usage:       "Search the logs of finished runs for lines matching a regular expression",
+					ArgsUsage:   "[pattern]",
+					Action:      searchLogs,
+					Flags: []cli.Flag{
+						&cli.StringFlag{
+							Name:  "repository, r",
+							Usage: "Repository name to search the logs of",
+						},
+						&cli.StringFlag{
+							Name:  "since, s",
+							Usage: "Search logs written after this time; an RFC3339 time or a duration ago such as 48h. Defaults to a day before --until",
+						},
+						&cli.StringFlag{
+							Name:  "until, u",
+							Usage: "Search logs written before this time; an RFC3339 time or a duration ago. Defaults to now",
+						},
+						&cli.Int64Flag{
+							Name:  "context, C",
+							Usage: "Show this many lines around each match",
+						},
+						&cli.Int64Flag{
+							Name:  "limit, l",
+							Usage: "The most matches to show",
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "artifacts",
+			Aliases:     []string{"a"},
+			Description: "List and download the artifacts of runs",
+			Usage:       "List and download the artifacts of runs",
+			Subcommands: []*cli.Command{
+				{
+					Name:        "list",
+					Aliases:     []string{"l"},
+					Description: "List the artifacts of a run",
+					Usage:       "List the artifacts of a run",
+					ArgsUsage:   "[run id]",
+					Action:      listArtifacts,
+				},
+				{
+					Name:        "get",
+					Aliases:     []string{"g"},
+					Description: "Download an artifact of a run",
+					Usage:       "Download an artifact of a run",
+					ArgsUsage:   "[run id] [name]",
+					Action:      getArtifact,
+					Flags: []cli.Flag{
+						&cli.StringFlag{
+							Name:  "output, o",
+							Usage: "File to write the artifact to; - writes it to stdout. Defaults to the artifact's name",
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "tests",
+			Description: "List the test cases of a run read from its test reports",
+			Usage:       "List the test cases of a run read from its test reports",
+			ArgsUsage:   "[run id]",
+			Action:      listTestCases,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "status, s",
+					Usage: "Only list the tests with this status: passed, failed, skipped or error",
+				},
+			},
+		},
+		{
+			Name:        "flaky",
+			Description: "List the tests of a repository which both passed and failed on the same SHA",
+			Usage:       "List the flaky tests of a repository",
+			ArgsUsage:   "[owner/repository]",
+			Action:      listFlakyTests,
+			Flags: []cli.Flag{
+				&cli.Int64Flag{
+					Name:  "days, d",
+					Usage: "The number of days to look for flaky tests in. Defaults to the server's window",
+				},
+			},
+		},
+		{
+			Name:        "rerun",
+			Description: "Re-run finished runs, tasks or submissions",
+			Usage:       "Re-run finished runs, tasks or submissions",
+			Subcommands: []*cli.Command{
+				{
+					Name:        "run",
+					Description: "Queue a new attempt of a run",
+					Usage:       "Queue a new attempt of a run",
+					ArgsUsage:   "[run id]",
+					Action:      rerun("run", rerunRun),
+				},
+				{
+					Name:        "task",
+					Description: "Re-run all runs of a task in a copy of it",
+					Usage:       "Re-run all runs of a task in a copy of it",
+					ArgsUsage:   "[task id]",
+					Action:      rerun("task", (*tinyci.Client).RerunTask),
+				},
+				{
+					Name:        "submission",
+					Description: "Re-run all tasks of a submission",
+					Usage:       "Re-run all tasks of a submission",
+					ArgsUsage:   "[submission id]",
+					Action:      rerun("submission", (*tinyci.Client).RerunSubmission),
+				},
+			},
 		},
 		{
 			Name:        "capabilities",
@@ -286,6 +433,36 @@ You can also specify the TINYCLI_CONFIG environment variable.
 				},
 			},
 		},
+		{
+			Name:        "runners",
+			Aliases:     []string{"rn"},
+			Description: "List and manage runners",
+			Usage:       "List and manage runners",
+			Action:      runners,
+			Subcommands: []*cli.Command{
+				{
+					Name:        "cordon",
+					Description: "Stop a runner from receiving new runs",
+					Usage:       "Stop a runner from receiving new runs",
+					ArgsUsage:   "[runner name]",
+					Action:      runnerAction((*tinyci.Client).CordonRunner),
+				},
+				{
+					Name:        "drain",
+					Description: "Cordon a runner and have it exit once its current run finishes",
+					Usage:       "Cordon a runner and have it exit once its current run finishes",
+					ArgsUsage:   "[runner name]",
+					Action:      runnerAction((*tinyci.Client).DrainRunner),
+				},
+				{
+					Name:        "uncordon",
+					Description: "Return a cordoned or draining runner to service",
+					Usage:       "Return a cordoned or draining runner to service",
+					ArgsUsage:   "[runner name]",
+					Action:      runnerAction((*tinyci.Client).UncordonRunner),
+				},
+			},
+		},
 	}
 
 	if err := app.Run(os.Args); err != nil {
@@ -396,9 +573,15 @@ func submit(ctx *cli.Context) error {
 		ctx.Args().Get(1),
 		ctx.Bool("all")
 
+	var priority *int32
+	if ctx.IsSet("priority") {
+		p := int32(ctx.Int("priority"))
+		priority = &p
+	}
+
 	fmt.Printf("Submitting %s / %s (all tasks: %v) -- this may take a few seconds to complete.\n", owner, repo, all)
 
-	if err := client.Submit(context.Background(), owner, repo, all); err != nil {
+	if err := client.Submit(context.Background(), owner, repo, all, priority); err != nil {
 		return err
 	}
 
@@ -614,6 +797,10 @@ func runs(ctx *cli.Context) error {
 		return err
 	}
 
+	return writeRuns(ctx, runs)
+}
+
+func writeRuns(ctx *cli.Context, runs []*uisvc.Run) error {
 	w := stdTabWriter(ctx)
 	if _, err := w.Write([]byte(getHeaderColorFunc()("RUN ID\tREPOSITORY\tREF\tSHA\tRUN\tTASK ID\tSTATE\tDURATION\n"))); err != nil {
 		return err
@@ -625,6 +812,10 @@ func runs(ctx *cli.Context) error {
 		} else if run.Status != nil {
 			if *run.Status {
 				statusStr = "success"
+			} else if run.FailureReason != nil && *run.FailureReason == topTypes.FailureReasonTimeout {
+				statusStr = "timed out"
+			} else if run.FailureReason != nil && *run.FailureReason == topTypes.FailureReasonExpired {
+				statusStr = "expired"
 			} else {
 				statusStr = "failure"
 			}
@@ -642,7 +833,12 @@ func runs(ctx *cli.Context) error {
 		refName := *run.Task.Submission.HeadRef.RefName
 		sha := (*run.Task.Submission.HeadRef.Sha)[:12]
 
-		if _, err := w.Write([]byte(getRowColorFunc(i)(fmt.Sprintf("%d\t%s\t%s\t%s\t%s\t%d\t%s\t%s\n", *run.Id, *run.Task.Submission.HeadRef.Repository.Name, refName, sha, *run.Name, *run.Task.Id, statusStr, duration)))); err != nil {
+		name := *run.Name
+		if run.Attempt != nil && *run.Attempt > 1 {
+			name = fmt.Sprintf("%s (attempt %d)", name, *run.Attempt)
+		}
+
+		if _, err := w.Write([]byte(getRowColorFunc(i)(fmt.Sprintf("%d\t%s\t%s\t%s\t%s\t%d\t%s\t%s\n", *run.Id, *run.Task.Submission.HeadRef.Repository.Name, refName, sha, name, *run.Task.Id, statusStr, duration)))); err != nil {
 			return err
 		}
 	}
@@ -666,7 +862,374 @@ func log(ctx *cli.Context) error {
 		return utils.WrapError(convErr, "Invalid ID")
 	}
 
-	return client.LogAttach(context.Background(), id, os.Stdout)
+	opts := tinyci.LogOptions{
+		Offset: ctx.Int64("offset"),
+		Limit:  ctx.Int64("limit"),
+		Tail:   ctx.Int64("tail"),
+	}
+
+	if ctx.Bool("sections") {
+		return logSections(ctx, client, id)
+	}
+
+	if name := ctx.String("section"); name != "" {
+		if opts != (tinyci.LogOptions{}) {
+			return errors.New("--section cannot be combined with --offset, --limit or --tail")
+		}
+
+		section, err := findLogSection(client, id, name)
+		if err != nil {
+			return err
+		}
+
+		opts.Offset = int64Deref(section.Offset)
+		// sections still being written are followed to the end of the log.
+		if section.FinishedAt != nil {
+			opts.Limit = int64Deref(section.Length)
+			if opts.Limit == 0 {
+				return nil
+			}
+		}
+	}
+
+	return client.LogAttachRange(context.Background(), id, opts, os.Stdout)
+}
+
+func stringDeref(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+func int64Deref(i *int64) int64 {
+	if i == nil {
+		return 0
+	}
+
+	return *i
+}
+
+// findLogSection finds the first section of the log with the name.
+func findLogSection(client *tinyci.Client, id int64, name string) (*uisvc.LogSection, error) {
+	res, err := client.LogSections(context.Background(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+
+	if res.Sections != nil {
+		for i, section := range *res.Sections {
+			if section.Name == nil {
+				continue
+			}
+
+			if *section.Name == name {
+				return &(*res.Sections)[i], nil
+			}
+
+			names = append(names, *section.Name)
+		}
+	}
+
+	if len(names) == 0 {
+		return nil, fmt.Errorf("Log %d has no sections", id)
+	}
+
+	return nil, fmt.Errorf("Log %d has no section %q; its sections are: %s", id, name, strings.Join(names, ", "))
+}
+
+func logSections(ctx *cli.Context, client *tinyci.Client, id int64) error {
+	res, err := client.LogSections(context.Background(), id)
+	if err != nil {
+		return err
+	}
+
+	w := stdTabWriter(ctx)
+	if _, err := w.Write([]byte(getHeaderColorFunc()("SECTION\tLINE\tLINES\tDURATION\n"))); err != nil {
+		return err
+	}
+
+	if res.Sections != nil {
+		for _, section := range *res.Sections {
+			duration := "running"
+			if section.Duration != nil {
+				duration = time.Duration(*section.Duration * float64(time.Second)).Round(time.Millisecond).String()
+			}
+
+			name := ""
+			if section.Name != nil {
+				name = *section.Name
+			}
+
+			if _, err := fmt.Fprintf(w, "%s\t%d\t%d\t%s\n", name, int64Deref(section.Line), int64Deref(section.Lines), duration); err != nil {
+				return err
+			}
+		}
+	}
+
+	return w.Flush()
+}
+
+func listArtifacts(ctx *cli.Context) error {
+	if ctx.Args().Len() != 1 {
+		return errors.New("Invalid arguments: [run id] required")
+	}
+
+	client, err := loadConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	id, convErr := strconv.ParseInt(ctx.Args().First(), 10, 64)
+	if convErr != nil {
+		return utils.WrapError(convErr, "Invalid ID")
+	}
+
+	artifacts, err := client.Artifacts(context.Background(), id)
+	if err != nil {
+		return err
+	}
+
+	w := stdTabWriter(ctx)
+	if _, err := w.Write([]byte(getHeaderColorFunc()("NAME\tSIZE\tTYPE\tSHA256\n"))); err != nil {
+		return err
+	}
+
+	for _, artifact := range artifacts {
+		if _, err := fmt.Fprintf(w, "%s\t%d\t%s\t%s\n", stringDeref(artifact.Name), int64Deref(artifact.Size), stringDeref(artifact.ContentType), stringDeref(artifact.Sha256)); err != nil {
+			return err
+		}
+	}
+
+	return w.Flush()
+}
+
+func listTestCases(ctx *cli.Context) error {
+	if ctx.Args().Len() != 1 {
+		return errors.New("Invalid arguments: [run id] required")
+	}
+
+	client, err := loadConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	id, convErr := strconv.ParseInt(ctx.Args().First(), 10, 64)
+	if convErr != nil {
+		return utils.WrapError(convErr, "Invalid ID")
+	}
+
+	summary, err := client.TestSummary(context.Background(), id)
+	if err != nil {
+		return err
+	}
+
+	cases, err := client.TestCases(context.Background(), id, ctx.String("status"))
+	if err != nil {
+		return err
+	}
+
+	w := stdTabWriter(ctx)
+	if _, err := w.Write([]byte(getHeaderColorFunc()("SUITE\tNAME\tSTATUS\tDURATION\n"))); err != nil {
+		return err
+	}
+
+	for _, tc := range cases {
+		var status string
+		if tc.Status != nil {
+			status = string(*tc.Status)
+		}
+
+		var duration float64
+		if tc.Duration != nil {
+			duration = *tc.Duration
+		}
+
+		if _, err := fmt.Fprintf(w, "%s\t%s\t%s\t%v\n", stringDeref(tc.Suite), stringDeref(tc.Name), status, time.Duration(duration*float64(time.Second))); err != nil {
+			return err
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Printf(
+		"\n%d tests: %d passed, %d failed, %d errored, %d skipped\n",
+		int64Deref(summary.Total), int64Deref(summary.Passed), int64Deref(summary.Failed), int64Deref(summary.Errored), int64Deref(summary.Skipped),
+	)
+
+	return nil
+}
+
+func listFlakyTests(ctx *cli.Context) error {
+	if ctx.Args().Len() != 1 {
+		return errors.New("Invalid arguments: [owner/repository] required")
+	}
+
+	parts := strings.SplitN(ctx.Args().First(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("Invalid repository %q: must be owner/repository", ctx.Args().First())
+	}
+
+	client, err := loadConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	tests, err := client.FlakyTests(context.Background(), parts[0], parts[1], ctx.Int64("days"))
+	if err != nil {
+		return err
+	}
+
+	w := stdTabWriter(ctx)
+	if _, err := w.Write([]byte(getHeaderColorFunc()("SCORE\tFLIPPED\tFAILURES\tSUITE\tNAME\n"))); err != nil {
+		return err
+	}
+
+	for _, test := range tests {
+		var score float64
+		if test.Score != nil {
+			score = *test.Score
+		}
+
+		if _, err := fmt.Fprintf(w, "%.2f\t%d/%d\t%d/%d\t%s\t%s\n", score, int64Deref(test.Flipped), int64Deref(test.Shas), int64Deref(test.Failures), int64Deref(test.Executions), stringDeref(test.Suite), stringDeref(test.Name)); err != nil {
+			return err
+		}
+	}
+
+	return w.Flush()
+}
+
+func getArtifact(ctx *cli.Context) error {
+	if ctx.Args().Len() != 2 {
+		return errors.New("Invalid arguments: [run id] [name] required")
+	}
+
+	client, err := loadConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	id, convErr := strconv.ParseInt(ctx.Args().First(), 10, 64)
+	if convErr != nil {
+		return utils.WrapError(convErr, "Invalid ID")
+	}
+
+	name := ctx.Args().Get(1)
+
+	output := ctx.String("output")
+	if output == "" {
+		output = name
+	}
+
+	if output == "-" {
+		return client.DownloadArtifact(context.Background(), id, name, os.Stdout)
+	}
+
+	f, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+
+	if err := client.DownloadArtifact(context.Background(), id, name, f); err != nil {
+		f.Close()
+		os.Remove(output)
+		return err
+	}
+
+	return f.Close()
+}
+
+// parseSearchTime parses either an RFC3339 time or a duration before now.
+func parseSearchTime(param string) (*time.Time, error) {
+	if param == "" {
+		return nil, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, param); err == nil {
+		return &t, nil
+	}
+
+	dur, err := time.ParseDuration(param)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid time %q: must be an RFC3339 time or a duration", param)
+	}
+
+	t := time.Now().Add(-dur)
+	return &t, nil
+}
+
+func searchLogs(ctx *cli.Context) error {
+	if ctx.Args().Len() != 1 {
+		return errors.New("Invalid arguments: [pattern] required")
+	}
+
+	client, err := loadConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	params := uisvc.GetLogsSearchParams{Pattern: ctx.Args().First()}
+
+	if repo := ctx.String("repository"); repo != "" {
+		params.Repository = &repo
+	}
+
+	if params.Since, err = parseSearchTime(ctx.String("since")); err != nil {
+		return err
+	}
+
+	if params.Until, err = parseSearchTime(ctx.String("until")); err != nil {
+		return err
+	}
+
+	if ctx.IsSet("context") {
+		params.Context = int64p(ctx.Int64("context"))
+	}
+
+	if ctx.IsSet("limit") {
+		params.Limit = int64p(ctx.Int64("limit"))
+	}
+
+	res, err := client.LogSearch(context.Background(), params)
+	if err != nil {
+		return err
+	}
+
+	if res.Matches == nil {
+		return nil
+	}
+
+	// grep-style: matches are "run:line:text", their context "run-line-text".
+	for i, m := range *res.Matches {
+		if i > 0 && (m.Before != nil && len(*m.Before) > 0 || m.After != nil && len(*m.After) > 0) {
+			fmt.Println("--")
+		}
+
+		if m.Before != nil {
+			for j, line := range *m.Before {
+				fmt.Printf("%d-%d-%s\n", *m.RunId, *m.Line-int64(len(*m.Before)-j), line)
+			}
+		}
+
+		fmt.Printf("%d:%d:%s\n", *m.RunId, *m.Line, *m.Text)
+
+		if m.After != nil {
+			for j, line := range *m.After {
+				fmt.Printf("%d-%d-%s\n", *m.RunId, *m.Line+int64(j+1), line)
+			}
+		}
+	}
+
+	if res.Truncated != nil && *res.Truncated {
+		fmt.Fprintf(os.Stderr, "Stopped after %d matches; narrow the search or raise --limit to see more\n", len(*res.Matches))
+	}
+
+	return nil
 }
 
 func addCapability(ctx *cli.Context) error {
@@ -704,3 +1267,89 @@ func removeCapability(ctx *cli.Context) error {
 
 	return nil
 }
+
+func runners(ctx *cli.Context) error {
+	client, err := loadConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	runners, err := client.Runners(context.Background())
+	if err != nil {
+		return err
+	}
+
+	w := stdTabWriter(ctx)
+	if _, err := w.Write([]byte(getHeaderColorFunc()("NAME\tQUEUE\tLABELS\tSTATE\tLAST HEARTBEAT\n"))); err != nil {
+		return err
+	}
+	for i, runner := range runners {
+		state := "ready"
+		if *runner.Draining {
+			state = "draining"
+		} else if *runner.Cordoned {
+			state = "cordoned"
+		}
+
+		heartbeat := ""
+		if runner.LastHeartbeat != nil {
+			heartbeat = time.Since(*runner.LastHeartbeat).Round(time.Second).String() + " ago"
+		}
+
+		if _, err := w.Write([]byte(getRowColorFunc(i)(fmt.Sprintf("%s\t%s\t%s\t%s\t%s\n", *runner.Name, *runner.QueueName, strings.Join(*runner.Labels, ","), state, heartbeat)))); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+
+	return nil
+}
+
+func rerun(kind string, fun func(*tinyci.Client, context.Context, int64) ([]*uisvc.Run, error)) cli.ActionFunc {
+	return func(ctx *cli.Context) error {
+		if ctx.Args().Len() != 1 {
+			return fmt.Errorf("Invalid arguments: [%s id] required", kind)
+		}
+
+		client, err := loadConfig(ctx)
+		if err != nil {
+			return err
+		}
+
+		id, convErr := strconv.ParseInt(ctx.Args().First(), 10, 64)
+		if convErr != nil {
+			return utils.WrapError(convErr, "Invalid ID")
+		}
+
+		runs, err := fun(client, context.Background(), id)
+		if err != nil {
+			return err
+		}
+
+		return writeRuns(ctx, runs)
+	}
+}
+
+func rerunRun(client *tinyci.Client, ctx context.Context, id int64) ([]*uisvc.Run, error) {
+	run, err := client.RerunRun(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*uisvc.Run{run}, nil
+}
+
+func runnerAction(fun func(*tinyci.Client, context.Context, string) error) cli.ActionFunc {
+	return func(ctx *cli.Context) error {
+		if ctx.Args().Len() != 1 {
+			return errors.New("Invalid arguments: [runner name] required")
+		}
+
+		client, err := loadConfig(ctx)
+		if err != nil {
+			return err
+		}
+
+		return fun(client, context.Background(), ctx.Args().First())
+	}
+}
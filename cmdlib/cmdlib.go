@@ -68,6 +68,10 @@ func (s *GRPCServer) MakeHandlerFunc(configFile string) (HandlerFunc, error) {
 		return nil, err
 	}
 
+	if err := h.GithubApp.Validate(); err != nil {
+		return nil, err
+	}
+
 	cert, certErr := h.TLS.Load()
 	if certErr != nil {
 		return nil, certErr
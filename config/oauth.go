@@ -1,6 +1,8 @@
 package config
 
 import (
+	"context"
+	"io/ioutil"
 	"net/url"
 	"strings"
 	"time"
@@ -92,6 +94,70 @@ func (oc OAuthConfig) GithubClient(username string, token []byte) (github.Client
 	return github.NewClientFromAccessToken(t.Token), nil
 }
 
+// GithubAppConfig configures the GitHub App tinyCI reports check runs as, for
+// repositories using the Checks API (see types.StatusAPIChecks); github only
+// lets apps write check runs. The app must be installed on those repositories
+// with the checks permission, and deliver its check_run events to the hooksvc,
+// which verifies them with the app's webhook secret.
+type GithubAppConfig struct {
+	ID             int64  `yaml:"id"`
+	PrivateKeyPath string `yaml:"private_key_path"`
+	WebhookSecret  string `yaml:"webhook_secret"`
+}
+
+// Validate validates the github app configuration; it is optional.
+func (gc *GithubAppConfig) Validate() error {
+	if (gc.ID == 0) != (strings.TrimSpace(gc.PrivateKeyPath) == "") {
+		return errors.New("github_app needs both an id and a private_key_path")
+	}
+
+	return nil
+}
+
+// GithubClient returns a client acting as the app's installation on the
+// repository, which can report check runs.
+func (gc GithubAppConfig) GithubClient(ctx context.Context, owner, repo string) (github.Client, error) {
+	client := DefaultGithubClient("")
+	if client != nil {
+		return client, nil
+	}
+
+	if gc.ID == 0 {
+		return nil, errors.New("no github_app is configured; repositories using the checks status_api need one")
+	}
+
+	app, err := gc.app()
+	if err != nil {
+		return nil, err
+	}
+
+	return app.Client(ctx, owner, repo)
+}
+
+// app returns the app, loading its key the first time, so the app's tokens
+// are shared by all copies of the configuration.
+func (gc GithubAppConfig) app() (*github.App, error) {
+	githubClientMutex.Lock()
+	defer githubClientMutex.Unlock()
+
+	if app, ok := githubApps[gc]; ok {
+		return app, nil
+	}
+
+	key, err := ioutil.ReadFile(gc.PrivateKeyPath)
+	if err != nil {
+		return nil, utils.WrapError(err, "reading the github app's private key")
+	}
+
+	app, err := github.NewApp(gc.ID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	githubApps[gc] = app
+	return app, nil
+}
+
 // Config returns the oauth configuration if one was provided.
 func (oc OAuthConfig) Config(scopes []string) *oauth2.Config {
 	return &oauth2.Config{
@@ -18,6 +18,7 @@ import (
 var (
 	// DefaultGithubClient if set, will override any requested github client.
 	defaultGithubClientMap = map[string]github.Client{}
+	githubApps             = map[GithubAppConfig]*github.App{}
 	githubClientMutex      = sync.RWMutex{}
 )
 
@@ -40,19 +41,20 @@ type UserConfig struct {
 	ServiceConfig ServiceConfig `yaml:"services"`
 	ClientConfig  ClientConfig  `yaml:"clients"`
 
-	LogLevel       string      `yaml:"log_level"`
-	OAuth          OAuthConfig `yaml:"oauth"`
-	Auth           AuthConfig  `yaml:"auth"`
-	HookURL        string      `yaml:"hook_url"`
-	DSN            string      `yaml:"db"`
-	TLS            CertConfig  `yaml:"tls"`
-	Websockets     Websockets  `yaml:"websockets"`
-	RequestLogging bool        `yaml:"log_requests"`
-	Port           uint        `yaml:"port"`
-	URL            string      `yaml:"url"`
-	EnableTracing  bool        `yaml:"enable_tracing"`
-	ReadonlyClient bool        `yaml:"readonly_client"`
-	CORSOrigins    []string    `yaml:"cors_origins"`
+	LogLevel       string          `yaml:"log_level"`
+	OAuth          OAuthConfig     `yaml:"oauth"`
+	GithubApp      GithubAppConfig `yaml:"github_app"`
+	Auth           AuthConfig      `yaml:"auth"`
+	HookURL        string          `yaml:"hook_url"`
+	DSN            string          `yaml:"db"`
+	TLS            CertConfig      `yaml:"tls"`
+	Websockets     Websockets      `yaml:"websockets"`
+	RequestLogging bool            `yaml:"log_requests"`
+	Port           uint            `yaml:"port"`
+	URL            string          `yaml:"url"`
+	EnableTracing  bool            `yaml:"enable_tracing"`
+	ReadonlyClient bool            `yaml:"readonly_client"`
+	CORSOrigins    []string        `yaml:"cors_origins"`
 }
 
 // Service is the internal configuration for a service
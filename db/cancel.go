@@ -3,10 +3,13 @@ package db
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 
 	"github.com/google/go-github/github"
 	"github.com/tinyci/ci-agents/db/models"
+	topTypes "github.com/tinyci/ci-agents/types"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
 )
 
 // CancelRefByName is used in auto cancellation on new queue item arrivals. It finds
@@ -63,3 +66,87 @@ func (m *Model) CancelRun(ctx context.Context, runID int64) error {
 
 	return m.CancelTask(ctx, task.ID)
 }
+
+// WatchCancel returns a channel which receives a value when the task, or one
+// of its runs, is canceled or the task finishes, and a function to stop
+// watching. A value is also sent once the watch is in place, and whenever
+// notifications may have been missed, so the caller should check the canceled
+// flags on each.
+func (m *Model) WatchCancel(taskID int64) (<-chan struct{}, func()) {
+	return m.cancelWatcher.subscribe(strconv.FormatInt(taskID, 10))
+}
+
+// CancelConcurrentTasks is used when new tasks are queued. It cancels the
+// unfinished tasks older than the task given, in the same repository, which
+// belong to a concurrency group that the task, or one of its runs, sets
+// cancel_in_progress for. A task belongs to a group if its own concurrency
+// group matches, or that of any of its runs for run-level groups. The IDs of
+// the canceled tasks are returned.
+func (m *Model) CancelConcurrentTasks(ctx context.Context, taskID int64) ([]int64, error) {
+	task, err := models.FindTask(ctx, m.db, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	ts := &topTypes.TaskSettings{}
+	if err := task.TaskSettings.Unmarshal(ts); err != nil {
+		return nil, err
+	}
+
+	taskGroups := []string{}
+	if ts.Concurrency != nil && ts.Concurrency.CancelInProgress {
+		taskGroups = append(taskGroups, ts.Concurrency.Group)
+	}
+
+	runs, err := task.Runs().All(ctx, m.db)
+	if err != nil {
+		return nil, err
+	}
+
+	runGroups := []string{}
+	for _, run := range runs {
+		rs := &topTypes.RunSettings{}
+		if err := json.Unmarshal(run.RunSettings, rs); err != nil {
+			return nil, err
+		}
+
+		if rs.Concurrency != nil && rs.Concurrency.CancelInProgress {
+			runGroups = append(runGroups, rs.Concurrency.Group)
+		}
+	}
+
+	if len(taskGroups) == 0 && len(runGroups) == 0 {
+		return nil, nil
+	}
+
+	tasks, err := models.Tasks(
+		qm.InnerJoin("submissions on submissions.id = tasks.submission_id"),
+		qm.InnerJoin("refs on refs.id = submissions.base_ref_id"),
+		qm.Where(`refs.repository_id = (
+			select base.repository_id from submissions sub
+			inner join refs base on base.id = sub.base_ref_id
+			where sub.id = ?
+		)`, task.SubmissionID),
+		qm.Where("tasks.id < ? and tasks.finished_at is null", task.ID),
+		qm.Where(`tasks.task_settings->'concurrency'->>'group' = any(?::text[]) or exists (
+			select 1 from runs members
+			where members.task_id = tasks.id and members.run_settings->'concurrency'->>'group' = any(?::text[])
+		)`, types.StringArray(taskGroups), types.StringArray(runGroups)),
+		qm.OrderBy("tasks.id"),
+	).All(ctx, m.db)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []int64{}
+
+	for _, t := range tasks {
+		if err := m.CancelTask(ctx, t.ID); err != nil {
+			return ids, err
+		}
+
+		ids = append(ids, t.ID)
+	}
+
+	return ids, nil
+}
@@ -2,8 +2,12 @@ package db
 
 import (
 	"testing"
+	"time"
 
+	"github.com/tinyci/ci-agents/db/models"
+	"github.com/tinyci/ci-agents/types"
 	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
 )
 
 func TestCancellationByRef(t *testing.T) {
@@ -93,3 +97,94 @@ func TestCancellationByRun(t *testing.T) {
 		}
 	}
 }
+
+func TestCancellationByConcurrencyGroup(t *testing.T) {
+	m := testInit(t)
+
+	base, err := m.CreateTestRun(ctx)
+	assert.NilError(t, err)
+
+	baseTask, err := models.FindTask(ctx, m.db, base.TaskID)
+	assert.NilError(t, err)
+
+	subID := baseTask.SubmissionID
+
+	older, _, err := m.CreateTestConcurrentTask(ctx, subID, &types.Concurrency{Group: "deploy"}, &types.RunSettings{Name: "deploy"})
+	assert.NilError(t, err)
+
+	unrelated, _, err := m.CreateTestConcurrentTask(ctx, subID, &types.Concurrency{Group: "other"}, &types.RunSettings{Name: "deploy"})
+	assert.NilError(t, err)
+
+	olderRun, _, err := m.CreateTestConcurrentTask(ctx, subID, nil, &types.RunSettings{Name: "migrate", Concurrency: &types.Concurrency{Group: "database"}})
+	assert.NilError(t, err)
+
+	newer, _, err := m.CreateTestConcurrentTask(ctx, subID, &types.Concurrency{Group: "deploy", CancelInProgress: true},
+		&types.RunSettings{Name: "migrate", Concurrency: &types.Concurrency{Group: "database", CancelInProgress: true}},
+	)
+	assert.NilError(t, err)
+
+	// tasks which do not cancel in progress leave the others alone.
+	ids, err := m.CancelConcurrentTasks(ctx, unrelated.ID)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(ids, 0))
+
+	ids, err = m.CancelConcurrentTasks(ctx, newer.ID)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.DeepEqual(ids, []int64{older.ID, olderRun.ID}))
+
+	for id, canceled := range map[int64]bool{older.ID: true, olderRun.ID: true, unrelated.ID: false, newer.ID: false, baseTask.ID: false} {
+		task, err := models.FindTask(ctx, m.db, id)
+		assert.NilError(t, err)
+		assert.Assert(t, cmp.Equal(task.Canceled, canceled))
+	}
+}
+
+func TestWatchCancel(t *testing.T) {
+	m := testInit(t)
+
+	woken := func(wake <-chan struct{}, timeout time.Duration) bool {
+		select {
+		case <-wake:
+			return true
+		case <-time.After(timeout):
+			return false
+		}
+	}
+
+	run, err := m.CreateTestRun(ctx)
+	assert.NilError(t, err)
+
+	other, err := m.CreateTestRun(ctx)
+	assert.NilError(t, err)
+
+	wake, cancel := m.WatchCancel(run.TaskID)
+	defer cancel()
+
+	otherWake, cancelOther := m.WatchCancel(other.TaskID)
+	defer cancelOther()
+
+	// both are woken once the watch is in place
+	assert.Assert(t, woken(wake, 10*time.Second))
+	assert.Assert(t, woken(otherWake, 10*time.Second))
+
+	assert.NilError(t, m.CancelRun(ctx, run.ID))
+	assert.Assert(t, woken(wake, 10*time.Second))
+	assert.Assert(t, !woken(otherWake, 500*time.Millisecond))
+
+	task, err := models.FindTask(ctx, m.db, run.TaskID)
+	assert.NilError(t, err)
+	assert.Assert(t, task.Canceled)
+
+	// canceling it again does not notify, as it was already canceled
+	cancel()
+	wake, cancel = m.WatchCancel(run.TaskID)
+	defer cancel()
+
+	assert.Assert(t, woken(wake, 10*time.Second))
+	assert.Assert(t, m.CancelRun(ctx, run.ID) != nil)
+	assert.Assert(t, !woken(wake, 500*time.Millisecond))
+
+	// tasks finishing otherwise wake their watchers too, so they can stop.
+	assert.NilError(t, m.SetRunStatus(ctx, other.ID, true, ""))
+	assert.Assert(t, woken(otherWake, 10*time.Second))
+}
@@ -20,8 +20,12 @@ func NewConn(dsn string) (*sql.DB, error) {
 
 // Model is the handle into the DB subsystem.
 type Model struct {
-	db     *sql.DB
-	config *config.UserConfig
+	db             *sql.DB
+	config         *config.UserConfig
+	queuePolicy    string
+	queueWatcher   *watcher
+	cancelWatcher  *watcher
+	liveLogWatcher *watcher
 }
 
 // Open opens a handle into the database, exposing its functionality.
@@ -41,7 +45,14 @@ func Open(conf *config.UserConfig) (*Model, error) {
 
 	registerHooks()
 
-	return &Model{db: sqlDB, config: conf}, nil
+	return &Model{
+		db:             sqlDB,
+		config:         conf,
+		queuePolicy:    QueuePolicyFIFO,
+		queueWatcher:   newWatcher(conf.DSN, queueChannel),
+		cancelWatcher:  newWatcher(conf.DSN, cancelChannel),
+		liveLogWatcher: newWatcher(conf.DSN, liveLogChannel),
+	}, nil
 }
 
 // GetDB is used to bridge some gaps, mostly by the protoconv lib
@@ -10,7 +10,7 @@ import (
 
 var ctx = context.Background() // save typing
 
-func testInit(t *testing.T) *Model {
+func testInit(t testing.TB) *Model {
 	t.Helper()
 
 	if err := testutil.WipeDB(); err != nil {
@@ -0,0 +1,103 @@
+package db
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/tinyci/ci-agents/db/models"
+	"github.com/tinyci/ci-agents/utils"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// StartLiveLog records that the run's log is being written, so that readers on
+// any service sharing the database can tail it. utils.ErrLogWriting is returned
+// if it already is.
+func (m *Model) StartLiveLog(ctx context.Context, runID int64) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	exists, err := models.LiveLogExists(ctx, tx, runID)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return utils.ErrLogWriting
+	}
+
+	ll := &models.LiveLog{RunID: runID}
+	if err := ll.Insert(ctx, tx, boil.Infer()); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// AppendLiveLog adds a chunk, written at the offset, to the run's live log.
+func (m *Model) AppendLiveLog(ctx context.Context, runID, offset int64, chunk []byte) error {
+	llc := &models.LiveLogChunk{RunID: runID, StartOffset: offset, Chunk: chunk}
+	return llc.Insert(ctx, m.db, boil.Infer())
+}
+
+// ReadLiveLog returns the content of the run's live log after the offset, and
+// whether the log is still being written.
+func (m *Model) ReadLiveLog(ctx context.Context, runID, offset int64) ([]byte, bool, error) {
+	exists, err := models.LiveLogExists(ctx, m.db, runID)
+	if err != nil || !exists {
+		return nil, false, err
+	}
+
+	chunks, err := models.LiveLogChunks(
+		models.LiveLogChunkWhere.RunID.EQ(runID),
+		qm.Where("start_offset + length(chunk) > ?", offset),
+		qm.OrderBy("start_offset"),
+	).All(ctx, m.db)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var content []byte
+
+	for _, chunk := range chunks {
+		if chunk.StartOffset < offset {
+			chunk.Chunk = chunk.Chunk[offset-chunk.StartOffset:]
+		}
+
+		content = append(content, chunk.Chunk...)
+	}
+
+	return content, true, nil
+}
+
+// FinishLiveLog removes the run's live log, along with its chunks.
+func (m *Model) FinishLiveLog(ctx context.Context, runID int64) error {
+	_, err := models.LiveLogs(models.LiveLogWhere.RunID.EQ(runID)).DeleteAll(ctx, m.db)
+	return err
+}
+
+// LiveLogIDs returns the IDs of the runs whose logs are being written.
+func (m *Model) LiveLogIDs(ctx context.Context) ([]int64, error) {
+	lls, err := models.LiveLogs(qm.Select(models.LiveLogColumns.RunID)).All(ctx, m.db)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, len(lls))
+	for i, ll := range lls {
+		ids[i] = ll.RunID
+	}
+
+	return ids, nil
+}
+
+// WatchLiveLog returns a channel which receives a value whenever a chunk is
+// added to the run's live log or it is finished, and a function to stop
+// watching. A value is also sent once the watch is in place, and whenever
+// notifications may have been missed.
+func (m *Model) WatchLiveLog(runID int64) (<-chan struct{}, func()) {
+	return m.liveLogWatcher.subscribe(strconv.FormatInt(runID, 10))
+}
@@ -0,0 +1,76 @@
+package db
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tinyci/ci-agents/utils"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+)
+
+func TestLiveLogs(t *testing.T) {
+	m := testInit(t)
+
+	woken := func(wake <-chan struct{}) bool {
+		select {
+		case <-wake:
+			return true
+		case <-time.After(10 * time.Second):
+			return false
+		}
+	}
+
+	_, live, err := m.ReadLiveLog(ctx, 1, 0)
+	assert.NilError(t, err)
+	assert.Assert(t, !live)
+
+	assert.NilError(t, m.StartLiveLog(ctx, 1))
+	assert.Assert(t, errors.Is(m.StartLiveLog(ctx, 1), utils.ErrLogWriting))
+
+	wake, cancel := m.WatchLiveLog(1)
+	defer cancel()
+	assert.Assert(t, woken(wake))
+
+	assert.NilError(t, m.AppendLiveLog(ctx, 1, 0, []byte("hello ")))
+	assert.Assert(t, woken(wake))
+	assert.NilError(t, m.AppendLiveLog(ctx, 1, 6, []byte("world")))
+
+	buf, live, err := m.ReadLiveLog(ctx, 1, 0)
+	assert.NilError(t, err)
+	assert.Assert(t, live)
+	assert.Assert(t, cmp.Equal(string(buf), "hello world"))
+
+	buf, live, err = m.ReadLiveLog(ctx, 1, 8)
+	assert.NilError(t, err)
+	assert.Assert(t, live)
+	assert.Assert(t, cmp.Equal(string(buf), "rld"))
+
+	buf, _, err = m.ReadLiveLog(ctx, 1, 11)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(buf, 0))
+
+	assert.NilError(t, m.StartLiveLog(ctx, 2))
+
+	ids, err := m.LiveLogIDs(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.DeepEqual(ids, []int64{1, 2}))
+
+	// drain the wakeup of the second chunk, so the one for finishing is seen.
+	select {
+	case <-wake:
+	case <-time.After(500 * time.Millisecond):
+	}
+
+	assert.NilError(t, m.FinishLiveLog(ctx, 1))
+	assert.Assert(t, woken(wake))
+
+	_, live, err = m.ReadLiveLog(ctx, 1, 0)
+	assert.NilError(t, err)
+	assert.Assert(t, !live)
+
+	ids, err = m.LiveLogIDs(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.DeepEqual(ids, []int64{2}))
+}
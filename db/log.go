@@ -0,0 +1,138 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/tinyci/ci-agents/db/models"
+	"github.com/tinyci/ci-agents/utils"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// CreateLogObject creates an empty postgres large object to hold the log for
+// the run, returning its object ID. utils.ErrLogExists is returned if the run
+// already has one.
+func (m *Model) CreateLogObject(ctx context.Context, runID int64) (int64, error) {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	exists, err := models.LogObjectExists(ctx, tx, runID)
+	if err != nil {
+		return 0, err
+	}
+
+	if exists {
+		return 0, utils.ErrLogExists
+	}
+
+	var oid int64
+	if err := tx.QueryRowContext(ctx, "select lo_create(0)").Scan(&oid); err != nil {
+		return 0, err
+	}
+
+	lo := &models.LogObject{RunID: runID, ObjectID: oid}
+	if err := lo.Insert(ctx, tx, boil.Infer()); err != nil {
+		return 0, err
+	}
+
+	return oid, tx.Commit()
+}
+
+// GetLogObject returns the ID of the large object holding the run's log.
+func (m *Model) GetLogObject(ctx context.Context, runID int64) (int64, error) {
+	lo, err := models.FindLogObject(ctx, m.db, runID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, utils.ErrNotFound
+	} else if err != nil {
+		return 0, err
+	}
+
+	return lo.ObjectID, nil
+}
+
+// WriteLogObject writes buf into the large object at the offset.
+func (m *Model) WriteLogObject(ctx context.Context, oid, offset int64, buf []byte) error {
+	_, err := m.db.ExecContext(ctx, "select lo_put($1::oid, $2, $3)", oid, offset, buf)
+	return err
+}
+
+// ReadLogObject reads up to size bytes from the large object at the offset.
+// An empty result means the end of the object was reached.
+func (m *Model) ReadLogObject(ctx context.Context, oid, offset int64, size int) ([]byte, error) {
+	var buf []byte
+	err := m.db.QueryRowContext(ctx, "select lo_get($1::oid, $2, $3)", oid, offset, size).Scan(&buf)
+	return buf, err
+}
+
+// SetLogObjectSize records the size of the run's log once it has been written.
+func (m *Model) SetLogObjectSize(ctx context.Context, runID, size int64) error {
+	_, err := models.LogObjects(models.LogObjectWhere.RunID.EQ(runID)).UpdateAll(ctx, m.db, models.M{models.LogObjectColumns.Size: size})
+	return err
+}
+
+// SetLogObjectIndex stores the index of the sections of the run's log.
+func (m *Model) SetLogObjectIndex(ctx context.Context, runID int64, index []byte) error {
+	n, err := models.LogObjects(models.LogObjectWhere.RunID.EQ(runID)).UpdateAll(ctx, m.db, models.M{models.LogObjectColumns.SectionIndex: index})
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return utils.ErrNotFound
+	}
+
+	return nil
+}
+
+// GetLogObjectIndex returns the index of the sections of the run's log.
+// utils.ErrNotFound is returned if the log has no index.
+func (m *Model) GetLogObjectIndex(ctx context.Context, runID int64) ([]byte, error) {
+	lo, err := models.FindLogObject(ctx, m.db, runID, models.LogObjectColumns.RunID, models.LogObjectColumns.SectionIndex)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, utils.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	if !lo.SectionIndex.Valid {
+		return nil, utils.ErrNotFound
+	}
+
+	return lo.SectionIndex.Bytes, nil
+}
+
+// ListLogObjects lists all the stored logs.
+func (m *Model) ListLogObjects(ctx context.Context) ([]*models.LogObject, error) {
+	return models.LogObjects(qm.OrderBy("run_id")).All(ctx, m.db)
+}
+
+// DeleteLogObject removes the run's log along with its large object.
+func (m *Model) DeleteLogObject(ctx context.Context, runID int64) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	lo, err := models.FindLogObject(ctx, tx, runID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return utils.ErrNotFound
+	} else if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, "select lo_unlink($1::oid)", lo.ObjectID); err != nil {
+		return err
+	}
+
+	if _, err := lo.Delete(ctx, tx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,68 @@
+package db
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/tinyci/ci-agents/utils"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+)
+
+func TestLogObjects(t *testing.T) {
+	m := testInit(t)
+
+	_, err := m.GetLogObject(ctx, 1)
+	assert.Assert(t, errors.Is(err, utils.ErrNotFound))
+
+	oid, err := m.CreateLogObject(ctx, 1)
+	assert.NilError(t, err)
+
+	_, err = m.CreateLogObject(ctx, 1)
+	assert.Assert(t, errors.Is(err, utils.ErrLogExists))
+
+	found, err := m.GetLogObject(ctx, 1)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Equal(found, oid))
+
+	assert.NilError(t, m.WriteLogObject(ctx, oid, 0, []byte("hello ")))
+	assert.NilError(t, m.WriteLogObject(ctx, oid, 6, []byte("world")))
+
+	buf, err := m.ReadLogObject(ctx, oid, 0, 5)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Equal(string(buf), "hello"))
+
+	buf, err = m.ReadLogObject(ctx, oid, 5, 1024)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Equal(string(buf), " world"))
+
+	buf, err = m.ReadLogObject(ctx, oid, 11, 1024)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(buf, 0))
+
+	assert.NilError(t, m.SetLogObjectSize(ctx, 1, 11))
+
+	_, err = m.GetLogObjectIndex(ctx, 1)
+	assert.Assert(t, errors.Is(err, utils.ErrNotFound))
+	assert.NilError(t, m.SetLogObjectIndex(ctx, 1, []byte("index")))
+	index, err := m.GetLogObjectIndex(ctx, 1)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Equal(string(index), "index"))
+	assert.Assert(t, errors.Is(m.SetLogObjectIndex(ctx, 3, []byte("index")), utils.ErrNotFound))
+
+	_, err = m.CreateLogObject(ctx, 2)
+	assert.NilError(t, err)
+
+	los, err := m.ListLogObjects(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(los, 2))
+	assert.Assert(t, cmp.Equal(los[0].RunID, int64(1)))
+	assert.Assert(t, cmp.Equal(los[0].Size, int64(11)))
+	assert.Assert(t, cmp.Equal(los[1].Size, int64(0)))
+
+	assert.NilError(t, m.DeleteLogObject(ctx, 1))
+	assert.Assert(t, errors.Is(m.DeleteLogObject(ctx, 1), utils.ErrNotFound))
+
+	_, err = m.GetLogObject(ctx, 1)
+	assert.Assert(t, errors.Is(err, utils.ErrNotFound))
+}
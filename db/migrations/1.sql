@@ -0,0 +1,11 @@
+-- +migrate Up
+
+ALTER TABLE tasks ADD COLUMN depends_on bigint[];
+
+CREATE INDEX task_depends_on_idx ON tasks USING gin (depends_on);
+
+-- +migrate Down
+
+DROP INDEX task_depends_on_idx;
+
+ALTER TABLE tasks DROP COLUMN depends_on;
@@ -0,0 +1,19 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+CREATE FUNCTION notify_queue_items() RETURNS trigger AS $$
+BEGIN
+  PERFORM pg_notify('queue_items', NEW.queue_name);
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +migrate StatementEnd
+
+CREATE TRIGGER queue_items_notify AFTER INSERT OR UPDATE OF running ON queue_items
+  FOR EACH ROW WHEN (NOT NEW.running) EXECUTE PROCEDURE notify_queue_items();
+
+-- +migrate Down
+
+DROP TRIGGER queue_items_notify ON queue_items;
+
+DROP FUNCTION notify_queue_items();
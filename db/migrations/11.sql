@@ -0,0 +1,21 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+CREATE FUNCTION notify_tasks_canceled() RETURNS trigger AS $$
+BEGIN
+  PERFORM pg_notify('tasks_canceled', NEW.id::text);
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +migrate StatementEnd
+
+-- tasks finishing wake the cancel watchers too, so they stop watching.
+CREATE TRIGGER tasks_canceled_notify AFTER UPDATE OF canceled, finished_at ON tasks
+  FOR EACH ROW WHEN ((NEW.canceled AND NOT OLD.canceled) OR (NEW.finished_at IS NOT NULL AND OLD.finished_at IS NULL))
+  EXECUTE PROCEDURE notify_tasks_canceled();
+
+-- +migrate Down
+
+DROP TRIGGER tasks_canceled_notify ON tasks;
+
+DROP FUNCTION notify_tasks_canceled();
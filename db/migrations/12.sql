@@ -0,0 +1,13 @@
+-- +migrate Up
+
+ALTER TABLE runs ADD COLUMN failure_reason varchar;
+ALTER TABLE queue_items ADD COLUMN queued_at timestamp with time zone DEFAULT now() NOT NULL;
+
+CREATE INDEX queue_items_queued_at_idx ON queue_items USING btree (queue_name, queued_at) WHERE NOT running;
+
+-- +migrate Down
+
+DROP INDEX queue_items_queued_at_idx;
+
+ALTER TABLE queue_items DROP COLUMN queued_at;
+ALTER TABLE runs DROP COLUMN failure_reason;
@@ -0,0 +1,7 @@
+-- +migrate Up
+
+ALTER TABLE repositories ADD COLUMN last_served_at timestamp with time zone;
+
+-- +migrate Down
+
+ALTER TABLE repositories DROP COLUMN last_served_at;
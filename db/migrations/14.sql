@@ -0,0 +1,46 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+CREATE TABLE live_logs (
+    run_id bigint NOT NULL primary key,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+CREATE TABLE live_log_chunks (
+    run_id bigint NOT NULL REFERENCES live_logs(run_id) ON DELETE CASCADE,
+    start_offset bigint NOT NULL,
+    chunk bytea NOT NULL,
+    primary key (run_id, start_offset)
+);
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+CREATE FUNCTION notify_live_logs() RETURNS trigger AS $$
+BEGIN
+  IF TG_OP = 'DELETE' THEN
+    PERFORM pg_notify('live_logs', OLD.run_id::text);
+  ELSE
+    PERFORM pg_notify('live_logs', NEW.run_id::text);
+  END IF;
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +migrate StatementEnd
+
+CREATE TRIGGER live_log_chunks_notify AFTER INSERT ON live_log_chunks
+  FOR EACH ROW EXECUTE PROCEDURE notify_live_logs();
+
+CREATE TRIGGER live_logs_notify AFTER DELETE ON live_logs
+  FOR EACH ROW EXECUTE PROCEDURE notify_live_logs();
+
+-- +migrate Down
+
+DROP TRIGGER live_logs_notify ON live_logs;
+DROP TRIGGER live_log_chunks_notify ON live_log_chunks;
+
+DROP FUNCTION notify_live_logs();
+
+DROP TABLE live_log_chunks;
+DROP TABLE live_logs;
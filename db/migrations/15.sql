@@ -0,0 +1,7 @@
+-- +migrate Up
+
+ALTER TABLE tasks ADD COLUMN priority integer;
+
+-- +migrate Down
+
+ALTER TABLE tasks DROP COLUMN priority;
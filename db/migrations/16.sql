@@ -0,0 +1,37 @@
+-- +migrate Up
+
+-- finishing a run or a task can free its concurrency group, or the tasks
+-- depending on it, so every queue with items waiting is woken. This is done
+-- once per statement, as runs and tasks are often finished in bulk.
+
+-- +migrate StatementBegin
+CREATE FUNCTION notify_waiting_queues() RETURNS trigger AS $$
+BEGIN
+  IF EXISTS (
+    SELECT 1 FROM new_rows JOIN old_rows ON new_rows.id = old_rows.id
+    WHERE new_rows.finished_at IS NOT NULL AND old_rows.finished_at IS NULL
+  ) THEN
+    PERFORM pg_notify('queue_items', waiting.queue_name)
+      FROM (SELECT DISTINCT queue_name FROM queue_items WHERE NOT running) waiting;
+  END IF;
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +migrate StatementEnd
+
+-- transition tables cannot be used with the column lists of UPDATE OF, so
+-- the function itself looks for the rows which finished.
+CREATE TRIGGER runs_finished_notify AFTER UPDATE ON runs
+  REFERENCING OLD TABLE AS old_rows NEW TABLE AS new_rows
+  FOR EACH STATEMENT EXECUTE PROCEDURE notify_waiting_queues();
+
+CREATE TRIGGER tasks_finished_notify AFTER UPDATE ON tasks
+  REFERENCING OLD TABLE AS old_rows NEW TABLE AS new_rows
+  FOR EACH STATEMENT EXECUTE PROCEDURE notify_waiting_queues();
+
+-- +migrate Down
+
+DROP TRIGGER tasks_finished_notify ON tasks;
+DROP TRIGGER runs_finished_notify ON runs;
+
+DROP FUNCTION notify_waiting_queues();
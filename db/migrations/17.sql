@@ -0,0 +1,10 @@
+-- +migrate Up
+
+-- when the item last became claimable after being held back by a dependency
+-- or concurrency group, so the TTL of its queue only counts the time it has
+-- been claimable.
+ALTER TABLE queue_items ADD COLUMN claimable_at timestamp with time zone;
+
+-- +migrate Down
+
+ALTER TABLE queue_items DROP COLUMN claimable_at;
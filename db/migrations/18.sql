@@ -0,0 +1,25 @@
+-- +migrate Up
+
+-- runs are canceled on their own when they time out, so their runners stop
+-- them while the rest of their task goes on.
+ALTER TABLE runs ADD COLUMN canceled boolean DEFAULT false NOT NULL;
+
+-- +migrate StatementBegin
+CREATE FUNCTION notify_runs_canceled() RETURNS trigger AS $$
+BEGIN
+  PERFORM pg_notify('tasks_canceled', NEW.task_id::text);
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +migrate StatementEnd
+
+CREATE TRIGGER runs_canceled_notify AFTER UPDATE OF canceled ON runs
+  FOR EACH ROW WHEN (NEW.canceled AND NOT OLD.canceled) EXECUTE PROCEDURE notify_runs_canceled();
+
+-- +migrate Down
+
+DROP TRIGGER runs_canceled_notify ON runs;
+
+DROP FUNCTION notify_runs_canceled();
+
+ALTER TABLE runs DROP COLUMN canceled;
@@ -0,0 +1,11 @@
+-- +migrate Up
+
+ALTER TABLE queue_items ADD COLUMN priority integer DEFAULT 0 NOT NULL;
+
+CREATE INDEX queue_priority_idx ON queue_items USING btree (queue_name, running, priority DESC, id);
+
+-- +migrate Down
+
+DROP INDEX queue_priority_idx;
+
+ALTER TABLE queue_items DROP COLUMN priority;
@@ -0,0 +1,26 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+CREATE TABLE runners (
+    id bigserial NOT NULL primary key,
+    name character varying NOT NULL,
+    queue_name character varying NOT NULL,
+    labels text[] DEFAULT '{}' NOT NULL,
+    cordoned boolean DEFAULT false NOT NULL,
+    draining boolean DEFAULT false NOT NULL,
+    registered_at timestamp with time zone DEFAULT now() NOT NULL,
+    last_heartbeat timestamp with time zone DEFAULT now() NOT NULL,
+
+    UNIQUE(name)
+);
+-- +migrate StatementEnd
+
+CREATE INDEX runner_heartbeat_idx ON runners USING btree (last_heartbeat);
+
+ALTER TABLE queue_items ADD COLUMN requeues integer DEFAULT 0 NOT NULL;
+
+-- +migrate Down
+
+ALTER TABLE queue_items DROP COLUMN requeues;
+
+DROP TABLE runners;
@@ -0,0 +1,13 @@
+-- +migrate Up
+
+ALTER TABLE runs ADD COLUMN attempt integer DEFAULT 1 NOT NULL;
+ALTER TABLE runs ADD COLUMN previous_attempt_id bigint REFERENCES runs(id);
+
+CREATE INDEX run_previous_attempt_idx ON runs USING btree (previous_attempt_id);
+
+-- +migrate Down
+
+DROP INDEX run_previous_attempt_idx;
+
+ALTER TABLE runs DROP COLUMN previous_attempt_id;
+ALTER TABLE runs DROP COLUMN attempt;
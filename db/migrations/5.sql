@@ -0,0 +1,13 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+CREATE TABLE log_objects (
+    run_id bigint NOT NULL primary key,
+    object_id bigint NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+-- +migrate StatementEnd
+
+-- +migrate Down
+
+DROP TABLE log_objects;
@@ -0,0 +1,7 @@
+-- +migrate Up
+
+ALTER TABLE log_objects ADD COLUMN size bigint DEFAULT 0 NOT NULL;
+
+-- +migrate Down
+
+ALTER TABLE log_objects DROP COLUMN size;
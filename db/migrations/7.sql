@@ -0,0 +1,7 @@
+-- +migrate Up
+
+ALTER TABLE log_objects ADD COLUMN section_index bytea;
+
+-- +migrate Down
+
+ALTER TABLE log_objects DROP COLUMN section_index;
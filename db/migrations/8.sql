@@ -0,0 +1,21 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+CREATE TABLE test_cases (
+    id bigserial NOT NULL primary key,
+    run_id bigint NOT NULL,
+    suite character varying DEFAULT '' NOT NULL,
+    name character varying NOT NULL,
+    status character varying NOT NULL,
+    duration double precision DEFAULT 0 NOT NULL,
+    message text DEFAULT '' NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    FOREIGN KEY (run_id) REFERENCES runs(id)
+);
+-- +migrate StatementEnd
+
+CREATE INDEX test_case_run_idx ON test_cases USING btree (run_id, status);
+
+-- +migrate Down
+
+DROP TABLE test_cases;
@@ -0,0 +1,11 @@
+-- +migrate Up
+
+ALTER TABLE tasks ADD COLUMN rerun_of_id bigint REFERENCES tasks(id);
+
+CREATE INDEX task_rerun_of_idx ON tasks USING btree (rerun_of_id);
+
+-- +migrate Down
+
+DROP INDEX task_rerun_of_idx;
+
+ALTER TABLE tasks DROP COLUMN rerun_of_id;
@@ -8,7 +8,7 @@ import (
 
 
 func init() {
-	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\x07\x06\xc4R\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x05\x00	\x000.sqlUT\x05\x00\x01Ox\xb9`\xccXM\x8f\xdb6\x10\xbd\xfbW\x10\xb9\xc4F\x1d\xa0\x87\xa2@\xb3''\xd1\x06F]m\xeb\xb5\x81\xe6DP\xf2\xd8bm\x91*I\xed\xae\xfb\xeb\x0bR\xa4\x97\xd4\x87%o\x054G\x893O|o>8\xe2\x87\x0f\xe8\x87\x9c\x1e\x04Q\x80\xb6\xc5d\xe2??*\xa2 \x07\xa6>\xc1\x81\xb2\xc9\xe7u\xb4\xd8Dh\xb3\xf8\xb4\x8a\x10\xc7\xa4T\x99D\xd3	B\x08Im\x89\xd2\x8c\x08\x92*\x10\xe8\x89\x883e\x87\xe9O?\xfe\xf2\xf3\x0c\xc5\x0f\x1b\x14oW+T\x08\x9a\x13qFG8\xcf\x8d\x1f\xbc\x14T\x80\xc4\x9c!Es\x90\x8a\xe4\x05z\xa6*3\x8f\xe8\x1f\xce\xe0\xe2]y\xc8\x94\x17 \x9b\x9fB_\xa2\xfb\xc5v\xb5A\xef\xdf\x7f\xfc\xd8\\u \x93\xd9];\xc5\x88\xed\x86\x93\x97 %\xe5\xcc\xb1?\xc2\xf9\x0d\xdc\xdf=\x91S	\xf2]\xaf\xeb\xedR\x8d\xc3\xb2\x94 \x1cE\xbaC	=H\x10\x94\x9c\xaep\xd2.\x8c\xe4-\xa9Pc\xa3\xf8\x11\x18J\xce\nHm\xe5D\xa4\xc22%\x8c\xc1\x0e\x0b(\xb8\xec\xe4k\x1d\xf8\x812\xec\x01\xce'\xe6\xfd6^\xfe\xb1\x8d\xa6nK\xb3qD1;\xa2\x8a\x0b\n7j3H\x97B\xd0'\xbd\x85\x84\xf3\x13\x10V[=P\x95\x95	\xfaKr\x96\xd4\x96vT\x92\xe4\x04\xbb\x8b\xa7\xab\x87=9I\xab\x14)\x15\xc7\xa9\x00\xa2<\xbb\x10&\xe3\xfc\x88%\xa4\x02T\xef^\xf93\x03\x81+\xf6\x94)o\xd5,\xdf?\xac\xa3\xe5\xd7\x18\xfd\x1a}CSg;C\xeb\xe8>ZG\xf1\xe7\xe8\xb1J\xb0)\xdd\xcd\xc2\x88\x8d\x1a\xad\xfd\x8dQ\xba\xc4\xf7\xdc\xca\xac2\xd9\xf7j#3r\xd5\xc6\xe3\x8b\xa6\xc17\xe7\x1a\x7f\xae\x01fmB\x06\xb6\x81\x9a~fjQ\xc7QP\x96IN\x83n7PH\x1d\xdcW	mv\x01\xd15\xbd\xaf\xbfO\x88\x84\xf0}MN\x9b\xb4\x98\xa8\xee\xe6\xe7\x12\x9e\xf1\xe7i\xbdy*\x9a\x1eA\xf9\x9fm*k7\xdc\x95\xa1\x0d{\x8fL-\x0e\xfb.\x17\x8fg\xab\xcb8!SD\x1eo\x0c\x96>\xbfK\xe9z\x82\xd5\x8cH\xdd\n\x94\xa2\xec [{\xce\x7f\x8d\x8aTD\xf4\xf8W\x86{\xca\xa8\xcc\x86X\xa6\x84\xa5\xd0\xd9\x06\xeb\xcd\x96\xa8\xec\xcd\x93\x84\xa5p)\x8f\x81\x8d0p\x08\x92\xc0\xab\xb4\xf1rA\x94\xb7\xd6\xad	{W\x1d\x0e:\xc3D\xc9\xae\xe7M[\xb6}\x87\xb9$\x08\xd3\xd3V\x83n[X\xadjA@M\x1d\x8e\x17\xca\xbfK(\x01S\x05\xf9\x8d\x11\xd5\xf1\xe8<\xcbJ\xc6t\x04\x87\x94\x8b\xb5\xed\xd0D[T[\x1c\x94$\x83\xe2\xd5\xd4\xb9\xe2\x126\xcf\x92y\xfd\xd6\xce\x10\xd6n\x1c\xe5e\x99\xc8T\xd0By\xa7`x\xbc5&\x84\xabC\x84\xb1\xf9}\xbd\xfcm\xb1\xfe\x16\x9c=\xf3\xd0\xb3\xed\x08\xb9zJ\x8dC\xd7|\"%\x05I\xe8\x89\xaa\xd79\xf7*\xe5\xde\xa0_!m\x06\xbe\xff\x91+\x08\xc1\xdf\xf2\xa7\xd3)\x86\x01\xec-\x81\xb1\xb8ZB\xcb\xf8K\xf4\xa7W\x82\x98\xee^\xd0C\x1c\xf4\x8d\xed\xe32\xfe\x8a\x12%\x00\\-\xcd]]\xcf=\xdf\xd9]\x1b\xaak\x007\x02\xd7\xc1\xf4\xb4\x83\x83D7pfV\x0fp\x9a\x05\xd1@*x\xc05\xf8;k`\xb51\xd3{\xb5\x08\x06@\x9f\x97\x83\x1dm\xd7\x7f\xe9\xf6t\xe7B\xcd\xd9^ \xe0#\x9c\xb1\xfb\xb1\xb78\x97\xbb\x85\x00K\xe7\x9dw\x05\xd0\xc0{\x9d*\xfcYZ\xc3y\xf3{cw\xfe<\xda\x8d\xe8O\xed}\x88\xfeP\\C\xac\xe6I\x7f\xfc1|\xabi\xb5\x01\x14\xceI5(\xafj\xb1-\x1c\x07\xe7\x17t\x03\xd4\x15Y\x0b\x9c\xd6\x1f{W	>^\x0b\x92g\xd9\x8efrj\x18\x9e\xbb\xa1\x18\x80\xeaL{vw\xb9\xf3\xb8\x9b\xfc\x1b\x00\x00\xff\xffPK\x07\x08y$!\xd3\xba\x03\x00\x00\xe0\x13\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x07\x06\xc4Ry$!\xd3\xba\x03\x00\x00\xe0\x13\x00\x00\x05\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x00\x00\x00\x000.sqlUT\x05\x00\x01Ox\xb9`PK\x05\x06\x00\x00\x00\x00\x01\x00\x01\x00<\x00\x00\x00\xf6\x03\x00\x00\x00\x00"
+	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00l\"\xc4R\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x05\x00	\x000.sqlUT\x05\x00\x01\xcc\xa9\xb9`\xccX\xc1n\xe36\x10\xbd\xfb+\x88\xbd\xac\x8dz\x81\x1e\x8a\x02\xdd\x9c\xb2\xbb\xca\xc2h\xaa\xb4N\x0ctO\x04%\x8f-\xd6\x16\xa9\x92T\x12\xf7\xeb\x0bJ\xa4LJ\x94-\xa5\x02\xbaGS3O3o\xde\x8c\xc6\xfc\xf0\x01\xfd\x90\xd3\xbd \n\xd0\xa6\x98\xcd\xdc\xdf\x8f\x8a(\xc8\x81\xa9O\xb0\xa7l\xf6y\x1d\xdd>E\xe8\xe9\xf6\xd3}\x848&\xa5\xca$\x9a\xcf\x10BHjK\x94fD\x90T\x81@\xcfD\x9c(\xdb\xcf\x7f\xfa\xf1\x97\x9f\x17(~xB\xf1\xe6\xfe\x1e\x15\x82\xe6D\x9c\xd0\x01N\xcb\xca\x0f^\x0b*@b\xce\x90\xa29HE\xf2\x02\xbdP\x95U?\xd1?\x9cA\xe3]{\xc8\x94\x17 \xbb\xafB_\xa2\xbb\xdb\xcd\xfd\x13z\xff\xfe\xe3\xc7\xeeS\x0b2[\xdc\x84S\x8c\xd8vx\xf2\x12\xa4\xa4\x9c\xd9\xec\x0fpzC\xee\xef\x9e\xc9\xb1\x04\xf9\xee\xaa\xebx\xaa\xa6\xc9\xb2\x94 l\x8at\x8b\x12\xba\x97 (9^\xa8\xa7va$\x0fH\xa1q\xaa\x0d\x15?\x00C\xc9I\x01i=9\x12\xa9\xb0L	c\xb0\xc5\x02\n.{\xa5a\x1c\xf8\x9e2\xec\x00.g\xd5\xf9&^\xfd\xb1\x89\xe66\xa4\xc54\xa4T\x11Q\xc5\x05\x85\x91\xdc\x0c\xe2\xa5\x10\xf4Y\x07\x97p~\x04\xc2Z\xdc\xec\xa9\xca\xca\x04\xfd%9KZ\x8f\xb6T\x92\xe4\x08\xdb\xc6\xd3\xf6\xc3\x8e\x1c\xa5a\x8a\x94\x8a\xe3T\x00Q\x8e\x9d\x0f\x93q~\xc0\x12R\x01\xeaj\x0d\xf9\x0b\x03\x81keP\xa6\x1c\xa4\n\xea\xeea\x1d\xad\xbe\xc6\xe8\xd7\xe8\x1b\x9a[\xdb\x05ZGw\xd1:\x8a?G\x8f\x95Z\xe4\x9cn\x17~\xc5&\xad\xd6nd\x95\x9a\xfa\x9e\x82\x99\xe90\x05\xec\xaer#3r\xd1\xc6\xc9\x17\xcd\xbdw.5\xfeR\x03,BDz\xb6\x1e\x9b\xcd\x13\n\x15\xa9\xd30(\xcb$\xa7\xde\xb4\x1bH\xa4.\xee\x99B\xa3. \xba\xa7w\xed\xf3\x84H\xf0\xcf\x1d1i\x03#ZLT\xffw\xc2\n\x9e\xf1\x97y{x*\x9a\x1e@\xb9\xaf\xed2k\x02\xeeSh\xc7\xdeI\xa6U\x87]\x9f\x8b\x93g\xd0e\x9a\x92)\"\x0f#\x8b\xa5\xbf\xdf\xa5\xb43\xc1\x8ch\"\xf5(P\x8a\xb2\xbd\x0c\xce\x9c\xffZ\x15\xa9\x88\xb8\xe2_\xbfhG\x19\x95\xd9\x10\xcb\x94\xb0\x14z\xc7`K\x15\x05QY\xa0K\x87m\x12\x86\xb8\xa6=\xce\xe2rL\xba\xaa\xf1\x1c<\x11\x9c\x9fL\xd8\xbe\xa2d#\xa5P\x95=\x94\xcb\xe0o\x98(\xd9e\xdd\x84\xd4\xf6\x1djI\x10\xa6\x17\xd3\x8e@Bc\xd9\xb0\xe6\x15T\x9fMX\xca\xbfK(\x01S\x05\xf9\xc8\x8a\xeaz\xf4\x15T\x94\x8ci\xcd\x9b\xce\xbf\xd8.\xc6\xb6\x87\x13MI\x1d\xe2\xa0EgP\xefwy\xaes\xf1h\xd6\x12?\xcf[\xb3\xf5\x19\xbbi\x98\x97e\"SA\x0b\xe5\xec\xfc\xfe\xe7\xad\xcd\xd4\xe5%\xa2\x02\xf8}\xbd\xfa\xedv\xfd\xadN\xcb\xa0-}\xcf\xd0'\xc4Xz\x0c4{\xd44\xe9j8\x9c\x92\x82$\xf4H\xd5y\xcf5\xaf~\xe3d\xb8\x90\xb4\x16\xcc\xff\x99+\x08\xc1\xdf\xf2O\xa7\xb7\xab*\xc0\x8blL\x99\xab\x99\x12\xab\xf8K\xf4\xa7\xd3\x82\x98n_\xd1ClN\xea\xb9\xb1y\\\xc5_Q\xa2\x04\x002=\xb2\xb4}\xbdt|\x177!Tc8\x16\xb8\x0d\xa6\x97r\xec	\xbd\x82\xd3\xc7~\x80\xdd\x86\xe8 \x15\xbc\x8a\xd7\x86\xd4\xa0R\x08`\x852\xd3\xb1\x1a\x84*\x88\x92\x8dp4S\xff\xb5\xdf\xd3~\x17Zo5\x17\x08\xf8\x00'l\xef@\x0cNs\xb7\xe0E\xa1u\xe7\\\x01t\xf0\x9a\xdd\x01\xbb\xbb\xf4C\xecn\x15\xdd\xe8\xdc}\xb4\x1f\xd1\xdd\xda\xaf!\xbaKq\x0b\xb1\xa2\xe2\x1c\x8e\xcdW\x1f\x07B\xf3\x0c\xdbPN\xd7b\xd38\x16\xcem\xe8\x0e\xa8m\xb2\x00\x9c\xe6\x1f;W	.^ <\xc72\x8cVij\x18\x9e\xbd\xa1\x18\x80jM\xafDWJ\x10\x8c\xe4\xb0\xb8\x99\xfd;\x00PK\x07\x08y$!\xd3\xd3\x03\x00\x00\xe0\x13\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x168R]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x05\x00	\x001.sqlUT\x05\x00\x01\x9dn\xd4jt\x8e\xd1\xca\xc2 \x1cG\xef\xffO\xf1\xbb\xfc>bO\xe0\x95M\x89\xc1r\xb1\x14\x82\x08Y(\"\x91\x1b)\xd4\xe3\x07#XP\xdd\x9fs8U\x85\xd55\x86\xdbP<\xccD\xc4[-{h\xben%\xca\x90/\x19\\\x08\xd4]k\xb6\n\xceO>\xb9l\xc7\x84s\x0c1\x95\xe3\x89\x11\xd5\xbd\xe4Z\xa2QB\x1ef\xc7.\x9c\x8d\xee\x81N\xbdRf\xdf\xa8\x0dBL\xf8[\x90\x7fF\xf4~!\xc6{\"\x12}\xb7\xfb\x9dd\xdfFg\xe5\xe3\x94\xd1s\x00PK\x07\x08:\x94\xc9\xfa\x88\x00\x00\x00\xe3\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xadIR]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x06\x00	\x0010.sqlUT\x05\x00\x01\xb6\x8d\xd4j|\x91\xc1j\xeb0\x10E\xf7\xfa\x8a\xbb0$\xe1\xbd\xf4\x07\xb4r\xec\xb1cH%3\x96Hw!PU\x18b\xc5qdJ\xff\xbe\xb4q\xc1\x81\x90\xe5\x0c\x9c\x993w\xd6k\xfc\xebZ?\x1c\xa3\x83\xed\x85\x98\xd7M<F\xd7\xb9\x107\xce\xb7AdL\xa9!\x14Ve\xa6\xd2\n\xe1\x1c\xdb\x8f\xaf\xc3et\xa3;\xb4\xd1u\xd7\xe5\nL\xc6\xb2j\x10\x87\xd6{7 m\x90$bCe\xa5\x04P\x13\x17\x9a_\xd1\xfb\xc3\x8d^.f\xf8\xe2?\x14\xed_n\x9dp\xec\xdcJ\nL\x13\xa1\xecn'\x05\xa9\\\x8a$\xc1.U\xa5MKB\x7f\xea\xfd\xf5r\x92\x8f\xc5)\xbc\x8b?o\xc3UY\x12c\xb6p\x92@Z\x18bT\xaa!6\xd0\x0c[\xe7?\x97\xea\x02\xc3\x18B\x1b<\xb4\x9as\x02(4\x83\xd2l\x0b\xd6{\xec\xb7\xa4\xb0T\xda\xfc\xfaO\xcc\n\xf4F\x995\x84\x9auF\xb9ez\x18\x99\xbc\x0f=?\x7f\x06!r\xd6\xf53\xe3{\x1f9\x01O_#\xc5\xf7\x00PK\x07\x08\xbcN\xd1\x14\x05\x01\x00\x00\xec\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe4ZR]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x06\x00	\x0011.sqlUT\x05\x00\x01\x1c\xac\xd4j\x84R]o\x9b0\x14}\xf7\xaf8\x0f\x91\x1a\xb4\xb4?\xa0<Q\xb8\xd0H\xccF\x06+{C(\xb8\x8e\xd5\xd40\xb0\x94\xf5\xdfO|dj\xa6My\x84{>\xee9\xbe\x8f\x8f\xf8\xf6a\xcd\xd0x\x0d\xd53\xf6\xf5\xbb\xf4\x8d\xd7\x1f\xda\xf9\x17m\xacc\xb1\xa4\xa8\"\xa4\x8a\xc7\xd5^p\xb8\xce\xdb\xb7\xcf\xda7\xe3\xfbX\x1f\x1bw\xd4g\xddn\x03H\xaa\x94\xe4%\xfc`\x8d\xd1\x03\xa2\x12\x9b\x0d{\xa1l\xcf\x19P\x90L\x85\xfc\x8e\xde\xd4\x8b\xc0\xf6\xe1V\xe1a\x07N\x87'\xdb>?{\xfd\xcb\x07!\xc3*	\xae\xf2<d\xc4\x93\x90m6\xc8#\x9e\xa9(#\xf4\xe7\xde\x8c?\xcf\xe1\xbf\x97'\xd7\xce\xb1f\x17\xbcYg\xc7\x93u\x06\x97\xe6]\xc3\x9f4\x16c\\\x1a\x7f<\xe9a\x84\xef\xba\x1d\xc6n\x9a}b\xf4]\xbf\x8c\xac3O\xd7\x0e*\xb9\xcf2\x92\xb8\xdd|\x0d\x84(\xadHB\x15\xc9T\x97HW\x03\xdd\xeeVw\xdd\xd6\x8d\x87\xe0\x0b\x9d\x01\xa9\x90\xa0(~\x85\x14\x07\x1c^\x89c\xbb\x9d:\xb8\x12\x11\xf1\x04\\T\x10y\xf2\xe7g\x00!1\xc3\xbe\xaa\xee\xcb\x19855\xb3&\xc6\xdfs\x95\xe7A\xc0\x00\xfaA\xb1\xaa\x08\x85\x141%J\xd2\xff\x9e4\xbc\xbd\x8b\xa4\xbb8\xc6\x12)\x8a;E\\#\x86+\xfa\xde\xe9\x84\xec\xf7\x00PK\x07\x08\xb2\x1c\x0e'U\x01\x00\x00\x8f\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xa5JR]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x06\x00	\x0012.sqlUT\x05\x00\x01\x86\x8f\xd4j\x84\x90\xcfN\x03!\x1c\x84\xef<\xc5\x1c\xdbh\x9f`OkAm\x82\xacY\x97\xe8\x8d\xa0\xfd\xd9\x92\x08[Yp\x8dOo\x82\xa6.'\x8f\xfc\x99\xf9&\xdff\x83\x0b\xef\x0e\xd1&\x82>1\xd6\xcaA\xf4\x18\xda+)\x10s\x98\xd0r\x8em'\xf5\x9d\xc2\xabuo9\x92\x89d\xa71\xe0\xc3\xc6\x97\xa3\x8dM\x95y\xcf\x94\xc9\xb8D\xbe\x8a\x96\xeb\xbd\xb1	\xc9y\x9a\x92\xf5'\xcc.\x1d\xcb\x11_c pq\xddj9 \x8c\xf3j\x0d\xd5\x0dPZ\xca\x86\xb1m/\xdaA`\xa7\xb8xZ\xf6\x9bs\xa9q\xfbOt\xaa\x82\xeb\x87\x9d\xba\xc1s\x8aDX\xfd<\x04\xeb\xe9\xf2o\xca\x1a\x8f\xb7\xa2\x17\x05\x15s\x08.\x1c\x1a\xc6\x96B\xf88\x07\xc6x\xdf\xdd\xffGojs\xcb!%\xfek\xf0\xcc\xae\xa5\x15\xd1\xcb\x7f\xb5\xe9\x86}\x0f\x00PK\x07\x08\xdf\xcc[v\xdb\x00\x00\x00\xa6\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00|MR]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x06\x00	\x0013.sqlUT\x05\x00\x01\xdc\x94\xd4j|\xcd\xb1\n\xc20\x10\x06\xe0\xfd\x9e\xe2\xdf\xa5O\xd0)\x9an\xd1Ji\xe7\x12\xf0\xd0\x03\xd3\x84\xbb\xc3\x82O\xef\xea \xbe\xc0\xf7u\x1d\x0eE\xee\x9a\x9d\xb14\xa2\x90\xe6a\xc2\x1c\x8ei\x80r\xab&^U\xd8\x10b\xc4iL\xcb\xf9\x82g6_\x8d\xf5\xc5\xb75;\\\n\x9b\xe7\xd2\xb0\x8b?\xe0R\x18\xef\xbaqO\xf4\xad\xc7\xbao\x7f\xfc8\x8d\xd7\xdfAO\x9f\x01\x00PK\x07\x08\x1bDF\xe2o\x00\x00\x00\xa5\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xebNR]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x06\x00	\x0014.sqlUT\x05\x00\x01\x8a\x97\xd4j\xa4\x94Oo\x9b@\x10\xc5\xef\xfb)\xde\xc1\x92\x8d\xea\xf4\x03\x04\xf5\x80a Ht\xb1\x96E\xe9\x0d\x91xMV\xb1\x17\n\x9b\xba\xee\xa7\xafl\x88\xe3X$\xfd\x93\xe3jf\xdeo\xdec\xc4\xd5\x15>mu\xd5\x96V!o\x18;\x7fg\xb6\xb4j\xab\x8c]\xa8J\x1b\xe6\x0b\xf2$Az\x8b\x84\xb0\xd1?T\xb1\xa9\xab\x0e3\x06\x00\xed\x93)\xf4\nw\xba\xd2\xc6\x82\xa7\x12<O\x124\xad\xde\x96\xed\x1e\x8fj??\xf6\xdd\xb7\xaa\xb4jU\x94\x16VoUg\xcbm\x83\x9d\xb6\x0f\xc7'~\xd5F!\xa0\xd0\xcb\x13	S\xeff\xceI\x8b9\xee\xf8vdV\xff\xbewq\xff\xf0d\x1e\xff\xb0\xbd\xa0\x90\x04q\x9f\xb2\x17\xbf\xb3\xde\xa9\x83\x94#\xa0\x84$\xc1\xf72\xdf\x0b\xa87\xd8\xd9\xb2\xb5E\xbd^w\xca^\n\x0e\x11\x1c\xc8\xb8\xdb[U\x9e\xcc\xf5\x95\xb3\xb40p\xe6\xaf\x04\x9d\x8f\x86\x10\xe6\xdc\x97q\xcaaj\xab\xd7\xfb\xe2\xc5\x96\x03A2\x17<\x83muU\xa9\x16^\x86\xc9\x84-(\x8a9\x03\xe2\x102*\xd2%\xbe`\xda\xfb\x9eB\xde\xd0\xa1\x04,I\x84\xa9\xf8\x8a\xa6*z\xe1\xd9\xf4\xa4<\x9d#M\x82\xcf\xbd\x9d\xebk\xab~Z\xc7e\x00%\x19\xfd\xcd0\xa7\xdb\x91a\x1e \x0e\x0f2\xfd\xd6\xc7ks\x19\xf1\xc0e\x93	\x12\x8fG\xb9\x17\x11\x9aMSu\xdf7\xef\x85\xf6|\x1e\"\x8e\"\x12\x97\x072\xf8\x81\x17J\x12\x88yFB\x1e>\xfdE\x1b\x03\xc2T\x80<\xff\x06\"\xbd\x05}#?\x97\x84\xa5H}\nrA#\x81\xbbo\xb2/\xa8\xc3\x99\x9dQ\xff\x97w\x9eBP\xef\x0cc\x81H\x97o\xf3\xcf\x91\xeex\xef\x10\xc0\xc8\xc4Pq\x07\xc8;\xa7\xf7\xdc\xf2\xfa\xd7r\x9a\x1f\xa9u.\xfb=\x00PK\x07\x08\xbc\xe1}\xbe\xc3\x01\x00\x00\xba\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe7PR]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x06\x00	\x0015.sqlUT\x05\x00\x01R\x9a\xd4j\x00z\x00\x85\xff-- +migrate Up\n\nALTER TABLE tasks ADD COLUMN priority integer;\n\n-- +migrate Down\n\nALTER TABLE tasks DROP COLUMN priority;\n\x03\x00PK\x07\x08\xb5\xd5hN\x81\x00\x00\x00z\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xfcZR]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x06\x00	\x0016.sqlUT\x05\x00\x01L\xac\xd4j\xc4T\xcd\x8e\xa38\x18\xbc\xfb)\xea\xd0\xd2$\xda$\xd2\x9e\xd1\x1eh0iV\xb4\x89\xc0h\xe6\x16\xd1`\x88\x15bg\xb0\xd9\xa8\xdf~e~\x92\xdeQ\xaf\xfa8\xb7\xd8.\x97\xab\xbe\xaa\xb0\xdd\xe2\x8f\x8bl\xfb\xd2\n\x14WB\xb6[4RIs\x92\xaaE\x89~P\xd0=J\xd8\xd2\x9cQ\x95\nM/\x04\xa45\xa8\xb4\xaa\x86\xbe\x17\xaazG\xdb\xeb\xe1\xbaqH{\x12#\xd68\xa6Z\\\x85\xaa\x1d\x93V\x90v\x03\xa3!\xfe\x11\xfd;~\x0eb\x10\xb8I{\x82\xb4\xe2bp+\xa5u@ip\xd3g\xa1v\xe0'i\xdc\xb2\xd6J82\xad*\x81\xab\xe8ali\xc5E(\xbbAi\x9cB\x83R\xd5\xd3\xab({\x01\xddX\xa1f\x1b\xa2\x86Tx\x1b\xba\xf3\x8e\x90\x8ff\xf3\x85\xe5Y\xb4R\x91 \xa3>\xa7\x88\n\x16\xf08eP\xda\xca\xe6\xfd8\xcb:\x8ez\xcdj\x8d\x8c\xf2\"c9l/\xdbV\xf4\xf0s<=\x91g\xba\x8f\x19\x01\xe2\x08\xf4G\x9c\xf3\x1c+\x02\x009Mh\xc0\xf1'\xa2,}\x85\x12\xb7c\xafo\x06\x7f\xa71\x83\xee\xeai\xe5^\x9bOv\xb2\xc6_\xf7\x93\x9d\xacG\x96\xef/4\xa3\x0f\xccb\xecXZ\xc49X\xca\xc1\x8a$\x81\xcf\xc2\xc7\xd5_AE\x92\x10`\x0d\xfeB\x9dP\xe0@\xb3(\xcd^qm\x8f\x93\xd7\xd5\xb7\xd1\xe4q\xcc\xe3\xdbfId7\xed\xaa\xf2\"\xd6\xe3ELfV\xb3\xb70\xcey\xcc\x02\x8e\x07n\x02|`\x9b\x1d8\xa5\xfd\xa0\x94T\xedz\xa1\xf7\x08@Y\x888r\xbf\xa6\xe9\x8ev<BY\xe8\x91\xa7'$>\xdb\x17\xfe\x9e\xe2\xda][\xf3\xb3\xf3>\xcf\x91\xaazL\xd8\xf6\xa52\xd2J\xad`\xcb\xb7N\x18W[\xa5-\xde\x04\x06#\xea\xa9u\xae\xa7\x95\xee\x86\x8bB'\x8d5\xd0\x0d\x8aC\xe8:\x90F\xae\xa8#\xd5I\xa0\x19T5\x92IkD\xd7\xa0\xd3\xfal\xd0\xccUw\xa1\xe1v\x92\xd5\xe9\xde\xb7\xdd\xd2%\x9e\xc5\xfb=\xcd\x9ces\\N\xe7a\xc3\x8f8\xcd\xee/\xb2\x114\x0e \xa2\x19eA\xcc\xf6H\x93\x10\xdc\x7fN(\xfc\xfc\x1e,\x18\xfd\xfe\xd8]:A\x80(\xcd@\xfd\xe0\x059\xf79}\xa5\x8c\x83\xfe\xa0A\xc1)\x0eY\x1a\xd0\xb0\xc8\xe8\xff\xb5\xda#\xbfj\x1e\xffL_\x8a\x1eQ\xbfS\xf5\xc7\"\x84\xfa\xa6\x08	\xb3\xf4\xf0\x85\x8bE\xb8\xf7_\xf0\xa71\xcd\xc9x3\xf1W\x9f\x07\x8f\xfc;\x00PK\x07\x088US\x00m\x02\x00\x00P\x05\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00BYR]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x06\x00	\x0017.sqlUT\x05\x00\x01\x0d\xa9\xd4jt\xd0\xb1N\xc30\x10\x06\xe0\xddO\xf1\xef\x10^\xa0S \xdd\x02EU:Wg\xe7\x9aX\xc4\xe7`_\x14\x85\xa7G\x0eC\x19`\xbc\xd3\xaf\xef~]U\xe1!\xf8!\x912.\xb31U\x85ud\x81\x8e\x0c\xaf\x1c0QVXv\x14\x18n\"\x1f\xc8N\x0c\xba)'X\xf62`\xe4\xa9\x87%\xf7\x01\xbb\x81\xd0\xf3\xcc\xd2\xb3\xb8\xad`1\xc1EqKJe\x83!\xc5e~D\x8e\xfb\x81\xaek\x11o\xf0\x9a\xf1\xb9\xf0\xc2\x882mpq\x11\xcd{@}(50R.\x98e\x96{\x89'S\xb7\xdd\xf1\x8c\xae~n\x8f?\xc0\xb5T\xce\xa8\x9b\x06/\xa7\xf6\xf2\xfavO_Iw.+\x85\x19\xab\xd7q\x1f\xf1\x15\x85\x0f\xc6\xfc\xfeC\x13W1\xff\xe2\xcd\xf9\xf4\xfe\x97~0\xdf\x03\x00PK\x07\x08[L^\x0c\xce\x00\x00\x00L\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x008XR]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x06\x00	\x0018.sqlUT\x05\x00\x01\x1d\xa7\xd4j\x84\x92\xdfn\xda0\x18\xc5\xef\xfd\x14\xe7\x02\xa9\xa0\x95>@se\xe2/)Rj#\x13\x8b\xdd\xa1\xac\x98\x10-\xd8Yl\xc4\xfa\xf6SXZ6\xf6\xa7w\x89u|\xbe\xdf9\xfe\xe6s|:6u_E\x0b\xd316\x9f\xa3?\xb9\x80\xaa\xb7x\xa9\xdc\x8bm\xed\x0e\xde!\x1el\xd3\xc3\x9f\x1d\xce\x07{\xf9}El\x8e\x16\xfe\x14\xef\x11\xfc(\xe8O\xce\xd9> D\xdf\x0d^\xf1`\x8f8\x1f\x9a\xd6\x0e\x9f\xe8m\x88\xf0\xfbQ\x1c\xab\xf0\x15\xb5\xb7\x01\xde=0^\x94\xa4Q\xf2EA\x83M\x00\x17\x02\xa9*\xcc\xb3\xbc\x92|\xf1\xbe\xb5\x95\x83\xa0\x8c\x9b\xa2\xc4\xbej\x83\x85T%\xa4)\x8a\x84\xb1_\xf3\xacc\x15\xed\xd1\xba\xb8\xb0u\xe3X\xaa\x89\x97\x84\xcc\xc8\xb4\\*	\xe7c\xb3\x7f\xdd\x0e\xb3\xb6o\x03\xa63h*\x8d\x96k\xc4\xbe\xa9k\xdb\x83\xaf1\x99\xb0\x05\xe5K\xc9\x80\x15\xe9L\xe9gt\xf5\xf6\xe7\xfd\xe9\xdd\x10\xe3\xeapw\x0fI\x9b\x87\xe1p\xdb\xec\x1e\x1f\xa3\xfd\x1eg	\xc3\xe8;r\x92\x14	\x9bLPp\x99\x1b\x9e\x13\xba\xb6\xab\xc3\xb76\xf9{\x00r;\xf6\xc6_\xeae\x9e\x93\xc6o\xdc#\x0cx6\x94hVbH\xaa\xb2kqJ^.0 S\x1a\xc4\xd3'h\xb5\xc1\xe6\x89$\xa6\x03\xf0\xbb\x92Kq)T\x15\xe2\xfdp\x06\xfaL\xa9)	+\xadR\x12F\xd3?\xea\xbby\x02\xe1\xcf\x8e1\xa1\xd5\xea\xff\xdc#^2j?x\xa3\x84\xfd\xb9-\x97\x197\xeb\x92\xb0\x1f\x03\x00PK\x07\x08\x96\xc3)\x8a\x85\x01\x00\x00\xdf\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xf09R]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x05\x00	\x002.sqlUT\x05\x00\x01\x15r\xd4jt\x8f\xcdJ\xc50\x10F\xf7\xf3\x14\xdfR\xb1\x17\xdcg\x15\x9b(\x17b\"\xbd	\xb8+\x95\x0ee\x16MkLQ\xdf^\xf0\x07\xf5B\xd7\xc3w\xce\x9c\xc3\x01W\xb3Le\xa8\x8c\xb4\x12i\x17m\x87\xa8o\x9c\xc5\xf3\xc6\x1b\xf7Ry~\x816\x06mp\xe9\xdec-\xb2\x14\xa9\xef\x90\\y\xe2\x02cour\x11\xd7\xf0!\xc2'\xe7\x14Q\xdbY\x1d-\x8e\xde\xd8\xc7o\xd2\xcf\xb0\x97\xf1\x0d\xc1\xff\xe3\xa7\xd3\xd1\xdf\xe1\xa9\x16f\\|\x1d\xf20s\x83\xb2\xe5,yj~\xb5\xc6\x9e\xda\x062^*\xa2\xbf\xef\x9b\xe55\x13\x99.<\xecZ\xd5~\xe0\xe7\xee\xacP\xd1\xc7\x00PK\x07\x08\xa7\xe0-~\xab\x00\x00\x00\x1f\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x002;R]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x05\x00	\x003.sqlUT\x05\x00\x01qt\xd4j\x9c\x92Ao\x9b@\x10\x85\xef\xfb+\xe6fPk\xa9wN\xd8l+K\x14Z\x1b\xa4JU\x85\x063\xc5\xab\xc2\xe2\xce\x8ec;Q\xfe{d\xec\x90\x18%\x92\x95\xe3\xec~\xfbfv\xde\x9bN\xe1SkjF!\xc8\xb7J\xbd\xaeW\x82B-Y\x99Qm\xac\x9a/u\x98i\xc8\xc2Y\xac\x81w\xd6\x12;\xf0\x14\x00\x80\xa9\xa04\xb5#6\xd8@\x92f\x90\xe4q\x0c[6-\xf2\x11\xfe\xd1\xf1s\x8fYl	\xd6\x1bd\\\x0b1\xdc!\x1f\x8d\xad\x87\x07g\xe8\xff\x8evT\xdc\x846XR\xe3@\xe8 \xbf\xff@\xa4\xbf\x86y\x9c\xc1\xe4\xe1q2\xd2\\w\\u\x96*(\xbb\xae!\xb4\x03\xfb\x17\x1bG#\xb8b4\xf64\xd7-0Sm\x9c\x10SU\xa0\x80\x98\x96\x9c`\xbb\x85\xbd\x91M_\xc2}gi\xe8g\xbb\xbd\xe7\x8f\xfa5\xe8\xa4\xd8\x10\xb2\x94\xf4\x11\x8d~ky\xb2\xf8\x99k\xef\xb46_\xf9\xc1\xdb>j[\xa9g\x1b\x17I\xa4\x7f]l|\xe9^\x98\xea\x00i2\xd8\x9b\xaf\x16\xc97(\x85\x89\xc0\xbb\x1e\xd4\x0f\x94\n\xe3L//\x898\xfbf\x84Z\x07a\x14\xc1<\x8d\xf3\xef	0\xf5\x17\x0e\x8c\x15\xaa\x89\x87U|\x19\xbe\x10\\\xc7.\xea\xf6\xf6}\xe9h\x99\xfe\x18k\x07J\xf5\xc7W\xd1\x0c\xd4\xd3\x00PK\x07\x08d|M\x94O\x01\x00\x00\xdc\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00j=R]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x05\x00	\x004.sqlUT\x05\x00\x01\x98x\xd4j\x84\x901k\xc30\x10\x85w\xfd\x8a7\xa6\x94\x0c\x9d5\xa9\xd6\xa5\x04T\xb9(\x12t3\x0e9\x8c\x06;F\xbe\xb4\xfd\xf9\xc5!C\x07\xe1\xaew\xf7}\xc7{\xfb=\x9e\xc7<\x94^\x18iV\xca\xb8H\x01\xd1\xbc:B\xb9M\x0b\x8c\xb5hZ\x97\xde=z\x11\x1egA\x9e\x84\x07.\xb0t0\xc9E\xbc\xc0\xb7\x11>9\xa77\xf9\xb9\xf0W\xbe\xde\x96\xee!\xea\xf2\x05\xe7<\xe4I\x10\xe8@\x81|C\xa7;\xb5\xcb\x97'\xadT\x13\xc8D\xc2\xd1[\xfa\\\xe7]\xc5\xf0\x83\xd6\xaf\xbb\x05\xe9t\xf4o8Ka\xc6\xaer\xb9\x1a\xff\xc6\xb5\xd7\xefI)\x1b\xda\x8f\x7f>\xe8J-w\xec\xd1K\x85\xd1\xdbD/\xc2\xe3,Z\xfd\x0e\x00PK\x07\x08\xc0<\x98\xea\xbc\x00\x00\x00\x7f\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x05>R]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x05\x00	\x005.sqlUT\x05\x00\x01\xbay\xd4jl\x8f\xc1N\xc30\x0c\x86\xef~\x8a\xff\xb8	\xf6\x04;u4\x9c\xa2\x0d\x8d\xf4\\e\xab\x15\x0c\xc4\xa92\xa3j<=\xd2*!\xd0z\xfc\xe4\xcf\xb2\xbf\xcd\x06\x0fYR\x8d\xc6\xe8F\xa2\xbf\xfcj\xd18\xb3\xda\x8e\x93(=\x1d]\x13\x1cB\xb3\xf3\x0e\x9f%\xf5\xe5\xf4\xceg\xbb`E\x00P\xbf\xb4\x97\x01'I\xa2\x86\xfd!`\xdfy\x8f\xb1J\x8e\xf5\x8a\x0f\xbe>\xde\xbcykA\x9d\xc7\xe7\xca\xd1x\xe8\xa3\xc1$\xf3\xc5b\x1e1\x89\xbd\xdd\x10\xdfE\x19\xad{n:\x1f\xa0eZ\xad\x7fO\xd1z\xbb\xfc\xbe\xd3\xe1\x7fX[&%j\x8f\x87\x97\xfb\x9a-\xfd\x0c\x00PK\x07\x0867\x94v\xa9\x00\x00\x00\x13\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x1c@R]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x05\x00	\x006.sqlUT\x05\x00\x01\xb8|\xd4j\xd2\xd5U\xd0\xce\xcdL/J,IU\x08-\xe0\xe2r\xf4	q\x0dR\x08qt\xf2qU\xc8\xc9O\x8f\xcfO\xcaJM.)VptqQp\xf6\xf7	\xf5\xf5S(\xce\xacJUH\xcaL\xcf\xcc+Qpqus\x0c\xf5	Q0P\xf0\xf3\x0fQ\xf0\x0b\xf5\xf1\xb1\xe6\xe2B6\xd3%\xbf<\x0f\xb7\xa9.A\xfe\x01\xc8\xc6Zs\x01\x06\x00PK\x07\x08VN\x1aqh\x00\x00\x00\x90\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00oBR]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x05\x00	\x007.sqlUT\x05\x00\x01\x12\x81\xd4j\xd2\xd5U\xd0\xce\xcdL/J,IU\x08-\xe0\xe2r\xf4	q\x0dR\x08qt\xf2qU\xc8\xc9O\x8f\xcfO\xcaJM.)VptqQp\xf6\xf7	\xf5\xf5S(NM.\xc9\xcc\xcf\x8b\xcf\xccKI\xadPH\xaa,IM\xb4\xe6\xe2B6\xc8%\xbf<\x0f\xb7Q.A\xfe\x01X\xcd\xb2\xe6\x02\x0c\x00PK\x07\x08q\x93\xdbs]\x00\x00\x00\x8e\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\"PR]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x05\x00	\x008.sqlUT\x05\x00\x01\xe1\x98\xd4j\x84\x92Ao\xba@\x10\xc5\xef\xfb)\xdeM\xc8_\x93\xff\xdd\x93\xcajL	4\x08I{\"+LpSY\xc8\xeeP\xb5\x9f\xbeQ\xaaUk\xd3\xe3\xec\xfe\xde\xcc\xe4\xbd\x19\x8d\xf0\xaf\xd6\x95UL\xc8Z!\xae\xeb\x15+\xa6\x9a\x0cO\xa9\xd2F\xcc\x129I%\xd2\xc94\x94`r\x9c\x17\xca\x91\x83'\x00@\x97X\xeb\xca\x91\xd5j\x8b(N\x11ea\x88\xd6\xeaZ\xd9\x03\xde\xe80<a\xb63y\x8fj\xc3\x17\xae\xffs\x9dfB\xb1QV\x15L\x16\xef\xca\x1e\xb4\xa9\x10\xc8\xf9$\x0bS\x0c\x06w\x02\xa3\xeaG\xfc-\xe4Xq\xe7\xfe\xc4\xca\xce*\xd6\x8dA\xd9t\xeb-\xa1\xb5Thw|8\x8f\xff\x7f\xd7\xb8&\xe7TE`\xda\xf3\xef;\x16\x96\x14S\x99+\x06\xeb\x9a\x1c\xab\xba\xc5N\xf3\xe6T\xe2\xa31t\x11\x9bf\xe7\xf9w\xfay\x9c\xc8\xe5\"\xc2\x93|\x85\xd7\xbb\xe7#\x91s\x99\xc8h&WGC\x9d\xa7K_\xf8\xe3\xc7\xe1IS\x8asv\xcb(\x90/\xdf\xd9\xe5}\xbf=\xe2\xe8:\xd0l\xb5\x8c\x16X\xb3%:\x8f\x1c~\xd9\xe8\x8foO$hvF\x88 \x89\x9f\x7f\xdc\xc5X|\x0e\x00PK\x07\x08\x07j\x94_,\x01\x00\x00\\\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x07GR]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x05\x00	\x009.sqlUT\x05\x00\x01\xae\x89\xd4jt\x8e\xc1\n\x82@\x14E\xf7\xef+\xee\xd2\x08\xbf\xc0\xd5\xe4\xbcB\xb01F\x85v\xa28\xc9\x10i\x8c\x13\xf5\xf9\x91\xb5\x18\xa8\xd6\xf7\x9e\xc3\x89c\xac/vp\xad7\xa8\xafD\"\xafX\xa3\x12\x9b\x9c\xe1\xdb\xf9<CH\x89\xb4\xc8\xeb\xbd\x823\xee66\xd3\xa9\xb1=:;\xd8\xd1C\xf3\x965\xab\x94\xcb\xf7=\xb2\xfd*!J5\x8b\x8a\x91)\xc9\xc7eh\x02\xf6\x81B}\xe4u\x99\xa9\x1d:\xef\x8cA\x14\\^\x8e0MN\xf7\x91H\xea\xe2\xf0\xcf\x99\xfcj_\x80\xef\xf8\x84\x9e\x03\x00PK\x07\x08\x9dCb\x94\x92\x00\x00\x00\xf7\x00\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00l\"\xc4Ry$!\xd3\xd3\x03\x00\x00\xe0\x13\x00\x00\x05\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x00\x00\x00\x000.sqlUT\x05\x00\x01\xcc\xa9\xb9`PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x168R]:\x94\xc9\xfa\x88\x00\x00\x00\xe3\x00\x00\x00\x05\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x0f\x04\x00\x001.sqlUT\x05\x00\x01\x9dn\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xadIR]\xbcN\xd1\x14\x05\x01\x00\x00\xec\x01\x00\x00\x06\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xd3\x04\x00\x0010.sqlUT\x05\x00\x01\xb6\x8d\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe4ZR]\xb2\x1c\x0e'U\x01\x00\x00\x8f\x02\x00\x00\x06\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x15\x06\x00\x0011.sqlUT\x05\x00\x01\x1c\xac\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xa5JR]\xdf\xcc[v\xdb\x00\x00\x00\xa6\x01\x00\x00\x06\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xa7\x07\x00\x0012.sqlUT\x05\x00\x01\x86\x8f\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00|MR]\x1bDF\xe2o\x00\x00\x00\xa5\x00\x00\x00\x06\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xbf\x08\x00\x0013.sqlUT\x05\x00\x01\xdc\x94\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xebNR]\xbc\xe1}\xbe\xc3\x01\x00\x00\xba\x04\x00\x00\x06\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81k	\x00\x0014.sqlUT\x05\x00\x01\x8a\x97\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe7PR]\xb5\xd5hN\x81\x00\x00\x00z\x00\x00\x00\x06\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81k\x0b\x00\x0015.sqlUT\x05\x00\x01R\x9a\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xfcZR]8US\x00m\x02\x00\x00P\x05\x00\x00\x06\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81)\x0c\x00\x0016.sqlUT\x05\x00\x01L\xac\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00BYR][L^\x0c\xce\x00\x00\x00L\x01\x00\x00\x06\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xd3\x0e\x00\x0017.sqlUT\x05\x00\x01\x0d\xa9\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x008XR]\x96\xc3)\x8a\x85\x01\x00\x00\xdf\x02\x00\x00\x06\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xde\x0f\x00\x0018.sqlUT\x05\x00\x01\x1d\xa7\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xf09R]\xa7\xe0-~\xab\x00\x00\x00\x1f\x01\x00\x00\x05\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xa0\x11\x00\x002.sqlUT\x05\x00\x01\x15r\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x002;R]d|M\x94O\x01\x00\x00\xdc\x02\x00\x00\x05\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x87\x12\x00\x003.sqlUT\x05\x00\x01qt\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00j=R]\xc0<\x98\xea\xbc\x00\x00\x00\x7f\x01\x00\x00\x05\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x12\x14\x00\x004.sqlUT\x05\x00\x01\x98x\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x05>R]67\x94v\xa9\x00\x00\x00\x13\x01\x00\x00\x05\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\n\x15\x00\x005.sqlUT\x05\x00\x01\xbay\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x1c@R]VN\x1aqh\x00\x00\x00\x90\x00\x00\x00\x05\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xef\x15\x00\x006.sqlUT\x05\x00\x01\xb8|\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00oBR]q\x93\xdbs]\x00\x00\x00\x8e\x00\x00\x00\x05\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x93\x16\x00\x007.sqlUT\x05\x00\x01\x12\x81\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\"PR]\x07j\x94_,\x01\x00\x00\\\x02\x00\x00\x05\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81,\x17\x00\x008.sqlUT\x05\x00\x01\xe1\x98\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x07GR]\x9dCb\x94\x92\x00\x00\x00\xf7\x00\x00\x00\x05\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x94\x18\x00\x009.sqlUT\x05\x00\x01\xae\x89\xd4jPK\x05\x06\x00\x00\x00\x00\x13\x00\x13\x00}\x04\x00\x00b\x19\x00\x00\x00\x00"
 		fs.Register(data)
 	}
 	
\ No newline at end of file
@@ -12,171 +12,232 @@ import "testing"
 // It does NOT run each operation group in parallel.
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
+	t.Run("LiveLogChunks", testLiveLogChunks)
+	t.Run("LiveLogs", testLiveLogs)
+	t.Run("LogObjects", testLogObjects)
 	t.Run("OAuths", testOAuths)
 	t.Run("QueueItems", testQueueItems)
 	t.Run("Refs", testRefs)
 	t.Run("Repositories", testRepositories)
+	t.Run("Runners", testRunners)
 	t.Run("Runs", testRuns)
 	t.Run("Sessions", testSessions)
 	t.Run("Submissions", testSubmissions)
 	t.Run("Subscriptions", testSubscriptions)
 	t.Run("Tasks", testTasks)
+	t.Run("TestCases", testTestCases)
 	t.Run("UserCapabilities", testUserCapabilities)
 	t.Run("UserErrors", testUserErrors)
 	t.Run("Users", testUsers)
 }
 
 func TestDelete(t *testing.T) {
+	t.Run("LiveLogChunks", testLiveLogChunksDelete)
+	t.Run("LiveLogs", testLiveLogsDelete)
+	t.Run("LogObjects", testLogObjectsDelete)
 	t.Run("OAuths", testOAuthsDelete)
 	t.Run("QueueItems", testQueueItemsDelete)
 	t.Run("Refs", testRefsDelete)
 	t.Run("Repositories", testRepositoriesDelete)
+	t.Run("Runners", testRunnersDelete)
 	t.Run("Runs", testRunsDelete)
 	t.Run("Sessions", testSessionsDelete)
 	t.Run("Submissions", testSubmissionsDelete)
 	t.Run("Subscriptions", testSubscriptionsDelete)
 	t.Run("Tasks", testTasksDelete)
+	t.Run("TestCases", testTestCasesDelete)
 	t.Run("UserCapabilities", testUserCapabilitiesDelete)
 	t.Run("UserErrors", testUserErrorsDelete)
 	t.Run("Users", testUsersDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
+	t.Run("LiveLogChunks", testLiveLogChunksQueryDeleteAll)
+	t.Run("LiveLogs", testLiveLogsQueryDeleteAll)
+	t.Run("LogObjects", testLogObjectsQueryDeleteAll)
 	t.Run("OAuths", testOAuthsQueryDeleteAll)
 	t.Run("QueueItems", testQueueItemsQueryDeleteAll)
 	t.Run("Refs", testRefsQueryDeleteAll)
 	t.Run("Repositories", testRepositoriesQueryDeleteAll)
+	t.Run("Runners", testRunnersQueryDeleteAll)
 	t.Run("Runs", testRunsQueryDeleteAll)
 	t.Run("Sessions", testSessionsQueryDeleteAll)
 	t.Run("Submissions", testSubmissionsQueryDeleteAll)
 	t.Run("Subscriptions", testSubscriptionsQueryDeleteAll)
 	t.Run("Tasks", testTasksQueryDeleteAll)
+	t.Run("TestCases", testTestCasesQueryDeleteAll)
 	t.Run("UserCapabilities", testUserCapabilitiesQueryDeleteAll)
 	t.Run("UserErrors", testUserErrorsQueryDeleteAll)
 	t.Run("Users", testUsersQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
+	t.Run("LiveLogChunks", testLiveLogChunksSliceDeleteAll)
+	t.Run("LiveLogs", testLiveLogsSliceDeleteAll)
+	t.Run("LogObjects", testLogObjectsSliceDeleteAll)
 	t.Run("OAuths", testOAuthsSliceDeleteAll)
 	t.Run("QueueItems", testQueueItemsSliceDeleteAll)
 	t.Run("Refs", testRefsSliceDeleteAll)
 	t.Run("Repositories", testRepositoriesSliceDeleteAll)
+	t.Run("Runners", testRunnersSliceDeleteAll)
 	t.Run("Runs", testRunsSliceDeleteAll)
 	t.Run("Sessions", testSessionsSliceDeleteAll)
 	t.Run("Submissions", testSubmissionsSliceDeleteAll)
 	t.Run("Subscriptions", testSubscriptionsSliceDeleteAll)
 	t.Run("Tasks", testTasksSliceDeleteAll)
+	t.Run("TestCases", testTestCasesSliceDeleteAll)
 	t.Run("UserCapabilities", testUserCapabilitiesSliceDeleteAll)
 	t.Run("UserErrors", testUserErrorsSliceDeleteAll)
 	t.Run("Users", testUsersSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
+	t.Run("LiveLogChunks", testLiveLogChunksExists)
+	t.Run("LiveLogs", testLiveLogsExists)
+	t.Run("LogObjects", testLogObjectsExists)
 	t.Run("OAuths", testOAuthsExists)
 	t.Run("QueueItems", testQueueItemsExists)
 	t.Run("Refs", testRefsExists)
 	t.Run("Repositories", testRepositoriesExists)
+	t.Run("Runners", testRunnersExists)
 	t.Run("Runs", testRunsExists)
 	t.Run("Sessions", testSessionsExists)
 	t.Run("Submissions", testSubmissionsExists)
 	t.Run("Subscriptions", testSubscriptionsExists)
 	t.Run("Tasks", testTasksExists)
+	t.Run("TestCases", testTestCasesExists)
 	t.Run("UserCapabilities", testUserCapabilitiesExists)
 	t.Run("UserErrors", testUserErrorsExists)
 	t.Run("Users", testUsersExists)
 }
 
 func TestFind(t *testing.T) {
+	t.Run("LiveLogChunks", testLiveLogChunksFind)
+	t.Run("LiveLogs", testLiveLogsFind)
+	t.Run("LogObjects", testLogObjectsFind)
 	t.Run("OAuths", testOAuthsFind)
 	t.Run("QueueItems", testQueueItemsFind)
 	t.Run("Refs", testRefsFind)
 	t.Run("Repositories", testRepositoriesFind)
+	t.Run("Runners", testRunnersFind)
 	t.Run("Runs", testRunsFind)
 	t.Run("Sessions", testSessionsFind)
 	t.Run("Submissions", testSubmissionsFind)
 	t.Run("Subscriptions", testSubscriptionsFind)
 	t.Run("Tasks", testTasksFind)
+	t.Run("TestCases", testTestCasesFind)
 	t.Run("UserCapabilities", testUserCapabilitiesFind)
 	t.Run("UserErrors", testUserErrorsFind)
 	t.Run("Users", testUsersFind)
 }
 
 func TestBind(t *testing.T) {
+	t.Run("LiveLogChunks", testLiveLogChunksBind)
+	t.Run("LiveLogs", testLiveLogsBind)
+	t.Run("LogObjects", testLogObjectsBind)
 	t.Run("OAuths", testOAuthsBind)
 	t.Run("QueueItems", testQueueItemsBind)
 	t.Run("Refs", testRefsBind)
 	t.Run("Repositories", testRepositoriesBind)
+	t.Run("Runners", testRunnersBind)
 	t.Run("Runs", testRunsBind)
 	t.Run("Sessions", testSessionsBind)
 	t.Run("Submissions", testSubmissionsBind)
 	t.Run("Subscriptions", testSubscriptionsBind)
 	t.Run("Tasks", testTasksBind)
+	t.Run("TestCases", testTestCasesBind)
 	t.Run("UserCapabilities", testUserCapabilitiesBind)
 	t.Run("UserErrors", testUserErrorsBind)
 	t.Run("Users", testUsersBind)
 }
 
 func TestOne(t *testing.T) {
+	t.Run("LiveLogChunks", testLiveLogChunksOne)
+	t.Run("LiveLogs", testLiveLogsOne)
+	t.Run("LogObjects", testLogObjectsOne)
 	t.Run("OAuths", testOAuthsOne)
 	t.Run("QueueItems", testQueueItemsOne)
 	t.Run("Refs", testRefsOne)
 	t.Run("Repositories", testRepositoriesOne)
+	t.Run("Runners", testRunnersOne)
 	t.Run("Runs", testRunsOne)
 	t.Run("Sessions", testSessionsOne)
 	t.Run("Submissions", testSubmissionsOne)
 	t.Run("Subscriptions", testSubscriptionsOne)
 	t.Run("Tasks", testTasksOne)
+	t.Run("TestCases", testTestCasesOne)
 	t.Run("UserCapabilities", testUserCapabilitiesOne)
 	t.Run("UserErrors", testUserErrorsOne)
 	t.Run("Users", testUsersOne)
 }
 
 func TestAll(t *testing.T) {
+	t.Run("LiveLogChunks", testLiveLogChunksAll)
+	t.Run("LiveLogs", testLiveLogsAll)
+	t.Run("LogObjects", testLogObjectsAll)
 	t.Run("OAuths", testOAuthsAll)
 	t.Run("QueueItems", testQueueItemsAll)
 	t.Run("Refs", testRefsAll)
 	t.Run("Repositories", testRepositoriesAll)
+	t.Run("Runners", testRunnersAll)
 	t.Run("Runs", testRunsAll)
 	t.Run("Sessions", testSessionsAll)
 	t.Run("Submissions", testSubmissionsAll)
 	t.Run("Subscriptions", testSubscriptionsAll)
 	t.Run("Tasks", testTasksAll)
+	t.Run("TestCases", testTestCasesAll)
 	t.Run("UserCapabilities", testUserCapabilitiesAll)
 	t.Run("UserErrors", testUserErrorsAll)
 	t.Run("Users", testUsersAll)
 }
 
 func TestCount(t *testing.T) {
+	t.Run("LiveLogChunks", testLiveLogChunksCount)
+	t.Run("LiveLogs", testLiveLogsCount)
+	t.Run("LogObjects", testLogObjectsCount)
 	t.Run("OAuths", testOAuthsCount)
 	t.Run("QueueItems", testQueueItemsCount)
 	t.Run("Refs", testRefsCount)
 	t.Run("Repositories", testRepositoriesCount)
+	t.Run("Runners", testRunnersCount)
 	t.Run("Runs", testRunsCount)
 	t.Run("Sessions", testSessionsCount)
 	t.Run("Submissions", testSubmissionsCount)
 	t.Run("Subscriptions", testSubscriptionsCount)
 	t.Run("Tasks", testTasksCount)
+	t.Run("TestCases", testTestCasesCount)
 	t.Run("UserCapabilities", testUserCapabilitiesCount)
 	t.Run("UserErrors", testUserErrorsCount)
 	t.Run("Users", testUsersCount)
 }
 
 func TestHooks(t *testing.T) {
+	t.Run("LiveLogChunks", testLiveLogChunksHooks)
+	t.Run("LiveLogs", testLiveLogsHooks)
+	t.Run("LogObjects", testLogObjectsHooks)
 	t.Run("OAuths", testOAuthsHooks)
 	t.Run("QueueItems", testQueueItemsHooks)
 	t.Run("Refs", testRefsHooks)
 	t.Run("Repositories", testRepositoriesHooks)
+	t.Run("Runners", testRunnersHooks)
 	t.Run("Runs", testRunsHooks)
 	t.Run("Sessions", testSessionsHooks)
 	t.Run("Submissions", testSubmissionsHooks)
 	t.Run("Subscriptions", testSubscriptionsHooks)
 	t.Run("Tasks", testTasksHooks)
+	t.Run("TestCases", testTestCasesHooks)
 	t.Run("UserCapabilities", testUserCapabilitiesHooks)
 	t.Run("UserErrors", testUserErrorsHooks)
 	t.Run("Users", testUsersHooks)
 }
 
 func TestInsert(t *testing.T) {
+	t.Run("LiveLogChunks", testLiveLogChunksInsert)
+	t.Run("LiveLogChunks", testLiveLogChunksInsertWhitelist)
+	t.Run("LiveLogs", testLiveLogsInsert)
+	t.Run("LiveLogs", testLiveLogsInsertWhitelist)
+	t.Run("LogObjects", testLogObjectsInsert)
+	t.Run("LogObjects", testLogObjectsInsertWhitelist)
 	t.Run("OAuths", testOAuthsInsert)
 	t.Run("OAuths", testOAuthsInsertWhitelist)
 	t.Run("QueueItems", testQueueItemsInsert)
@@ -185,6 +246,8 @@ func TestInsert(t *testing.T) {
 	t.Run("Refs", testRefsInsertWhitelist)
 	t.Run("Repositories", testRepositoriesInsert)
 	t.Run("Repositories", testRepositoriesInsertWhitelist)
+	t.Run("Runners", testRunnersInsert)
+	t.Run("Runners", testRunnersInsertWhitelist)
 	t.Run("Runs", testRunsInsert)
 	t.Run("Runs", testRunsInsertWhitelist)
 	t.Run("Sessions", testSessionsInsert)
@@ -194,7 +257,9 @@ func TestInsert(t *testing.T) {
 	t.Run("Subscriptions", testSubscriptionsInsert)
 	t.Run("Subscriptions", testSubscriptionsInsertWhitelist)
 	t.Run("Tasks", testTasksInsert)
+	t.Run("TestCases", testTestCasesInsert)
 	t.Run("Tasks", testTasksInsertWhitelist)
+	t.Run("TestCases", testTestCasesInsertWhitelist)
 	t.Run("UserCapabilities", testUserCapabilitiesInsert)
 	t.Run("UserCapabilities", testUserCapabilitiesInsertWhitelist)
 	t.Run("UserErrors", testUserErrorsInsert)
@@ -206,15 +271,19 @@ func TestInsert(t *testing.T) {
 // TestToOne tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOne(t *testing.T) {
+	t.Run("LiveLogChunkToLiveLogUsingRun", testLiveLogChunkToOneLiveLogUsingRun)
 	t.Run("QueueItemToRunUsingRun", testQueueItemToOneRunUsingRun)
 	t.Run("RefToRepositoryUsingRepository", testRefToOneRepositoryUsingRepository)
 	t.Run("RepositoryToUserUsingOwner", testRepositoryToOneUserUsingOwner)
 	t.Run("RunToTaskUsingTask", testRunToOneTaskUsingTask)
+	t.Run("RunToRunUsingPreviousAttempt", testRunToOneRunUsingPreviousAttempt)
 	t.Run("SubmissionToRefUsingBaseRef", testSubmissionToOneRefUsingBaseRef)
 	t.Run("SubmissionToRefUsingHeadRef", testSubmissionToOneRefUsingHeadRef)
 	t.Run("SubmissionToUserUsingUser", testSubmissionToOneUserUsingUser)
 	t.Run("SubscriptionToUserUsingUser", testSubscriptionToOneUserUsingUser)
 	t.Run("TaskToSubmissionUsingSubmission", testTaskToOneSubmissionUsingSubmission)
+	t.Run("TaskToTaskUsingRerunOf", testTaskToOneTaskUsingRerunOf)
+	t.Run("TestCaseToRunUsingRun", testTestCaseToOneRunUsingRun)
 	t.Run("UserCapabilityToUserUsingUser", testUserCapabilityToOneUserUsingUser)
 	t.Run("UserErrorToUserUsingUser", testUserErrorToOneUserUsingUser)
 }
@@ -228,11 +297,15 @@ func TestOneToOne(t *testing.T) {
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToMany(t *testing.T) {
+	t.Run("LiveLogToRunLiveLogChunks", testLiveLogToManyRunLiveLogChunks)
 	t.Run("RefToBaseRefSubmissions", testRefToManyBaseRefSubmissions)
 	t.Run("RefToHeadRefSubmissions", testRefToManyHeadRefSubmissions)
 	t.Run("RepositoryToRefs", testRepositoryToManyRefs)
+	t.Run("RunToPreviousAttemptRuns", testRunToManyPreviousAttemptRuns)
+	t.Run("RunToTestCases", testRunToManyTestCases)
 	t.Run("SubmissionToTasks", testSubmissionToManyTasks)
 	t.Run("TaskToRuns", testTaskToManyRuns)
+	t.Run("TaskToRerunOfTasks", testTaskToManyRerunOfTasks)
 	t.Run("UserToOwnerRepositories", testUserToManyOwnerRepositories)
 	t.Run("UserToSubmissions", testUserToManySubmissions)
 	t.Run("UserToSubscriptions", testUserToManySubscriptions)
@@ -243,15 +316,19 @@ func TestToMany(t *testing.T) {
 // TestToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOneSet(t *testing.T) {
+	t.Run("LiveLogChunkToLiveLogUsingRunLiveLogChunks", testLiveLogChunkToOneSetOpLiveLogUsingRun)
 	t.Run("QueueItemToRunUsingQueueItem", testQueueItemToOneSetOpRunUsingRun)
 	t.Run("RefToRepositoryUsingRefs", testRefToOneSetOpRepositoryUsingRepository)
 	t.Run("RepositoryToUserUsingOwnerRepositories", testRepositoryToOneSetOpUserUsingOwner)
 	t.Run("RunToTaskUsingRuns", testRunToOneSetOpTaskUsingTask)
+	t.Run("RunToRunUsingPreviousAttemptRuns", testRunToOneSetOpRunUsingPreviousAttempt)
 	t.Run("SubmissionToRefUsingBaseRefSubmissions", testSubmissionToOneSetOpRefUsingBaseRef)
 	t.Run("SubmissionToRefUsingHeadRefSubmissions", testSubmissionToOneSetOpRefUsingHeadRef)
 	t.Run("SubmissionToUserUsingSubmissions", testSubmissionToOneSetOpUserUsingUser)
 	t.Run("SubscriptionToUserUsingSubscriptions", testSubscriptionToOneSetOpUserUsingUser)
 	t.Run("TaskToSubmissionUsingTasks", testTaskToOneSetOpSubmissionUsingSubmission)
+	t.Run("TaskToTaskUsingRerunOfTasks", testTaskToOneSetOpTaskUsingRerunOf)
+	t.Run("TestCaseToRunUsingTestCases", testTestCaseToOneSetOpRunUsingRun)
 	t.Run("UserCapabilityToUserUsingUserCapabilities", testUserCapabilityToOneSetOpUserUsingUser)
 	t.Run("UserErrorToUserUsingUserErrors", testUserErrorToOneSetOpUserUsingUser)
 }
@@ -259,8 +336,10 @@ func TestToOneSet(t *testing.T) {
 // TestToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOneRemove(t *testing.T) {
+	t.Run("RunToRunUsingPreviousAttemptRuns", testRunToOneRemoveOpRunUsingPreviousAttempt)
 	t.Run("SubmissionToRefUsingHeadRefSubmissions", testSubmissionToOneRemoveOpRefUsingHeadRef)
 	t.Run("SubmissionToUserUsingSubmissions", testSubmissionToOneRemoveOpUserUsingUser)
+	t.Run("TaskToTaskUsingRerunOfTasks", testTaskToOneRemoveOpTaskUsingRerunOf)
 }
 
 // TestOneToOneSet tests cannot be run in parallel
@@ -276,11 +355,15 @@ func TestOneToOneRemove(t *testing.T) {}
 // TestToManyAdd tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyAdd(t *testing.T) {
+	t.Run("LiveLogToRunLiveLogChunks", testLiveLogToManyAddOpRunLiveLogChunks)
 	t.Run("RefToBaseRefSubmissions", testRefToManyAddOpBaseRefSubmissions)
 	t.Run("RefToHeadRefSubmissions", testRefToManyAddOpHeadRefSubmissions)
 	t.Run("RepositoryToRefs", testRepositoryToManyAddOpRefs)
+	t.Run("RunToPreviousAttemptRuns", testRunToManyAddOpPreviousAttemptRuns)
+	t.Run("RunToTestCases", testRunToManyAddOpTestCases)
 	t.Run("SubmissionToTasks", testSubmissionToManyAddOpTasks)
 	t.Run("TaskToRuns", testTaskToManyAddOpRuns)
+	t.Run("TaskToRerunOfTasks", testTaskToManyAddOpRerunOfTasks)
 	t.Run("UserToOwnerRepositories", testUserToManyAddOpOwnerRepositories)
 	t.Run("UserToSubmissions", testUserToManyAddOpSubmissions)
 	t.Run("UserToSubscriptions", testUserToManyAddOpSubscriptions)
@@ -292,6 +375,8 @@ func TestToManyAdd(t *testing.T) {
 // or deadlocks can occur.
 func TestToManySet(t *testing.T) {
 	t.Run("RefToHeadRefSubmissions", testRefToManySetOpHeadRefSubmissions)
+	t.Run("RunToPreviousAttemptRuns", testRunToManySetOpPreviousAttemptRuns)
+	t.Run("TaskToRerunOfTasks", testTaskToManySetOpRerunOfTasks)
 	t.Run("UserToSubmissions", testUserToManySetOpSubmissions)
 }
 
@@ -299,79 +384,106 @@ func TestToManySet(t *testing.T) {
 // or deadlocks can occur.
 func TestToManyRemove(t *testing.T) {
 	t.Run("RefToHeadRefSubmissions", testRefToManyRemoveOpHeadRefSubmissions)
+	t.Run("RunToPreviousAttemptRuns", testRunToManyRemoveOpPreviousAttemptRuns)
+	t.Run("TaskToRerunOfTasks", testTaskToManyRemoveOpRerunOfTasks)
 	t.Run("UserToSubmissions", testUserToManyRemoveOpSubmissions)
 }
 
 func TestReload(t *testing.T) {
+	t.Run("LiveLogChunks", testLiveLogChunksReload)
+	t.Run("LiveLogs", testLiveLogsReload)
+	t.Run("LogObjects", testLogObjectsReload)
 	t.Run("OAuths", testOAuthsReload)
 	t.Run("QueueItems", testQueueItemsReload)
 	t.Run("Refs", testRefsReload)
 	t.Run("Repositories", testRepositoriesReload)
+	t.Run("Runners", testRunnersReload)
 	t.Run("Runs", testRunsReload)
 	t.Run("Sessions", testSessionsReload)
 	t.Run("Submissions", testSubmissionsReload)
 	t.Run("Subscriptions", testSubscriptionsReload)
 	t.Run("Tasks", testTasksReload)
+	t.Run("TestCases", testTestCasesReload)
 	t.Run("UserCapabilities", testUserCapabilitiesReload)
 	t.Run("UserErrors", testUserErrorsReload)
 	t.Run("Users", testUsersReload)
 }
 
 func TestReloadAll(t *testing.T) {
+	t.Run("LiveLogChunks", testLiveLogChunksReloadAll)
+	t.Run("LiveLogs", testLiveLogsReloadAll)
+	t.Run("LogObjects", testLogObjectsReloadAll)
 	t.Run("OAuths", testOAuthsReloadAll)
 	t.Run("QueueItems", testQueueItemsReloadAll)
 	t.Run("Refs", testRefsReloadAll)
 	t.Run("Repositories", testRepositoriesReloadAll)
+	t.Run("Runners", testRunnersReloadAll)
 	t.Run("Runs", testRunsReloadAll)
 	t.Run("Sessions", testSessionsReloadAll)
 	t.Run("Submissions", testSubmissionsReloadAll)
 	t.Run("Subscriptions", testSubscriptionsReloadAll)
 	t.Run("Tasks", testTasksReloadAll)
+	t.Run("TestCases", testTestCasesReloadAll)
 	t.Run("UserCapabilities", testUserCapabilitiesReloadAll)
 	t.Run("UserErrors", testUserErrorsReloadAll)
 	t.Run("Users", testUsersReloadAll)
 }
 
 func TestSelect(t *testing.T) {
+	t.Run("LiveLogChunks", testLiveLogChunksSelect)
+	t.Run("LiveLogs", testLiveLogsSelect)
+	t.Run("LogObjects", testLogObjectsSelect)
 	t.Run("OAuths", testOAuthsSelect)
 	t.Run("QueueItems", testQueueItemsSelect)
 	t.Run("Refs", testRefsSelect)
 	t.Run("Repositories", testRepositoriesSelect)
+	t.Run("Runners", testRunnersSelect)
 	t.Run("Runs", testRunsSelect)
 	t.Run("Sessions", testSessionsSelect)
 	t.Run("Submissions", testSubmissionsSelect)
 	t.Run("Subscriptions", testSubscriptionsSelect)
 	t.Run("Tasks", testTasksSelect)
+	t.Run("TestCases", testTestCasesSelect)
 	t.Run("UserCapabilities", testUserCapabilitiesSelect)
 	t.Run("UserErrors", testUserErrorsSelect)
 	t.Run("Users", testUsersSelect)
 }
 
 func TestUpdate(t *testing.T) {
+	t.Run("LiveLogChunks", testLiveLogChunksUpdate)
+	t.Run("LiveLogs", testLiveLogsUpdate)
+	t.Run("LogObjects", testLogObjectsUpdate)
 	t.Run("OAuths", testOAuthsUpdate)
 	t.Run("QueueItems", testQueueItemsUpdate)
 	t.Run("Refs", testRefsUpdate)
 	t.Run("Repositories", testRepositoriesUpdate)
+	t.Run("Runners", testRunnersUpdate)
 	t.Run("Runs", testRunsUpdate)
 	t.Run("Sessions", testSessionsUpdate)
 	t.Run("Submissions", testSubmissionsUpdate)
 	t.Run("Subscriptions", testSubscriptionsUpdate)
 	t.Run("Tasks", testTasksUpdate)
+	t.Run("TestCases", testTestCasesUpdate)
 	t.Run("UserCapabilities", testUserCapabilitiesUpdate)
 	t.Run("UserErrors", testUserErrorsUpdate)
 	t.Run("Users", testUsersUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
+	t.Run("LiveLogChunks", testLiveLogChunksSliceUpdateAll)
+	t.Run("LiveLogs", testLiveLogsSliceUpdateAll)
+	t.Run("LogObjects", testLogObjectsSliceUpdateAll)
 	t.Run("OAuths", testOAuthsSliceUpdateAll)
 	t.Run("QueueItems", testQueueItemsSliceUpdateAll)
 	t.Run("Refs", testRefsSliceUpdateAll)
 	t.Run("Repositories", testRepositoriesSliceUpdateAll)
+	t.Run("Runners", testRunnersSliceUpdateAll)
 	t.Run("Runs", testRunsSliceUpdateAll)
 	t.Run("Sessions", testSessionsSliceUpdateAll)
 	t.Run("Submissions", testSubmissionsSliceUpdateAll)
 	t.Run("Subscriptions", testSubscriptionsSliceUpdateAll)
 	t.Run("Tasks", testTasksSliceUpdateAll)
+	t.Run("TestCases", testTestCasesSliceUpdateAll)
 	t.Run("UserCapabilities", testUserCapabilitiesSliceUpdateAll)
 	t.Run("UserErrors", testUserErrorsSliceUpdateAll)
 	t.Run("Users", testUsersSliceUpdateAll)
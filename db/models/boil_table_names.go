@@ -4,28 +4,38 @@
 package models
 
 var TableNames = struct {
+	LiveLogChunks    string
+	LiveLogs         string
+	LogObjects       string
 	OAuths           string
 	QueueItems       string
 	Refs             string
 	Repositories     string
+	Runners          string
 	Runs             string
 	Sessions         string
 	Submissions      string
 	Subscriptions    string
 	Tasks            string
+	TestCases        string
 	UserCapabilities string
 	UserErrors       string
 	Users            string
 }{
+	LiveLogChunks:    "live_log_chunks",
+	LiveLogs:         "live_logs",
+	LogObjects:       "log_objects",
 	OAuths:           "o_auths",
 	QueueItems:       "queue_items",
 	Refs:             "refs",
 	Repositories:     "repositories",
+	Runners:          "runners",
 	Runs:             "runs",
 	Sessions:         "sessions",
 	Submissions:      "submissions",
 	Subscriptions:    "subscriptions",
 	Tasks:            "tasks",
+	TestCases:        "test_cases",
 	UserCapabilities: "user_capabilities",
 	UserErrors:       "user_errors",
 	Users:            "users",
@@ -0,0 +1,1042 @@
+// Code generated by SQLBoiler 4.5.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// LiveLogChunk is an object representing the database table.
+type LiveLogChunk struct {
+	RunID       int64  `boil:"run_id" json:"run_id" toml:"run_id" yaml:"run_id"`
+	StartOffset int64  `boil:"start_offset" json:"start_offset" toml:"start_offset" yaml:"start_offset"`
+	Chunk       []byte `boil:"chunk" json:"chunk" toml:"chunk" yaml:"chunk"`
+
+	R *liveLogChunkR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L liveLogChunkL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var LiveLogChunkColumns = struct {
+	RunID       string
+	StartOffset string
+	Chunk       string
+}{
+	RunID:       "run_id",
+	StartOffset: "start_offset",
+	Chunk:       "chunk",
+}
+
+// Generated where
+
+var LiveLogChunkWhere = struct {
+	RunID       whereHelperint64
+	StartOffset whereHelperint64
+	Chunk       whereHelper__byte
+}{
+	RunID:       whereHelperint64{field: "\"live_log_chunks\".\"run_id\""},
+	StartOffset: whereHelperint64{field: "\"live_log_chunks\".\"start_offset\""},
+	Chunk:       whereHelper__byte{field: "\"live_log_chunks\".\"chunk\""},
+}
+
+// LiveLogChunkRels is where relationship names are stored.
+var LiveLogChunkRels = struct {
+	Run string
+}{
+	Run: "Run",
+}
+
+// liveLogChunkR is where relationships are stored.
+type liveLogChunkR struct {
+	Run *LiveLog `boil:"Run" json:"Run" toml:"Run" yaml:"Run"`
+}
+
+// NewStruct creates a new relationship struct
+func (*liveLogChunkR) NewStruct() *liveLogChunkR {
+	return &liveLogChunkR{}
+}
+
+// liveLogChunkL is where Load methods for each relationship are stored.
+type liveLogChunkL struct{}
+
+var (
+	liveLogChunkAllColumns            = []string{"run_id", "start_offset", "chunk"}
+	liveLogChunkColumnsWithoutDefault = []string{"run_id", "start_offset", "chunk"}
+	liveLogChunkColumnsWithDefault    = []string{}
+	liveLogChunkPrimaryKeyColumns     = []string{"run_id", "start_offset"}
+)
+
+type (
+	// LiveLogChunkSlice is an alias for a slice of pointers to LiveLogChunk.
+	// This should generally be used opposed to []LiveLogChunk.
+	LiveLogChunkSlice []*LiveLogChunk
+	// LiveLogChunkHook is the signature for custom LiveLogChunk hook methods
+	LiveLogChunkHook func(context.Context, boil.ContextExecutor, *LiveLogChunk) error
+
+	liveLogChunkQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	liveLogChunkType                 = reflect.TypeOf(&LiveLogChunk{})
+	liveLogChunkMapping              = queries.MakeStructMapping(liveLogChunkType)
+	liveLogChunkPrimaryKeyMapping, _ = queries.BindMapping(liveLogChunkType, liveLogChunkMapping, liveLogChunkPrimaryKeyColumns)
+	liveLogChunkInsertCacheMut       sync.RWMutex
+	liveLogChunkInsertCache          = make(map[string]insertCache)
+	liveLogChunkUpdateCacheMut       sync.RWMutex
+	liveLogChunkUpdateCache          = make(map[string]updateCache)
+	liveLogChunkUpsertCacheMut       sync.RWMutex
+	liveLogChunkUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var liveLogChunkBeforeInsertHooks []LiveLogChunkHook
+var liveLogChunkBeforeUpdateHooks []LiveLogChunkHook
+var liveLogChunkBeforeDeleteHooks []LiveLogChunkHook
+var liveLogChunkBeforeUpsertHooks []LiveLogChunkHook
+
+var liveLogChunkAfterInsertHooks []LiveLogChunkHook
+var liveLogChunkAfterSelectHooks []LiveLogChunkHook
+var liveLogChunkAfterUpdateHooks []LiveLogChunkHook
+var liveLogChunkAfterDeleteHooks []LiveLogChunkHook
+var liveLogChunkAfterUpsertHooks []LiveLogChunkHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *LiveLogChunk) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveLogChunkBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *LiveLogChunk) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveLogChunkBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *LiveLogChunk) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveLogChunkBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *LiveLogChunk) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveLogChunkBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *LiveLogChunk) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveLogChunkAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *LiveLogChunk) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveLogChunkAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *LiveLogChunk) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveLogChunkAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *LiveLogChunk) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveLogChunkAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *LiveLogChunk) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveLogChunkAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddLiveLogChunkHook registers your hook function for all future operations.
+func AddLiveLogChunkHook(hookPoint boil.HookPoint, liveLogChunkHook LiveLogChunkHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		liveLogChunkBeforeInsertHooks = append(liveLogChunkBeforeInsertHooks, liveLogChunkHook)
+	case boil.BeforeUpdateHook:
+		liveLogChunkBeforeUpdateHooks = append(liveLogChunkBeforeUpdateHooks, liveLogChunkHook)
+	case boil.BeforeDeleteHook:
+		liveLogChunkBeforeDeleteHooks = append(liveLogChunkBeforeDeleteHooks, liveLogChunkHook)
+	case boil.BeforeUpsertHook:
+		liveLogChunkBeforeUpsertHooks = append(liveLogChunkBeforeUpsertHooks, liveLogChunkHook)
+	case boil.AfterInsertHook:
+		liveLogChunkAfterInsertHooks = append(liveLogChunkAfterInsertHooks, liveLogChunkHook)
+	case boil.AfterSelectHook:
+		liveLogChunkAfterSelectHooks = append(liveLogChunkAfterSelectHooks, liveLogChunkHook)
+	case boil.AfterUpdateHook:
+		liveLogChunkAfterUpdateHooks = append(liveLogChunkAfterUpdateHooks, liveLogChunkHook)
+	case boil.AfterDeleteHook:
+		liveLogChunkAfterDeleteHooks = append(liveLogChunkAfterDeleteHooks, liveLogChunkHook)
+	case boil.AfterUpsertHook:
+		liveLogChunkAfterUpsertHooks = append(liveLogChunkAfterUpsertHooks, liveLogChunkHook)
+	}
+}
+
+// One returns a single liveLogChunk record from the query.
+func (q liveLogChunkQuery) One(ctx context.Context, exec boil.ContextExecutor) (*LiveLogChunk, error) {
+	o := &LiveLogChunk{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for live_log_chunks")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all LiveLogChunk records from the query.
+func (q liveLogChunkQuery) All(ctx context.Context, exec boil.ContextExecutor) (LiveLogChunkSlice, error) {
+	var o []*LiveLogChunk
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to LiveLogChunk slice")
+	}
+
+	if len(liveLogChunkAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all LiveLogChunk records in the query.
+func (q liveLogChunkQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count live_log_chunks rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q liveLogChunkQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if live_log_chunks exists")
+	}
+
+	return count > 0, nil
+}
+
+// Run pointed to by the foreign key.
+func (o *LiveLogChunk) Run(mods ...qm.QueryMod) liveLogQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"run_id\" = ?", o.RunID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := LiveLogs(queryMods...)
+	queries.SetFrom(query.Query, "\"live_logs\"")
+
+	return query
+}
+
+// LoadRun allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (liveLogChunkL) LoadRun(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLiveLogChunk interface{}, mods queries.Applicator) error {
+	var slice []*LiveLogChunk
+	var object *LiveLogChunk
+
+	if singular {
+		object = maybeLiveLogChunk.(*LiveLogChunk)
+	} else {
+		slice = *maybeLiveLogChunk.(*[]*LiveLogChunk)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &liveLogChunkR{}
+		}
+		args = append(args, object.RunID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &liveLogChunkR{}
+			}
+
+			for _, a := range args {
+				if a == obj.RunID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.RunID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`live_logs`),
+		qm.WhereIn(`live_logs.run_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load LiveLog")
+	}
+
+	var resultSlice []*LiveLog
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice LiveLog")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for live_logs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for live_logs")
+	}
+
+	if len(liveLogChunkAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Run = foreign
+		if foreign.R == nil {
+			foreign.R = &liveLogR{}
+		}
+		foreign.R.RunLiveLogChunks = append(foreign.R.RunLiveLogChunks, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.RunID == foreign.RunID {
+				local.R.Run = foreign
+				if foreign.R == nil {
+					foreign.R = &liveLogR{}
+				}
+				foreign.R.RunLiveLogChunks = append(foreign.R.RunLiveLogChunks, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetRun of the liveLogChunk to the related item.
+// Sets o.R.Run to related.
+// Adds o to related.R.RunLiveLogChunks.
+func (o *LiveLogChunk) SetRun(ctx context.Context, exec boil.ContextExecutor, insert bool, related *LiveLog) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"live_log_chunks\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"run_id"}),
+		strmangle.WhereClause("\"", "\"", 2, liveLogChunkPrimaryKeyColumns),
+	)
+	values := []interface{}{related.RunID, o.RunID, o.StartOffset}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.RunID = related.RunID
+	if o.R == nil {
+		o.R = &liveLogChunkR{
+			Run: related,
+		}
+	} else {
+		o.R.Run = related
+	}
+
+	if related.R == nil {
+		related.R = &liveLogR{
+			RunLiveLogChunks: LiveLogChunkSlice{o},
+		}
+	} else {
+		related.R.RunLiveLogChunks = append(related.R.RunLiveLogChunks, o)
+	}
+
+	return nil
+}
+
+// LiveLogChunks retrieves all the records using an executor.
+func LiveLogChunks(mods ...qm.QueryMod) liveLogChunkQuery {
+	mods = append(mods, qm.From("\"live_log_chunks\""))
+	return liveLogChunkQuery{NewQuery(mods...)}
+}
+
+// FindLiveLogChunk retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindLiveLogChunk(ctx context.Context, exec boil.ContextExecutor, runID int64, startOffset int64, selectCols ...string) (*LiveLogChunk, error) {
+	liveLogChunkObj := &LiveLogChunk{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"live_log_chunks\" where \"run_id\"=$1 AND \"start_offset\"=$2", sel,
+	)
+
+	q := queries.Raw(query, runID, startOffset)
+
+	err := q.Bind(ctx, exec, liveLogChunkObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from live_log_chunks")
+	}
+
+	return liveLogChunkObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *LiveLogChunk) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no live_log_chunks provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(liveLogChunkColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	liveLogChunkInsertCacheMut.RLock()
+	cache, cached := liveLogChunkInsertCache[key]
+	liveLogChunkInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			liveLogChunkAllColumns,
+			liveLogChunkColumnsWithDefault,
+			liveLogChunkColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(liveLogChunkType, liveLogChunkMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(liveLogChunkType, liveLogChunkMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"live_log_chunks\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"live_log_chunks\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into live_log_chunks")
+	}
+
+	if !cached {
+		liveLogChunkInsertCacheMut.Lock()
+		liveLogChunkInsertCache[key] = cache
+		liveLogChunkInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the LiveLogChunk.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *LiveLogChunk) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	liveLogChunkUpdateCacheMut.RLock()
+	cache, cached := liveLogChunkUpdateCache[key]
+	liveLogChunkUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			liveLogChunkAllColumns,
+			liveLogChunkPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update live_log_chunks, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"live_log_chunks\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, liveLogChunkPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(liveLogChunkType, liveLogChunkMapping, append(wl, liveLogChunkPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update live_log_chunks row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for live_log_chunks")
+	}
+
+	if !cached {
+		liveLogChunkUpdateCacheMut.Lock()
+		liveLogChunkUpdateCache[key] = cache
+		liveLogChunkUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q liveLogChunkQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for live_log_chunks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for live_log_chunks")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o LiveLogChunkSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), liveLogChunkPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"live_log_chunks\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, liveLogChunkPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in liveLogChunk slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all liveLogChunk")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *LiveLogChunk) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no live_log_chunks provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(liveLogChunkColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	liveLogChunkUpsertCacheMut.RLock()
+	cache, cached := liveLogChunkUpsertCache[key]
+	liveLogChunkUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			liveLogChunkAllColumns,
+			liveLogChunkColumnsWithDefault,
+			liveLogChunkColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			liveLogChunkAllColumns,
+			liveLogChunkPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert live_log_chunks, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(liveLogChunkPrimaryKeyColumns))
+			copy(conflict, liveLogChunkPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"live_log_chunks\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(liveLogChunkType, liveLogChunkMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(liveLogChunkType, liveLogChunkMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert live_log_chunks")
+	}
+
+	if !cached {
+		liveLogChunkUpsertCacheMut.Lock()
+		liveLogChunkUpsertCache[key] = cache
+		liveLogChunkUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single LiveLogChunk record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *LiveLogChunk) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no LiveLogChunk provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), liveLogChunkPrimaryKeyMapping)
+	sql := "DELETE FROM \"live_log_chunks\" WHERE \"run_id\"=$1 AND \"start_offset\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from live_log_chunks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for live_log_chunks")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q liveLogChunkQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no liveLogChunkQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from live_log_chunks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for live_log_chunks")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o LiveLogChunkSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(liveLogChunkBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), liveLogChunkPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"live_log_chunks\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, liveLogChunkPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from liveLogChunk slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for live_log_chunks")
+	}
+
+	if len(liveLogChunkAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *LiveLogChunk) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindLiveLogChunk(ctx, exec, o.RunID, o.StartOffset)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LiveLogChunkSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := LiveLogChunkSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), liveLogChunkPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"live_log_chunks\".* FROM \"live_log_chunks\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, liveLogChunkPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in LiveLogChunkSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// LiveLogChunkExists checks if the LiveLogChunk row exists.
+func LiveLogChunkExists(ctx context.Context, exec boil.ContextExecutor, runID int64, startOffset int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"live_log_chunks\" where \"run_id\"=$1 AND \"start_offset\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, runID, startOffset)
+	}
+	row := exec.QueryRowContext(ctx, sql, runID, startOffset)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if live_log_chunks exists")
+	}
+
+	return exists, nil
+}
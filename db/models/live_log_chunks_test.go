@@ -0,0 +1,837 @@
+// Code generated by SQLBoiler 4.5.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testLiveLogChunks(t *testing.T) {
+	t.Parallel()
+
+	query := LiveLogChunks()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testLiveLogChunksDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LiveLogChunk{}
+	if err = randomize.Struct(seed, o, liveLogChunkDBTypes, true, liveLogChunkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLogChunk struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := LiveLogChunks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLiveLogChunksQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LiveLogChunk{}
+	if err = randomize.Struct(seed, o, liveLogChunkDBTypes, true, liveLogChunkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLogChunk struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := LiveLogChunks().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := LiveLogChunks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLiveLogChunksSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LiveLogChunk{}
+	if err = randomize.Struct(seed, o, liveLogChunkDBTypes, true, liveLogChunkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLogChunk struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := LiveLogChunkSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := LiveLogChunks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLiveLogChunksExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LiveLogChunk{}
+	if err = randomize.Struct(seed, o, liveLogChunkDBTypes, true, liveLogChunkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLogChunk struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := LiveLogChunkExists(ctx, tx, o.RunID, o.StartOffset)
+	if err != nil {
+		t.Errorf("Unable to check if LiveLogChunk exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected LiveLogChunkExists to return true, but got false.")
+	}
+}
+
+func testLiveLogChunksFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LiveLogChunk{}
+	if err = randomize.Struct(seed, o, liveLogChunkDBTypes, true, liveLogChunkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLogChunk struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	liveLogChunkFound, err := FindLiveLogChunk(ctx, tx, o.RunID, o.StartOffset)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if liveLogChunkFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testLiveLogChunksBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LiveLogChunk{}
+	if err = randomize.Struct(seed, o, liveLogChunkDBTypes, true, liveLogChunkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLogChunk struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = LiveLogChunks().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLiveLogChunksOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LiveLogChunk{}
+	if err = randomize.Struct(seed, o, liveLogChunkDBTypes, true, liveLogChunkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLogChunk struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := LiveLogChunks().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testLiveLogChunksAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	liveLogChunkOne := &LiveLogChunk{}
+	liveLogChunkTwo := &LiveLogChunk{}
+	if err = randomize.Struct(seed, liveLogChunkOne, liveLogChunkDBTypes, false, liveLogChunkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLogChunk struct: %s", err)
+	}
+	if err = randomize.Struct(seed, liveLogChunkTwo, liveLogChunkDBTypes, false, liveLogChunkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLogChunk struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = liveLogChunkOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = liveLogChunkTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := LiveLogChunks().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testLiveLogChunksCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	liveLogChunkOne := &LiveLogChunk{}
+	liveLogChunkTwo := &LiveLogChunk{}
+	if err = randomize.Struct(seed, liveLogChunkOne, liveLogChunkDBTypes, false, liveLogChunkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLogChunk struct: %s", err)
+	}
+	if err = randomize.Struct(seed, liveLogChunkTwo, liveLogChunkDBTypes, false, liveLogChunkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLogChunk struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = liveLogChunkOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = liveLogChunkTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LiveLogChunks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func liveLogChunkBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *LiveLogChunk) error {
+	*o = LiveLogChunk{}
+	return nil
+}
+
+func liveLogChunkAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *LiveLogChunk) error {
+	*o = LiveLogChunk{}
+	return nil
+}
+
+func liveLogChunkAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *LiveLogChunk) error {
+	*o = LiveLogChunk{}
+	return nil
+}
+
+func liveLogChunkBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *LiveLogChunk) error {
+	*o = LiveLogChunk{}
+	return nil
+}
+
+func liveLogChunkAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *LiveLogChunk) error {
+	*o = LiveLogChunk{}
+	return nil
+}
+
+func liveLogChunkBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *LiveLogChunk) error {
+	*o = LiveLogChunk{}
+	return nil
+}
+
+func liveLogChunkAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *LiveLogChunk) error {
+	*o = LiveLogChunk{}
+	return nil
+}
+
+func liveLogChunkBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *LiveLogChunk) error {
+	*o = LiveLogChunk{}
+	return nil
+}
+
+func liveLogChunkAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *LiveLogChunk) error {
+	*o = LiveLogChunk{}
+	return nil
+}
+
+func testLiveLogChunksHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &LiveLogChunk{}
+	o := &LiveLogChunk{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, liveLogChunkDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize LiveLogChunk object: %s", err)
+	}
+
+	AddLiveLogChunkHook(boil.BeforeInsertHook, liveLogChunkBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	liveLogChunkBeforeInsertHooks = []LiveLogChunkHook{}
+
+	AddLiveLogChunkHook(boil.AfterInsertHook, liveLogChunkAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	liveLogChunkAfterInsertHooks = []LiveLogChunkHook{}
+
+	AddLiveLogChunkHook(boil.AfterSelectHook, liveLogChunkAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	liveLogChunkAfterSelectHooks = []LiveLogChunkHook{}
+
+	AddLiveLogChunkHook(boil.BeforeUpdateHook, liveLogChunkBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	liveLogChunkBeforeUpdateHooks = []LiveLogChunkHook{}
+
+	AddLiveLogChunkHook(boil.AfterUpdateHook, liveLogChunkAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	liveLogChunkAfterUpdateHooks = []LiveLogChunkHook{}
+
+	AddLiveLogChunkHook(boil.BeforeDeleteHook, liveLogChunkBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	liveLogChunkBeforeDeleteHooks = []LiveLogChunkHook{}
+
+	AddLiveLogChunkHook(boil.AfterDeleteHook, liveLogChunkAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	liveLogChunkAfterDeleteHooks = []LiveLogChunkHook{}
+
+	AddLiveLogChunkHook(boil.BeforeUpsertHook, liveLogChunkBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	liveLogChunkBeforeUpsertHooks = []LiveLogChunkHook{}
+
+	AddLiveLogChunkHook(boil.AfterUpsertHook, liveLogChunkAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	liveLogChunkAfterUpsertHooks = []LiveLogChunkHook{}
+}
+
+func testLiveLogChunksInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LiveLogChunk{}
+	if err = randomize.Struct(seed, o, liveLogChunkDBTypes, true, liveLogChunkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLogChunk struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LiveLogChunks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testLiveLogChunksInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LiveLogChunk{}
+	if err = randomize.Struct(seed, o, liveLogChunkDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize LiveLogChunk struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(liveLogChunkColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LiveLogChunks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testLiveLogChunkToOneLiveLogUsingRun(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local LiveLogChunk
+	var foreign LiveLog
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, liveLogChunkDBTypes, false, liveLogChunkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLogChunk struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, liveLogDBTypes, false, liveLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLog struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.RunID = foreign.RunID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Run().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.RunID != foreign.RunID {
+		t.Errorf("want: %v, got %v", foreign.RunID, check.RunID)
+	}
+
+	slice := LiveLogChunkSlice{&local}
+	if err = local.L.LoadRun(ctx, tx, false, (*[]*LiveLogChunk)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Run == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Run = nil
+	if err = local.L.LoadRun(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Run == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testLiveLogChunkToOneSetOpLiveLogUsingRun(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a LiveLogChunk
+	var b, c LiveLog
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, liveLogChunkDBTypes, false, strmangle.SetComplement(liveLogChunkPrimaryKeyColumns, liveLogChunkColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, liveLogDBTypes, false, strmangle.SetComplement(liveLogPrimaryKeyColumns, liveLogColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, liveLogDBTypes, false, strmangle.SetComplement(liveLogPrimaryKeyColumns, liveLogColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*LiveLog{&b, &c} {
+		err = a.SetRun(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Run != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.RunLiveLogChunks[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.RunID != x.RunID {
+			t.Error("foreign key was wrong value", a.RunID)
+		}
+
+		if exists, err := LiveLogChunkExists(ctx, tx, a.RunID, a.StartOffset); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testLiveLogChunksReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LiveLogChunk{}
+	if err = randomize.Struct(seed, o, liveLogChunkDBTypes, true, liveLogChunkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLogChunk struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLiveLogChunksReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LiveLogChunk{}
+	if err = randomize.Struct(seed, o, liveLogChunkDBTypes, true, liveLogChunkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLogChunk struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := LiveLogChunkSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLiveLogChunksSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LiveLogChunk{}
+	if err = randomize.Struct(seed, o, liveLogChunkDBTypes, true, liveLogChunkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLogChunk struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := LiveLogChunks().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	liveLogChunkDBTypes = map[string]string{`RunID`: `bigint`, `StartOffset`: `bigint`, `Chunk`: `bytea`}
+	_                   = bytes.MinRead
+)
+
+func testLiveLogChunksUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(liveLogChunkPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(liveLogChunkAllColumns) == len(liveLogChunkPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LiveLogChunk{}
+	if err = randomize.Struct(seed, o, liveLogChunkDBTypes, true, liveLogChunkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLogChunk struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LiveLogChunks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, liveLogChunkDBTypes, true, liveLogChunkPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize LiveLogChunk struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testLiveLogChunksSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(liveLogChunkAllColumns) == len(liveLogChunkPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LiveLogChunk{}
+	if err = randomize.Struct(seed, o, liveLogChunkDBTypes, true, liveLogChunkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLogChunk struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LiveLogChunks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, liveLogChunkDBTypes, true, liveLogChunkPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize LiveLogChunk struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(liveLogChunkAllColumns, liveLogChunkPrimaryKeyColumns) {
+		fields = liveLogChunkAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			liveLogChunkAllColumns,
+			liveLogChunkPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := LiveLogChunkSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testLiveLogChunksUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(liveLogChunkAllColumns) == len(liveLogChunkPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := LiveLogChunk{}
+	if err = randomize.Struct(seed, &o, liveLogChunkDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize LiveLogChunk struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert LiveLogChunk: %s", err)
+	}
+
+	count, err := LiveLogChunks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, liveLogChunkDBTypes, false, liveLogChunkPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize LiveLogChunk struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert LiveLogChunk: %s", err)
+	}
+
+	count, err = LiveLogChunks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
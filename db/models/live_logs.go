@@ -0,0 +1,1058 @@
+// Code generated by SQLBoiler 4.5.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// LiveLog is an object representing the database table.
+type LiveLog struct {
+	RunID     int64     `boil:"run_id" json:"run_id" toml:"run_id" yaml:"run_id"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *liveLogR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L liveLogL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var LiveLogColumns = struct {
+	RunID     string
+	CreatedAt string
+}{
+	RunID:     "run_id",
+	CreatedAt: "created_at",
+}
+
+// Generated where
+
+var LiveLogWhere = struct {
+	RunID     whereHelperint64
+	CreatedAt whereHelpertime_Time
+}{
+	RunID:     whereHelperint64{field: "\"live_logs\".\"run_id\""},
+	CreatedAt: whereHelpertime_Time{field: "\"live_logs\".\"created_at\""},
+}
+
+// LiveLogRels is where relationship names are stored.
+var LiveLogRels = struct {
+	RunLiveLogChunks string
+}{
+	RunLiveLogChunks: "RunLiveLogChunks",
+}
+
+// liveLogR is where relationships are stored.
+type liveLogR struct {
+	RunLiveLogChunks LiveLogChunkSlice `boil:"RunLiveLogChunks" json:"RunLiveLogChunks" toml:"RunLiveLogChunks" yaml:"RunLiveLogChunks"`
+}
+
+// NewStruct creates a new relationship struct
+func (*liveLogR) NewStruct() *liveLogR {
+	return &liveLogR{}
+}
+
+// liveLogL is where Load methods for each relationship are stored.
+type liveLogL struct{}
+
+var (
+	liveLogAllColumns            = []string{"run_id", "created_at"}
+	liveLogColumnsWithoutDefault = []string{"run_id"}
+	liveLogColumnsWithDefault    = []string{"created_at"}
+	liveLogPrimaryKeyColumns     = []string{"run_id"}
+)
+
+type (
+	// LiveLogSlice is an alias for a slice of pointers to LiveLog.
+	// This should generally be used opposed to []LiveLog.
+	LiveLogSlice []*LiveLog
+	// LiveLogHook is the signature for custom LiveLog hook methods
+	LiveLogHook func(context.Context, boil.ContextExecutor, *LiveLog) error
+
+	liveLogQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	liveLogType                 = reflect.TypeOf(&LiveLog{})
+	liveLogMapping              = queries.MakeStructMapping(liveLogType)
+	liveLogPrimaryKeyMapping, _ = queries.BindMapping(liveLogType, liveLogMapping, liveLogPrimaryKeyColumns)
+	liveLogInsertCacheMut       sync.RWMutex
+	liveLogInsertCache          = make(map[string]insertCache)
+	liveLogUpdateCacheMut       sync.RWMutex
+	liveLogUpdateCache          = make(map[string]updateCache)
+	liveLogUpsertCacheMut       sync.RWMutex
+	liveLogUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var liveLogBeforeInsertHooks []LiveLogHook
+var liveLogBeforeUpdateHooks []LiveLogHook
+var liveLogBeforeDeleteHooks []LiveLogHook
+var liveLogBeforeUpsertHooks []LiveLogHook
+
+var liveLogAfterInsertHooks []LiveLogHook
+var liveLogAfterSelectHooks []LiveLogHook
+var liveLogAfterUpdateHooks []LiveLogHook
+var liveLogAfterDeleteHooks []LiveLogHook
+var liveLogAfterUpsertHooks []LiveLogHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *LiveLog) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveLogBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *LiveLog) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveLogBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *LiveLog) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveLogBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *LiveLog) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveLogBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *LiveLog) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveLogAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *LiveLog) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveLogAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *LiveLog) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveLogAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *LiveLog) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveLogAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *LiveLog) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveLogAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddLiveLogHook registers your hook function for all future operations.
+func AddLiveLogHook(hookPoint boil.HookPoint, liveLogHook LiveLogHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		liveLogBeforeInsertHooks = append(liveLogBeforeInsertHooks, liveLogHook)
+	case boil.BeforeUpdateHook:
+		liveLogBeforeUpdateHooks = append(liveLogBeforeUpdateHooks, liveLogHook)
+	case boil.BeforeDeleteHook:
+		liveLogBeforeDeleteHooks = append(liveLogBeforeDeleteHooks, liveLogHook)
+	case boil.BeforeUpsertHook:
+		liveLogBeforeUpsertHooks = append(liveLogBeforeUpsertHooks, liveLogHook)
+	case boil.AfterInsertHook:
+		liveLogAfterInsertHooks = append(liveLogAfterInsertHooks, liveLogHook)
+	case boil.AfterSelectHook:
+		liveLogAfterSelectHooks = append(liveLogAfterSelectHooks, liveLogHook)
+	case boil.AfterUpdateHook:
+		liveLogAfterUpdateHooks = append(liveLogAfterUpdateHooks, liveLogHook)
+	case boil.AfterDeleteHook:
+		liveLogAfterDeleteHooks = append(liveLogAfterDeleteHooks, liveLogHook)
+	case boil.AfterUpsertHook:
+		liveLogAfterUpsertHooks = append(liveLogAfterUpsertHooks, liveLogHook)
+	}
+}
+
+// One returns a single liveLog record from the query.
+func (q liveLogQuery) One(ctx context.Context, exec boil.ContextExecutor) (*LiveLog, error) {
+	o := &LiveLog{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for live_logs")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all LiveLog records from the query.
+func (q liveLogQuery) All(ctx context.Context, exec boil.ContextExecutor) (LiveLogSlice, error) {
+	var o []*LiveLog
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to LiveLog slice")
+	}
+
+	if len(liveLogAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all LiveLog records in the query.
+func (q liveLogQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count live_logs rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q liveLogQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if live_logs exists")
+	}
+
+	return count > 0, nil
+}
+
+// RunLiveLogChunks retrieves all the live_log_chunk's LiveLogChunks with an executor via run_id column.
+func (o *LiveLog) RunLiveLogChunks(mods ...qm.QueryMod) liveLogChunkQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"live_log_chunks\".\"run_id\"=?", o.RunID),
+	)
+
+	query := LiveLogChunks(queryMods...)
+	queries.SetFrom(query.Query, "\"live_log_chunks\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"live_log_chunks\".*"})
+	}
+
+	return query
+}
+
+// LoadRunLiveLogChunks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (liveLogL) LoadRunLiveLogChunks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLiveLog interface{}, mods queries.Applicator) error {
+	var slice []*LiveLog
+	var object *LiveLog
+
+	if singular {
+		object = maybeLiveLog.(*LiveLog)
+	} else {
+		slice = *maybeLiveLog.(*[]*LiveLog)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &liveLogR{}
+		}
+		args = append(args, object.RunID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &liveLogR{}
+			}
+
+			for _, a := range args {
+				if a == obj.RunID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.RunID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`live_log_chunks`),
+		qm.WhereIn(`live_log_chunks.run_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load live_log_chunks")
+	}
+
+	var resultSlice []*LiveLogChunk
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice live_log_chunks")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on live_log_chunks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for live_log_chunks")
+	}
+
+	if len(liveLogChunkAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RunLiveLogChunks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &liveLogChunkR{}
+			}
+			foreign.R.Run = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.RunID == foreign.RunID {
+				local.R.RunLiveLogChunks = append(local.R.RunLiveLogChunks, foreign)
+				if foreign.R == nil {
+					foreign.R = &liveLogChunkR{}
+				}
+				foreign.R.Run = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddRunLiveLogChunks adds the given related objects to the existing relationships
+// of the live_log, optionally inserting them as new records.
+// Appends related to o.R.RunLiveLogChunks.
+// Sets related.R.Run appropriately.
+func (o *LiveLog) AddRunLiveLogChunks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*LiveLogChunk) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RunID = o.RunID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"live_log_chunks\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"run_id"}),
+				strmangle.WhereClause("\"", "\"", 2, liveLogChunkPrimaryKeyColumns),
+			)
+			values := []interface{}{o.RunID, rel.RunID, rel.StartOffset}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RunID = o.RunID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &liveLogR{
+			RunLiveLogChunks: related,
+		}
+	} else {
+		o.R.RunLiveLogChunks = append(o.R.RunLiveLogChunks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &liveLogChunkR{
+				Run: o,
+			}
+		} else {
+			rel.R.Run = o
+		}
+	}
+	return nil
+}
+
+// LiveLogs retrieves all the records using an executor.
+func LiveLogs(mods ...qm.QueryMod) liveLogQuery {
+	mods = append(mods, qm.From("\"live_logs\""))
+	return liveLogQuery{NewQuery(mods...)}
+}
+
+// FindLiveLog retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindLiveLog(ctx context.Context, exec boil.ContextExecutor, runID int64, selectCols ...string) (*LiveLog, error) {
+	liveLogObj := &LiveLog{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"live_logs\" where \"run_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, runID)
+
+	err := q.Bind(ctx, exec, liveLogObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from live_logs")
+	}
+
+	return liveLogObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *LiveLog) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no live_logs provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(liveLogColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	liveLogInsertCacheMut.RLock()
+	cache, cached := liveLogInsertCache[key]
+	liveLogInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			liveLogAllColumns,
+			liveLogColumnsWithDefault,
+			liveLogColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(liveLogType, liveLogMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(liveLogType, liveLogMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"live_logs\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"live_logs\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into live_logs")
+	}
+
+	if !cached {
+		liveLogInsertCacheMut.Lock()
+		liveLogInsertCache[key] = cache
+		liveLogInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the LiveLog.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *LiveLog) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	liveLogUpdateCacheMut.RLock()
+	cache, cached := liveLogUpdateCache[key]
+	liveLogUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			liveLogAllColumns,
+			liveLogPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update live_logs, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"live_logs\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, liveLogPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(liveLogType, liveLogMapping, append(wl, liveLogPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update live_logs row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for live_logs")
+	}
+
+	if !cached {
+		liveLogUpdateCacheMut.Lock()
+		liveLogUpdateCache[key] = cache
+		liveLogUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q liveLogQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for live_logs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for live_logs")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o LiveLogSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), liveLogPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"live_logs\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, liveLogPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in liveLog slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all liveLog")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *LiveLog) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no live_logs provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(liveLogColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	liveLogUpsertCacheMut.RLock()
+	cache, cached := liveLogUpsertCache[key]
+	liveLogUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			liveLogAllColumns,
+			liveLogColumnsWithDefault,
+			liveLogColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			liveLogAllColumns,
+			liveLogPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert live_logs, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(liveLogPrimaryKeyColumns))
+			copy(conflict, liveLogPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"live_logs\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(liveLogType, liveLogMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(liveLogType, liveLogMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert live_logs")
+	}
+
+	if !cached {
+		liveLogUpsertCacheMut.Lock()
+		liveLogUpsertCache[key] = cache
+		liveLogUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single LiveLog record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *LiveLog) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no LiveLog provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), liveLogPrimaryKeyMapping)
+	sql := "DELETE FROM \"live_logs\" WHERE \"run_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from live_logs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for live_logs")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q liveLogQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no liveLogQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from live_logs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for live_logs")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o LiveLogSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(liveLogBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), liveLogPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"live_logs\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, liveLogPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from liveLog slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for live_logs")
+	}
+
+	if len(liveLogAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *LiveLog) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindLiveLog(ctx, exec, o.RunID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LiveLogSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := LiveLogSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), liveLogPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"live_logs\".* FROM \"live_logs\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, liveLogPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in LiveLogSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// LiveLogExists checks if the LiveLog row exists.
+func LiveLogExists(ctx context.Context, exec boil.ContextExecutor, runID int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"live_logs\" where \"run_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, runID)
+	}
+	row := exec.QueryRowContext(ctx, sql, runID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if live_logs exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,886 @@
+// Code generated by SQLBoiler 4.5.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testLiveLogs(t *testing.T) {
+	t.Parallel()
+
+	query := LiveLogs()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testLiveLogsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LiveLog{}
+	if err = randomize.Struct(seed, o, liveLogDBTypes, true, liveLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := LiveLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLiveLogsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LiveLog{}
+	if err = randomize.Struct(seed, o, liveLogDBTypes, true, liveLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := LiveLogs().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := LiveLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLiveLogsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LiveLog{}
+	if err = randomize.Struct(seed, o, liveLogDBTypes, true, liveLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := LiveLogSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := LiveLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLiveLogsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LiveLog{}
+	if err = randomize.Struct(seed, o, liveLogDBTypes, true, liveLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := LiveLogExists(ctx, tx, o.RunID)
+	if err != nil {
+		t.Errorf("Unable to check if LiveLog exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected LiveLogExists to return true, but got false.")
+	}
+}
+
+func testLiveLogsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LiveLog{}
+	if err = randomize.Struct(seed, o, liveLogDBTypes, true, liveLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	liveLogFound, err := FindLiveLog(ctx, tx, o.RunID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if liveLogFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testLiveLogsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LiveLog{}
+	if err = randomize.Struct(seed, o, liveLogDBTypes, true, liveLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = LiveLogs().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLiveLogsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LiveLog{}
+	if err = randomize.Struct(seed, o, liveLogDBTypes, true, liveLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := LiveLogs().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testLiveLogsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	liveLogOne := &LiveLog{}
+	liveLogTwo := &LiveLog{}
+	if err = randomize.Struct(seed, liveLogOne, liveLogDBTypes, false, liveLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLog struct: %s", err)
+	}
+	if err = randomize.Struct(seed, liveLogTwo, liveLogDBTypes, false, liveLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = liveLogOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = liveLogTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := LiveLogs().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testLiveLogsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	liveLogOne := &LiveLog{}
+	liveLogTwo := &LiveLog{}
+	if err = randomize.Struct(seed, liveLogOne, liveLogDBTypes, false, liveLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLog struct: %s", err)
+	}
+	if err = randomize.Struct(seed, liveLogTwo, liveLogDBTypes, false, liveLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = liveLogOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = liveLogTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LiveLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func liveLogBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *LiveLog) error {
+	*o = LiveLog{}
+	return nil
+}
+
+func liveLogAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *LiveLog) error {
+	*o = LiveLog{}
+	return nil
+}
+
+func liveLogAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *LiveLog) error {
+	*o = LiveLog{}
+	return nil
+}
+
+func liveLogBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *LiveLog) error {
+	*o = LiveLog{}
+	return nil
+}
+
+func liveLogAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *LiveLog) error {
+	*o = LiveLog{}
+	return nil
+}
+
+func liveLogBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *LiveLog) error {
+	*o = LiveLog{}
+	return nil
+}
+
+func liveLogAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *LiveLog) error {
+	*o = LiveLog{}
+	return nil
+}
+
+func liveLogBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *LiveLog) error {
+	*o = LiveLog{}
+	return nil
+}
+
+func liveLogAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *LiveLog) error {
+	*o = LiveLog{}
+	return nil
+}
+
+func testLiveLogsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &LiveLog{}
+	o := &LiveLog{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, liveLogDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize LiveLog object: %s", err)
+	}
+
+	AddLiveLogHook(boil.BeforeInsertHook, liveLogBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	liveLogBeforeInsertHooks = []LiveLogHook{}
+
+	AddLiveLogHook(boil.AfterInsertHook, liveLogAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	liveLogAfterInsertHooks = []LiveLogHook{}
+
+	AddLiveLogHook(boil.AfterSelectHook, liveLogAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	liveLogAfterSelectHooks = []LiveLogHook{}
+
+	AddLiveLogHook(boil.BeforeUpdateHook, liveLogBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	liveLogBeforeUpdateHooks = []LiveLogHook{}
+
+	AddLiveLogHook(boil.AfterUpdateHook, liveLogAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	liveLogAfterUpdateHooks = []LiveLogHook{}
+
+	AddLiveLogHook(boil.BeforeDeleteHook, liveLogBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	liveLogBeforeDeleteHooks = []LiveLogHook{}
+
+	AddLiveLogHook(boil.AfterDeleteHook, liveLogAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	liveLogAfterDeleteHooks = []LiveLogHook{}
+
+	AddLiveLogHook(boil.BeforeUpsertHook, liveLogBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	liveLogBeforeUpsertHooks = []LiveLogHook{}
+
+	AddLiveLogHook(boil.AfterUpsertHook, liveLogAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	liveLogAfterUpsertHooks = []LiveLogHook{}
+}
+
+func testLiveLogsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LiveLog{}
+	if err = randomize.Struct(seed, o, liveLogDBTypes, true, liveLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LiveLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testLiveLogsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LiveLog{}
+	if err = randomize.Struct(seed, o, liveLogDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize LiveLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(liveLogColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LiveLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testLiveLogToManyRunLiveLogChunks(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a LiveLog
+	var b, c LiveLogChunk
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, liveLogDBTypes, true, liveLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLog struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, liveLogChunkDBTypes, false, liveLogChunkColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, liveLogChunkDBTypes, false, liveLogChunkColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.RunID = a.RunID
+	c.RunID = a.RunID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.RunLiveLogChunks().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.RunID == b.RunID {
+			bFound = true
+		}
+		if v.RunID == c.RunID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := LiveLogSlice{&a}
+	if err = a.L.LoadRunLiveLogChunks(ctx, tx, false, (*[]*LiveLog)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.RunLiveLogChunks); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.RunLiveLogChunks = nil
+	if err = a.L.LoadRunLiveLogChunks(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.RunLiveLogChunks); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testLiveLogToManyAddOpRunLiveLogChunks(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a LiveLog
+	var b, c, d, e LiveLogChunk
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, liveLogDBTypes, false, strmangle.SetComplement(liveLogPrimaryKeyColumns, liveLogColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*LiveLogChunk{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, liveLogChunkDBTypes, false, strmangle.SetComplement(liveLogChunkPrimaryKeyColumns, liveLogChunkColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*LiveLogChunk{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddRunLiveLogChunks(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.RunID != first.RunID {
+			t.Error("foreign key was wrong value", a.RunID, first.RunID)
+		}
+		if a.RunID != second.RunID {
+			t.Error("foreign key was wrong value", a.RunID, second.RunID)
+		}
+
+		if first.R.Run != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Run != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.RunLiveLogChunks[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.RunLiveLogChunks[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.RunLiveLogChunks().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testLiveLogsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LiveLog{}
+	if err = randomize.Struct(seed, o, liveLogDBTypes, true, liveLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLiveLogsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LiveLog{}
+	if err = randomize.Struct(seed, o, liveLogDBTypes, true, liveLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := LiveLogSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLiveLogsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LiveLog{}
+	if err = randomize.Struct(seed, o, liveLogDBTypes, true, liveLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := LiveLogs().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	liveLogDBTypes = map[string]string{`RunID`: `bigint`, `CreatedAt`: `timestamp with time zone`}
+	_              = bytes.MinRead
+)
+
+func testLiveLogsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(liveLogPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(liveLogAllColumns) == len(liveLogPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LiveLog{}
+	if err = randomize.Struct(seed, o, liveLogDBTypes, true, liveLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LiveLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, liveLogDBTypes, true, liveLogPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize LiveLog struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testLiveLogsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(liveLogAllColumns) == len(liveLogPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LiveLog{}
+	if err = randomize.Struct(seed, o, liveLogDBTypes, true, liveLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LiveLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LiveLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, liveLogDBTypes, true, liveLogPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize LiveLog struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(liveLogAllColumns, liveLogPrimaryKeyColumns) {
+		fields = liveLogAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			liveLogAllColumns,
+			liveLogPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := LiveLogSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testLiveLogsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(liveLogAllColumns) == len(liveLogPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := LiveLog{}
+	if err = randomize.Struct(seed, &o, liveLogDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize LiveLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert LiveLog: %s", err)
+	}
+
+	count, err := LiveLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, liveLogDBTypes, false, liveLogPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize LiveLog struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert LiveLog: %s", err)
+	}
+
+	count, err = LiveLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,898 @@
+// Code generated by SQLBoiler 4.5.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// LogObject is an object representing the database table.
+type LogObject struct {
+	RunID        int64      `boil:"run_id" json:"run_id" toml:"run_id" yaml:"run_id"`
+	ObjectID     int64      `boil:"object_id" json:"object_id" toml:"object_id" yaml:"object_id"`
+	CreatedAt    time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	Size         int64      `boil:"size" json:"size" toml:"size" yaml:"size"`
+	SectionIndex null.Bytes `boil:"section_index" json:"section_index,omitempty" toml:"section_index" yaml:"section_index,omitempty"`
+
+	R *logObjectR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L logObjectL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var LogObjectColumns = struct {
+	RunID        string
+	ObjectID     string
+	CreatedAt    string
+	Size         string
+	SectionIndex string
+}{
+	RunID:        "run_id",
+	ObjectID:     "object_id",
+	CreatedAt:    "created_at",
+	Size:         "size",
+	SectionIndex: "section_index",
+}
+
+// Generated where
+
+var LogObjectWhere = struct {
+	RunID        whereHelperint64
+	ObjectID     whereHelperint64
+	CreatedAt    whereHelpertime_Time
+	Size         whereHelperint64
+	SectionIndex whereHelpernull_Bytes
+}{
+	RunID:        whereHelperint64{field: "\"log_objects\".\"run_id\""},
+	ObjectID:     whereHelperint64{field: "\"log_objects\".\"object_id\""},
+	CreatedAt:    whereHelpertime_Time{field: "\"log_objects\".\"created_at\""},
+	Size:         whereHelperint64{field: "\"log_objects\".\"size\""},
+	SectionIndex: whereHelpernull_Bytes{field: "\"log_objects\".\"section_index\""},
+}
+
+// LogObjectRels is where relationship names are stored.
+var LogObjectRels = struct {
+}{}
+
+// logObjectR is where relationships are stored.
+type logObjectR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*logObjectR) NewStruct() *logObjectR {
+	return &logObjectR{}
+}
+
+// logObjectL is where Load methods for each relationship are stored.
+type logObjectL struct{}
+
+var (
+	logObjectAllColumns            = []string{"run_id", "object_id", "created_at", "size", "section_index"}
+	logObjectColumnsWithoutDefault = []string{"run_id", "object_id", "section_index"}
+	logObjectColumnsWithDefault    = []string{"created_at", "size"}
+	logObjectPrimaryKeyColumns     = []string{"run_id"}
+)
+
+type (
+	// LogObjectSlice is an alias for a slice of pointers to LogObject.
+	// This should generally be used opposed to []LogObject.
+	LogObjectSlice []*LogObject
+	// LogObjectHook is the signature for custom LogObject hook methods
+	LogObjectHook func(context.Context, boil.ContextExecutor, *LogObject) error
+
+	logObjectQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	logObjectType                 = reflect.TypeOf(&LogObject{})
+	logObjectMapping              = queries.MakeStructMapping(logObjectType)
+	logObjectPrimaryKeyMapping, _ = queries.BindMapping(logObjectType, logObjectMapping, logObjectPrimaryKeyColumns)
+	logObjectInsertCacheMut       sync.RWMutex
+	logObjectInsertCache          = make(map[string]insertCache)
+	logObjectUpdateCacheMut       sync.RWMutex
+	logObjectUpdateCache          = make(map[string]updateCache)
+	logObjectUpsertCacheMut       sync.RWMutex
+	logObjectUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var logObjectBeforeInsertHooks []LogObjectHook
+var logObjectBeforeUpdateHooks []LogObjectHook
+var logObjectBeforeDeleteHooks []LogObjectHook
+var logObjectBeforeUpsertHooks []LogObjectHook
+
+var logObjectAfterInsertHooks []LogObjectHook
+var logObjectAfterSelectHooks []LogObjectHook
+var logObjectAfterUpdateHooks []LogObjectHook
+var logObjectAfterDeleteHooks []LogObjectHook
+var logObjectAfterUpsertHooks []LogObjectHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *LogObject) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range logObjectBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *LogObject) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range logObjectBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *LogObject) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range logObjectBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *LogObject) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range logObjectBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *LogObject) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range logObjectAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *LogObject) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range logObjectAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *LogObject) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range logObjectAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *LogObject) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range logObjectAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *LogObject) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range logObjectAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddLogObjectHook registers your hook function for all future operations.
+func AddLogObjectHook(hookPoint boil.HookPoint, logObjectHook LogObjectHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		logObjectBeforeInsertHooks = append(logObjectBeforeInsertHooks, logObjectHook)
+	case boil.BeforeUpdateHook:
+		logObjectBeforeUpdateHooks = append(logObjectBeforeUpdateHooks, logObjectHook)
+	case boil.BeforeDeleteHook:
+		logObjectBeforeDeleteHooks = append(logObjectBeforeDeleteHooks, logObjectHook)
+	case boil.BeforeUpsertHook:
+		logObjectBeforeUpsertHooks = append(logObjectBeforeUpsertHooks, logObjectHook)
+	case boil.AfterInsertHook:
+		logObjectAfterInsertHooks = append(logObjectAfterInsertHooks, logObjectHook)
+	case boil.AfterSelectHook:
+		logObjectAfterSelectHooks = append(logObjectAfterSelectHooks, logObjectHook)
+	case boil.AfterUpdateHook:
+		logObjectAfterUpdateHooks = append(logObjectAfterUpdateHooks, logObjectHook)
+	case boil.AfterDeleteHook:
+		logObjectAfterDeleteHooks = append(logObjectAfterDeleteHooks, logObjectHook)
+	case boil.AfterUpsertHook:
+		logObjectAfterUpsertHooks = append(logObjectAfterUpsertHooks, logObjectHook)
+	}
+}
+
+// One returns a single logObject record from the query.
+func (q logObjectQuery) One(ctx context.Context, exec boil.ContextExecutor) (*LogObject, error) {
+	o := &LogObject{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for log_objects")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all LogObject records from the query.
+func (q logObjectQuery) All(ctx context.Context, exec boil.ContextExecutor) (LogObjectSlice, error) {
+	var o []*LogObject
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to LogObject slice")
+	}
+
+	if len(logObjectAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all LogObject records in the query.
+func (q logObjectQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count log_objects rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q logObjectQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if log_objects exists")
+	}
+
+	return count > 0, nil
+}
+
+// LogObjects retrieves all the records using an executor.
+func LogObjects(mods ...qm.QueryMod) logObjectQuery {
+	mods = append(mods, qm.From("\"log_objects\""))
+	return logObjectQuery{NewQuery(mods...)}
+}
+
+// FindLogObject retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindLogObject(ctx context.Context, exec boil.ContextExecutor, runID int64, selectCols ...string) (*LogObject, error) {
+	logObjectObj := &LogObject{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"log_objects\" where \"run_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, runID)
+
+	err := q.Bind(ctx, exec, logObjectObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from log_objects")
+	}
+
+	return logObjectObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *LogObject) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no log_objects provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(logObjectColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	logObjectInsertCacheMut.RLock()
+	cache, cached := logObjectInsertCache[key]
+	logObjectInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			logObjectAllColumns,
+			logObjectColumnsWithDefault,
+			logObjectColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(logObjectType, logObjectMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(logObjectType, logObjectMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"log_objects\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"log_objects\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into log_objects")
+	}
+
+	if !cached {
+		logObjectInsertCacheMut.Lock()
+		logObjectInsertCache[key] = cache
+		logObjectInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the LogObject.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *LogObject) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	logObjectUpdateCacheMut.RLock()
+	cache, cached := logObjectUpdateCache[key]
+	logObjectUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			logObjectAllColumns,
+			logObjectPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update log_objects, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"log_objects\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, logObjectPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(logObjectType, logObjectMapping, append(wl, logObjectPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update log_objects row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for log_objects")
+	}
+
+	if !cached {
+		logObjectUpdateCacheMut.Lock()
+		logObjectUpdateCache[key] = cache
+		logObjectUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q logObjectQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for log_objects")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for log_objects")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o LogObjectSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), logObjectPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"log_objects\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, logObjectPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in logObject slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all logObject")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *LogObject) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no log_objects provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(logObjectColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	logObjectUpsertCacheMut.RLock()
+	cache, cached := logObjectUpsertCache[key]
+	logObjectUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			logObjectAllColumns,
+			logObjectColumnsWithDefault,
+			logObjectColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			logObjectAllColumns,
+			logObjectPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert log_objects, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(logObjectPrimaryKeyColumns))
+			copy(conflict, logObjectPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"log_objects\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(logObjectType, logObjectMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(logObjectType, logObjectMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert log_objects")
+	}
+
+	if !cached {
+		logObjectUpsertCacheMut.Lock()
+		logObjectUpsertCache[key] = cache
+		logObjectUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single LogObject record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *LogObject) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no LogObject provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), logObjectPrimaryKeyMapping)
+	sql := "DELETE FROM \"log_objects\" WHERE \"run_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from log_objects")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for log_objects")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q logObjectQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no logObjectQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from log_objects")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for log_objects")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o LogObjectSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(logObjectBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), logObjectPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"log_objects\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, logObjectPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from logObject slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for log_objects")
+	}
+
+	if len(logObjectAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *LogObject) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindLogObject(ctx, exec, o.RunID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LogObjectSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := LogObjectSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), logObjectPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"log_objects\".* FROM \"log_objects\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, logObjectPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in LogObjectSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// LogObjectExists checks if the LogObject row exists.
+func LogObjectExists(ctx context.Context, exec boil.ContextExecutor, runID int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"log_objects\" where \"run_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, runID)
+	}
+	row := exec.QueryRowContext(ctx, sql, runID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if log_objects exists")
+	}
+
+	return exists, nil
+}
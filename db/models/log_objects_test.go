@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.5.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testLogObjects(t *testing.T) {
+	t.Parallel()
+
+	query := LogObjects()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testLogObjectsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LogObject{}
+	if err = randomize.Struct(seed, o, logObjectDBTypes, true, logObjectColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LogObject struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := LogObjects().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLogObjectsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LogObject{}
+	if err = randomize.Struct(seed, o, logObjectDBTypes, true, logObjectColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LogObject struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := LogObjects().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := LogObjects().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLogObjectsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LogObject{}
+	if err = randomize.Struct(seed, o, logObjectDBTypes, true, logObjectColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LogObject struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := LogObjectSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := LogObjects().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLogObjectsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LogObject{}
+	if err = randomize.Struct(seed, o, logObjectDBTypes, true, logObjectColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LogObject struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := LogObjectExists(ctx, tx, o.RunID)
+	if err != nil {
+		t.Errorf("Unable to check if LogObject exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected LogObjectExists to return true, but got false.")
+	}
+}
+
+func testLogObjectsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LogObject{}
+	if err = randomize.Struct(seed, o, logObjectDBTypes, true, logObjectColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LogObject struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	logObjectFound, err := FindLogObject(ctx, tx, o.RunID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if logObjectFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testLogObjectsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LogObject{}
+	if err = randomize.Struct(seed, o, logObjectDBTypes, true, logObjectColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LogObject struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = LogObjects().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLogObjectsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LogObject{}
+	if err = randomize.Struct(seed, o, logObjectDBTypes, true, logObjectColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LogObject struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := LogObjects().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testLogObjectsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	logObjectOne := &LogObject{}
+	logObjectTwo := &LogObject{}
+	if err = randomize.Struct(seed, logObjectOne, logObjectDBTypes, false, logObjectColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LogObject struct: %s", err)
+	}
+	if err = randomize.Struct(seed, logObjectTwo, logObjectDBTypes, false, logObjectColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LogObject struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = logObjectOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = logObjectTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := LogObjects().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testLogObjectsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	logObjectOne := &LogObject{}
+	logObjectTwo := &LogObject{}
+	if err = randomize.Struct(seed, logObjectOne, logObjectDBTypes, false, logObjectColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LogObject struct: %s", err)
+	}
+	if err = randomize.Struct(seed, logObjectTwo, logObjectDBTypes, false, logObjectColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LogObject struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = logObjectOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = logObjectTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LogObjects().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func logObjectBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *LogObject) error {
+	*o = LogObject{}
+	return nil
+}
+
+func logObjectAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *LogObject) error {
+	*o = LogObject{}
+	return nil
+}
+
+func logObjectAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *LogObject) error {
+	*o = LogObject{}
+	return nil
+}
+
+func logObjectBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *LogObject) error {
+	*o = LogObject{}
+	return nil
+}
+
+func logObjectAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *LogObject) error {
+	*o = LogObject{}
+	return nil
+}
+
+func logObjectBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *LogObject) error {
+	*o = LogObject{}
+	return nil
+}
+
+func logObjectAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *LogObject) error {
+	*o = LogObject{}
+	return nil
+}
+
+func logObjectBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *LogObject) error {
+	*o = LogObject{}
+	return nil
+}
+
+func logObjectAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *LogObject) error {
+	*o = LogObject{}
+	return nil
+}
+
+func testLogObjectsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &LogObject{}
+	o := &LogObject{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, logObjectDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize LogObject object: %s", err)
+	}
+
+	AddLogObjectHook(boil.BeforeInsertHook, logObjectBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	logObjectBeforeInsertHooks = []LogObjectHook{}
+
+	AddLogObjectHook(boil.AfterInsertHook, logObjectAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	logObjectAfterInsertHooks = []LogObjectHook{}
+
+	AddLogObjectHook(boil.AfterSelectHook, logObjectAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	logObjectAfterSelectHooks = []LogObjectHook{}
+
+	AddLogObjectHook(boil.BeforeUpdateHook, logObjectBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	logObjectBeforeUpdateHooks = []LogObjectHook{}
+
+	AddLogObjectHook(boil.AfterUpdateHook, logObjectAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	logObjectAfterUpdateHooks = []LogObjectHook{}
+
+	AddLogObjectHook(boil.BeforeDeleteHook, logObjectBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	logObjectBeforeDeleteHooks = []LogObjectHook{}
+
+	AddLogObjectHook(boil.AfterDeleteHook, logObjectAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	logObjectAfterDeleteHooks = []LogObjectHook{}
+
+	AddLogObjectHook(boil.BeforeUpsertHook, logObjectBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	logObjectBeforeUpsertHooks = []LogObjectHook{}
+
+	AddLogObjectHook(boil.AfterUpsertHook, logObjectAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	logObjectAfterUpsertHooks = []LogObjectHook{}
+}
+
+func testLogObjectsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LogObject{}
+	if err = randomize.Struct(seed, o, logObjectDBTypes, true, logObjectColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LogObject struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LogObjects().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testLogObjectsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LogObject{}
+	if err = randomize.Struct(seed, o, logObjectDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize LogObject struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(logObjectColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LogObjects().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testLogObjectsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LogObject{}
+	if err = randomize.Struct(seed, o, logObjectDBTypes, true, logObjectColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LogObject struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLogObjectsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LogObject{}
+	if err = randomize.Struct(seed, o, logObjectDBTypes, true, logObjectColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LogObject struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := LogObjectSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLogObjectsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LogObject{}
+	if err = randomize.Struct(seed, o, logObjectDBTypes, true, logObjectColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LogObject struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := LogObjects().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	logObjectDBTypes = map[string]string{`RunID`: `bigint`, `ObjectID`: `bigint`, `CreatedAt`: `timestamp with time zone`, `Size`: `bigint`, `SectionIndex`: `bytea`}
+	_                = bytes.MinRead
+)
+
+func testLogObjectsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(logObjectPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(logObjectAllColumns) == len(logObjectPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LogObject{}
+	if err = randomize.Struct(seed, o, logObjectDBTypes, true, logObjectColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LogObject struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LogObjects().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, logObjectDBTypes, true, logObjectPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize LogObject struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testLogObjectsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(logObjectAllColumns) == len(logObjectPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LogObject{}
+	if err = randomize.Struct(seed, o, logObjectDBTypes, true, logObjectColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LogObject struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LogObjects().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, logObjectDBTypes, true, logObjectPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize LogObject struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(logObjectAllColumns, logObjectPrimaryKeyColumns) {
+		fields = logObjectAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			logObjectAllColumns,
+			logObjectPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := LogObjectSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testLogObjectsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(logObjectAllColumns) == len(logObjectPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := LogObject{}
+	if err = randomize.Struct(seed, &o, logObjectDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize LogObject struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert LogObject: %s", err)
+	}
+
+	count, err := LogObjects().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, logObjectDBTypes, false, logObjectPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize LogObject struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert LogObject: %s", err)
+	}
+
+	count, err = LogObjects().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
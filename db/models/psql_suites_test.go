@@ -6,6 +6,8 @@ package models
 import "testing"
 
 func TestUpsert(t *testing.T) {
+	t.Run("LogObjects", testLogObjectsUpsert)
+
 	t.Run("OAuths", testOAuthsUpsert)
 
 	t.Run("QueueItems", testQueueItemsUpsert)
@@ -14,6 +16,8 @@ func TestUpsert(t *testing.T) {
 
 	t.Run("Repositories", testRepositoriesUpsert)
 
+	t.Run("Runners", testRunnersUpsert)
+
 	t.Run("Runs", testRunsUpsert)
 
 	t.Run("Sessions", testSessionsUpsert)
@@ -24,6 +28,8 @@ func TestUpsert(t *testing.T) {
 
 	t.Run("Tasks", testTasksUpsert)
 
+	t.Run("TestCases", testTestCasesUpsert)
+
 	t.Run("UserCapabilities", testUserCapabilitiesUpsert)
 
 	t.Run("UserErrors", testUserErrorsUpsert)
@@ -24,31 +24,43 @@ import (
 
 // QueueItem is an object representing the database table.
 type QueueItem struct {
-	ID        int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
-	RunID     int64       `boil:"run_id" json:"run_id" toml:"run_id" yaml:"run_id"`
-	Running   bool        `boil:"running" json:"running" toml:"running" yaml:"running"`
-	RunningOn null.String `boil:"running_on" json:"running_on,omitempty" toml:"running_on" yaml:"running_on,omitempty"`
-	QueueName string      `boil:"queue_name" json:"queue_name" toml:"queue_name" yaml:"queue_name"`
-	StartedAt null.Time   `boil:"started_at" json:"started_at,omitempty" toml:"started_at" yaml:"started_at,omitempty"`
+	ID          int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	RunID       int64       `boil:"run_id" json:"run_id" toml:"run_id" yaml:"run_id"`
+	Running     bool        `boil:"running" json:"running" toml:"running" yaml:"running"`
+	RunningOn   null.String `boil:"running_on" json:"running_on,omitempty" toml:"running_on" yaml:"running_on,omitempty"`
+	QueueName   string      `boil:"queue_name" json:"queue_name" toml:"queue_name" yaml:"queue_name"`
+	StartedAt   null.Time   `boil:"started_at" json:"started_at,omitempty" toml:"started_at" yaml:"started_at,omitempty"`
+	Priority    int         `boil:"priority" json:"priority" toml:"priority" yaml:"priority"`
+	Requeues    int         `boil:"requeues" json:"requeues" toml:"requeues" yaml:"requeues"`
+	QueuedAt    time.Time   `boil:"queued_at" json:"queued_at" toml:"queued_at" yaml:"queued_at"`
+	ClaimableAt null.Time   `boil:"claimable_at" json:"claimable_at,omitempty" toml:"claimable_at" yaml:"claimable_at,omitempty"`
 
 	R *queueItemR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L queueItemL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var QueueItemColumns = struct {
-	ID        string
-	RunID     string
-	Running   string
-	RunningOn string
-	QueueName string
-	StartedAt string
+	ID          string
+	RunID       string
+	Running     string
+	RunningOn   string
+	QueueName   string
+	StartedAt   string
+	Priority    string
+	Requeues    string
+	QueuedAt    string
+	ClaimableAt string
 }{
-	ID:        "id",
-	RunID:     "run_id",
-	Running:   "running",
-	RunningOn: "running_on",
-	QueueName: "queue_name",
-	StartedAt: "started_at",
+	ID:          "id",
+	RunID:       "run_id",
+	Running:     "running",
+	RunningOn:   "running_on",
+	QueueName:   "queue_name",
+	StartedAt:   "started_at",
+	Priority:    "priority",
+	Requeues:    "requeues",
+	QueuedAt:    "queued_at",
+	ClaimableAt: "claimable_at",
 }
 
 // Generated where
@@ -131,20 +143,49 @@ func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
 
+type whereHelperint struct{ field string }
+
+func (w whereHelperint) EQ(x int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperint) NEQ(x int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperint) LT(x int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperint) LTE(x int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperint) GT(x int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperint) GTE(x int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
 var QueueItemWhere = struct {
-	ID        whereHelperint64
-	RunID     whereHelperint64
-	Running   whereHelperbool
-	RunningOn whereHelpernull_String
-	QueueName whereHelperstring
-	StartedAt whereHelpernull_Time
+	ID          whereHelperint64
+	RunID       whereHelperint64
+	Running     whereHelperbool
+	RunningOn   whereHelpernull_String
+	QueueName   whereHelperstring
+	StartedAt   whereHelpernull_Time
+	Priority    whereHelperint
+	Requeues    whereHelperint
+	QueuedAt    whereHelpertime_Time
+	ClaimableAt whereHelpernull_Time
 }{
-	ID:        whereHelperint64{field: "\"queue_items\".\"id\""},
-	RunID:     whereHelperint64{field: "\"queue_items\".\"run_id\""},
-	Running:   whereHelperbool{field: "\"queue_items\".\"running\""},
-	RunningOn: whereHelpernull_String{field: "\"queue_items\".\"running_on\""},
-	QueueName: whereHelperstring{field: "\"queue_items\".\"queue_name\""},
-	StartedAt: whereHelpernull_Time{field: "\"queue_items\".\"started_at\""},
+	ID:          whereHelperint64{field: "\"queue_items\".\"id\""},
+	RunID:       whereHelperint64{field: "\"queue_items\".\"run_id\""},
+	Running:     whereHelperbool{field: "\"queue_items\".\"running\""},
+	RunningOn:   whereHelpernull_String{field: "\"queue_items\".\"running_on\""},
+	QueueName:   whereHelperstring{field: "\"queue_items\".\"queue_name\""},
+	StartedAt:   whereHelpernull_Time{field: "\"queue_items\".\"started_at\""},
+	Priority:    whereHelperint{field: "\"queue_items\".\"priority\""},
+	Requeues:    whereHelperint{field: "\"queue_items\".\"requeues\""},
+	QueuedAt:    whereHelpertime_Time{field: "\"queue_items\".\"queued_at\""},
+	ClaimableAt: whereHelpernull_Time{field: "\"queue_items\".\"claimable_at\""},
 }
 
 // QueueItemRels is where relationship names are stored.
@@ -168,9 +209,9 @@ func (*queueItemR) NewStruct() *queueItemR {
 type queueItemL struct{}
 
 var (
-	queueItemAllColumns            = []string{"id", "run_id", "running", "running_on", "queue_name", "started_at"}
-	queueItemColumnsWithoutDefault = []string{"run_id", "running_on", "queue_name", "started_at"}
-	queueItemColumnsWithDefault    = []string{"id", "running"}
+	queueItemAllColumns            = []string{"id", "run_id", "running", "running_on", "queue_name", "started_at", "priority", "requeues", "queued_at", "claimable_at"}
+	queueItemColumnsWithoutDefault = []string{"run_id", "running_on", "queue_name", "started_at", "claimable_at"}
+	queueItemColumnsWithDefault    = []string{"id", "running", "priority", "requeues", "queued_at"}
 	queueItemPrimaryKeyColumns     = []string{"id"}
 )
 
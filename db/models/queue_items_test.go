@@ -677,7 +677,7 @@ func testQueueItemsSelect(t *testing.T) {
 }
 
 var (
-	queueItemDBTypes = map[string]string{`ID`: `bigint`, `RunID`: `bigint`, `Running`: `boolean`, `RunningOn`: `character varying`, `QueueName`: `character varying`, `StartedAt`: `timestamp with time zone`}
+	queueItemDBTypes = map[string]string{`ID`: `bigint`, `RunID`: `bigint`, `Running`: `boolean`, `RunningOn`: `character varying`, `QueueName`: `character varying`, `StartedAt`: `timestamp with time zone`, `Priority`: `integer`, `Requeues`: `integer`, `QueuedAt`: `timestamp with time zone`, `ClaimableAt`: `timestamp with time zone`}
 	_                = bytes.MinRead
 )
 
@@ -25,37 +25,40 @@ import (
 
 // Repository is an object representing the database table.
 type Repository struct {
-	ID          int64      `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Name        string     `boil:"name" json:"name" toml:"name" yaml:"name"`
-	Private     bool       `boil:"private" json:"private" toml:"private" yaml:"private"`
-	Github      types.JSON `boil:"github" json:"github" toml:"github" yaml:"github"`
-	Disabled    null.Bool  `boil:"disabled" json:"disabled,omitempty" toml:"disabled" yaml:"disabled,omitempty"`
-	AutoCreated bool       `boil:"auto_created" json:"auto_created" toml:"auto_created" yaml:"auto_created"`
-	HookSecret  string     `boil:"hook_secret" json:"hook_secret" toml:"hook_secret" yaml:"hook_secret"`
-	OwnerID     int64      `boil:"owner_id" json:"owner_id" toml:"owner_id" yaml:"owner_id"`
+	ID           int64      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name         string     `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Private      bool       `boil:"private" json:"private" toml:"private" yaml:"private"`
+	Github       types.JSON `boil:"github" json:"github" toml:"github" yaml:"github"`
+	Disabled     null.Bool  `boil:"disabled" json:"disabled,omitempty" toml:"disabled" yaml:"disabled,omitempty"`
+	AutoCreated  bool       `boil:"auto_created" json:"auto_created" toml:"auto_created" yaml:"auto_created"`
+	HookSecret   string     `boil:"hook_secret" json:"hook_secret" toml:"hook_secret" yaml:"hook_secret"`
+	OwnerID      int64      `boil:"owner_id" json:"owner_id" toml:"owner_id" yaml:"owner_id"`
+	LastServedAt null.Time  `boil:"last_served_at" json:"last_served_at,omitempty" toml:"last_served_at" yaml:"last_served_at,omitempty"`
 
 	R *repositoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L repositoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var RepositoryColumns = struct {
-	ID          string
-	Name        string
-	Private     string
-	Github      string
-	Disabled    string
-	AutoCreated string
-	HookSecret  string
-	OwnerID     string
+	ID           string
+	Name         string
+	Private      string
+	Github       string
+	Disabled     string
+	AutoCreated  string
+	HookSecret   string
+	OwnerID      string
+	LastServedAt string
 }{
-	ID:          "id",
-	Name:        "name",
-	Private:     "private",
-	Github:      "github",
-	Disabled:    "disabled",
-	AutoCreated: "auto_created",
-	HookSecret:  "hook_secret",
-	OwnerID:     "owner_id",
+	ID:           "id",
+	Name:         "name",
+	Private:      "private",
+	Github:       "github",
+	Disabled:     "disabled",
+	AutoCreated:  "auto_created",
+	HookSecret:   "hook_secret",
+	OwnerID:      "owner_id",
+	LastServedAt: "last_served_at",
 }
 
 // Generated where
@@ -105,23 +108,25 @@ func (w whereHelpernull_Bool) GTE(x null.Bool) qm.QueryMod {
 }
 
 var RepositoryWhere = struct {
-	ID          whereHelperint64
-	Name        whereHelperstring
-	Private     whereHelperbool
-	Github      whereHelpertypes_JSON
-	Disabled    whereHelpernull_Bool
-	AutoCreated whereHelperbool
-	HookSecret  whereHelperstring
-	OwnerID     whereHelperint64
+	ID           whereHelperint64
+	Name         whereHelperstring
+	Private      whereHelperbool
+	Github       whereHelpertypes_JSON
+	Disabled     whereHelpernull_Bool
+	AutoCreated  whereHelperbool
+	HookSecret   whereHelperstring
+	OwnerID      whereHelperint64
+	LastServedAt whereHelpernull_Time
 }{
-	ID:          whereHelperint64{field: "\"repositories\".\"id\""},
-	Name:        whereHelperstring{field: "\"repositories\".\"name\""},
-	Private:     whereHelperbool{field: "\"repositories\".\"private\""},
-	Github:      whereHelpertypes_JSON{field: "\"repositories\".\"github\""},
-	Disabled:    whereHelpernull_Bool{field: "\"repositories\".\"disabled\""},
-	AutoCreated: whereHelperbool{field: "\"repositories\".\"auto_created\""},
-	HookSecret:  whereHelperstring{field: "\"repositories\".\"hook_secret\""},
-	OwnerID:     whereHelperint64{field: "\"repositories\".\"owner_id\""},
+	ID:           whereHelperint64{field: "\"repositories\".\"id\""},
+	Name:         whereHelperstring{field: "\"repositories\".\"name\""},
+	Private:      whereHelperbool{field: "\"repositories\".\"private\""},
+	Github:       whereHelpertypes_JSON{field: "\"repositories\".\"github\""},
+	Disabled:     whereHelpernull_Bool{field: "\"repositories\".\"disabled\""},
+	AutoCreated:  whereHelperbool{field: "\"repositories\".\"auto_created\""},
+	HookSecret:   whereHelperstring{field: "\"repositories\".\"hook_secret\""},
+	OwnerID:      whereHelperint64{field: "\"repositories\".\"owner_id\""},
+	LastServedAt: whereHelpernull_Time{field: "\"repositories\".\"last_served_at\""},
 }
 
 // RepositoryRels is where relationship names are stored.
@@ -148,8 +153,8 @@ func (*repositoryR) NewStruct() *repositoryR {
 type repositoryL struct{}
 
 var (
-	repositoryAllColumns            = []string{"id", "name", "private", "github", "disabled", "auto_created", "hook_secret", "owner_id"}
-	repositoryColumnsWithoutDefault = []string{"name", "private", "github", "auto_created", "hook_secret", "owner_id"}
+	repositoryAllColumns            = []string{"id", "name", "private", "github", "disabled", "auto_created", "hook_secret", "owner_id", "last_served_at"}
+	repositoryColumnsWithoutDefault = []string{"name", "private", "github", "auto_created", "hook_secret", "owner_id", "last_served_at"}
 	repositoryColumnsWithDefault    = []string{"id", "disabled"}
 	repositoryPrimaryKeyColumns     = []string{"id"}
 )
@@ -830,7 +830,7 @@ func testRepositoriesSelect(t *testing.T) {
 }
 
 var (
-	repositoryDBTypes = map[string]string{`ID`: `bigint`, `Name`: `character varying`, `Private`: `boolean`, `Github`: `jsonb`, `Disabled`: `boolean`, `AutoCreated`: `boolean`, `HookSecret`: `character varying`, `OwnerID`: `bigint`}
+	repositoryDBTypes = map[string]string{`ID`: `bigint`, `Name`: `character varying`, `Private`: `boolean`, `Github`: `jsonb`, `Disabled`: `boolean`, `AutoCreated`: `boolean`, `HookSecret`: `character varying`, `OwnerID`: `bigint`, `LastServedAt`: `timestamp with time zone`}
 	_                 = bytes.MinRead
 )
 
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.5.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testRunners(t *testing.T) {
+	t.Parallel()
+
+	query := Runners()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testRunnersDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Runner{}
+	if err = randomize.Struct(seed, o, runnerDBTypes, true, runnerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Runner struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Runners().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRunnersQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Runner{}
+	if err = randomize.Struct(seed, o, runnerDBTypes, true, runnerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Runner struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Runners().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Runners().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRunnersSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Runner{}
+	if err = randomize.Struct(seed, o, runnerDBTypes, true, runnerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Runner struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := RunnerSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Runners().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRunnersExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Runner{}
+	if err = randomize.Struct(seed, o, runnerDBTypes, true, runnerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Runner struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := RunnerExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Runner exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected RunnerExists to return true, but got false.")
+	}
+}
+
+func testRunnersFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Runner{}
+	if err = randomize.Struct(seed, o, runnerDBTypes, true, runnerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Runner struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	runnerFound, err := FindRunner(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if runnerFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testRunnersBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Runner{}
+	if err = randomize.Struct(seed, o, runnerDBTypes, true, runnerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Runner struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Runners().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRunnersOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Runner{}
+	if err = randomize.Struct(seed, o, runnerDBTypes, true, runnerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Runner struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Runners().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testRunnersAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	runnerOne := &Runner{}
+	runnerTwo := &Runner{}
+	if err = randomize.Struct(seed, runnerOne, runnerDBTypes, false, runnerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Runner struct: %s", err)
+	}
+	if err = randomize.Struct(seed, runnerTwo, runnerDBTypes, false, runnerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Runner struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = runnerOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = runnerTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Runners().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testRunnersCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	runnerOne := &Runner{}
+	runnerTwo := &Runner{}
+	if err = randomize.Struct(seed, runnerOne, runnerDBTypes, false, runnerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Runner struct: %s", err)
+	}
+	if err = randomize.Struct(seed, runnerTwo, runnerDBTypes, false, runnerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Runner struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = runnerOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = runnerTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Runners().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func runnerBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Runner) error {
+	*o = Runner{}
+	return nil
+}
+
+func runnerAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Runner) error {
+	*o = Runner{}
+	return nil
+}
+
+func runnerAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Runner) error {
+	*o = Runner{}
+	return nil
+}
+
+func runnerBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Runner) error {
+	*o = Runner{}
+	return nil
+}
+
+func runnerAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Runner) error {
+	*o = Runner{}
+	return nil
+}
+
+func runnerBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Runner) error {
+	*o = Runner{}
+	return nil
+}
+
+func runnerAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Runner) error {
+	*o = Runner{}
+	return nil
+}
+
+func runnerBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Runner) error {
+	*o = Runner{}
+	return nil
+}
+
+func runnerAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Runner) error {
+	*o = Runner{}
+	return nil
+}
+
+func testRunnersHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Runner{}
+	o := &Runner{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, runnerDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Runner object: %s", err)
+	}
+
+	AddRunnerHook(boil.BeforeInsertHook, runnerBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	runnerBeforeInsertHooks = []RunnerHook{}
+
+	AddRunnerHook(boil.AfterInsertHook, runnerAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	runnerAfterInsertHooks = []RunnerHook{}
+
+	AddRunnerHook(boil.AfterSelectHook, runnerAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	runnerAfterSelectHooks = []RunnerHook{}
+
+	AddRunnerHook(boil.BeforeUpdateHook, runnerBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	runnerBeforeUpdateHooks = []RunnerHook{}
+
+	AddRunnerHook(boil.AfterUpdateHook, runnerAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	runnerAfterUpdateHooks = []RunnerHook{}
+
+	AddRunnerHook(boil.BeforeDeleteHook, runnerBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	runnerBeforeDeleteHooks = []RunnerHook{}
+
+	AddRunnerHook(boil.AfterDeleteHook, runnerAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	runnerAfterDeleteHooks = []RunnerHook{}
+
+	AddRunnerHook(boil.BeforeUpsertHook, runnerBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	runnerBeforeUpsertHooks = []RunnerHook{}
+
+	AddRunnerHook(boil.AfterUpsertHook, runnerAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	runnerAfterUpsertHooks = []RunnerHook{}
+}
+
+func testRunnersInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Runner{}
+	if err = randomize.Struct(seed, o, runnerDBTypes, true, runnerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Runner struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Runners().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testRunnersInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Runner{}
+	if err = randomize.Struct(seed, o, runnerDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Runner struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(runnerColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Runners().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testRunnersReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Runner{}
+	if err = randomize.Struct(seed, o, runnerDBTypes, true, runnerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Runner struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRunnersReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Runner{}
+	if err = randomize.Struct(seed, o, runnerDBTypes, true, runnerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Runner struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := RunnerSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRunnersSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Runner{}
+	if err = randomize.Struct(seed, o, runnerDBTypes, true, runnerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Runner struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Runners().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	runnerDBTypes = map[string]string{`ID`: `bigint`, `Name`: `character varying`, `QueueName`: `character varying`, `Labels`: `ARRAYtext`, `Cordoned`: `boolean`, `Draining`: `boolean`, `RegisteredAt`: `timestamp with time zone`, `LastHeartbeat`: `timestamp with time zone`}
+	_             = bytes.MinRead
+)
+
+func testRunnersUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(runnerPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(runnerAllColumns) == len(runnerPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Runner{}
+	if err = randomize.Struct(seed, o, runnerDBTypes, true, runnerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Runner struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Runners().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, runnerDBTypes, true, runnerPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Runner struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testRunnersSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(runnerAllColumns) == len(runnerPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Runner{}
+	if err = randomize.Struct(seed, o, runnerDBTypes, true, runnerColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Runner struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Runners().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, runnerDBTypes, true, runnerPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Runner struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(runnerAllColumns, runnerPrimaryKeyColumns) {
+		fields = runnerAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			runnerAllColumns,
+			runnerPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := RunnerSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testRunnersUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(runnerAllColumns) == len(runnerPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Runner{}
+	if err = randomize.Struct(seed, &o, runnerDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Runner struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Runner: %s", err)
+	}
+
+	count, err := Runners().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, runnerDBTypes, false, runnerPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Runner struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Runner: %s", err)
+	}
+
+	count, err = Runners().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
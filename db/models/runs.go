@@ -25,79 +25,108 @@ import (
 
 // Run is an object representing the database table.
 type Run struct {
-	ID          int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
-	TaskID      int64       `boil:"task_id" json:"task_id" toml:"task_id" yaml:"task_id"`
-	Name        string      `boil:"name" json:"name" toml:"name" yaml:"name"`
-	RunSettings types.JSON  `boil:"run_settings" json:"run_settings" toml:"run_settings" yaml:"run_settings"`
-	Status      null.Bool   `boil:"status" json:"status,omitempty" toml:"status" yaml:"status,omitempty"`
-	CreatedAt   time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	StartedAt   null.Time   `boil:"started_at" json:"started_at,omitempty" toml:"started_at" yaml:"started_at,omitempty"`
-	FinishedAt  null.Time   `boil:"finished_at" json:"finished_at,omitempty" toml:"finished_at" yaml:"finished_at,omitempty"`
-	RanOn       null.String `boil:"ran_on" json:"ran_on,omitempty" toml:"ran_on" yaml:"ran_on,omitempty"`
+	ID                int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	TaskID            int64       `boil:"task_id" json:"task_id" toml:"task_id" yaml:"task_id"`
+	Name              string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	RunSettings       types.JSON  `boil:"run_settings" json:"run_settings" toml:"run_settings" yaml:"run_settings"`
+	Status            null.Bool   `boil:"status" json:"status,omitempty" toml:"status" yaml:"status,omitempty"`
+	CreatedAt         time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	StartedAt         null.Time   `boil:"started_at" json:"started_at,omitempty" toml:"started_at" yaml:"started_at,omitempty"`
+	FinishedAt        null.Time   `boil:"finished_at" json:"finished_at,omitempty" toml:"finished_at" yaml:"finished_at,omitempty"`
+	RanOn             null.String `boil:"ran_on" json:"ran_on,omitempty" toml:"ran_on" yaml:"ran_on,omitempty"`
+	Attempt           int         `boil:"attempt" json:"attempt" toml:"attempt" yaml:"attempt"`
+	PreviousAttemptID null.Int64  `boil:"previous_attempt_id" json:"previous_attempt_id,omitempty" toml:"previous_attempt_id" yaml:"previous_attempt_id,omitempty"`
+	FailureReason     null.String `boil:"failure_reason" json:"failure_reason,omitempty" toml:"failure_reason" yaml:"failure_reason,omitempty"`
+	Canceled          bool        `boil:"canceled" json:"canceled" toml:"canceled" yaml:"canceled"`
 
 	R *runR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L runL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var RunColumns = struct {
-	ID          string
-	TaskID      string
-	Name        string
-	RunSettings string
-	Status      string
-	CreatedAt   string
-	StartedAt   string
-	FinishedAt  string
-	RanOn       string
+	ID                string
+	TaskID            string
+	Name              string
+	RunSettings       string
+	Status            string
+	CreatedAt         string
+	StartedAt         string
+	FinishedAt        string
+	RanOn             string
+	Attempt           string
+	PreviousAttemptID string
+	FailureReason     string
+	Canceled          string
 }{
-	ID:          "id",
-	TaskID:      "task_id",
-	Name:        "name",
-	RunSettings: "run_settings",
-	Status:      "status",
-	CreatedAt:   "created_at",
-	StartedAt:   "started_at",
-	FinishedAt:  "finished_at",
-	RanOn:       "ran_on",
+	ID:                "id",
+	TaskID:            "task_id",
+	Name:              "name",
+	RunSettings:       "run_settings",
+	Status:            "status",
+	CreatedAt:         "created_at",
+	StartedAt:         "started_at",
+	FinishedAt:        "finished_at",
+	RanOn:             "ran_on",
+	Attempt:           "attempt",
+	PreviousAttemptID: "previous_attempt_id",
+	FailureReason:     "failure_reason",
+	Canceled:          "canceled",
 }
 
 // Generated where
 
 var RunWhere = struct {
-	ID          whereHelperint64
-	TaskID      whereHelperint64
-	Name        whereHelperstring
-	RunSettings whereHelpertypes_JSON
-	Status      whereHelpernull_Bool
-	CreatedAt   whereHelpertime_Time
-	StartedAt   whereHelpernull_Time
-	FinishedAt  whereHelpernull_Time
-	RanOn       whereHelpernull_String
+	ID                whereHelperint64
+	TaskID            whereHelperint64
+	Name              whereHelperstring
+	RunSettings       whereHelpertypes_JSON
+	Status            whereHelpernull_Bool
+	CreatedAt         whereHelpertime_Time
+	StartedAt         whereHelpernull_Time
+	FinishedAt        whereHelpernull_Time
+	RanOn             whereHelpernull_String
+	Attempt           whereHelperint
+	PreviousAttemptID whereHelpernull_Int64
+	FailureReason     whereHelpernull_String
+	Canceled          whereHelperbool
 }{
-	ID:          whereHelperint64{field: "\"runs\".\"id\""},
-	TaskID:      whereHelperint64{field: "\"runs\".\"task_id\""},
-	Name:        whereHelperstring{field: "\"runs\".\"name\""},
-	RunSettings: whereHelpertypes_JSON{field: "\"runs\".\"run_settings\""},
-	Status:      whereHelpernull_Bool{field: "\"runs\".\"status\""},
-	CreatedAt:   whereHelpertime_Time{field: "\"runs\".\"created_at\""},
-	StartedAt:   whereHelpernull_Time{field: "\"runs\".\"started_at\""},
-	FinishedAt:  whereHelpernull_Time{field: "\"runs\".\"finished_at\""},
-	RanOn:       whereHelpernull_String{field: "\"runs\".\"ran_on\""},
+	ID:                whereHelperint64{field: "\"runs\".\"id\""},
+	TaskID:            whereHelperint64{field: "\"runs\".\"task_id\""},
+	Name:              whereHelperstring{field: "\"runs\".\"name\""},
+	RunSettings:       whereHelpertypes_JSON{field: "\"runs\".\"run_settings\""},
+	Status:            whereHelpernull_Bool{field: "\"runs\".\"status\""},
+	CreatedAt:         whereHelpertime_Time{field: "\"runs\".\"created_at\""},
+	StartedAt:         whereHelpernull_Time{field: "\"runs\".\"started_at\""},
+	FinishedAt:        whereHelpernull_Time{field: "\"runs\".\"finished_at\""},
+	RanOn:             whereHelpernull_String{field: "\"runs\".\"ran_on\""},
+	Attempt:           whereHelperint{field: "\"runs\".\"attempt\""},
+	PreviousAttemptID: whereHelpernull_Int64{field: "\"runs\".\"previous_attempt_id\""},
+	FailureReason:     whereHelpernull_String{field: "\"runs\".\"failure_reason\""},
+	Canceled:          whereHelperbool{field: "\"runs\".\"canceled\""},
 }
 
 // RunRels is where relationship names are stored.
 var RunRels = struct {
-	Task      string
-	QueueItem string
+	Task                string
+	PreviousAttempt     string
+	QueueItem           string
+	PreviousAttemptRuns string
+	TestCases           string
 }{
-	Task:      "Task",
-	QueueItem: "QueueItem",
+	Task:                "Task",
+	PreviousAttempt:     "PreviousAttempt",
+	QueueItem:           "QueueItem",
+	PreviousAttemptRuns: "PreviousAttemptRuns",
+	TestCases:           "TestCases",
 }
 
 // runR is where relationships are stored.
 type runR struct {
-	Task      *Task      `boil:"Task" json:"Task" toml:"Task" yaml:"Task"`
-	QueueItem *QueueItem `boil:"QueueItem" json:"QueueItem" toml:"QueueItem" yaml:"QueueItem"`
+	Task                *Task         `boil:"Task" json:"Task" toml:"Task" yaml:"Task"`
+	PreviousAttempt     *Run          `boil:"PreviousAttempt" json:"PreviousAttempt" toml:"PreviousAttempt" yaml:"PreviousAttempt"`
+	QueueItem           *QueueItem    `boil:"QueueItem" json:"QueueItem" toml:"QueueItem" yaml:"QueueItem"`
+	PreviousAttemptRuns RunSlice      `boil:"PreviousAttemptRuns" json:"PreviousAttemptRuns" toml:"PreviousAttemptRuns" yaml:"PreviousAttemptRuns"`
+	TestCases           TestCaseSlice `boil:"TestCases" json:"TestCases" toml:"TestCases" yaml:"TestCases"`
 }
 
 // NewStruct creates a new relationship struct
@@ -109,9 +138,9 @@ func (*runR) NewStruct() *runR {
 type runL struct{}
 
 var (
-	runAllColumns            = []string{"id", "task_id", "name", "run_settings", "status", "created_at", "started_at", "finished_at", "ran_on"}
-	runColumnsWithoutDefault = []string{"task_id", "name", "run_settings", "status", "started_at", "finished_at", "ran_on"}
-	runColumnsWithDefault    = []string{"id", "created_at"}
+	runAllColumns            = []string{"id", "task_id", "name", "run_settings", "status", "created_at", "started_at", "finished_at", "ran_on", "attempt", "previous_attempt_id", "failure_reason", "canceled"}
+	runColumnsWithoutDefault = []string{"task_id", "name", "run_settings", "status", "started_at", "finished_at", "ran_on", "previous_attempt_id", "failure_reason"}
+	runColumnsWithDefault    = []string{"id", "created_at", "attempt", "canceled"}
 	runPrimaryKeyColumns     = []string{"id"}
 )
 
@@ -404,6 +433,20 @@ func (o *Run) Task(mods ...qm.QueryMod) taskQuery {
 	return query
 }
 
+// PreviousAttempt pointed to by the foreign key.
+func (o *Run) PreviousAttempt(mods ...qm.QueryMod) runQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.PreviousAttemptID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Runs(queryMods...)
+	queries.SetFrom(query.Query, "\"runs\"")
+
+	return query
+}
+
 // QueueItem pointed to by the foreign key.
 func (o *Run) QueueItem(mods ...qm.QueryMod) queueItemQuery {
 	queryMods := []qm.QueryMod{
@@ -418,6 +461,48 @@ func (o *Run) QueueItem(mods ...qm.QueryMod) queueItemQuery {
 	return query
 }
 
+// PreviousAttemptRuns retrieves all the run's Runs with an executor via previous_attempt_id column.
+func (o *Run) PreviousAttemptRuns(mods ...qm.QueryMod) runQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"runs\".\"previous_attempt_id\"=?", o.ID),
+	)
+
+	query := Runs(queryMods...)
+	queries.SetFrom(query.Query, "\"runs\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"runs\".*"})
+	}
+
+	return query
+}
+
+// TestCases retrieves all the test_case's TestCases with an executor.
+func (o *Run) TestCases(mods ...qm.QueryMod) testCaseQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"test_cases\".\"run_id\"=?", o.ID),
+	)
+
+	query := TestCases(queryMods...)
+	queries.SetFrom(query.Query, "\"test_cases\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"test_cases\".*"})
+	}
+
+	return query
+}
+
 // LoadTask allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (runL) LoadTask(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRun interface{}, mods queries.Applicator) error {
@@ -522,6 +607,114 @@ func (runL) LoadTask(ctx context.Context, e boil.ContextExecutor, singular bool,
 	return nil
 }
 
+// LoadPreviousAttempt allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (runL) LoadPreviousAttempt(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRun interface{}, mods queries.Applicator) error {
+	var slice []*Run
+	var object *Run
+
+	if singular {
+		object = maybeRun.(*Run)
+	} else {
+		slice = *maybeRun.(*[]*Run)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &runR{}
+		}
+		if !queries.IsNil(object.PreviousAttemptID) {
+			args = append(args, object.PreviousAttemptID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &runR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.PreviousAttemptID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.PreviousAttemptID) {
+				args = append(args, obj.PreviousAttemptID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`runs`),
+		qm.WhereIn(`runs.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Run")
+	}
+
+	var resultSlice []*Run
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Run")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for runs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for runs")
+	}
+
+	if len(runAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.PreviousAttempt = foreign
+		if foreign.R == nil {
+			foreign.R = &runR{}
+		}
+		foreign.R.PreviousAttemptRuns = append(foreign.R.PreviousAttemptRuns, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.PreviousAttemptID, foreign.ID) {
+				local.R.PreviousAttempt = foreign
+				if foreign.R == nil {
+					foreign.R = &runR{}
+				}
+				foreign.R.PreviousAttemptRuns = append(foreign.R.PreviousAttemptRuns, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadQueueItem allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-1 relationship.
 func (runL) LoadQueueItem(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRun interface{}, mods queries.Applicator) error {
@@ -623,6 +816,202 @@ func (runL) LoadQueueItem(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
+// LoadPreviousAttemptRuns allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (runL) LoadPreviousAttemptRuns(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRun interface{}, mods queries.Applicator) error {
+	var slice []*Run
+	var object *Run
+
+	if singular {
+		object = maybeRun.(*Run)
+	} else {
+		slice = *maybeRun.(*[]*Run)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &runR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &runR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`runs`),
+		qm.WhereIn(`runs.previous_attempt_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load runs")
+	}
+
+	var resultSlice []*Run
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice runs")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on runs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for runs")
+	}
+
+	if len(runAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PreviousAttemptRuns = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &runR{}
+			}
+			foreign.R.PreviousAttempt = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.PreviousAttemptID) {
+				local.R.PreviousAttemptRuns = append(local.R.PreviousAttemptRuns, foreign)
+				if foreign.R == nil {
+					foreign.R = &runR{}
+				}
+				foreign.R.PreviousAttempt = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTestCases allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (runL) LoadTestCases(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRun interface{}, mods queries.Applicator) error {
+	var slice []*Run
+	var object *Run
+
+	if singular {
+		object = maybeRun.(*Run)
+	} else {
+		slice = *maybeRun.(*[]*Run)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &runR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &runR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`test_cases`),
+		qm.WhereIn(`test_cases.run_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load test_cases")
+	}
+
+	var resultSlice []*TestCase
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice test_cases")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on test_cases")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for test_cases")
+	}
+
+	if len(testCaseAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TestCases = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &testCaseR{}
+			}
+			foreign.R.Run = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.RunID {
+				local.R.TestCases = append(local.R.TestCases, foreign)
+				if foreign.R == nil {
+					foreign.R = &testCaseR{}
+				}
+				foreign.R.Run = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // SetTask of the run to the related item.
 // Sets o.R.Task to related.
 // Adds o to related.R.Runs.
@@ -670,6 +1059,86 @@ func (o *Run) SetTask(ctx context.Context, exec boil.ContextExecutor, insert boo
 	return nil
 }
 
+// SetPreviousAttempt of the run to the related item.
+// Sets o.R.PreviousAttempt to related.
+// Adds o to related.R.PreviousAttemptRuns.
+func (o *Run) SetPreviousAttempt(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Run) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"runs\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"previous_attempt_id"}),
+		strmangle.WhereClause("\"", "\"", 2, runPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.PreviousAttemptID, related.ID)
+	if o.R == nil {
+		o.R = &runR{
+			PreviousAttempt: related,
+		}
+	} else {
+		o.R.PreviousAttempt = related
+	}
+
+	if related.R == nil {
+		related.R = &runR{
+			PreviousAttemptRuns: RunSlice{o},
+		}
+	} else {
+		related.R.PreviousAttemptRuns = append(related.R.PreviousAttemptRuns, o)
+	}
+
+	return nil
+}
+
+// RemovePreviousAttempt relationship.
+// Sets o.R.PreviousAttempt to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *Run) RemovePreviousAttempt(ctx context.Context, exec boil.ContextExecutor, related *Run) error {
+	var err error
+
+	queries.SetScanner(&o.PreviousAttemptID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("previous_attempt_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.PreviousAttempt = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.PreviousAttemptRuns {
+		if queries.Equal(o.PreviousAttemptID, ri.PreviousAttemptID) {
+			continue
+		}
+
+		ln := len(related.R.PreviousAttemptRuns)
+		if ln > 1 && i < ln-1 {
+			related.R.PreviousAttemptRuns[i] = related.R.PreviousAttemptRuns[ln-1]
+		}
+		related.R.PreviousAttemptRuns = related.R.PreviousAttemptRuns[:ln-1]
+		break
+	}
+	return nil
+}
+
 // SetQueueItem of the run to the related item.
 // Sets o.R.QueueItem to related.
 // Adds o to related.R.Run.
@@ -721,6 +1190,182 @@ func (o *Run) SetQueueItem(ctx context.Context, exec boil.ContextExecutor, inser
 	return nil
 }
 
+// AddPreviousAttemptRuns adds the given related objects to the existing relationships
+// of the run, optionally inserting them as new records.
+// Appends related to o.R.PreviousAttemptRuns.
+// Sets related.R.PreviousAttempt appropriately.
+func (o *Run) AddPreviousAttemptRuns(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Run) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.PreviousAttemptID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"runs\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"previous_attempt_id"}),
+				strmangle.WhereClause("\"", "\"", 2, runPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.PreviousAttemptID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &runR{
+			PreviousAttemptRuns: related,
+		}
+	} else {
+		o.R.PreviousAttemptRuns = append(o.R.PreviousAttemptRuns, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &runR{
+				PreviousAttempt: o,
+			}
+		} else {
+			rel.R.PreviousAttempt = o
+		}
+	}
+	return nil
+}
+
+// SetPreviousAttemptRuns removes all previously related items of the
+// run replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.PreviousAttempt's PreviousAttemptRuns accordingly.
+// Replaces o.R.PreviousAttemptRuns with related.
+// Sets related.R.PreviousAttempt's PreviousAttemptRuns accordingly.
+func (o *Run) SetPreviousAttemptRuns(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Run) error {
+	query := "update \"runs\" set \"previous_attempt_id\" = null where \"previous_attempt_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.PreviousAttemptRuns {
+			queries.SetScanner(&rel.PreviousAttemptID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.PreviousAttempt = nil
+		}
+
+		o.R.PreviousAttemptRuns = nil
+	}
+	return o.AddPreviousAttemptRuns(ctx, exec, insert, related...)
+}
+
+// RemovePreviousAttemptRuns relationships from objects passed in.
+// Removes related items from R.PreviousAttemptRuns (uses pointer comparison, removal does not keep order)
+// Sets related.R.PreviousAttempt.
+func (o *Run) RemovePreviousAttemptRuns(ctx context.Context, exec boil.ContextExecutor, related ...*Run) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.PreviousAttemptID, nil)
+		if rel.R != nil {
+			rel.R.PreviousAttempt = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("previous_attempt_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.PreviousAttemptRuns {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.PreviousAttemptRuns)
+			if ln > 1 && i < ln-1 {
+				o.R.PreviousAttemptRuns[i] = o.R.PreviousAttemptRuns[ln-1]
+			}
+			o.R.PreviousAttemptRuns = o.R.PreviousAttemptRuns[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddTestCases adds the given related objects to the existing relationships
+// of the run, optionally inserting them as new records.
+// Appends related to o.R.TestCases.
+// Sets related.R.Run appropriately.
+func (o *Run) AddTestCases(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TestCase) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RunID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"test_cases\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"run_id"}),
+				strmangle.WhereClause("\"", "\"", 2, testCasePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RunID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &runR{
+			TestCases: related,
+		}
+	} else {
+		o.R.TestCases = append(o.R.TestCases, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &testCaseR{
+				Run: o,
+			}
+		} else {
+			rel.R.Run = o
+		}
+	}
+	return nil
+}
+
 // Runs retrieves all the records using an executor.
 func Runs(mods ...qm.QueryMod) runQuery {
 	mods = append(mods, qm.From("\"runs\""))
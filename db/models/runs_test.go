@@ -607,6 +607,487 @@ func testRunOneToOneSetOpQueueItemUsingQueueItem(t *testing.T) {
 	}
 }
 
+func testRunToManyPreviousAttemptRuns(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Run
+	var b, c Run
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, runDBTypes, true, runColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Run struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, runDBTypes, false, runColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, runDBTypes, false, runColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.PreviousAttemptID, a.ID)
+	queries.Assign(&c.PreviousAttemptID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.PreviousAttemptRuns().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.PreviousAttemptID, b.PreviousAttemptID) {
+			bFound = true
+		}
+		if queries.Equal(v.PreviousAttemptID, c.PreviousAttemptID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := RunSlice{&a}
+	if err = a.L.LoadPreviousAttemptRuns(ctx, tx, false, (*[]*Run)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.PreviousAttemptRuns); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.PreviousAttemptRuns = nil
+	if err = a.L.LoadPreviousAttemptRuns(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.PreviousAttemptRuns); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testRunToManyTestCases(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Run
+	var b, c TestCase
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, runDBTypes, true, runColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Run struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, testCaseDBTypes, false, testCaseColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, testCaseDBTypes, false, testCaseColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.RunID = a.ID
+	c.RunID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.TestCases().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.RunID == b.RunID {
+			bFound = true
+		}
+		if v.RunID == c.RunID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := RunSlice{&a}
+	if err = a.L.LoadTestCases(ctx, tx, false, (*[]*Run)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.TestCases); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.TestCases = nil
+	if err = a.L.LoadTestCases(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.TestCases); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testRunToManyAddOpPreviousAttemptRuns(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Run
+	var b, c, d, e Run
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, runDBTypes, false, strmangle.SetComplement(runPrimaryKeyColumns, runColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Run{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, runDBTypes, false, strmangle.SetComplement(runPrimaryKeyColumns, runColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Run{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddPreviousAttemptRuns(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.PreviousAttemptID) {
+			t.Error("foreign key was wrong value", a.ID, first.PreviousAttemptID)
+		}
+		if !queries.Equal(a.ID, second.PreviousAttemptID) {
+			t.Error("foreign key was wrong value", a.ID, second.PreviousAttemptID)
+		}
+
+		if first.R.PreviousAttempt != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.PreviousAttempt != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.PreviousAttemptRuns[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.PreviousAttemptRuns[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.PreviousAttemptRuns().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testRunToManySetOpPreviousAttemptRuns(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Run
+	var b, c, d, e Run
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, runDBTypes, false, strmangle.SetComplement(runPrimaryKeyColumns, runColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Run{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, runDBTypes, false, strmangle.SetComplement(runPrimaryKeyColumns, runColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetPreviousAttemptRuns(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.PreviousAttemptRuns().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetPreviousAttemptRuns(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.PreviousAttemptRuns().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.PreviousAttemptID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.PreviousAttemptID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.PreviousAttemptID) {
+		t.Error("foreign key was wrong value", a.ID, d.PreviousAttemptID)
+	}
+	if !queries.Equal(a.ID, e.PreviousAttemptID) {
+		t.Error("foreign key was wrong value", a.ID, e.PreviousAttemptID)
+	}
+
+	if b.R.PreviousAttempt != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.PreviousAttempt != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.PreviousAttempt != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.PreviousAttempt != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.PreviousAttemptRuns[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.PreviousAttemptRuns[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testRunToManyRemoveOpPreviousAttemptRuns(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Run
+	var b, c, d, e Run
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, runDBTypes, false, strmangle.SetComplement(runPrimaryKeyColumns, runColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Run{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, runDBTypes, false, strmangle.SetComplement(runPrimaryKeyColumns, runColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddPreviousAttemptRuns(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.PreviousAttemptRuns().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemovePreviousAttemptRuns(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.PreviousAttemptRuns().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.PreviousAttemptID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.PreviousAttemptID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.PreviousAttempt != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.PreviousAttempt != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.PreviousAttempt != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.PreviousAttempt != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.PreviousAttemptRuns) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.PreviousAttemptRuns[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.PreviousAttemptRuns[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testRunToManyAddOpTestCases(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Run
+	var b, c, d, e TestCase
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, runDBTypes, false, strmangle.SetComplement(runPrimaryKeyColumns, runColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*TestCase{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, testCaseDBTypes, false, strmangle.SetComplement(testCasePrimaryKeyColumns, testCaseColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*TestCase{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddTestCases(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.RunID {
+			t.Error("foreign key was wrong value", a.ID, first.RunID)
+		}
+		if a.ID != second.RunID {
+			t.Error("foreign key was wrong value", a.ID, second.RunID)
+		}
+
+		if first.R.Run != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Run != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.TestCases[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.TestCases[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.TestCases().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
 func testRunToOneTaskUsingTask(t *testing.T) {
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
@@ -658,6 +1139,57 @@ func testRunToOneTaskUsingTask(t *testing.T) {
 	}
 }
 
+func testRunToOneRunUsingPreviousAttempt(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Run
+	var foreign Run
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, runDBTypes, true, runColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Run struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, runDBTypes, false, runColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Run struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.PreviousAttemptID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.PreviousAttempt().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := RunSlice{&local}
+	if err = local.L.LoadPreviousAttempt(ctx, tx, false, (*[]*Run)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.PreviousAttempt == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.PreviousAttempt = nil
+	if err = local.L.LoadPreviousAttempt(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.PreviousAttempt == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
 func testRunToOneSetOpTaskUsingTask(t *testing.T) {
 	var err error
 
@@ -715,6 +1247,114 @@ func testRunToOneSetOpTaskUsingTask(t *testing.T) {
 		}
 	}
 }
+func testRunToOneSetOpRunUsingPreviousAttempt(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Run
+	var b, c Run
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, runDBTypes, false, strmangle.SetComplement(runPrimaryKeyColumns, runColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, runDBTypes, false, strmangle.SetComplement(runPrimaryKeyColumns, runColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, runDBTypes, false, strmangle.SetComplement(runPrimaryKeyColumns, runColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Run{&b, &c} {
+		err = a.SetPreviousAttempt(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.PreviousAttempt != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.PreviousAttemptRuns[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.PreviousAttemptID, x.ID) {
+			t.Error("foreign key was wrong value", a.PreviousAttemptID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.PreviousAttemptID))
+		reflect.Indirect(reflect.ValueOf(&a.PreviousAttemptID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.PreviousAttemptID, x.ID) {
+			t.Error("foreign key was wrong value", a.PreviousAttemptID, x.ID)
+		}
+	}
+}
+
+func testRunToOneRemoveOpRunUsingPreviousAttempt(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Run
+	var b Run
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, runDBTypes, false, strmangle.SetComplement(runPrimaryKeyColumns, runColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, runDBTypes, false, strmangle.SetComplement(runPrimaryKeyColumns, runColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetPreviousAttempt(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemovePreviousAttempt(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.PreviousAttempt().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.PreviousAttempt != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.PreviousAttemptID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.PreviousAttemptRuns) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
 
 func testRunsReload(t *testing.T) {
 	t.Parallel()
@@ -790,7 +1430,7 @@ func testRunsSelect(t *testing.T) {
 }
 
 var (
-	runDBTypes = map[string]string{`ID`: `bigint`, `TaskID`: `bigint`, `Name`: `character varying`, `RunSettings`: `jsonb`, `Status`: `boolean`, `CreatedAt`: `timestamp with time zone`, `StartedAt`: `timestamp with time zone`, `FinishedAt`: `timestamp with time zone`, `RanOn`: `character varying`}
+	runDBTypes = map[string]string{`ID`: `bigint`, `TaskID`: `bigint`, `Name`: `character varying`, `RunSettings`: `jsonb`, `Status`: `boolean`, `CreatedAt`: `timestamp with time zone`, `StartedAt`: `timestamp with time zone`, `FinishedAt`: `timestamp with time zone`, `RanOn`: `character varying`, `Attempt`: `integer`, `PreviousAttemptID`: `bigint`, `FailureReason`: `character varying`, `Canceled`: `boolean`}
 	_          = bytes.MinRead
 )
 
@@ -25,15 +25,18 @@ import (
 
 // Task is an object representing the database table.
 type Task struct {
-	ID           int64      `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Status       null.Bool  `boil:"status" json:"status,omitempty" toml:"status" yaml:"status,omitempty"`
-	TaskSettings types.JSON `boil:"task_settings" json:"task_settings" toml:"task_settings" yaml:"task_settings"`
-	CreatedAt    time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	StartedAt    null.Time  `boil:"started_at" json:"started_at,omitempty" toml:"started_at" yaml:"started_at,omitempty"`
-	FinishedAt   null.Time  `boil:"finished_at" json:"finished_at,omitempty" toml:"finished_at" yaml:"finished_at,omitempty"`
-	Canceled     bool       `boil:"canceled" json:"canceled" toml:"canceled" yaml:"canceled"`
-	Path         string     `boil:"path" json:"path" toml:"path" yaml:"path"`
-	SubmissionID int64      `boil:"submission_id" json:"submission_id" toml:"submission_id" yaml:"submission_id"`
+	ID           int64            `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Status       null.Bool        `boil:"status" json:"status,omitempty" toml:"status" yaml:"status,omitempty"`
+	TaskSettings types.JSON       `boil:"task_settings" json:"task_settings" toml:"task_settings" yaml:"task_settings"`
+	CreatedAt    time.Time        `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	StartedAt    null.Time        `boil:"started_at" json:"started_at,omitempty" toml:"started_at" yaml:"started_at,omitempty"`
+	FinishedAt   null.Time        `boil:"finished_at" json:"finished_at,omitempty" toml:"finished_at" yaml:"finished_at,omitempty"`
+	Canceled     bool             `boil:"canceled" json:"canceled" toml:"canceled" yaml:"canceled"`
+	Path         string           `boil:"path" json:"path" toml:"path" yaml:"path"`
+	SubmissionID int64            `boil:"submission_id" json:"submission_id" toml:"submission_id" yaml:"submission_id"`
+	DependsOn    types.Int64Array `boil:"depends_on" json:"depends_on,omitempty" toml:"depends_on" yaml:"depends_on,omitempty"`
+	RerunOfID    null.Int64       `boil:"rerun_of_id" json:"rerun_of_id,omitempty" toml:"rerun_of_id" yaml:"rerun_of_id,omitempty"`
+	Priority     null.Int         `boil:"priority" json:"priority,omitempty" toml:"priority" yaml:"priority,omitempty"`
 
 	R *taskR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L taskL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -49,6 +52,9 @@ var TaskColumns = struct {
 	Canceled     string
 	Path         string
 	SubmissionID string
+	DependsOn    string
+	RerunOfID    string
+	Priority     string
 }{
 	ID:           "id",
 	Status:       "status",
@@ -59,10 +65,59 @@ var TaskColumns = struct {
 	Canceled:     "canceled",
 	Path:         "path",
 	SubmissionID: "submission_id",
+	DependsOn:    "depends_on",
+	RerunOfID:    "rerun_of_id",
+	Priority:     "priority",
 }
 
 // Generated where
 
+type whereHelpertypes_Int64Array struct{ field string }
+
+func (w whereHelpertypes_Int64Array) EQ(x types.Int64Array) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpertypes_Int64Array) NEQ(x types.Int64Array) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpertypes_Int64Array) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpertypes_Int64Array) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelpertypes_Int64Array) LT(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_Int64Array) LTE(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_Int64Array) GT(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_Int64Array) GTE(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelpernull_Int struct{ field string }
+
+func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Int) NEQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Int) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelpernull_Int) LT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Int) LTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Int) GT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Int) GTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
 var TaskWhere = struct {
 	ID           whereHelperint64
 	Status       whereHelpernull_Bool
@@ -73,6 +128,9 @@ var TaskWhere = struct {
 	Canceled     whereHelperbool
 	Path         whereHelperstring
 	SubmissionID whereHelperint64
+	DependsOn    whereHelpertypes_Int64Array
+	RerunOfID    whereHelpernull_Int64
+	Priority     whereHelpernull_Int
 }{
 	ID:           whereHelperint64{field: "\"tasks\".\"id\""},
 	Status:       whereHelpernull_Bool{field: "\"tasks\".\"status\""},
@@ -83,21 +141,30 @@ var TaskWhere = struct {
 	Canceled:     whereHelperbool{field: "\"tasks\".\"canceled\""},
 	Path:         whereHelperstring{field: "\"tasks\".\"path\""},
 	SubmissionID: whereHelperint64{field: "\"tasks\".\"submission_id\""},
+	DependsOn:    whereHelpertypes_Int64Array{field: "\"tasks\".\"depends_on\""},
+	RerunOfID:    whereHelpernull_Int64{field: "\"tasks\".\"rerun_of_id\""},
+	Priority:     whereHelpernull_Int{field: "\"tasks\".\"priority\""},
 }
 
 // TaskRels is where relationship names are stored.
 var TaskRels = struct {
-	Submission string
-	Runs       string
+	Submission   string
+	RerunOf      string
+	Runs         string
+	RerunOfTasks string
 }{
-	Submission: "Submission",
-	Runs:       "Runs",
+	Submission:   "Submission",
+	RerunOf:      "RerunOf",
+	Runs:         "Runs",
+	RerunOfTasks: "RerunOfTasks",
 }
 
 // taskR is where relationships are stored.
 type taskR struct {
-	Submission *Submission `boil:"Submission" json:"Submission" toml:"Submission" yaml:"Submission"`
-	Runs       RunSlice    `boil:"Runs" json:"Runs" toml:"Runs" yaml:"Runs"`
+	Submission   *Submission `boil:"Submission" json:"Submission" toml:"Submission" yaml:"Submission"`
+	RerunOf      *Task       `boil:"RerunOf" json:"RerunOf" toml:"RerunOf" yaml:"RerunOf"`
+	Runs         RunSlice    `boil:"Runs" json:"Runs" toml:"Runs" yaml:"Runs"`
+	RerunOfTasks TaskSlice   `boil:"RerunOfTasks" json:"RerunOfTasks" toml:"RerunOfTasks" yaml:"RerunOfTasks"`
 }
 
 // NewStruct creates a new relationship struct
@@ -109,8 +176,8 @@ func (*taskR) NewStruct() *taskR {
 type taskL struct{}
 
 var (
-	taskAllColumns            = []string{"id", "status", "task_settings", "created_at", "started_at", "finished_at", "canceled", "path", "submission_id"}
-	taskColumnsWithoutDefault = []string{"status", "task_settings", "started_at", "finished_at", "submission_id"}
+	taskAllColumns            = []string{"id", "status", "task_settings", "created_at", "started_at", "finished_at", "canceled", "path", "submission_id", "depends_on", "rerun_of_id", "priority"}
+	taskColumnsWithoutDefault = []string{"status", "task_settings", "started_at", "finished_at", "submission_id", "depends_on", "rerun_of_id", "priority"}
 	taskColumnsWithDefault    = []string{"id", "created_at", "canceled", "path"}
 	taskPrimaryKeyColumns     = []string{"id"}
 )
@@ -404,6 +471,20 @@ func (o *Task) Submission(mods ...qm.QueryMod) submissionQuery {
 	return query
 }
 
+// RerunOf pointed to by the foreign key.
+func (o *Task) RerunOf(mods ...qm.QueryMod) taskQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.RerunOfID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Tasks(queryMods...)
+	queries.SetFrom(query.Query, "\"tasks\"")
+
+	return query
+}
+
 // Runs retrieves all the run's Runs with an executor.
 func (o *Task) Runs(mods ...qm.QueryMod) runQuery {
 	var queryMods []qm.QueryMod
@@ -425,6 +506,27 @@ func (o *Task) Runs(mods ...qm.QueryMod) runQuery {
 	return query
 }
 
+// RerunOfTasks retrieves all the task's Tasks with an executor via rerun_of_id column.
+func (o *Task) RerunOfTasks(mods ...qm.QueryMod) taskQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"tasks\".\"rerun_of_id\"=?", o.ID),
+	)
+
+	query := Tasks(queryMods...)
+	queries.SetFrom(query.Query, "\"tasks\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"tasks\".*"})
+	}
+
+	return query
+}
+
 // LoadSubmission allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (taskL) LoadSubmission(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTask interface{}, mods queries.Applicator) error {
@@ -529,6 +631,114 @@ func (taskL) LoadSubmission(ctx context.Context, e boil.ContextExecutor, singula
 	return nil
 }
 
+// LoadRerunOf allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (taskL) LoadRerunOf(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTask interface{}, mods queries.Applicator) error {
+	var slice []*Task
+	var object *Task
+
+	if singular {
+		object = maybeTask.(*Task)
+	} else {
+		slice = *maybeTask.(*[]*Task)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &taskR{}
+		}
+		if !queries.IsNil(object.RerunOfID) {
+			args = append(args, object.RerunOfID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &taskR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.RerunOfID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.RerunOfID) {
+				args = append(args, obj.RerunOfID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`tasks`),
+		qm.WhereIn(`tasks.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Task")
+	}
+
+	var resultSlice []*Task
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Task")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tasks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tasks")
+	}
+
+	if len(taskAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.RerunOf = foreign
+		if foreign.R == nil {
+			foreign.R = &taskR{}
+		}
+		foreign.R.RerunOfTasks = append(foreign.R.RerunOfTasks, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.RerunOfID, foreign.ID) {
+				local.R.RerunOf = foreign
+				if foreign.R == nil {
+					foreign.R = &taskR{}
+				}
+				foreign.R.RerunOfTasks = append(foreign.R.RerunOfTasks, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadRuns allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (taskL) LoadRuns(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTask interface{}, mods queries.Applicator) error {
@@ -627,6 +837,104 @@ func (taskL) LoadRuns(ctx context.Context, e boil.ContextExecutor, singular bool
 	return nil
 }
 
+// LoadRerunOfTasks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (taskL) LoadRerunOfTasks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTask interface{}, mods queries.Applicator) error {
+	var slice []*Task
+	var object *Task
+
+	if singular {
+		object = maybeTask.(*Task)
+	} else {
+		slice = *maybeTask.(*[]*Task)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &taskR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &taskR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`tasks`),
+		qm.WhereIn(`tasks.rerun_of_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tasks")
+	}
+
+	var resultSlice []*Task
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tasks")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tasks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tasks")
+	}
+
+	if len(taskAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RerunOfTasks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &taskR{}
+			}
+			foreign.R.RerunOf = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.RerunOfID) {
+				local.R.RerunOfTasks = append(local.R.RerunOfTasks, foreign)
+				if foreign.R == nil {
+					foreign.R = &taskR{}
+				}
+				foreign.R.RerunOf = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // SetSubmission of the task to the related item.
 // Sets o.R.Submission to related.
 // Adds o to related.R.Tasks.
@@ -674,6 +982,86 @@ func (o *Task) SetSubmission(ctx context.Context, exec boil.ContextExecutor, ins
 	return nil
 }
 
+// SetRerunOf of the task to the related item.
+// Sets o.R.RerunOf to related.
+// Adds o to related.R.RerunOfTasks.
+func (o *Task) SetRerunOf(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Task) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"tasks\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"rerun_of_id"}),
+		strmangle.WhereClause("\"", "\"", 2, taskPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.RerunOfID, related.ID)
+	if o.R == nil {
+		o.R = &taskR{
+			RerunOf: related,
+		}
+	} else {
+		o.R.RerunOf = related
+	}
+
+	if related.R == nil {
+		related.R = &taskR{
+			RerunOfTasks: TaskSlice{o},
+		}
+	} else {
+		related.R.RerunOfTasks = append(related.R.RerunOfTasks, o)
+	}
+
+	return nil
+}
+
+// RemoveRerunOf relationship.
+// Sets o.R.RerunOf to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *Task) RemoveRerunOf(ctx context.Context, exec boil.ContextExecutor, related *Task) error {
+	var err error
+
+	queries.SetScanner(&o.RerunOfID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("rerun_of_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.RerunOf = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.RerunOfTasks {
+		if queries.Equal(o.RerunOfID, ri.RerunOfID) {
+			continue
+		}
+
+		ln := len(related.R.RerunOfTasks)
+		if ln > 1 && i < ln-1 {
+			related.R.RerunOfTasks[i] = related.R.RerunOfTasks[ln-1]
+		}
+		related.R.RerunOfTasks = related.R.RerunOfTasks[:ln-1]
+		break
+	}
+	return nil
+}
+
 // AddRuns adds the given related objects to the existing relationships
 // of the task, optionally inserting them as new records.
 // Appends related to o.R.Runs.
@@ -727,6 +1115,129 @@ func (o *Task) AddRuns(ctx context.Context, exec boil.ContextExecutor, insert bo
 	return nil
 }
 
+// AddRerunOfTasks adds the given related objects to the existing relationships
+// of the task, optionally inserting them as new records.
+// Appends related to o.R.RerunOfTasks.
+// Sets related.R.RerunOf appropriately.
+func (o *Task) AddRerunOfTasks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Task) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.RerunOfID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"tasks\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"rerun_of_id"}),
+				strmangle.WhereClause("\"", "\"", 2, taskPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.RerunOfID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &taskR{
+			RerunOfTasks: related,
+		}
+	} else {
+		o.R.RerunOfTasks = append(o.R.RerunOfTasks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &taskR{
+				RerunOf: o,
+			}
+		} else {
+			rel.R.RerunOf = o
+		}
+	}
+	return nil
+}
+
+// SetRerunOfTasks removes all previously related items of the
+// task replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.RerunOf's RerunOfTasks accordingly.
+// Replaces o.R.RerunOfTasks with related.
+// Sets related.R.RerunOf's RerunOfTasks accordingly.
+func (o *Task) SetRerunOfTasks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Task) error {
+	query := "update \"tasks\" set \"rerun_of_id\" = null where \"rerun_of_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.RerunOfTasks {
+			queries.SetScanner(&rel.RerunOfID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.RerunOf = nil
+		}
+
+		o.R.RerunOfTasks = nil
+	}
+	return o.AddRerunOfTasks(ctx, exec, insert, related...)
+}
+
+// RemoveRerunOfTasks relationships from objects passed in.
+// Removes related items from R.RerunOfTasks (uses pointer comparison, removal does not keep order)
+// Sets related.R.RerunOf.
+func (o *Task) RemoveRerunOfTasks(ctx context.Context, exec boil.ContextExecutor, related ...*Task) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.RerunOfID, nil)
+		if rel.R != nil {
+			rel.R.RerunOf = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("rerun_of_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.RerunOfTasks {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.RerunOfTasks)
+			if ln > 1 && i < ln-1 {
+				o.R.RerunOfTasks[i] = o.R.RerunOfTasks[ln-1]
+			}
+			o.R.RerunOfTasks = o.R.RerunOfTasks[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // Tasks retrieves all the records using an executor.
 func Tasks(mods ...qm.QueryMod) taskQuery {
 	mods = append(mods, qm.From("\"tasks\""))
@@ -572,6 +572,83 @@ func testTaskToManyRuns(t *testing.T) {
 	}
 }
 
+func testTaskToManyRerunOfTasks(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Task
+	var b, c Task
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, taskDBTypes, true, taskColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Task struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, taskDBTypes, false, taskColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, taskDBTypes, false, taskColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.RerunOfID, a.ID)
+	queries.Assign(&c.RerunOfID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.RerunOfTasks().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.RerunOfID, b.RerunOfID) {
+			bFound = true
+		}
+		if queries.Equal(v.RerunOfID, c.RerunOfID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := TaskSlice{&a}
+	if err = a.L.LoadRerunOfTasks(ctx, tx, false, (*[]*Task)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.RerunOfTasks); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.RerunOfTasks = nil
+	if err = a.L.LoadRerunOfTasks(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.RerunOfTasks); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
 func testTaskToManyAddOpRuns(t *testing.T) {
 	var err error
 
@@ -647,6 +724,257 @@ func testTaskToManyAddOpRuns(t *testing.T) {
 		}
 	}
 }
+func testTaskToManyAddOpRerunOfTasks(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Task
+	var b, c, d, e Task
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, taskDBTypes, false, strmangle.SetComplement(taskPrimaryKeyColumns, taskColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Task{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, taskDBTypes, false, strmangle.SetComplement(taskPrimaryKeyColumns, taskColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Task{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddRerunOfTasks(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.RerunOfID) {
+			t.Error("foreign key was wrong value", a.ID, first.RerunOfID)
+		}
+		if !queries.Equal(a.ID, second.RerunOfID) {
+			t.Error("foreign key was wrong value", a.ID, second.RerunOfID)
+		}
+
+		if first.R.RerunOf != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.RerunOf != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.RerunOfTasks[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.RerunOfTasks[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.RerunOfTasks().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testTaskToManySetOpRerunOfTasks(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Task
+	var b, c, d, e Task
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, taskDBTypes, false, strmangle.SetComplement(taskPrimaryKeyColumns, taskColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Task{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, taskDBTypes, false, strmangle.SetComplement(taskPrimaryKeyColumns, taskColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetRerunOfTasks(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.RerunOfTasks().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetRerunOfTasks(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.RerunOfTasks().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.RerunOfID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.RerunOfID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.RerunOfID) {
+		t.Error("foreign key was wrong value", a.ID, d.RerunOfID)
+	}
+	if !queries.Equal(a.ID, e.RerunOfID) {
+		t.Error("foreign key was wrong value", a.ID, e.RerunOfID)
+	}
+
+	if b.R.RerunOf != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.RerunOf != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.RerunOf != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.RerunOf != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.RerunOfTasks[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.RerunOfTasks[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testTaskToManyRemoveOpRerunOfTasks(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Task
+	var b, c, d, e Task
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, taskDBTypes, false, strmangle.SetComplement(taskPrimaryKeyColumns, taskColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Task{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, taskDBTypes, false, strmangle.SetComplement(taskPrimaryKeyColumns, taskColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddRerunOfTasks(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.RerunOfTasks().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveRerunOfTasks(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.RerunOfTasks().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.RerunOfID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.RerunOfID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.RerunOf != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.RerunOf != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.RerunOf != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.RerunOf != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.RerunOfTasks) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.RerunOfTasks[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.RerunOfTasks[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
 func testTaskToOneSubmissionUsingSubmission(t *testing.T) {
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
@@ -698,6 +1026,57 @@ func testTaskToOneSubmissionUsingSubmission(t *testing.T) {
 	}
 }
 
+func testTaskToOneTaskUsingRerunOf(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Task
+	var foreign Task
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, taskDBTypes, true, taskColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Task struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, taskDBTypes, false, taskColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Task struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.RerunOfID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.RerunOf().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := TaskSlice{&local}
+	if err = local.L.LoadRerunOf(ctx, tx, false, (*[]*Task)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.RerunOf == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.RerunOf = nil
+	if err = local.L.LoadRerunOf(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.RerunOf == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
 func testTaskToOneSetOpSubmissionUsingSubmission(t *testing.T) {
 	var err error
 
@@ -755,6 +1134,114 @@ func testTaskToOneSetOpSubmissionUsingSubmission(t *testing.T) {
 		}
 	}
 }
+func testTaskToOneSetOpTaskUsingRerunOf(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Task
+	var b, c Task
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, taskDBTypes, false, strmangle.SetComplement(taskPrimaryKeyColumns, taskColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, taskDBTypes, false, strmangle.SetComplement(taskPrimaryKeyColumns, taskColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, taskDBTypes, false, strmangle.SetComplement(taskPrimaryKeyColumns, taskColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Task{&b, &c} {
+		err = a.SetRerunOf(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.RerunOf != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.RerunOfTasks[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.RerunOfID, x.ID) {
+			t.Error("foreign key was wrong value", a.RerunOfID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.RerunOfID))
+		reflect.Indirect(reflect.ValueOf(&a.RerunOfID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.RerunOfID, x.ID) {
+			t.Error("foreign key was wrong value", a.RerunOfID, x.ID)
+		}
+	}
+}
+
+func testTaskToOneRemoveOpTaskUsingRerunOf(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Task
+	var b Task
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, taskDBTypes, false, strmangle.SetComplement(taskPrimaryKeyColumns, taskColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, taskDBTypes, false, strmangle.SetComplement(taskPrimaryKeyColumns, taskColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetRerunOf(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveRerunOf(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.RerunOf().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.RerunOf != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.RerunOfID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.RerunOfTasks) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
 
 func testTasksReload(t *testing.T) {
 	t.Parallel()
@@ -830,7 +1317,7 @@ func testTasksSelect(t *testing.T) {
 }
 
 var (
-	taskDBTypes = map[string]string{`ID`: `bigint`, `Status`: `boolean`, `TaskSettings`: `jsonb`, `CreatedAt`: `timestamp with time zone`, `StartedAt`: `timestamp with time zone`, `FinishedAt`: `timestamp with time zone`, `Canceled`: `boolean`, `Path`: `character varying`, `SubmissionID`: `bigint`}
+	taskDBTypes = map[string]string{`ID`: `bigint`, `Status`: `boolean`, `TaskSettings`: `jsonb`, `CreatedAt`: `timestamp with time zone`, `StartedAt`: `timestamp with time zone`, `FinishedAt`: `timestamp with time zone`, `Canceled`: `boolean`, `Path`: `character varying`, `SubmissionID`: `bigint`, `DependsOn`: `ARRAYbigint`, `RerunOfID`: `bigint`, `Priority`: `integer`}
 	_           = bytes.MinRead
 )
 
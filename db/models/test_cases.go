@@ -0,0 +1,1110 @@
+// Code generated by SQLBoiler 4.5.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// TestCase is an object representing the database table.
+type TestCase struct {
+	ID        int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	RunID     int64     `boil:"run_id" json:"run_id" toml:"run_id" yaml:"run_id"`
+	Suite     string    `boil:"suite" json:"suite" toml:"suite" yaml:"suite"`
+	Name      string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Status    string    `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Duration  float64   `boil:"duration" json:"duration" toml:"duration" yaml:"duration"`
+	Message   string    `boil:"message" json:"message" toml:"message" yaml:"message"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *testCaseR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L testCaseL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TestCaseColumns = struct {
+	ID        string
+	RunID     string
+	Suite     string
+	Name      string
+	Status    string
+	Duration  string
+	Message   string
+	CreatedAt string
+}{
+	ID:        "id",
+	RunID:     "run_id",
+	Suite:     "suite",
+	Name:      "name",
+	Status:    "status",
+	Duration:  "duration",
+	Message:   "message",
+	CreatedAt: "created_at",
+}
+
+// Generated where
+
+type whereHelperfloat64 struct{ field string }
+
+func (w whereHelperfloat64) EQ(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperfloat64) NEQ(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperfloat64) LT(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperfloat64) LTE(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperfloat64) GT(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperfloat64) GTE(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperfloat64) IN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperfloat64) NIN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var TestCaseWhere = struct {
+	ID        whereHelperint64
+	RunID     whereHelperint64
+	Suite     whereHelperstring
+	Name      whereHelperstring
+	Status    whereHelperstring
+	Duration  whereHelperfloat64
+	Message   whereHelperstring
+	CreatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperint64{field: "\"test_cases\".\"id\""},
+	RunID:     whereHelperint64{field: "\"test_cases\".\"run_id\""},
+	Suite:     whereHelperstring{field: "\"test_cases\".\"suite\""},
+	Name:      whereHelperstring{field: "\"test_cases\".\"name\""},
+	Status:    whereHelperstring{field: "\"test_cases\".\"status\""},
+	Duration:  whereHelperfloat64{field: "\"test_cases\".\"duration\""},
+	Message:   whereHelperstring{field: "\"test_cases\".\"message\""},
+	CreatedAt: whereHelpertime_Time{field: "\"test_cases\".\"created_at\""},
+}
+
+// TestCaseRels is where relationship names are stored.
+var TestCaseRels = struct {
+	Run string
+}{
+	Run: "Run",
+}
+
+// testCaseR is where relationships are stored.
+type testCaseR struct {
+	Run *Run `boil:"Run" json:"Run" toml:"Run" yaml:"Run"`
+}
+
+// NewStruct creates a new relationship struct
+func (*testCaseR) NewStruct() *testCaseR {
+	return &testCaseR{}
+}
+
+// testCaseL is where Load methods for each relationship are stored.
+type testCaseL struct{}
+
+var (
+	testCaseAllColumns            = []string{"id", "run_id", "suite", "name", "status", "duration", "message", "created_at"}
+	testCaseColumnsWithoutDefault = []string{"run_id", "name", "status"}
+	testCaseColumnsWithDefault    = []string{"id", "suite", "duration", "message", "created_at"}
+	testCasePrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// TestCaseSlice is an alias for a slice of pointers to TestCase.
+	// This should generally be used opposed to []TestCase.
+	TestCaseSlice []*TestCase
+	// TestCaseHook is the signature for custom TestCase hook methods
+	TestCaseHook func(context.Context, boil.ContextExecutor, *TestCase) error
+
+	testCaseQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	testCaseType                 = reflect.TypeOf(&TestCase{})
+	testCaseMapping              = queries.MakeStructMapping(testCaseType)
+	testCasePrimaryKeyMapping, _ = queries.BindMapping(testCaseType, testCaseMapping, testCasePrimaryKeyColumns)
+	testCaseInsertCacheMut       sync.RWMutex
+	testCaseInsertCache          = make(map[string]insertCache)
+	testCaseUpdateCacheMut       sync.RWMutex
+	testCaseUpdateCache          = make(map[string]updateCache)
+	testCaseUpsertCacheMut       sync.RWMutex
+	testCaseUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var testCaseBeforeInsertHooks []TestCaseHook
+var testCaseBeforeUpdateHooks []TestCaseHook
+var testCaseBeforeDeleteHooks []TestCaseHook
+var testCaseBeforeUpsertHooks []TestCaseHook
+
+var testCaseAfterInsertHooks []TestCaseHook
+var testCaseAfterSelectHooks []TestCaseHook
+var testCaseAfterUpdateHooks []TestCaseHook
+var testCaseAfterDeleteHooks []TestCaseHook
+var testCaseAfterUpsertHooks []TestCaseHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TestCase) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range testCaseBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TestCase) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range testCaseBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TestCase) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range testCaseBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TestCase) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range testCaseBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TestCase) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range testCaseAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TestCase) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range testCaseAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TestCase) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range testCaseAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TestCase) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range testCaseAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TestCase) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range testCaseAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTestCaseHook registers your hook function for all future operations.
+func AddTestCaseHook(hookPoint boil.HookPoint, testCaseHook TestCaseHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		testCaseBeforeInsertHooks = append(testCaseBeforeInsertHooks, testCaseHook)
+	case boil.BeforeUpdateHook:
+		testCaseBeforeUpdateHooks = append(testCaseBeforeUpdateHooks, testCaseHook)
+	case boil.BeforeDeleteHook:
+		testCaseBeforeDeleteHooks = append(testCaseBeforeDeleteHooks, testCaseHook)
+	case boil.BeforeUpsertHook:
+		testCaseBeforeUpsertHooks = append(testCaseBeforeUpsertHooks, testCaseHook)
+	case boil.AfterInsertHook:
+		testCaseAfterInsertHooks = append(testCaseAfterInsertHooks, testCaseHook)
+	case boil.AfterSelectHook:
+		testCaseAfterSelectHooks = append(testCaseAfterSelectHooks, testCaseHook)
+	case boil.AfterUpdateHook:
+		testCaseAfterUpdateHooks = append(testCaseAfterUpdateHooks, testCaseHook)
+	case boil.AfterDeleteHook:
+		testCaseAfterDeleteHooks = append(testCaseAfterDeleteHooks, testCaseHook)
+	case boil.AfterUpsertHook:
+		testCaseAfterUpsertHooks = append(testCaseAfterUpsertHooks, testCaseHook)
+	}
+}
+
+// One returns a single testCase record from the query.
+func (q testCaseQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TestCase, error) {
+	o := &TestCase{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for test_cases")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TestCase records from the query.
+func (q testCaseQuery) All(ctx context.Context, exec boil.ContextExecutor) (TestCaseSlice, error) {
+	var o []*TestCase
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to TestCase slice")
+	}
+
+	if len(testCaseAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TestCase records in the query.
+func (q testCaseQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count test_cases rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q testCaseQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if test_cases exists")
+	}
+
+	return count > 0, nil
+}
+
+// Run pointed to by the foreign key.
+func (o *TestCase) Run(mods ...qm.QueryMod) runQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.RunID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Runs(queryMods...)
+	queries.SetFrom(query.Query, "\"runs\"")
+
+	return query
+}
+
+// LoadRun allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (testCaseL) LoadRun(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTestCase interface{}, mods queries.Applicator) error {
+	var slice []*TestCase
+	var object *TestCase
+
+	if singular {
+		object = maybeTestCase.(*TestCase)
+	} else {
+		slice = *maybeTestCase.(*[]*TestCase)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &testCaseR{}
+		}
+		args = append(args, object.RunID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &testCaseR{}
+			}
+
+			for _, a := range args {
+				if a == obj.RunID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.RunID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`runs`),
+		qm.WhereIn(`runs.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Run")
+	}
+
+	var resultSlice []*Run
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Run")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for runs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for runs")
+	}
+
+	if len(testCaseAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Run = foreign
+		if foreign.R == nil {
+			foreign.R = &runR{}
+		}
+		foreign.R.TestCases = append(foreign.R.TestCases, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.RunID == foreign.ID {
+				local.R.Run = foreign
+				if foreign.R == nil {
+					foreign.R = &runR{}
+				}
+				foreign.R.TestCases = append(foreign.R.TestCases, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetRun of the testCase to the related item.
+// Sets o.R.Run to related.
+// Adds o to related.R.TestCases.
+func (o *TestCase) SetRun(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Run) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"test_cases\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"run_id"}),
+		strmangle.WhereClause("\"", "\"", 2, testCasePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.RunID = related.ID
+	if o.R == nil {
+		o.R = &testCaseR{
+			Run: related,
+		}
+	} else {
+		o.R.Run = related
+	}
+
+	if related.R == nil {
+		related.R = &runR{
+			TestCases: TestCaseSlice{o},
+		}
+	} else {
+		related.R.TestCases = append(related.R.TestCases, o)
+	}
+
+	return nil
+}
+
+// TestCases retrieves all the records using an executor.
+func TestCases(mods ...qm.QueryMod) testCaseQuery {
+	mods = append(mods, qm.From("\"test_cases\""))
+	return testCaseQuery{NewQuery(mods...)}
+}
+
+// FindTestCase retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTestCase(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*TestCase, error) {
+	testCaseObj := &TestCase{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"test_cases\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, testCaseObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from test_cases")
+	}
+
+	return testCaseObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TestCase) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no test_cases provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(testCaseColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	testCaseInsertCacheMut.RLock()
+	cache, cached := testCaseInsertCache[key]
+	testCaseInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			testCaseAllColumns,
+			testCaseColumnsWithDefault,
+			testCaseColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(testCaseType, testCaseMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(testCaseType, testCaseMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"test_cases\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"test_cases\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into test_cases")
+	}
+
+	if !cached {
+		testCaseInsertCacheMut.Lock()
+		testCaseInsertCache[key] = cache
+		testCaseInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TestCase.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TestCase) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	testCaseUpdateCacheMut.RLock()
+	cache, cached := testCaseUpdateCache[key]
+	testCaseUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			testCaseAllColumns,
+			testCasePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update test_cases, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"test_cases\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, testCasePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(testCaseType, testCaseMapping, append(wl, testCasePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update test_cases row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for test_cases")
+	}
+
+	if !cached {
+		testCaseUpdateCacheMut.Lock()
+		testCaseUpdateCache[key] = cache
+		testCaseUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q testCaseQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for test_cases")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for test_cases")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TestCaseSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), testCasePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"test_cases\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, testCasePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in testCase slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all testCase")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TestCase) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no test_cases provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(testCaseColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	testCaseUpsertCacheMut.RLock()
+	cache, cached := testCaseUpsertCache[key]
+	testCaseUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			testCaseAllColumns,
+			testCaseColumnsWithDefault,
+			testCaseColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			testCaseAllColumns,
+			testCasePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert test_cases, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(testCasePrimaryKeyColumns))
+			copy(conflict, testCasePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"test_cases\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(testCaseType, testCaseMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(testCaseType, testCaseMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert test_cases")
+	}
+
+	if !cached {
+		testCaseUpsertCacheMut.Lock()
+		testCaseUpsertCache[key] = cache
+		testCaseUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TestCase record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TestCase) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no TestCase provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), testCasePrimaryKeyMapping)
+	sql := "DELETE FROM \"test_cases\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from test_cases")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for test_cases")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q testCaseQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no testCaseQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from test_cases")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for test_cases")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TestCaseSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(testCaseBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), testCasePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"test_cases\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, testCasePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from testCase slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for test_cases")
+	}
+
+	if len(testCaseAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TestCase) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTestCase(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TestCaseSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TestCaseSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), testCasePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"test_cases\".* FROM \"test_cases\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, testCasePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in TestCaseSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TestCaseExists checks if the TestCase row exists.
+func TestCaseExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"test_cases\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if test_cases exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,841 @@
+// Code generated by SQLBoiler 4.5.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testTestCases(t *testing.T) {
+	t.Parallel()
+
+	query := TestCases()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testTestCasesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TestCase{}
+	if err = randomize.Struct(seed, o, testCaseDBTypes, true, testCaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TestCase struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TestCases().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTestCasesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TestCase{}
+	if err = randomize.Struct(seed, o, testCaseDBTypes, true, testCaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TestCase struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := TestCases().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TestCases().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTestCasesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TestCase{}
+	if err = randomize.Struct(seed, o, testCaseDBTypes, true, testCaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TestCase struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := TestCaseSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TestCases().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTestCasesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TestCase{}
+	if err = randomize.Struct(seed, o, testCaseDBTypes, true, testCaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TestCase struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := TestCaseExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if TestCase exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected TestCaseExists to return true, but got false.")
+	}
+}
+
+func testTestCasesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TestCase{}
+	if err = randomize.Struct(seed, o, testCaseDBTypes, true, testCaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TestCase struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	testCaseFound, err := FindTestCase(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if testCaseFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testTestCasesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TestCase{}
+	if err = randomize.Struct(seed, o, testCaseDBTypes, true, testCaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TestCase struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = TestCases().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTestCasesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TestCase{}
+	if err = randomize.Struct(seed, o, testCaseDBTypes, true, testCaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TestCase struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := TestCases().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testTestCasesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	testCaseOne := &TestCase{}
+	testCaseTwo := &TestCase{}
+	if err = randomize.Struct(seed, testCaseOne, testCaseDBTypes, false, testCaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TestCase struct: %s", err)
+	}
+	if err = randomize.Struct(seed, testCaseTwo, testCaseDBTypes, false, testCaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TestCase struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = testCaseOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = testCaseTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := TestCases().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testTestCasesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	testCaseOne := &TestCase{}
+	testCaseTwo := &TestCase{}
+	if err = randomize.Struct(seed, testCaseOne, testCaseDBTypes, false, testCaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TestCase struct: %s", err)
+	}
+	if err = randomize.Struct(seed, testCaseTwo, testCaseDBTypes, false, testCaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TestCase struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = testCaseOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = testCaseTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TestCases().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testCaseBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *TestCase) error {
+	*o = TestCase{}
+	return nil
+}
+
+func testCaseAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *TestCase) error {
+	*o = TestCase{}
+	return nil
+}
+
+func testCaseAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *TestCase) error {
+	*o = TestCase{}
+	return nil
+}
+
+func testCaseBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *TestCase) error {
+	*o = TestCase{}
+	return nil
+}
+
+func testCaseAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *TestCase) error {
+	*o = TestCase{}
+	return nil
+}
+
+func testCaseBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *TestCase) error {
+	*o = TestCase{}
+	return nil
+}
+
+func testCaseAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *TestCase) error {
+	*o = TestCase{}
+	return nil
+}
+
+func testCaseBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *TestCase) error {
+	*o = TestCase{}
+	return nil
+}
+
+func testCaseAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *TestCase) error {
+	*o = TestCase{}
+	return nil
+}
+
+func testTestCasesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &TestCase{}
+	o := &TestCase{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, testCaseDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize TestCase object: %s", err)
+	}
+
+	AddTestCaseHook(boil.BeforeInsertHook, testCaseBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	testCaseBeforeInsertHooks = []TestCaseHook{}
+
+	AddTestCaseHook(boil.AfterInsertHook, testCaseAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	testCaseAfterInsertHooks = []TestCaseHook{}
+
+	AddTestCaseHook(boil.AfterSelectHook, testCaseAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	testCaseAfterSelectHooks = []TestCaseHook{}
+
+	AddTestCaseHook(boil.BeforeUpdateHook, testCaseBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	testCaseBeforeUpdateHooks = []TestCaseHook{}
+
+	AddTestCaseHook(boil.AfterUpdateHook, testCaseAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	testCaseAfterUpdateHooks = []TestCaseHook{}
+
+	AddTestCaseHook(boil.BeforeDeleteHook, testCaseBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	testCaseBeforeDeleteHooks = []TestCaseHook{}
+
+	AddTestCaseHook(boil.AfterDeleteHook, testCaseAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	testCaseAfterDeleteHooks = []TestCaseHook{}
+
+	AddTestCaseHook(boil.BeforeUpsertHook, testCaseBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	testCaseBeforeUpsertHooks = []TestCaseHook{}
+
+	AddTestCaseHook(boil.AfterUpsertHook, testCaseAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	testCaseAfterUpsertHooks = []TestCaseHook{}
+}
+
+func testTestCasesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TestCase{}
+	if err = randomize.Struct(seed, o, testCaseDBTypes, true, testCaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TestCase struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TestCases().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testTestCasesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TestCase{}
+	if err = randomize.Struct(seed, o, testCaseDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize TestCase struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(testCaseColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TestCases().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testTestCaseToOneRunUsingRun(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local TestCase
+	var foreign Run
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, testCaseDBTypes, false, testCaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TestCase struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, runDBTypes, false, runColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Run struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.RunID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Run().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := TestCaseSlice{&local}
+	if err = local.L.LoadRun(ctx, tx, false, (*[]*TestCase)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Run == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Run = nil
+	if err = local.L.LoadRun(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Run == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testTestCaseToOneSetOpRunUsingRun(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TestCase
+	var b, c Run
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, testCaseDBTypes, false, strmangle.SetComplement(testCasePrimaryKeyColumns, testCaseColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, runDBTypes, false, strmangle.SetComplement(runPrimaryKeyColumns, runColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, runDBTypes, false, strmangle.SetComplement(runPrimaryKeyColumns, runColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Run{&b, &c} {
+		err = a.SetRun(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Run != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.TestCases[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.RunID != x.ID {
+			t.Error("foreign key was wrong value", a.RunID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.RunID))
+		reflect.Indirect(reflect.ValueOf(&a.RunID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.RunID != x.ID {
+			t.Error("foreign key was wrong value", a.RunID, x.ID)
+		}
+	}
+}
+
+func testTestCasesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TestCase{}
+	if err = randomize.Struct(seed, o, testCaseDBTypes, true, testCaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TestCase struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTestCasesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TestCase{}
+	if err = randomize.Struct(seed, o, testCaseDBTypes, true, testCaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TestCase struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := TestCaseSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTestCasesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TestCase{}
+	if err = randomize.Struct(seed, o, testCaseDBTypes, true, testCaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TestCase struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := TestCases().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	testCaseDBTypes = map[string]string{`ID`: `bigint`, `RunID`: `bigint`, `Suite`: `character varying`, `Name`: `character varying`, `Status`: `character varying`, `Duration`: `double precision`, `Message`: `text`, `CreatedAt`: `timestamp with time zone`}
+	_               = bytes.MinRead
+)
+
+func testTestCasesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(testCasePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(testCaseAllColumns) == len(testCasePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TestCase{}
+	if err = randomize.Struct(seed, o, testCaseDBTypes, true, testCaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TestCase struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TestCases().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, testCaseDBTypes, true, testCasePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TestCase struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testTestCasesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(testCaseAllColumns) == len(testCasePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TestCase{}
+	if err = randomize.Struct(seed, o, testCaseDBTypes, true, testCaseColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TestCase struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TestCases().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, testCaseDBTypes, true, testCasePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TestCase struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(testCaseAllColumns, testCasePrimaryKeyColumns) {
+		fields = testCaseAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			testCaseAllColumns,
+			testCasePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := TestCaseSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testTestCasesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(testCaseAllColumns) == len(testCasePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := TestCase{}
+	if err = randomize.Struct(seed, &o, testCaseDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize TestCase struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert TestCase: %s", err)
+	}
+
+	count, err := TestCases().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, testCaseDBTypes, false, testCasePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TestCase struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert TestCase: %s", err)
+	}
+
+	count, err = TestCases().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -49,6 +49,58 @@ func sessionToProto(ctx context.Context, db *sql.DB, i interface{}) (interface{}
 	}, nil
 }
 
+func runnerToProto(ctx context.Context, db *sql.DB, i interface{}) (interface{}, error) {
+	r, ok := i.(*models.Runner)
+	if !ok {
+		return nil, fmt.Errorf("%T: %w", i, ErrConversionInvalidType)
+	}
+
+	return &types.Runner{
+		Id:            r.ID,
+		Name:          r.Name,
+		QueueName:     r.QueueName,
+		Labels:        r.Labels,
+		Cordoned:      r.Cordoned,
+		Draining:      r.Draining,
+		RegisteredAt:  timestamppb.New(r.RegisteredAt),
+		LastHeartbeat: timestamppb.New(r.LastHeartbeat),
+	}, nil
+}
+
+func testCaseFromProto(ctx context.Context, db *sql.DB, i interface{}) (interface{}, error) {
+	tc, ok := i.(*types.TestCase)
+	if !ok {
+		return nil, fmt.Errorf("%T: %w", i, ErrConversionInvalidType)
+	}
+
+	return &models.TestCase{
+		ID:       tc.Id,
+		RunID:    tc.RunID,
+		Suite:    tc.Suite,
+		Name:     tc.Name,
+		Status:   tc.Status,
+		Duration: tc.Duration,
+		Message:  tc.Message,
+	}, nil
+}
+
+func testCaseToProto(ctx context.Context, db *sql.DB, i interface{}) (interface{}, error) {
+	tc, ok := i.(*models.TestCase)
+	if !ok {
+		return nil, fmt.Errorf("%T: %w", i, ErrConversionInvalidType)
+	}
+
+	return &types.TestCase{
+		Id:       tc.ID,
+		RunID:    tc.RunID,
+		Suite:    tc.Suite,
+		Name:     tc.Name,
+		Status:   tc.Status,
+		Duration: tc.Duration,
+		Message:  tc.Message,
+	}, nil
+}
+
 func userErrorFromProto(ctx context.Context, db *sql.DB, i interface{}) (interface{}, error) {
 	e, ok := i.(*types.UserError)
 	if !ok {
@@ -88,6 +140,7 @@ func queueItemFromProto(ctx context.Context, db *sql.DB, i interface{}) (interfa
 		RunningOn: null.StringFrom(qi.RunningOn),
 		StartedAt: null.TimeFromPtr(timeFromPB(qi.StartedAt)),
 		QueueName: qi.QueueName,
+		Priority:  int(qi.Priority),
 	}, nil
 }
 
@@ -113,6 +166,7 @@ func queueItemToProto(ctx context.Context, db *sql.DB, i interface{}) (interface
 		RunningOn: qi.RunningOn.String,
 		StartedAt: timeToPB(qi.StartedAt),
 		QueueName: qi.QueueName,
+		Priority:  int32(qi.Priority),
 		Run:       r.(*types.Run),
 	}, nil
 }
@@ -140,16 +194,24 @@ func runFromProto(ctx context.Context, db *sql.DB, i interface{}) (interface{},
 		return nil, err
 	}
 
+	var previousAttemptID null.Int64
+	if run.PreviousAttemptId != 0 {
+		previousAttemptID = null.Int64From(run.PreviousAttemptId)
+	}
+
 	return &models.Run{
-		ID:          run.Id,
-		Name:        run.Name,
-		CreatedAt:   run.CreatedAt.AsTime(),
-		StartedAt:   null.TimeFromPtr(timeFromPB(run.StartedAt)),
-		FinishedAt:  null.TimeFromPtr(timeFromPB(run.FinishedAt)),
-		Status:      makeStatus(run.Status, run.StatusSet),
-		TaskID:      task.ID,
-		RunSettings: content,
-		RanOn:       null.StringFromPtr(ranOn),
+		ID:                run.Id,
+		Name:              run.Name,
+		CreatedAt:         run.CreatedAt.AsTime(),
+		StartedAt:         null.TimeFromPtr(timeFromPB(run.StartedAt)),
+		FinishedAt:        null.TimeFromPtr(timeFromPB(run.FinishedAt)),
+		Status:            makeStatus(run.Status, run.StatusSet),
+		TaskID:            task.ID,
+		RunSettings:       content,
+		RanOn:             null.StringFromPtr(ranOn),
+		Attempt:           int(run.Attempt),
+		PreviousAttemptID: previousAttemptID,
+		FailureReason:     null.NewString(run.FailureReason, run.FailureReason != ""),
 	}, nil
 }
 
@@ -191,17 +253,20 @@ func runToProto(ctx context.Context, db *sql.DB, i interface{}) (interface{}, er
 	}
 
 	return &types.Run{
-		Id:         r.ID,
-		Name:       r.Name,
-		CreatedAt:  timestamppb.New(r.CreatedAt),
-		StartedAt:  timeToPB(r.StartedAt),
-		FinishedAt: timeToPB(r.FinishedAt),
-		Status:     status,
-		StatusSet:  set,
-		Task:       taskProto.(*types.Task),
-		Settings:   rs.ToProto(),
-		RanOn:      ranOn,
-		RanOnSet:   ranOnSet,
+		Id:                r.ID,
+		Name:              r.Name,
+		CreatedAt:         timestamppb.New(r.CreatedAt),
+		StartedAt:         timeToPB(r.StartedAt),
+		FinishedAt:        timeToPB(r.FinishedAt),
+		Status:            status,
+		StatusSet:         set,
+		Task:              taskProto.(*types.Task),
+		Settings:          rs.ToProto(),
+		RanOn:             ranOn,
+		RanOnSet:          ranOnSet,
+		Attempt:           int64(r.Attempt),
+		PreviousAttemptId: r.PreviousAttemptID.Int64,
+		FailureReason:     r.FailureReason.String,
 	}, nil
 }
 
@@ -230,6 +295,11 @@ func taskFromProto(ctx context.Context, db *sql.DB, i interface{}) (interface{},
 		return nil, err
 	}
 
+	var rerunOfID null.Int64
+	if task.RerunOfId != 0 {
+		rerunOfID = null.Int64From(task.RerunOfId)
+	}
+
 	return &models.Task{
 		ID:           task.Id,
 		Path:         task.Path,
@@ -240,6 +310,9 @@ func taskFromProto(ctx context.Context, db *sql.DB, i interface{}) (interface{},
 		Status:       makeStatus(task.Status, task.StatusSet),
 		TaskSettings: content,
 		SubmissionID: sub.ID,
+		DependsOn:    task.DependsOn,
+		RerunOfID:    rerunOfID,
+		Priority:     null.IntFrom(int(task.Priority)),
 	}, nil
 }
 
@@ -281,6 +354,9 @@ func taskToProto(ctx context.Context, db *sql.DB, i interface{}) (interface{}, e
 		Settings:   ts.ToProto(),
 		Runs:       runCount,
 		Submission: sub.(*types.Submission),
+		DependsOn:  t.DependsOn,
+		RerunOfId:  t.RerunOfID.Int64,
+		Priority:   int32(t.Priority.Int),
 	}, nil
 }
 
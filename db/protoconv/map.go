@@ -50,6 +50,9 @@ func New(db *sql.DB) *Converter {
 	c.registerConversion(toProto, &models.UserError{}, userErrorToProto)
 	c.registerConversion(fromProto, &types.Session{}, sessionFromProto)
 	c.registerConversion(toProto, &models.Session{}, sessionToProto)
+	c.registerConversion(toProto, &models.Runner{}, runnerToProto)
+	c.registerConversion(fromProto, &types.TestCase{}, testCaseFromProto)
+	c.registerConversion(toProto, &models.TestCase{}, testCaseToProto)
 	return c
 }
 
@@ -2,17 +2,47 @@ package db
 
 import (
 	"context"
-	"database/sql"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/tinyci/ci-agents/db/models"
+	topTypes "github.com/tinyci/ci-agents/types"
 	"github.com/tinyci/ci-agents/utils"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
 )
 
+const (
+	// QueuePolicyFIFO hands out queue items by priority, then in the order they
+	// were queued. This is the default.
+	QueuePolicyFIFO = "fifo"
+	// QueuePolicyRoundRobin hands out queue items by priority, then to the
+	// repository that least recently had a run started, so one busy repository
+	// cannot starve the others.
+	QueuePolicyRoundRobin = "round_robin"
+)
+
+// queuePolicyOrder is the ordering of queue items for each policy.
+var queuePolicyOrder = map[string]string{
+	QueuePolicyFIFO: "queue_items.priority desc, queue_items.id",
+	// last_served_at is kept by NextQueueItems.
+	QueuePolicyRoundRobin: "queue_items.priority desc, repositories.last_served_at asc nulls first, queue_items.id",
+}
+
+// SetQueuePolicy sets the policy NextQueueItem uses to pick between items of
+// the same queue; see the QueuePolicy constants.
+func (m *Model) SetQueuePolicy(policy string) error {
+	if _, ok := queuePolicyOrder[policy]; !ok {
+		return fmt.Errorf("invalid queue policy %q", policy)
+	}
+
+	m.queuePolicy = policy
+	return nil
+}
+
 // Validate the item. if passed true, will validate for creation scenarios
 func queueItemValidateHook(ctx context.Context, db boil.ContextExecutor, qi *models.QueueItem) error {
 	if qi.QueueName == "" {
@@ -67,9 +97,188 @@ func (m *Model) QueueTotalCountForRepository(ctx context.Context, repoID int64)
 	return models.QueueItems(getQueueRepoQueryMods(repoID)...).Count(ctx, m.db)
 }
 
-// NextQueueItem returns the next item in the named queue. If for some reason the
+// queueJoinMods join queue items to their run, task, submission and base ref.
+var queueJoinMods = []qm.QueryMod{
+	qm.InnerJoin("runs on runs.id = queue_items.run_id"),
+	qm.InnerJoin("tasks on tasks.id = runs.task_id"),
+	qm.InnerJoin("submissions on submissions.id = tasks.submission_id"),
+	qm.InnerJoin("refs on refs.id = submissions.base_ref_id"),
+}
+
+// queueConcurrencyMods hold back the items of tasks in a concurrency group
+// while another task of the group in the repository is running, and likewise
+// for runs in a concurrency group. They expect queueJoinMods.
+var queueConcurrencyMods = []qm.QueryMod{
+	qm.Where(`not exists (
+		select 1 from tasks members
+		inner join submissions member_subs on member_subs.id = members.submission_id
+		inner join refs member_refs on member_refs.id = member_subs.base_ref_id
+		where member_refs.repository_id = refs.repository_id
+		and members.id != tasks.id
+		and members.task_settings->'concurrency'->>'group' = tasks.task_settings->'concurrency'->>'group'
+		and members.started_at is not null and members.finished_at is null
+	)`),
+	qm.Where(`not exists (
+		select 1 from runs members
+		inner join tasks member_tasks on member_tasks.id = members.task_id
+		inner join submissions member_subs on member_subs.id = member_tasks.submission_id
+		inner join refs member_refs on member_refs.id = member_subs.base_ref_id
+		where member_refs.repository_id = refs.repository_id
+		and members.run_settings->'concurrency'->>'group' = runs.run_settings->'concurrency'->>'group'
+		and members.started_at is not null and members.finished_at is null
+	)`),
+}
+
+// queueCandidate is a queue item which may be claimed, along with what is
+// needed to claim the concurrency groups of its task and run.
+type queueCandidate struct {
+	models.QueueItem `boil:",bind"`
+	TaskID           int64       `boil:"task_id"`
+	RepositoryID     int64       `boil:"repository_id"`
+	TaskGroup        null.String `boil:"task_group"`
+	RunGroup         null.String `boil:"run_group"`
+}
+
+// queueCandidateMods select the items which can be handed to a runner asking
+// for the named queue with the labels given. The queue is one of the runner's
+// labels, and each item requires the label of its own queue, so runners
+// carrying the labels of other queues are handed their items as well.
+func queueCandidateMods(queueName string, labels []string) []qm.QueryMod {
+	labels = topTypes.RunnerLabels(queueName, labels)
+
+	mods := append([]qm.QueryMod{}, queueJoinMods...)
+	mods = append(mods,
+		qm.Where("queue_items.queue_name = any(?) and not queue_items.running", types.StringArray(topTypes.LabelQueues(labels))),
+		qm.Where("coalesce(runs.run_settings->'runsOn', '[]'::jsonb) <@ to_jsonb(?::text[])", types.StringArray(labels)),
+	)
+
+	return append(mods, queueClaimableMods()...)
+}
+
+// queueClaimableMods hold back the items which cannot be claimed by any runner
+// yet, for their dependencies or concurrency groups. They expect
+// queueJoinMods.
+func queueClaimableMods() []qm.QueryMod {
+	mods := []qm.QueryMod{
+		// items whose task still has unfinished or failed dependencies are held
+		// back; see FailDependentTasks for how failures are propagated.
+		qm.Where(`not exists (
+			select 1 from tasks deps
+			where deps.id = any(tasks.depends_on) and (deps.finished_at is null or deps.status is not true)
+		)`),
+		// same for runs which need other runs in the task; see FailDependentRuns.
+		// Attempts which have been retried are superseded by their retry.
+		qm.Where(`not exists (
+			select 1 from runs needed
+			where needed.task_id = runs.task_id
+			and needed.run_settings->>'name' in (select jsonb_array_elements_text(runs.run_settings->'needs'))
+			and (needed.finished_at is null or needed.status is not true)
+			and not exists (select 1 from runs retry where retry.previous_attempt_id = needed.id)
+		)`),
+	}
+
+	return append(mods, queueConcurrencyMods...)
+}
+
+// ExpiredQueueItems returns the items of the named queue which have waited to
+// be handed out for longer than the ttl. Only the time an item could be
+// claimed counts: items waiting on their dependencies or concurrency groups
+// are held back, and their wait starts over once they are claimable, as of
+// the last call which found them held back. Items put back into the queue
+// after their runner was lost wait anew.
+func (m *Model) ExpiredQueueItems(ctx context.Context, queueName string, ttl time.Duration) ([]*models.QueueItem, error) {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+
+	mods := append([]qm.QueryMod{
+		qm.Select("queue_items.*"),
+		models.QueueItemWhere.QueueName.EQ(queueName),
+		models.QueueItemWhere.Running.EQ(false),
+	}, queueJoinMods...)
+	mods = append(mods, queueClaimableMods()...)
+
+	claimable, err := models.QueueItems(mods...).All(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []int64{}
+	for _, qi := range claimable {
+		ids = append(ids, qi.ID)
+	}
+
+	// restart the clock of the items held back.
+	if _, err := models.QueueItems(
+		models.QueueItemWhere.QueueName.EQ(queueName),
+		models.QueueItemWhere.Running.EQ(false),
+		models.QueueItemWhere.ID.NIN(ids),
+	).UpdateAll(ctx, tx, models.M{models.QueueItemColumns.ClaimableAt: now}); err != nil {
+		return nil, err
+	}
+
+	expired, err := models.QueueItems(append(mods,
+		qm.Where("greatest(queue_items.queued_at, queue_items.claimable_at) < ?", now.Add(-ttl)),
+		qm.OrderBy("queue_items.id"),
+	)...).All(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return expired, tx.Commit()
+}
+
+// WatchQueue returns a channel which receives a value whenever items are
+// added to the named queue, or put back into it, or a run or task finishes
+// while items are waiting in it, and a function to stop watching. The runner's
+// labels may name other queues, as in NextQueueItems, which are watched as
+// well. Once the watch is in place, a value is sent at once, so items queued
+// before it began are not missed. Wakeups do not guarantee there is an item
+// the caller can claim, as other runners may have claimed it first or its runs
+// may need other labels.
+func (m *Model) WatchQueue(queueName string, labels ...string) (<-chan struct{}, func()) {
+	if queueName == "" {
+		queueName = "default"
+	}
+
+	return m.queueWatcher.subscribe(topTypes.LabelQueues(topTypes.RunnerLabels(queueName, labels))...)
+}
+
+// NextQueueItem returns the next item in the named queue; it claims a single
+// item with NextQueueItems.
+func (m *Model) NextQueueItem(ctx context.Context, runningOn string, queueName string, labels ...string) (*models.QueueItem, error) {
+	qis, err := m.NextQueueItems(ctx, runningOn, queueName, 1, labels...)
+	if err != nil {
+		return nil, err
+	}
+
+	return qis[0], nil
+}
+
+// NextQueueItems claims up to count items in the named queue for the runner,
+// for runners which can run several at once. If for some reason the
 // queueName is an empty string, the string `default` will be used instead.
-func (m *Model) NextQueueItem(ctx context.Context, runningOn string, queueName string) (qi *models.QueueItem, retErr error) {
+// Items belonging to tasks that depend on other tasks are only returned once
+// all of those tasks have finished successfully, and likewise for runs that
+// need other runs in their task. Members of a concurrency group (see
+// Concurrency in TaskSettings and RunSettings) are held back while another
+// member in the repository is running. Runs that require labels (see runs_on in
+// RunSettings) are only returned if all of them are among the labels given.
+// The named queue counts as one of the labels, and items of the other queues
+// named by them (see QueueLabel in the types package) are returned too.
+// Cordoned runners get nothing.
+// Of the remaining items, those with the highest priority are returned, with
+// ties broken by the model's queue policy. utils.ErrNotFound is returned if
+// there were none.
+//
+// Runners claim items concurrently: items being claimed by another runner are
+// skipped rather than waited for, so fewer than count items may be returned
+// while others remain in the queue.
+func (m *Model) NextQueueItems(ctx context.Context, runningOn string, queueName string, count int, labels ...string) (qis []*models.QueueItem, retErr error) {
 	if queueName == "" {
 		queueName = "default"
 	}
@@ -78,7 +287,11 @@ func (m *Model) NextQueueItem(ctx context.Context, runningOn string, queueName s
 		return nil, errors.New("no runner hostname provided")
 	}
 
-	tx, err := m.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if count < 1 {
+		return nil, errors.New("at least one queue item must be requested")
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -88,50 +301,162 @@ func (m *Model) NextQueueItem(ctx context.Context, runningOn string, queueName s
 		}
 	}()
 
-	if _, err := tx.Exec("lock table queue_items"); err != nil {
+	cordoned, err := runnerCordoned(ctx, tx, runningOn)
+	if err != nil {
 		return nil, err
 	}
 
-	qi, err = models.QueueItems(qm.Limit(1), qm.OrderBy("id"), qm.Where("queue_name = ? and not running", queueName)).One(ctx, tx)
-	if errors.Is(err, sql.ErrNoRows) {
+	if cordoned {
 		return nil, utils.ErrNotFound
-	} else if err != nil {
-		return nil, err
 	}
 
-	run, err := qi.Run().One(ctx, tx)
+	candidates := []*queueCandidate{}
+
+	err = models.NewQuery(
+		append(
+			queueCandidateMods(queueName, labels),
+			qm.Select(
+				"queue_items.*",
+				"runs.task_id",
+				"refs.repository_id",
+				"tasks.task_settings->'concurrency'->>'group' as task_group",
+				"runs.run_settings->'concurrency'->>'group' as run_group",
+			),
+			qm.From("queue_items"),
+			qm.InnerJoin("repositories on repositories.id = refs.repository_id"),
+			qm.OrderBy(queuePolicyOrder[m.queuePolicy]),
+			qm.Limit(count),
+			qm.For("update of queue_items skip locked"),
+		)...,
+	).Bind(ctx, tx, &candidates)
 	if err != nil {
 		return nil, err
 	}
 
 	t := time.Now()
-	run.StartedAt = null.TimeFrom(t)
-	run.RanOn = null.StringFrom(runningOn)
-	if _, err := run.Update(ctx, tx, boil.Infer()); err != nil {
-		return nil, err
+	taskIDs := []int64{}
+	repoIDs := []int64{}
+
+	for _, c := range candidates {
+		taskIDs = append(taskIDs, c.TaskID)
 	}
 
-	task, err := run.Task().One(ctx, tx)
+	// tasks are locked in order, so claimers starting the same tasks cannot
+	// deadlock. They are started as their first item is claimed below, so the
+	// concurrency checks of the later candidates see them running.
+	tasks, err := models.Tasks(
+		qm.Where("id = any(?)", types.Int64Array(taskIDs)),
+		models.TaskWhere.StartedAt.IsNull(),
+		qm.OrderBy("id"),
+		qm.For("update"),
+	).All(ctx, tx)
 	if err != nil {
 		return nil, err
 	}
 
-	if !task.StartedAt.Valid {
-		task.StartedAt = null.TimeFrom(t)
-		if _, err := task.Update(ctx, tx, boil.Infer()); err != nil {
+	unstarted := map[int64]*models.Task{}
+	for _, task := range tasks {
+		unstarted[task.ID] = task
+	}
+
+	for _, c := range candidates {
+		ok, err := claimConcurrency(ctx, tx, c)
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			continue
+		}
+
+		if task, ok := unstarted[c.TaskID]; ok {
+			task.StartedAt = null.TimeFrom(t)
+			if _, err := task.Update(ctx, tx, boil.Infer()); err != nil {
+				return nil, err
+			}
+
+			delete(unstarted, c.TaskID)
+		}
+
+		run, err := models.FindRun(ctx, tx, c.RunID)
+		if err != nil {
+			return nil, err
+		}
+
+		run.StartedAt = null.TimeFrom(t)
+		run.RanOn = null.StringFrom(runningOn)
+		if _, err := run.Update(ctx, tx, boil.Infer()); err != nil {
+			return nil, err
+		}
+
+		qi := &c.QueueItem
+		qi.StartedAt = null.TimeFrom(t)
+		qi.Running = true
+		qi.RunningOn = null.StringFrom(runningOn)
+
+		if _, err := qi.Update(ctx, tx, boil.Infer()); err != nil {
+			return nil, err
+		}
+
+		qis = append(qis, qi)
+		repoIDs = append(repoIDs, c.RepositoryID)
+	}
+
+	if len(qis) == 0 {
+		return nil, utils.ErrNotFound
+	}
+
+	// the round robin policy records when repositories were last served.
+	// Repositories being served by another claimer are skipped rather than
+	// waited for, so claimers of the same repository are not serialized on
+	// it; the other claimer records nearly the same time anyway.
+	if m.queuePolicy == QueuePolicyRoundRobin {
+		_, err := tx.ExecContext(ctx, `
+			update repositories set last_served_at = greatest(last_served_at, $1)
+			where id in (select id from repositories where id = any($2) for update skip locked)
+		`, t, types.Int64Array(repoIDs))
+		if err != nil {
 			return nil, err
 		}
 	}
 
-	qi.StartedAt = null.TimeFrom(t)
-	qi.Running = true
-	qi.RunningOn = null.StringFrom(runningOn)
+	return qis, tx.Commit()
+}
 
-	if _, err := qi.Update(ctx, tx, boil.Infer()); err != nil {
-		return nil, err
+// claimConcurrency takes the advisory locks of the candidate's concurrency
+// groups for the rest of the transaction, and checks again that no other
+// member of them is running, now that the claims which held the locks before
+// have been committed. Groups locked by another claimer are skipped rather
+// than waited for. It returns false if the candidate must stay in the queue.
+func claimConcurrency(ctx context.Context, exec boil.ContextExecutor, c *queueCandidate) (bool, error) {
+	keys := []string{}
+
+	if c.TaskGroup.Valid {
+		keys = append(keys, fmt.Sprintf("%d:task:%s", c.RepositoryID, c.TaskGroup.String))
+	}
+
+	if c.RunGroup.Valid {
+		keys = append(keys, fmt.Sprintf("%d:run:%s", c.RepositoryID, c.RunGroup.String))
+	}
+
+	if len(keys) == 0 {
+		return true, nil
+	}
+
+	for _, key := range keys {
+		var locked bool
+		if err := exec.QueryRowContext(ctx, "select pg_try_advisory_xact_lock(hashtext($1))", key).Scan(&locked); err != nil {
+			return false, err
+		}
+
+		if !locked {
+			return false, nil
+		}
 	}
 
-	return qi, tx.Commit()
+	// this sees the members claimed earlier in this transaction, too.
+	mods := append([]qm.QueryMod{qm.Where("queue_items.id = ?", c.ID)}, queueJoinMods...)
+	return models.QueueItems(append(mods, queueConcurrencyMods...)...).Exists(ctx, exec)
 }
 
 // QueuePipelineAdd adds a group of queue items in a transaction.
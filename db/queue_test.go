@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -391,6 +392,9 @@ func TestQueueConcurrent(t *testing.T) {
 	fmt.Println("Filling queue took", time.Since(fillstart))
 
 	start := time.Now()
+	// claims no longer wait for each other, so items may arrive out of order,
+	// but each must be handed out exactly once.
+	seen := map[int64]struct{}{}
 
 	for i := 0; i < goRoutines; i++ {
 		go func(i int) {
@@ -416,14 +420,17 @@ func TestQueueConcurrent(t *testing.T) {
 		}(i)
 	}
 
-	for i := firstID; i <= firstID+count-1; i++ {
+	for i := int64(0); i < count; i++ {
 		select {
 		case err := <-errChan:
 			assert.NilError(t, err)
 		case qi := <-queueChan:
 			run, err := qi.Run().One(ctx, m.db)
 			assert.NilError(t, err)
-			assert.Assert(t, cmp.Equal(qi.RunID, i), fmt.Sprintf("%d", i-firstID)) // ensures same order
+			assert.Assert(t, qi.RunID >= firstID && qi.RunID < firstID+count, fmt.Sprintf("%d", qi.RunID-firstID))
+			_, ok := seen[qi.RunID]
+			assert.Assert(t, !ok, fmt.Sprintf("%d handed out twice", qi.RunID))
+			seen[qi.RunID] = struct{}{}
 			assert.Assert(t, run.Name != "")
 			assert.Assert(t, qi.Running)
 			assert.Assert(t, cmp.Equal(qi.RunningOn.String, "hostname"))
@@ -551,3 +558,619 @@ func TestQueueNamedConcurrent(t *testing.T) {
 
 	fmt.Println("Iterating queue took", time.Since(start))
 }
+
+func TestQueueDependencies(t *testing.T) {
+	m := testInit(t)
+
+	for _, succeed := range []bool{true, false} {
+		run, err := m.CreateTestRun(ctx)
+		assert.NilError(t, err)
+
+		assert.NilError(t, (&models.QueueItem{RunID: run.ID, QueueName: "default"}).Insert(ctx, m.db, boil.Infer()))
+
+		task, err := run.Task().One(ctx, m.db)
+		assert.NilError(t, err)
+
+		sub, err := task.Submission().One(ctx, m.db)
+		assert.NilError(t, err)
+
+		depTask, err := m.CreateTestTaskForSubmission(ctx, sub)
+		assert.NilError(t, err)
+
+		depTask.DependsOn = []int64{task.ID}
+		_, err = depTask.Update(ctx, m.db, boil.Infer())
+		assert.NilError(t, err)
+
+		qi, err := m.NextQueueItem(ctx, "hostname", "default")
+		assert.NilError(t, err)
+		assert.Assert(t, cmp.Equal(qi.RunID, run.ID))
+
+		// the dependent task must wait until the first one has finished.
+		_, err = m.NextQueueItem(ctx, "hostname", "default")
+		assert.Assert(t, errors.Is(err, utils.ErrNotFound))
+
+		assert.NilError(t, m.SetRunStatus(ctx, run.ID, succeed, ""))
+
+		if succeed {
+			qi, err = m.NextQueueItem(ctx, "hostname", "default")
+			assert.NilError(t, err)
+
+			depRun, err := qi.Run().One(ctx, m.db)
+			assert.NilError(t, err)
+			assert.Assert(t, cmp.Equal(depRun.TaskID, depTask.ID))
+			assert.NilError(t, m.SetRunStatus(ctx, depRun.ID, true, ""))
+		} else {
+			runs, err := m.FailDependentTasks(ctx, task.ID)
+			assert.NilError(t, err)
+			assert.Assert(t, cmp.Len(runs, 1))
+			assert.Assert(t, cmp.Equal(runs[0].TaskID, depTask.ID))
+
+			depTask, err = models.FindTask(ctx, m.db, depTask.ID)
+			assert.NilError(t, err)
+			assert.Assert(t, depTask.FinishedAt.Valid)
+			assert.Assert(t, depTask.Status.Valid && !depTask.Status.Bool)
+
+			_, err = m.NextQueueItem(ctx, "hostname", "default")
+			assert.Assert(t, errors.Is(err, utils.ErrNotFound))
+		}
+
+		count, err := m.QueueTotalCount(ctx)
+		assert.NilError(t, err)
+		assert.Assert(t, cmp.Equal(count, int64(0)))
+	}
+}
+
+func TestQueueRunNeeds(t *testing.T) {
+	m := testInit(t)
+
+	for _, succeed := range []bool{true, false} {
+		base, err := m.CreateTestRun(ctx)
+		assert.NilError(t, err)
+
+		runs := map[string]*models.Run{}
+
+		for _, rs := range []*topTypes.RunSettings{
+			{Name: "build"},
+			{Name: "integration", Needs: []string{"build"}},
+			{Name: "deploy", Needs: []string{"integration"}},
+		} {
+			rs.Image = "foo"
+			rs.Command = []string{"run", "me"}
+			rs.Queue = "default"
+
+			// runs are stored in their protobuf representation, which is what the
+			// queue inspects.
+			content, err := json.Marshal(rs.ToProto())
+			assert.NilError(t, err)
+
+			run := &models.Run{Name: rs.Name, RunSettings: content, TaskID: base.TaskID}
+			assert.NilError(t, run.Insert(ctx, m.db, boil.Infer()))
+			assert.NilError(t, (&models.QueueItem{RunID: run.ID, QueueName: "default"}).Insert(ctx, m.db, boil.Infer()))
+			runs[rs.Name] = run
+		}
+
+		// the base run has no queue item; finish it so the task can complete.
+		base.Status = null.BoolFrom(true)
+		base.FinishedAt = null.TimeFrom(time.Now())
+		_, err = base.Update(ctx, m.db, boil.Infer())
+		assert.NilError(t, err)
+
+		qi, err := m.NextQueueItem(ctx, "hostname", "default")
+		assert.NilError(t, err)
+		assert.Assert(t, cmp.Equal(qi.RunID, runs["build"].ID))
+
+		_, err = m.NextQueueItem(ctx, "hostname", "default")
+		assert.Assert(t, errors.Is(err, utils.ErrNotFound))
+
+		assert.NilError(t, m.SetRunStatus(ctx, runs["build"].ID, succeed, ""))
+
+		if succeed {
+			qi, err = m.NextQueueItem(ctx, "hostname", "default")
+			assert.NilError(t, err)
+			assert.Assert(t, cmp.Equal(qi.RunID, runs["integration"].ID))
+
+			_, err = m.NextQueueItem(ctx, "hostname", "default")
+			assert.Assert(t, errors.Is(err, utils.ErrNotFound))
+
+			assert.NilError(t, m.SetRunStatus(ctx, runs["integration"].ID, true, ""))
+
+			qi, err = m.NextQueueItem(ctx, "hostname", "default")
+			assert.NilError(t, err)
+			assert.Assert(t, cmp.Equal(qi.RunID, runs["deploy"].ID))
+			assert.NilError(t, m.SetRunStatus(ctx, runs["deploy"].ID, true, ""))
+		} else {
+			failed, err := m.FailDependentRuns(ctx, runs["build"].ID)
+			assert.NilError(t, err)
+			assert.Assert(t, cmp.Len(failed, 2))
+
+			_, err = m.NextQueueItem(ctx, "hostname", "default")
+			assert.Assert(t, errors.Is(err, utils.ErrNotFound))
+		}
+
+		task, err := models.FindTask(ctx, m.db, base.TaskID)
+		assert.NilError(t, err)
+		assert.Assert(t, task.FinishedAt.Valid)
+		assert.Assert(t, cmp.Equal(task.Status.Bool, succeed))
+
+		count, err := m.QueueTotalCount(ctx)
+		assert.NilError(t, err)
+		assert.Assert(t, cmp.Equal(count, int64(0)))
+	}
+}
+
+func TestQueuePriority(t *testing.T) {
+	m := testInit(t)
+
+	ids := map[int]int64{}
+
+	for _, priority := range []int{0, 5, 1} {
+		run, err := m.CreateTestRun(ctx)
+		assert.NilError(t, err)
+
+		assert.NilError(t, (&models.QueueItem{RunID: run.ID, QueueName: "default", Priority: priority}).Insert(ctx, m.db, boil.Infer()))
+		ids[priority] = run.ID
+	}
+
+	for _, priority := range []int{5, 1, 0} {
+		qi, err := m.NextQueueItem(ctx, "hostname", "default")
+		assert.NilError(t, err)
+		assert.Assert(t, cmp.Equal(qi.RunID, ids[priority]))
+		assert.Assert(t, cmp.Equal(qi.Priority, priority))
+	}
+}
+
+func TestQueuePolicy(t *testing.T) {
+	m := testInit(t)
+
+	assert.Assert(t, m.SetQueuePolicy("random") != nil)
+
+	for _, policy := range []string{QueuePolicyFIFO, QueuePolicyRoundRobin} {
+		assert.NilError(t, m.SetQueuePolicy(policy))
+
+		// two runs for one repository are queued before a run for another.
+		busy, err := m.CreateTestRun(ctx)
+		assert.NilError(t, err)
+
+		busy2 := &models.Run{Name: busy.Name + "2", RunSettings: busy.RunSettings, TaskID: busy.TaskID}
+		assert.NilError(t, busy2.Insert(ctx, m.db, boil.Infer()))
+
+		other, err := m.CreateTestRun(ctx)
+		assert.NilError(t, err)
+
+		for _, run := range []*models.Run{busy, busy2, other} {
+			assert.NilError(t, (&models.QueueItem{RunID: run.ID, QueueName: "default"}).Insert(ctx, m.db, boil.Infer()))
+		}
+
+		order := []int64{busy.ID, busy2.ID, other.ID}
+		if policy == QueuePolicyRoundRobin {
+			// the other repository has not been served yet, so it goes next.
+			order = []int64{busy.ID, other.ID, busy2.ID}
+		}
+
+		for _, id := range order {
+			qi, err := m.NextQueueItem(ctx, "hostname", "default")
+			assert.NilError(t, err)
+			assert.Assert(t, cmp.Equal(qi.RunID, id))
+			assert.NilError(t, m.SetRunStatus(ctx, qi.RunID, true, ""))
+		}
+	}
+}
+
+func TestQueueLabels(t *testing.T) {
+	m := testInit(t)
+
+	base, err := m.CreateTestRun(ctx)
+	assert.NilError(t, err)
+
+	runs := map[string]*models.Run{}
+
+	for name, labels := range map[string][]string{
+		"any": nil,
+		"arm": {"arch=arm64"},
+		"gpu": {"arch=amd64", "gpu"},
+	} {
+		rs := &topTypes.RunSettings{Name: name, Image: "foo", Command: []string{"run", "me"}, Queue: "default", RunsOn: labels}
+
+		content, err := json.Marshal(rs.ToProto())
+		assert.NilError(t, err)
+
+		run := &models.Run{Name: name, RunSettings: content, TaskID: base.TaskID}
+		assert.NilError(t, run.Insert(ctx, m.db, boil.Infer()))
+		assert.NilError(t, (&models.QueueItem{RunID: run.ID, QueueName: "default"}).Insert(ctx, m.db, boil.Infer()))
+		runs[name] = run
+	}
+
+	// a runner without labels only gets runs without requirements.
+	qi, err := m.NextQueueItem(ctx, "hostname", "default")
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Equal(qi.RunID, runs["any"].ID))
+
+	_, err = m.NextQueueItem(ctx, "hostname", "default")
+	assert.Assert(t, errors.Is(err, utils.ErrNotFound))
+
+	// all required labels must be present.
+	qi, err = m.NextQueueItem(ctx, "hostname", "default", "gpu", "arch=arm64")
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Equal(qi.RunID, runs["arm"].ID))
+
+	_, err = m.NextQueueItem(ctx, "hostname", "default", "gpu", "arch=arm64")
+	assert.Assert(t, errors.Is(err, utils.ErrNotFound))
+
+	qi, err = m.NextQueueItem(ctx, "hostname", "default", "arch=amd64", "gpu", "docker")
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Equal(qi.RunID, runs["gpu"].ID))
+
+	// the queue is a label as well, so runners carrying the label of another
+	// queue are handed its items.
+	other := &models.Run{Name: "other", RunSettings: base.RunSettings, TaskID: base.TaskID}
+	assert.NilError(t, other.Insert(ctx, m.db, boil.Infer()))
+	assert.NilError(t, (&models.QueueItem{RunID: other.ID, QueueName: "other"}).Insert(ctx, m.db, boil.Infer()))
+
+	_, err = m.NextQueueItem(ctx, "hostname", "default")
+	assert.Assert(t, errors.Is(err, utils.ErrNotFound))
+
+	qi, err = m.NextQueueItem(ctx, "hostname", "default", topTypes.QueueLabel("other"))
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Equal(qi.RunID, other.ID))
+}
+
+func TestQueueConcurrency(t *testing.T) {
+	m := testInit(t)
+
+	base, err := m.CreateTestRun(ctx)
+	assert.NilError(t, err)
+
+	baseTask, err := models.FindTask(ctx, m.db, base.TaskID)
+	assert.NilError(t, err)
+
+	// runs of the same group wait for each other, even within a task.
+	_, runs, err := m.CreateTestConcurrentTask(ctx, baseTask.SubmissionID, nil,
+		&topTypes.RunSettings{Name: "deploy-a", Concurrency: &topTypes.Concurrency{Group: "deploy"}},
+		&topTypes.RunSettings{Name: "deploy-b", Concurrency: &topTypes.Concurrency{Group: "deploy"}},
+		&topTypes.RunSettings{Name: "other"},
+	)
+	assert.NilError(t, err)
+
+	for _, i := range []int{0, 2} {
+		qi, err := m.NextQueueItem(ctx, "hostname", "default")
+		assert.NilError(t, err)
+		assert.Assert(t, cmp.Equal(qi.RunID, runs[i].ID))
+	}
+
+	_, err = m.NextQueueItem(ctx, "hostname", "default")
+	assert.Assert(t, errors.Is(err, utils.ErrNotFound))
+
+	assert.NilError(t, m.SetRunStatus(ctx, runs[0].ID, true, ""))
+
+	qi, err := m.NextQueueItem(ctx, "hostname", "default")
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Equal(qi.RunID, runs[1].ID))
+
+	for _, run := range runs[1:] {
+		assert.NilError(t, m.SetRunStatus(ctx, run.ID, true, ""))
+	}
+
+	// tasks of the same group wait for the whole task, but its own runs do not.
+	group := &topTypes.Concurrency{Group: "release"}
+
+	_, first, err := m.CreateTestConcurrentTask(ctx, baseTask.SubmissionID, group, &topTypes.RunSettings{Name: "a"}, &topTypes.RunSettings{Name: "b"})
+	assert.NilError(t, err)
+
+	_, second, err := m.CreateTestConcurrentTask(ctx, baseTask.SubmissionID, group, &topTypes.RunSettings{Name: "a"})
+	assert.NilError(t, err)
+
+	for _, run := range first {
+		qi, err := m.NextQueueItem(ctx, "hostname", "default")
+		assert.NilError(t, err)
+		assert.Assert(t, cmp.Equal(qi.RunID, run.ID))
+	}
+
+	_, err = m.NextQueueItem(ctx, "hostname", "default")
+	assert.Assert(t, errors.Is(err, utils.ErrNotFound))
+
+	assert.NilError(t, m.SetRunStatus(ctx, first[0].ID, true, ""))
+
+	_, err = m.NextQueueItem(ctx, "hostname", "default")
+	assert.Assert(t, errors.Is(err, utils.ErrNotFound))
+
+	assert.NilError(t, m.SetRunStatus(ctx, first[1].ID, false, ""))
+
+	qi, err = m.NextQueueItem(ctx, "hostname", "default")
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Equal(qi.RunID, second[0].ID))
+
+	// groups are scoped to the repository.
+	other, err := m.CreateTestRun(ctx)
+	assert.NilError(t, err)
+
+	otherTask, err := models.FindTask(ctx, m.db, other.TaskID)
+	assert.NilError(t, err)
+
+	_, third, err := m.CreateTestConcurrentTask(ctx, otherTask.SubmissionID, group, &topTypes.RunSettings{Name: "a"})
+	assert.NilError(t, err)
+
+	qi, err = m.NextQueueItem(ctx, "hostname", "default")
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Equal(qi.RunID, third[0].ID))
+}
+
+func TestQueueBatch(t *testing.T) {
+	m := testInit(t)
+
+	_, err := m.NextQueueItems(ctx, "hostname", "default", 0)
+	assert.Assert(t, err != nil)
+
+	base, err := m.CreateTestRun(ctx)
+	assert.NilError(t, err)
+
+	baseTask, err := models.FindTask(ctx, m.db, base.TaskID)
+	assert.NilError(t, err)
+
+	task, runs, err := m.CreateTestConcurrentTask(ctx, baseTask.SubmissionID, nil,
+		&topTypes.RunSettings{Name: "a"},
+		&topTypes.RunSettings{Name: "b", Concurrency: &topTypes.Concurrency{Group: "deploy"}},
+		&topTypes.RunSettings{Name: "c", Concurrency: &topTypes.Concurrency{Group: "deploy"}},
+		&topTypes.RunSettings{Name: "d"},
+	)
+	assert.NilError(t, err)
+
+	// only one member of a group is claimed, even within a batch.
+	qis, err := m.NextQueueItems(ctx, "hostname", "default", 3)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(qis, 2))
+	assert.Assert(t, cmp.Equal(qis[0].RunID, runs[0].ID))
+	assert.Assert(t, cmp.Equal(qis[1].RunID, runs[1].ID))
+
+	for _, qi := range qis {
+		assert.Assert(t, qi.Running)
+		assert.Assert(t, cmp.Equal(qi.RunningOn.String, "hostname"))
+	}
+
+	task, err = models.FindTask(ctx, m.db, task.ID)
+	assert.NilError(t, err)
+	assert.Assert(t, task.StartedAt.Valid)
+
+	qis, err = m.NextQueueItems(ctx, "hostname", "default", 3)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(qis, 1))
+	assert.Assert(t, cmp.Equal(qis[0].RunID, runs[3].ID))
+
+	_, err = m.NextQueueItems(ctx, "hostname", "default", 3)
+	assert.Assert(t, errors.Is(err, utils.ErrNotFound))
+
+	// likewise for tasks: the first task of a group to be claimed holds back
+	// the others in the same batch.
+	release := &topTypes.Concurrency{Group: "release"}
+
+	first, firstRuns, err := m.CreateTestConcurrentTask(ctx, baseTask.SubmissionID, release, &topTypes.RunSettings{Name: "a"})
+	assert.NilError(t, err)
+
+	second, secondRuns, err := m.CreateTestConcurrentTask(ctx, baseTask.SubmissionID, release, &topTypes.RunSettings{Name: "a"})
+	assert.NilError(t, err)
+
+	qis, err = m.NextQueueItems(ctx, "hostname", "default", 3)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(qis, 1))
+	assert.Assert(t, cmp.Equal(qis[0].RunID, firstRuns[0].ID))
+
+	first, err = models.FindTask(ctx, m.db, first.ID)
+	assert.NilError(t, err)
+	assert.Assert(t, first.StartedAt.Valid)
+
+	second, err = models.FindTask(ctx, m.db, second.ID)
+	assert.NilError(t, err)
+	assert.Assert(t, !second.StartedAt.Valid)
+
+	_, err = m.NextQueueItems(ctx, "hostname", "default", 3)
+	assert.Assert(t, errors.Is(err, utils.ErrNotFound))
+
+	assert.NilError(t, m.SetRunStatus(ctx, firstRuns[0].ID, true, ""))
+
+	qis, err = m.NextQueueItems(ctx, "hostname", "default", 3)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(qis, 1))
+	assert.Assert(t, cmp.Equal(qis[0].RunID, secondRuns[0].ID))
+}
+
+func TestExpiredQueueItems(t *testing.T) {
+	m := testInit(t)
+
+	base, err := m.CreateTestRun(ctx)
+	assert.NilError(t, err)
+
+	baseTask, err := models.FindTask(ctx, m.db, base.TaskID)
+	assert.NilError(t, err)
+
+	_, runs, err := m.CreateTestConcurrentTask(ctx, baseTask.SubmissionID, nil,
+		&topTypes.RunSettings{Name: "a"},
+		&topTypes.RunSettings{Name: "b"},
+		&topTypes.RunSettings{Name: "c"},
+		&topTypes.RunSettings{Name: "d", Needs: []string{"c"}},
+	)
+	assert.NilError(t, err)
+
+	// d only counts the time since c passed, as it cannot be claimed before.
+	for _, run := range append(runs[:2:2], runs[3]) {
+		qi, err := run.QueueItem().One(ctx, m.db)
+		assert.NilError(t, err)
+
+		qi.QueuedAt = time.Now().Add(-time.Hour)
+		_, err = qi.Update(ctx, m.db, boil.Whitelist(models.QueueItemColumns.QueuedAt))
+		assert.NilError(t, err)
+	}
+
+	qis, err := m.ExpiredQueueItems(ctx, "default", time.Minute)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(qis, 2))
+	assert.Assert(t, cmp.Equal(qis[0].RunID, runs[0].ID))
+	assert.Assert(t, cmp.Equal(qis[1].RunID, runs[1].ID))
+
+	qis, err = m.ExpiredQueueItems(ctx, "other", time.Minute)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(qis, 0))
+
+	// items handed out no longer expire.
+	qi, err := m.NextQueueItem(ctx, "hostname", "default")
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Equal(qi.RunID, runs[0].ID))
+
+	qis, err = m.ExpiredQueueItems(ctx, "default", time.Minute)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(qis, 1))
+	assert.Assert(t, cmp.Equal(qis[0].RunID, runs[1].ID))
+
+	err = m.SetRunStatus(ctx, runs[0].ID, false, topTypes.FailureReasonExpired)
+	assert.Assert(t, errors.Is(err, utils.ErrNotFound))
+
+	assert.NilError(t, m.SetRunStatus(ctx, runs[1].ID, false, topTypes.FailureReasonExpired))
+
+	run, err := models.FindRun(ctx, m.db, runs[1].ID)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Equal(run.Status, null.BoolFrom(false)))
+	assert.Assert(t, cmp.Equal(run.FailureReason, null.StringFrom(topTypes.FailureReasonExpired)))
+
+	qis, err = m.ExpiredQueueItems(ctx, "default", time.Minute)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(qis, 0))
+
+	assert.NilError(t, m.SetRunStatus(ctx, runs[2].ID, true, ""))
+
+	qis, err = m.ExpiredQueueItems(ctx, "default", time.Minute)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(qis, 0))
+
+	qi, err = runs[3].QueueItem().One(ctx, m.db)
+	assert.NilError(t, err)
+	assert.Assert(t, qi.ClaimableAt.Valid)
+
+	qi.ClaimableAt = null.TimeFrom(time.Now().Add(-2 * time.Minute))
+	_, err = qi.Update(ctx, m.db, boil.Whitelist(models.QueueItemColumns.ClaimableAt))
+	assert.NilError(t, err)
+
+	qis, err = m.ExpiredQueueItems(ctx, "default", time.Minute)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(qis, 1))
+	assert.Assert(t, cmp.Equal(qis[0].RunID, runs[3].ID))
+}
+
+func TestWatchQueue(t *testing.T) {
+	m := testInit(t)
+
+	woken := func(wake <-chan struct{}, timeout time.Duration) bool {
+		select {
+		case <-wake:
+			return true
+		case <-time.After(timeout):
+			return false
+		}
+	}
+
+	wake, cancel := m.WatchQueue("")
+	defer cancel()
+
+	other, cancelOther := m.WatchQueue("other")
+	defer cancelOther()
+
+	// runners whose labels name other queues watch those as well.
+	labeled, cancelLabeled := m.WatchQueue("other", topTypes.QueueLabel("default"))
+	defer cancelLabeled()
+
+	// all are woken once the watch is in place
+	assert.Assert(t, woken(wake, 10*time.Second))
+	assert.Assert(t, woken(other, 10*time.Second))
+	assert.Assert(t, woken(labeled, 10*time.Second))
+
+	run, err := m.CreateTestRun(ctx)
+	assert.NilError(t, err)
+
+	qi := &models.QueueItem{QueueName: "default", RunID: run.ID}
+	assert.NilError(t, qi.Insert(ctx, m.db, boil.Infer()))
+
+	assert.Assert(t, woken(wake, 10*time.Second))
+	assert.Assert(t, woken(labeled, 10*time.Second))
+	assert.Assert(t, !woken(other, 500*time.Millisecond))
+
+	claimed, err := m.NextQueueItem(ctx, "hostname", "default")
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Equal(claimed.ID, qi.ID))
+	assert.Assert(t, !woken(wake, 500*time.Millisecond))
+
+	// putting the item back into the queue wakes its watchers again
+	claimed.Running = false
+	_, err = claimed.Update(ctx, m.db, boil.Whitelist(models.QueueItemColumns.Running))
+	assert.NilError(t, err)
+	assert.Assert(t, woken(wake, 10*time.Second))
+
+	// finishing a run may free the items waiting in a queue, so it wakes the
+	// watchers of the queues which have any.
+	finished, err := m.CreateTestRun(ctx)
+	assert.NilError(t, err)
+	assert.NilError(t, m.SetRunStatus(ctx, finished.ID, true, ""))
+	assert.Assert(t, woken(wake, 10*time.Second))
+	assert.Assert(t, !woken(other, 500*time.Millisecond))
+
+	cancel()
+
+	run, err = m.CreateTestRun(ctx)
+	assert.NilError(t, err)
+
+	qi = &models.QueueItem{QueueName: "default", RunID: run.ID}
+	assert.NilError(t, qi.Insert(ctx, m.db, boil.Infer()))
+	assert.Assert(t, !woken(wake, 500*time.Millisecond))
+}
+
+// BenchmarkNextQueueItems measures how many queue items of a single
+// repository concurrent runners claim per second, claiming one or several
+// items at a time, under each queue policy.
+func BenchmarkNextQueueItems(b *testing.B) {
+	for _, policy := range []string{QueuePolicyFIFO, QueuePolicyRoundRobin} {
+		for _, count := range []int{1, 4} {
+			b.Run(fmt.Sprintf("policy=%s/count=%d", policy, count), func(b *testing.B) {
+				benchmarkNextQueueItems(b, policy, count)
+			})
+		}
+	}
+}
+
+func benchmarkNextQueueItems(b *testing.B, policy string, count int) {
+	m := testInit(b)
+	assert.NilError(b, m.SetQueuePolicy(policy))
+
+	base, err := m.CreateTestRun(ctx)
+	assert.NilError(b, err)
+
+	qis := []*models.QueueItem{}
+
+	for i := 0; i < b.N*count; i++ {
+		run := &models.Run{Name: fmt.Sprintf("run-%d", i), RunSettings: base.RunSettings, TaskID: base.TaskID}
+		assert.NilError(b, run.Insert(ctx, m.db, boil.Infer()))
+		qis = append(qis, &models.QueueItem{RunID: run.ID, QueueName: "default"})
+	}
+
+	assert.NilError(b, m.QueuePipelineAdd(ctx, qis))
+
+	var claimed, runners int64
+
+	b.SetParallelism(8)
+	b.ResetTimer()
+	start := time.Now()
+
+	b.RunParallel(func(pb *testing.PB) {
+		hostname := fmt.Sprintf("runner-%d", atomic.AddInt64(&runners, 1))
+
+		for pb.Next() {
+			qis, err := m.NextQueueItems(ctx, hostname, "default", count)
+			if errors.Is(err, utils.ErrNotFound) {
+				// the last items may all be locked by other claimers.
+				continue
+			} else if err != nil {
+				b.Error(err)
+				return
+			}
+
+			atomic.AddInt64(&claimed, int64(len(qis)))
+		}
+	})
+
+	b.ReportMetric(float64(claimed)/time.Since(start).Seconds(), "items/s")
+}
@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -53,6 +54,22 @@ func (m *Model) GetTaskForRun(ctx context.Context, runID int64) (*models.Task, e
 	return run.Task().One(ctx, m.db)
 }
 
+// GetRepoConfigForRun returns the repository configuration (tinyci.yml) the
+// run's task was created with.
+func (m *Model) GetRepoConfigForRun(ctx context.Context, runID int64) (*types.RepoConfig, error) {
+	task, err := m.GetTaskForRun(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	ts := &types.TaskSettings{}
+	if err := task.TaskSettings.Unmarshal(ts); err != nil {
+		return nil, err
+	}
+
+	return &ts.Config, nil
+}
+
 // GetOwnerForRun retrieves the owner of a run's repository.
 func (m *Model) GetOwnerForRun(ctx context.Context, runID int64) (*models.User, error) {
 	return models.Users(
@@ -64,20 +81,30 @@ func (m *Model) GetOwnerForRun(ctx context.Context, runID int64) (*models.User,
 	).One(ctx, m.db)
 }
 
-// SetRunStatus sets the status for the run; fails if it is already set.
-func (m *Model) SetRunStatus(ctx context.Context, runID int64, status bool) error {
+// SetRunStatus sets the status for the run; fails if it is already set. The
+// reason a failed run failed for is recorded with it, if given; see
+// types.FailureReasonFailure. Runs can only expire while they are still
+// waiting in the queue, so this fails if an expiring run was handed out.
+func (m *Model) SetRunStatus(ctx context.Context, runID int64, status bool, reason string) error {
 	run, err := models.Runs(models.RunWhere.ID.EQ(runID)).One(ctx, m.db)
 	if err != nil {
 		return err
 	}
 
-	qi, err := models.QueueItems(models.QueueItemWhere.RunID.EQ(runID)).One(ctx, m.db)
+	mods := []qm.QueryMod{models.QueueItemWhere.RunID.EQ(runID)}
+	if reason == types.FailureReasonExpired {
+		mods = append(mods, models.QueueItemWhere.Running.EQ(false))
+	}
+
+	// deleting the queue item in one statement keeps it from being handed out
+	// in between.
+	count, err := models.QueueItems(mods...).DeleteAll(ctx, m.db)
 	if err != nil {
 		return err
 	}
 
-	if _, err := qi.Delete(ctx, m.db); err != nil {
-		return err
+	if count == 0 {
+		return utils.WrapError(utils.ErrNotFound, "no waiting queue item for run %d", runID)
 	}
 
 	if run.Status.Valid && run.FinishedAt.Valid {
@@ -88,11 +115,350 @@ func (m *Model) SetRunStatus(ctx context.Context, runID int64, status bool) erro
 	now := time.Now()
 	run.FinishedAt = null.TimeFrom(now)
 
+	if !status && reason != "" {
+		run.FailureReason = null.StringFrom(reason)
+	}
+
 	if _, err := run.Update(ctx, m.db, boil.Infer()); err != nil {
 		return err
 	}
 
-	return m.UpdateTaskStatus(ctx, run.TaskID, run.Status, run.FinishedAt)
+	return m.UpdateTaskStatus(ctx, run.TaskID, run.FinishedAt)
+}
+
+// TimedOutRuns returns the unfinished runs which have been running for longer
+// than their timeout. Runs without a timeout never time out.
+func (m *Model) TimedOutRuns(ctx context.Context) ([]*models.Run, error) {
+	return models.Runs(
+		models.RunWhere.StartedAt.IsNotNull(),
+		models.RunWhere.FinishedAt.IsNull(),
+		// the settings hold the timeout in nanoseconds
+		qm.Where("coalesce((runs.run_settings->>'timeout')::bigint, 0) > 0"),
+		qm.Where("runs.started_at + make_interval(secs => (runs.run_settings->>'timeout')::bigint / 1e9) < now()"),
+		qm.OrderBy("runs.id"),
+	).All(ctx, m.db)
+}
+
+// TimeOutRun cancels the run which exceeded its timeout on its own, so that
+// the runner watching for its cancellation stops it while the rest of its task
+// goes on. The run is left to be failed, or retried, like any other. It fails
+// if the run is not running. Runs timed out already but not failed yet can be
+// timed out again, so failing them can be retried; only the first caller to
+// fail them succeeds, see SetRunStatus and RetryRun.
+func (m *Model) TimeOutRun(ctx context.Context, runID int64) error {
+	count, err := models.Runs(
+		models.RunWhere.ID.EQ(runID),
+		models.RunWhere.StartedAt.IsNotNull(),
+		models.RunWhere.FinishedAt.IsNull(),
+	).UpdateAll(ctx, m.db, models.M{models.RunColumns.Canceled: true})
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return fmt.Errorf("run %d is not running", runID)
+	}
+
+	return nil
+}
+
+// RetryRun fails the run and enqueues a new attempt of it, if the run's retry
+// policy allows another attempt after failing for the reason given (see
+// types.RetryPolicy). The new attempt links back to the run it retries. If no
+// attempt is made, nil is returned and the run is left alone so that its
+// status can be set as usual.
+func (m *Model) RetryRun(ctx context.Context, runID int64, reason string) (*models.Run, error) {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	run, err := models.Runs(models.RunWhere.ID.EQ(runID), qm.For("update")).One(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if run.FinishedAt.Valid {
+		return nil, nil
+	}
+
+	settings := &types.RunSettings{}
+	if err := json.Unmarshal(run.RunSettings, settings); err != nil {
+		return nil, err
+	}
+
+	if !settings.Retries.Allows(reason, run.Attempt) {
+		return nil, nil
+	}
+
+	task, err := run.Task().One(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if task.Canceled {
+		return nil, nil
+	}
+
+	qi, err := run.QueueItem().One(ctx, tx)
+	if err != nil {
+		return nil, utils.WrapError(err, "locating queue item for run %d", runID)
+	}
+
+	if _, err := qi.Delete(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	run.Status = null.BoolFrom(false)
+	run.FinishedAt = null.TimeFrom(time.Now())
+
+	if reason != "" {
+		run.FailureReason = null.StringFrom(reason)
+	}
+
+	if _, err := run.Update(ctx, tx, boil.Infer()); err != nil {
+		return nil, err
+	}
+
+	retry := &models.Run{
+		Name:              run.Name,
+		TaskID:            run.TaskID,
+		RunSettings:       run.RunSettings,
+		Attempt:           run.Attempt + 1,
+		PreviousAttemptID: null.Int64From(run.ID),
+	}
+
+	if err := retry.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, err
+	}
+
+	retryItem := &models.QueueItem{
+		RunID:     retry.ID,
+		QueueName: qi.QueueName,
+		Priority:  qi.Priority,
+	}
+
+	if err := retryItem.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, err
+	}
+
+	return retry, tx.Commit()
+}
+
+// RerunRun enqueues a new attempt of the finished run, linked back to it like
+// the attempts made by RetryRun, and reopens the run's task so its status
+// follows the new attempt. Only the latest attempt of a run can be re-run, and
+// only if the runs it needs have succeeded. Runs whose failure failed the runs
+// or tasks depending on them cannot be re-run on their own; their task or
+// submission has to be re-run instead, see RerunTask and RerunSubmission.
+func (m *Model) RerunRun(ctx context.Context, runID int64) (*models.Run, error) {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	run, err := models.Runs(models.RunWhere.ID.EQ(runID), qm.For("update")).One(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !run.FinishedAt.Valid {
+		return nil, fmt.Errorf("run %d has not finished", runID)
+	}
+
+	next, err := run.PreviousAttemptRuns().One(ctx, tx)
+	if err == nil {
+		return nil, fmt.Errorf("run %d was already attempted again as run %d", runID, next.ID)
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	task, err := run.Task(qm.For("update")).One(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := &types.RunSettings{}
+	if err := json.Unmarshal(run.RunSettings, settings); err != nil {
+		return nil, err
+	}
+
+	if len(settings.Needs) > 0 {
+		needs := []interface{}{}
+		for _, need := range settings.Needs {
+			needs = append(needs, need)
+		}
+
+		failed, err := models.Runs(
+			models.RunWhere.TaskID.EQ(task.ID),
+			qm.WhereIn("run_settings->>'name' in ?", needs...),
+			qm.Where("finished_at is not null and status is not true"),
+			qm.Where("not exists (select 1 from runs retry where retry.previous_attempt_id = runs.id)"),
+		).Exists(ctx, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		if failed {
+			return nil, fmt.Errorf("run %d needs runs which did not succeed; re-run its task instead", runID)
+		}
+	}
+
+	// runs and tasks failed without being started because this run failed
+	// would not be revived by a new attempt of it alone.
+	skipped, err := models.Runs(
+		models.RunWhere.TaskID.EQ(task.ID),
+		models.RunWhere.StartedAt.IsNull(),
+		models.RunWhere.FinishedAt.IsNotNull(),
+		qm.Where("run_settings->'needs' @> jsonb_build_array(?::text)", settings.Name),
+		qm.Where("not exists (select 1 from runs retry where retry.previous_attempt_id = runs.id)"),
+	).Exists(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if skipped {
+		return nil, fmt.Errorf("runs which need run %d were failed with it; re-run its task instead", runID)
+	}
+
+	skipped, err = models.Tasks(
+		qm.Where("depends_on @> array[?]::bigint[]", task.ID),
+		models.TaskWhere.FinishedAt.IsNotNull(),
+		qm.Where("exists (select 1 from runs where runs.task_id = tasks.id and runs.started_at is null and runs.finished_at is not null)"),
+	).Exists(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if skipped {
+		return nil, fmt.Errorf("tasks which depend on the task of run %d were failed with it; re-run its submission instead", runID)
+	}
+
+	task.Canceled = false
+	task.Status = null.Bool{}
+	task.FinishedAt = null.Time{}
+
+	if _, err := task.Update(ctx, tx, boil.Infer()); err != nil {
+		return nil, err
+	}
+
+	retry, err := rerun(ctx, tx, run, task)
+	if err != nil {
+		return nil, err
+	}
+
+	return retry, tx.Commit()
+}
+
+// rerun inserts a new attempt of the run into the task given and queues it at
+// the priority the task was submitted with, or its repository's if the task
+// has none recorded.
+func rerun(ctx context.Context, exec boil.ContextExecutor, run *models.Run, task *models.Task) (*models.Run, error) {
+	settings := &types.RunSettings{}
+	if err := json.Unmarshal(run.RunSettings, settings); err != nil {
+		return nil, err
+	}
+
+	ts := &types.TaskSettings{}
+	if err := task.TaskSettings.Unmarshal(ts); err != nil {
+		return nil, err
+	}
+
+	retry := &models.Run{
+		Name:              run.Name,
+		TaskID:            task.ID,
+		RunSettings:       run.RunSettings,
+		Attempt:           run.Attempt + 1,
+		PreviousAttemptID: null.Int64From(run.ID),
+	}
+
+	if err := retry.Insert(ctx, exec, boil.Infer()); err != nil {
+		return nil, err
+	}
+
+	priority := ts.Config.Priority
+	if task.Priority.Valid {
+		priority = task.Priority.Int
+	}
+
+	qi := &models.QueueItem{
+		RunID:     retry.ID,
+		QueueName: settings.Queue,
+		Priority:  priority,
+	}
+
+	if err := qi.Insert(ctx, exec, boil.Infer()); err != nil {
+		return nil, err
+	}
+
+	return retry, nil
+}
+
+// FailDependentRuns fails all unfinished runs in the same task which need the
+// run provided, directly or through other runs. The runs that were failed are
+// returned so their status can be reported.
+func (m *Model) FailDependentRuns(ctx context.Context, runID int64) ([]*models.Run, error) {
+	run, err := models.FindRun(ctx, m.db, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	failed := []*models.Run{}
+	pending := []*models.Run{run}
+	now := null.TimeFrom(time.Now())
+
+	for len(pending) > 0 {
+		needed := pending[0]
+		pending = pending[1:]
+
+		settings := &types.RunSettings{}
+		if err := json.Unmarshal(needed.RunSettings, settings); err != nil {
+			return nil, err
+		}
+
+		runs, err := models.Runs(
+			models.RunWhere.TaskID.EQ(needed.TaskID),
+			models.RunWhere.FinishedAt.IsNull(),
+			qm.Where("run_settings->'needs' @> jsonb_build_array(?::text)", settings.Name),
+		).All(ctx, tx)
+		if err != nil {
+			return nil, utils.WrapError(err, "locating runs which need run %d", needed.ID)
+		}
+
+		for _, run := range runs {
+			if _, err := models.QueueItems(models.QueueItemWhere.RunID.EQ(run.ID)).DeleteAll(ctx, tx); err != nil {
+				return nil, err
+			}
+
+			run.Status = null.BoolFrom(false)
+			run.FinishedAt = now
+
+			if _, err := run.Update(ctx, tx, boil.Infer()); err != nil {
+				return nil, err
+			}
+
+			failed = append(failed, run)
+			pending = append(pending, run)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	if len(failed) == 0 {
+		return failed, nil
+	}
+
+	return failed, m.UpdateTaskStatus(ctx, run.TaskID, now)
 }
 
 // RunDetail contains a number of parameters from inner joins in the run that
@@ -246,3 +612,50 @@ func (m *Model) RunTotalCountForRepositoryAndSHA(ctx context.Context, repo *mode
 	fmt.Println(feh)
 	return feh, nil
 }
+
+// RunRepositories maps each of the runs to the ID of the repository it was
+// submitted against. Runs which no longer exist are omitted.
+func (m *Model) RunRepositories(ctx context.Context, runIDs []int64) (map[int64]int64, error) {
+	ids := make([]interface{}, len(runIDs))
+	for i, id := range runIDs {
+		ids[i] = id
+	}
+
+	runs, err := models.Runs(
+		qm.WhereIn("runs.id in ?", ids...),
+		qm.Load(qm.Rels(models.RunRels.Task, models.TaskRels.Submission, models.SubmissionRels.BaseRef)),
+	).All(ctx, m.db)
+	if err != nil {
+		return nil, err
+	}
+
+	repos := map[int64]int64{}
+	for _, run := range runs {
+		repos[run.ID] = run.R.Task.R.Submission.R.BaseRef.RepositoryID
+	}
+
+	return repos, nil
+}
+
+// RunIDsForTask returns the IDs of all the runs in the task.
+func (m *Model) RunIDsForTask(ctx context.Context, taskID int64) ([]int64, error) {
+	return runIDs(models.Runs(models.RunWhere.TaskID.EQ(taskID), qm.OrderBy("runs.id")).All(ctx, m.db))
+}
+
+// RunIDsForSubmission returns the IDs of all the runs in the submission.
+func (m *Model) RunIDsForSubmission(ctx context.Context, subID int64) ([]int64, error) {
+	return runIDs(models.Runs(append(m.runsForSubmission(subID), qm.OrderBy("runs.id"))...).All(ctx, m.db))
+}
+
+func runIDs(runs []*models.Run, err error) ([]int64, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, len(runs))
+	for i, run := range runs {
+		ids[i] = run.ID
+	}
+
+	return ids, nil
+}
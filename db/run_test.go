@@ -10,6 +10,7 @@ import (
 	"github.com/tinyci/ci-agents/types"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"gotest.tools/v3/assert"
 	"gotest.tools/v3/assert/cmp"
 )
@@ -225,3 +226,322 @@ func TestRunList(t *testing.T) {
 	assert.Error(t, err, sql.ErrNoRows.Error())
 	assert.Assert(t, cmp.Equal(len(runs), 0))
 }
+
+func TestRetryRun(t *testing.T) {
+	m := testInit(t)
+
+	base, err := m.CreateTestRun(ctx)
+	assert.NilError(t, err)
+
+	runs := map[string]*models.Run{}
+
+	for name, rs := range map[string]*types.RunSettings{
+		"flaky": {Retries: &types.RetryPolicy{Count: 1, On: []string{types.FailureReasonFailure}}},
+		"after": {Needs: []string{"flaky"}},
+	} {
+		rs.Name = name
+		rs.Image = "foo"
+		rs.Command = []string{"run", "me"}
+		rs.Queue = "default"
+
+		content, err := json.Marshal(rs.ToProto())
+		assert.NilError(t, err)
+
+		run := &models.Run{Name: name, RunSettings: content, TaskID: base.TaskID}
+		assert.NilError(t, run.Insert(ctx, m.db, boil.Infer()))
+		assert.NilError(t, (&models.QueueItem{RunID: run.ID, QueueName: "default", Priority: 5}).Insert(ctx, m.db, boil.Infer()))
+		runs[name] = run
+	}
+
+	assert.Assert(t, cmp.Equal(runs["flaky"].Attempt, 1))
+
+	qi, err := m.NextQueueItem(ctx, "hostname", "default")
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Equal(qi.RunID, runs["flaky"].ID))
+
+	// the policy only covers plain failures.
+	retry, err := m.RetryRun(ctx, runs["flaky"].ID, types.FailureReasonInfraError)
+	assert.NilError(t, err)
+	assert.Assert(t, retry == nil)
+
+	retry, err = m.RetryRun(ctx, runs["flaky"].ID, "")
+	assert.NilError(t, err)
+	assert.Assert(t, retry != nil)
+	assert.Assert(t, cmp.Equal(retry.Attempt, 2))
+	assert.Assert(t, cmp.Equal(retry.PreviousAttemptID.Int64, runs["flaky"].ID))
+	assert.Assert(t, cmp.Equal(retry.Name, "flaky"))
+
+	first, err := models.FindRun(ctx, m.db, runs["flaky"].ID)
+	assert.NilError(t, err)
+	assert.Assert(t, first.FinishedAt.Valid)
+	assert.Assert(t, cmp.Equal(first.Status, null.BoolFrom(false)))
+
+	qi, err = m.NextQueueItem(ctx, "hostname", "default")
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Equal(qi.RunID, retry.ID))
+	assert.Assert(t, cmp.Equal(qi.Priority, 5))
+
+	// the retry budget is spent.
+	again, err := m.RetryRun(ctx, retry.ID, "")
+	assert.NilError(t, err)
+	assert.Assert(t, again == nil)
+
+	// the failed first attempt no longer holds back runs which need it.
+	assert.NilError(t, m.SetRunStatus(ctx, retry.ID, true, ""))
+
+	qi, err = m.NextQueueItem(ctx, "hostname", "default")
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Equal(qi.RunID, runs["after"].ID))
+}
+
+func TestTimedOutRuns(t *testing.T) {
+	m := testInit(t)
+
+	base, err := m.CreateTestRun(ctx)
+	assert.NilError(t, err)
+
+	baseTask, err := models.FindTask(ctx, m.db, base.TaskID)
+	assert.NilError(t, err)
+
+	_, runs, err := m.CreateTestConcurrentTask(ctx, baseTask.SubmissionID, nil,
+		&types.RunSettings{Name: "slow", Timeout: time.Second, Retries: &types.RetryPolicy{Count: 1, On: []string{types.FailureReasonTimeout}}},
+		&types.RunSettings{Name: "untimed"},
+	)
+	assert.NilError(t, err)
+
+	// runs waiting in the queue do not time out.
+	timedOut, err := m.TimedOutRuns(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(timedOut, 0))
+
+	qis, err := m.NextQueueItems(ctx, "hostname", "default", 2)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(qis, 2))
+
+	for _, run := range runs {
+		run.StartedAt = null.TimeFrom(time.Now().Add(-time.Minute))
+		_, err := run.Update(ctx, m.db, boil.Whitelist(models.RunColumns.StartedAt))
+		assert.NilError(t, err)
+	}
+
+	timedOut, err = m.TimedOutRuns(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(timedOut, 1))
+	assert.Assert(t, cmp.Equal(timedOut[0].ID, runs[0].ID))
+
+	woken := func(wake <-chan struct{}) bool {
+		select {
+		case <-wake:
+			return true
+		case <-time.After(10 * time.Second):
+			return false
+		}
+	}
+
+	wake, cancel := m.WatchCancel(runs[0].TaskID)
+	defer cancel()
+	assert.Assert(t, woken(wake))
+
+	// the runner watching for the cancellation is told to stop the run.
+	assert.NilError(t, m.TimeOutRun(ctx, runs[0].ID))
+	assert.Assert(t, woken(wake))
+
+	run, err := models.FindRun(ctx, m.db, runs[0].ID)
+	assert.NilError(t, err)
+	assert.Assert(t, run.Canceled)
+	assert.Assert(t, !run.FinishedAt.Valid)
+
+	// the rest of its task goes on.
+	task, err := models.FindTask(ctx, m.db, runs[0].TaskID)
+	assert.NilError(t, err)
+	assert.Assert(t, !task.Canceled)
+
+	untimed, err := models.FindRun(ctx, m.db, runs[1].ID)
+	assert.NilError(t, err)
+	assert.Assert(t, !untimed.Canceled)
+
+	// until it is failed, it is still listed, so a supervisor which could not
+	// fail it can try again.
+	timedOut, err = m.TimedOutRuns(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(timedOut, 1))
+	assert.NilError(t, m.TimeOutRun(ctx, runs[0].ID))
+
+	// and its retry policy applies.
+	retry, err := m.RetryRun(ctx, run.ID, types.FailureReasonTimeout)
+	assert.NilError(t, err)
+	assert.Assert(t, retry != nil)
+	assert.Assert(t, !retry.Canceled)
+
+	// timing it out once it was failed fails, so only one supervisor reports
+	// it.
+	assert.Assert(t, m.TimeOutRun(ctx, runs[0].ID) != nil)
+
+	timedOut, err = m.TimedOutRuns(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(timedOut, 0))
+}
+
+func TestRerunRun(t *testing.T) {
+	m := testInit(t)
+
+	run, err := m.CreateTestRun(ctx)
+	assert.NilError(t, err)
+	assert.NilError(t, (&models.QueueItem{RunID: run.ID, QueueName: "default"}).Insert(ctx, m.db, boil.Infer()))
+
+	_, err = m.RerunRun(ctx, run.ID)
+	assert.ErrorContains(t, err, "has not finished")
+
+	assert.NilError(t, m.SetRunStatus(ctx, run.ID, false, ""))
+
+	// the task was submitted above its repository's priority.
+	task, err := models.FindTask(ctx, m.db, run.TaskID)
+	assert.NilError(t, err)
+	task.Priority = null.IntFrom(7)
+	_, err = task.Update(ctx, m.db, boil.Infer())
+	assert.NilError(t, err)
+
+	rerun, err := m.RerunRun(ctx, run.ID)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Equal(rerun.Attempt, 2))
+	assert.Assert(t, cmp.Equal(rerun.PreviousAttemptID.Int64, run.ID))
+	assert.Assert(t, cmp.Equal(rerun.TaskID, run.TaskID))
+
+	item, err := models.QueueItems(models.QueueItemWhere.RunID.EQ(rerun.ID)).One(ctx, m.db)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Equal(item.Priority, 7))
+
+	// the task follows the new attempt.
+	task, err = models.FindTask(ctx, m.db, run.TaskID)
+	assert.NilError(t, err)
+	assert.Assert(t, !task.FinishedAt.Valid)
+	assert.Assert(t, !task.Status.Valid)
+
+	// only the latest attempt can be re-run.
+	_, err = m.RerunRun(ctx, run.ID)
+	assert.ErrorContains(t, err, "already attempted again")
+
+	qi, err := m.NextQueueItem(ctx, "hostname", "default")
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Equal(qi.RunID, rerun.ID))
+
+	assert.NilError(t, m.SetRunStatus(ctx, rerun.ID, true, ""))
+
+	task, err = models.FindTask(ctx, m.db, run.TaskID)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Equal(task.Status, null.BoolFrom(true)))
+}
+
+func TestRerunRunTaskStatus(t *testing.T) {
+	m := testInit(t)
+
+	base, err := m.CreateTestRun(ctx)
+	assert.NilError(t, err)
+
+	baseTask, err := models.FindTask(ctx, m.db, base.TaskID)
+	assert.NilError(t, err)
+
+	task, runs, err := m.CreateTestConcurrentTask(ctx, baseTask.SubmissionID, nil,
+		&types.RunSettings{Name: "a"},
+		&types.RunSettings{Name: "b"},
+	)
+	assert.NilError(t, err)
+
+	for _, run := range runs {
+		assert.NilError(t, m.SetRunStatus(ctx, run.ID, false, ""))
+	}
+
+	rerun, err := m.RerunRun(ctx, runs[0].ID)
+	assert.NilError(t, err)
+	assert.NilError(t, m.SetRunStatus(ctx, rerun.ID, true, ""))
+
+	// b still failed, so the task does too.
+	task, err = models.FindTask(ctx, m.db, task.ID)
+	assert.NilError(t, err)
+	assert.Assert(t, task.FinishedAt.Valid)
+	assert.Assert(t, cmp.Equal(task.Status, null.BoolFrom(false)))
+
+	rerun, err = m.RerunRun(ctx, runs[1].ID)
+	assert.NilError(t, err)
+	assert.NilError(t, m.SetRunStatus(ctx, rerun.ID, true, ""))
+
+	task, err = models.FindTask(ctx, m.db, task.ID)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Equal(task.Status, null.BoolFrom(true)))
+}
+
+func TestRerunRunFailedDependents(t *testing.T) {
+	m := testInit(t)
+
+	base, err := m.CreateTestRun(ctx)
+	assert.NilError(t, err)
+
+	baseTask, err := models.FindTask(ctx, m.db, base.TaskID)
+	assert.NilError(t, err)
+
+	task, runs, err := m.CreateTestConcurrentTask(ctx, baseTask.SubmissionID, nil,
+		&types.RunSettings{Name: "build"},
+		&types.RunSettings{Name: "integration", Needs: []string{"build"}},
+	)
+	assert.NilError(t, err)
+
+	assert.NilError(t, m.SetRunStatus(ctx, runs[0].ID, false, ""))
+
+	failed, err := m.FailDependentRuns(ctx, runs[0].ID)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(failed, 1))
+
+	// integration never ran, so a new attempt of build alone would pass the
+	// task without it.
+	_, err = m.RerunRun(ctx, runs[0].ID)
+	assert.ErrorContains(t, err, "re-run its task instead")
+
+	_, _, err = m.RerunTask(ctx, task.ID)
+	assert.NilError(t, err)
+
+	// the same goes for the tasks depending on the run's task.
+	sub, err := baseTask.Submission().One(ctx, m.db)
+	assert.NilError(t, err)
+
+	dep, err := m.CreateTestTaskForSubmission(ctx, sub)
+	assert.NilError(t, err)
+
+	dep.DependsOn = []int64{baseTask.ID}
+	_, err = dep.Update(ctx, m.db, boil.Infer())
+	assert.NilError(t, err)
+
+	assert.NilError(t, m.SetRunStatus(ctx, base.ID, false, ""))
+
+	_, err = m.FailDependentTasks(ctx, baseTask.ID)
+	assert.NilError(t, err)
+
+	_, err = m.RerunRun(ctx, base.ID)
+	assert.ErrorContains(t, err, "re-run its submission instead")
+}
+
+func TestRunRepositories(t *testing.T) {
+	m := testInit(t)
+
+	run, err := m.CreateTestRun(ctx)
+	assert.NilError(t, err)
+
+	other, err := m.CreateTestRun(ctx)
+	assert.NilError(t, err)
+
+	task, err := run.Task(qm.Load(qm.Rels(models.TaskRels.Submission, models.SubmissionRels.BaseRef))).One(ctx, m.db)
+	assert.NilError(t, err)
+
+	repos, err := m.RunRepositories(ctx, []int64{run.ID, other.ID, other.ID + 1})
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(repos, 2))
+	assert.Assert(t, cmp.Equal(repos[run.ID], task.R.Submission.R.BaseRef.RepositoryID))
+	assert.Assert(t, repos[run.ID] != repos[other.ID])
+
+	ids, err := m.RunIDsForTask(ctx, run.TaskID)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.DeepEqual(ids, []int64{run.ID}))
+
+	ids, err = m.RunIDsForSubmission(ctx, task.SubmissionID)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.DeepEqual(ids, []int64{run.ID}))
+}
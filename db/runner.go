@@ -0,0 +1,175 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/tinyci/ci-agents/db/models"
+	topTypes "github.com/tinyci/ci-agents/types"
+	"github.com/tinyci/ci-agents/utils"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+// RunnerHeartbeat records that the named runner is alive, registering it if it
+// has not been seen before. The runner's queue and labels are updated to the
+// ones given; its cordon and drain state is left alone.
+func (m *Model) RunnerHeartbeat(ctx context.Context, name, queueName string, labels []string) (*models.Runner, error) {
+	if name == "" {
+		return nil, errors.New("no runner hostname provided")
+	}
+
+	if queueName == "" {
+		queueName = "default"
+	}
+
+	runner := &models.Runner{
+		Name:          name,
+		QueueName:     queueName,
+		Labels:        types.StringArray(append([]string{}, labels...)),
+		LastHeartbeat: time.Now(),
+	}
+
+	err := runner.Upsert(
+		ctx, m.db, true, []string{models.RunnerColumns.Name},
+		boil.Whitelist(models.RunnerColumns.QueueName, models.RunnerColumns.Labels, models.RunnerColumns.LastHeartbeat),
+		boil.Infer(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.Runners(models.RunnerWhere.Name.EQ(name)).One(ctx, m.db)
+}
+
+// ListRunners lists all runners that have ever registered, by name.
+func (m *Model) ListRunners(ctx context.Context) (models.RunnerSlice, error) {
+	return models.Runners(qm.OrderBy("name")).All(ctx, m.db)
+}
+
+// SetRunnerState cordons or drains the named runner. Cordoned runners are not
+// handed new queue items; draining runners are also cordoned, and are told to
+// exit once their current work is done on their next heartbeat.
+func (m *Model) SetRunnerState(ctx context.Context, name string, cordoned, draining bool) (*models.Runner, error) {
+	runner, err := models.Runners(models.RunnerWhere.Name.EQ(name)).One(ctx, m.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, utils.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	runner.Cordoned = cordoned || draining
+	runner.Draining = draining
+
+	if _, err := runner.Update(ctx, m.db, boil.Whitelist(models.RunnerColumns.Cordoned, models.RunnerColumns.Draining)); err != nil {
+		return nil, err
+	}
+
+	return runner, nil
+}
+
+// runnerCordoned returns true if the runner is registered and has been
+// cordoned. Runners which never registered are never cordoned.
+func runnerCordoned(ctx context.Context, exec boil.ContextExecutor, name string) (bool, error) {
+	return models.Runners(models.RunnerWhere.Name.EQ(name), models.RunnerWhere.Cordoned.EQ(true)).Exists(ctx, exec)
+}
+
+// ReapRunners finds the queue items running on runners which have not sent a
+// heartbeat within the timeout. Items which have been re-queued fewer than
+// retries times are put back in the queue, their runs and tasks no longer
+// started; the run IDs of the rest, and of runs
+// whose retry policy covers infrastructure errors, are returned so that they
+// can be failed.
+func (m *Model) ReapRunners(ctx context.Context, timeout time.Duration, retries int) (requeued []*models.QueueItem, failed []int64, retErr error) {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		if retErr != nil {
+			tx.Rollback()
+		}
+	}()
+
+	qis, err := models.QueueItems(
+		qm.Where(`queue_items.running and queue_items.running_on in (
+			select name from runners where last_heartbeat < ?
+		)`, time.Now().Add(-timeout)),
+		qm.For("update"),
+	).All(ctx, tx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, qi := range qis {
+		run, err := qi.Run().One(ctx, tx)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		settings := &topTypes.RunSettings{}
+		if err := json.Unmarshal(run.RunSettings, settings); err != nil {
+			return nil, nil, err
+		}
+
+		// runs with their own policy for infrastructure errors get a new
+		// attempt instead; see RetryRun.
+		if qi.Requeues >= retries || settings.Retries.Allows(topTypes.FailureReasonInfraError, run.Attempt) {
+			failed = append(failed, qi.RunID)
+			continue
+		}
+
+		qi.Running = false
+		qi.RunningOn = null.String{}
+		qi.StartedAt = null.Time{}
+		qi.QueuedAt = time.Now()
+		qi.Requeues++
+
+		if _, err := qi.Update(ctx, tx, boil.Infer()); err != nil {
+			return nil, nil, err
+		}
+
+		if err := unstartRun(ctx, tx, run); err != nil {
+			return nil, nil, err
+		}
+
+		requeued = append(requeued, qi)
+	}
+
+	return requeued, failed, tx.Commit()
+}
+
+// unstartRun clears the start of a run whose queue item is put back into the
+// queue, and that of its task if none of its other runs are started.
+func unstartRun(ctx context.Context, exec boil.ContextExecutor, run *models.Run) error {
+	run.StartedAt = null.Time{}
+	run.RanOn = null.String{}
+
+	if _, err := run.Update(ctx, exec, boil.Infer()); err != nil {
+		return err
+	}
+
+	task, err := run.Task(qm.For("update")).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	started, err := task.Runs(models.RunWhere.StartedAt.IsNotNull()).Exists(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	if !started {
+		task.StartedAt = null.Time{}
+		if _, err := task.Update(ctx, exec, boil.Infer()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package db
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tinyci/ci-agents/db/models"
+	"github.com/tinyci/ci-agents/utils"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+)
+
+func TestRunners(t *testing.T) {
+	m := testInit(t)
+
+	runner, err := m.RunnerHeartbeat(ctx, "hostname", "", []string{"gpu"})
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Equal(runner.QueueName, "default"))
+	assert.Assert(t, cmp.DeepEqual([]string(runner.Labels), []string{"gpu"}))
+	assert.Assert(t, !runner.Cordoned)
+
+	// heartbeats update the runner in place.
+	runner2, err := m.RunnerHeartbeat(ctx, "hostname", "other", nil)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Equal(runner2.ID, runner.ID))
+	assert.Assert(t, cmp.Equal(runner2.QueueName, "other"))
+	assert.Assert(t, cmp.Len(runner2.Labels, 0))
+
+	_, err = m.RunnerHeartbeat(ctx, "hostname2", "default", nil)
+	assert.NilError(t, err)
+
+	runners, err := m.ListRunners(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(runners, 2))
+	assert.Assert(t, cmp.Equal(runners[0].Name, "hostname"))
+
+	_, err = m.SetRunnerState(ctx, "missing", true, false)
+	assert.Assert(t, errors.Is(err, utils.ErrNotFound))
+
+	runner, err = m.SetRunnerState(ctx, "hostname", false, true)
+	assert.NilError(t, err)
+	assert.Assert(t, runner.Cordoned && runner.Draining)
+
+	run, err := m.CreateTestRun(ctx)
+	assert.NilError(t, err)
+	assert.NilError(t, (&models.QueueItem{RunID: run.ID, QueueName: "default"}).Insert(ctx, m.db, boil.Infer()))
+
+	// cordoned runners are not handed any work.
+	_, err = m.NextQueueItem(ctx, "hostname", "default")
+	assert.Assert(t, errors.Is(err, utils.ErrNotFound))
+
+	runner, err = m.SetRunnerState(ctx, "hostname", false, false)
+	assert.NilError(t, err)
+	assert.Assert(t, !runner.Cordoned && !runner.Draining)
+
+	qi, err := m.NextQueueItem(ctx, "hostname", "default")
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Equal(qi.RunID, run.ID))
+
+	// a live runner's work is left alone.
+	requeued, failed, err := m.ReapRunners(ctx, time.Minute, 1)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(requeued, 0))
+	assert.Assert(t, cmp.Len(failed, 0))
+
+	stale := func(name string) {
+		_, err := models.Runners(models.RunnerWhere.Name.EQ(name)).UpdateAll(ctx, m.db, models.M{"last_heartbeat": time.Now().Add(-time.Hour)})
+		assert.NilError(t, err)
+	}
+
+	stale("hostname")
+
+	requeued, failed, err = m.ReapRunners(ctx, time.Minute, 1)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(requeued, 1))
+	assert.Assert(t, cmp.Len(failed, 0))
+	assert.Assert(t, cmp.Equal(requeued[0].Requeues, 1))
+
+	// the run's task is no longer started, as its only run is back in the
+	// queue.
+	task, err := models.FindTask(ctx, m.db, run.TaskID)
+	assert.NilError(t, err)
+	assert.Assert(t, !task.StartedAt.Valid)
+
+	qi, err = m.NextQueueItem(ctx, "hostname2", "default")
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Equal(qi.RunID, run.ID))
+
+	r, err := models.FindRun(ctx, m.db, run.ID)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Equal(r.RanOn.String, "hostname2"))
+
+	// the retry budget is spent, so the run is failed instead.
+	stale("hostname2")
+
+	requeued, failed, err = m.ReapRunners(ctx, time.Minute, 1)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(requeued, 0))
+	assert.Assert(t, cmp.DeepEqual(failed, []int64{run.ID}))
+}
@@ -204,6 +204,76 @@ func (m *Model) CancelSubmissionByID(ctx context.Context, id int64) error {
 	return nil
 }
 
+// RerunSubmission re-runs the latest re-run of each task of the finished
+// submission as RerunTask does, keeping the dependencies between them.
+func (m *Model) RerunSubmission(ctx context.Context, id int64) ([]*models.Task, []*models.Run, error) {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback()
+
+	tasks, err := models.Tasks(
+		models.TaskWhere.SubmissionID.EQ(id),
+		qm.Where("not exists (select 1 from tasks rerun where rerun.rerun_of_id = tasks.id)"),
+		qm.OrderBy("id"),
+		qm.For("update"),
+	).All(ctx, tx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(tasks) == 0 {
+		return nil, nil, utils.ErrNotFound
+	}
+
+	for _, task := range tasks {
+		if !task.FinishedAt.Valid {
+			return nil, nil, fmt.Errorf("submission %d has not finished", id)
+		}
+	}
+
+	clones := map[int64]*models.Task{}
+	reruns := []*models.Run{}
+
+	for _, task := range tasks {
+		clone, runs, err := rerunTask(ctx, tx, task, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		clones[task.ID] = clone
+		reruns = append(reruns, runs...)
+	}
+
+	newTasks := []*models.Task{}
+
+	for _, task := range tasks {
+		clone := clones[task.ID]
+
+		// every task was re-run above, so this finds the copies of the
+		// dependencies.
+		for _, dep := range task.DependsOn {
+			latest, err := latestTask(ctx, tx, dep)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			clone.DependsOn = append(clone.DependsOn, latest)
+		}
+
+		if len(clone.DependsOn) > 0 {
+			if _, err := clone.Update(ctx, tx, boil.Whitelist(models.TaskColumns.DependsOn)); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		newTasks = append(newTasks, clone)
+	}
+
+	return newTasks, reruns, tx.Commit()
+}
+
 //
 // func (m *Model) assignSubmissionPostFetch(subs []*models.Submission) error {
 // 	idmap := map[int64]*models.Submission{}
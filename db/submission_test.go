@@ -1,6 +1,7 @@
 package db
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
@@ -13,6 +14,7 @@ import (
 	"github.com/tinyci/ci-agents/db/protoconv"
 	"github.com/tinyci/ci-agents/types"
 	"github.com/tinyci/ci-agents/utils"
+	"github.com/volatiletech/sqlboiler/v4/boil"
 )
 
 var Fixtures = map[bool][]*types.Submission{
@@ -336,3 +338,56 @@ func TestSubmissionTasks(t *testing.T) {
 		}
 	}
 }
+
+func TestRerunSubmission(t *testing.T) {
+	m := testInit(t)
+
+	_, _, err := m.RerunSubmission(ctx, 1<<40)
+	assert.Assert(t, errors.Is(err, utils.ErrNotFound))
+
+	run, err := m.CreateTestRun(ctx)
+	assert.NilError(t, err)
+	assert.NilError(t, (&models.QueueItem{RunID: run.ID, QueueName: "default"}).Insert(ctx, m.db, boil.Infer()))
+
+	task, err := run.Task().One(ctx, m.db)
+	assert.NilError(t, err)
+
+	sub, err := task.Submission().One(ctx, m.db)
+	assert.NilError(t, err)
+
+	dep, err := m.CreateTestTaskForSubmission(ctx, sub)
+	assert.NilError(t, err)
+
+	dep.DependsOn = []int64{task.ID}
+	_, err = dep.Update(ctx, m.db, boil.Infer())
+	assert.NilError(t, err)
+
+	_, _, err = m.RerunSubmission(ctx, sub.ID)
+	assert.ErrorContains(t, err, "has not finished")
+
+	assert.NilError(t, m.SetRunStatus(ctx, run.ID, false, ""))
+	_, err = m.FailDependentTasks(ctx, task.ID)
+	assert.NilError(t, err)
+
+	tasks, runs, err := m.RerunSubmission(ctx, sub.ID)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(tasks, 2))
+	assert.Assert(t, cmp.Len(runs, 2))
+	assert.Assert(t, cmp.Equal(tasks[0].RerunOfID.Int64, task.ID))
+	assert.Assert(t, cmp.Equal(tasks[1].RerunOfID.Int64, dep.ID))
+	// the copies depend on each other like the originals.
+	assert.Assert(t, cmp.DeepEqual([]int64(tasks[1].DependsOn), []int64{tasks[0].ID}))
+
+	qi, err := m.NextQueueItem(ctx, "hostname", "default")
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Equal(qi.RunID, runs[0].ID))
+
+	_, err = m.NextQueueItem(ctx, "hostname", "default")
+	assert.Assert(t, errors.Is(err, utils.ErrNotFound))
+
+	assert.NilError(t, m.SetRunStatus(ctx, runs[0].ID, true, ""))
+
+	qi, err = m.NextQueueItem(ctx, "hostname", "default")
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Equal(qi.RunID, runs[1].ID))
+}
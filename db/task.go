@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"time"
@@ -9,6 +10,7 @@ import (
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	boilTypes "github.com/volatiletech/sqlboiler/v4/types"
 
 	"github.com/tinyci/ci-agents/db/models"
 	"github.com/tinyci/ci-agents/types"
@@ -91,7 +93,8 @@ func (m *Model) CountRunsForTask(ctx context.Context, id int64) (int64, error) {
 
 // CancelTask finds the queue items and runs for the task, removes them,
 // cancels the associated runs for the task, and finally, saves the task itself. It will
-// fail to do all of this if the task is already finished.
+// fail to do all of this if the task is already finished. Any tasks that depend
+// on this one are failed as well.
 func (m *Model) CancelTask(ctx context.Context, taskID int64) error {
 	task, err := models.FindTask(ctx, m.db, taskID)
 	if err != nil {
@@ -109,7 +112,7 @@ func (m *Model) CancelTask(ctx context.Context, taskID int64) error {
 
 	for _, thisRun := range runs {
 		if !thisRun.Status.Valid {
-			if err := m.SetRunStatus(ctx, thisRun.ID, false); err != nil {
+			if err := m.SetRunStatus(ctx, thisRun.ID, false, ""); err != nil {
 				fmt.Println(err)
 			}
 		}
@@ -119,10 +122,193 @@ func (m *Model) CancelTask(ctx context.Context, taskID int64) error {
 	task.Status = null.BoolFrom(false)
 	task.FinishedAt = null.TimeFrom(time.Now())
 
-	_, err = task.Update(ctx, m.db, boil.Infer())
+	if _, err := task.Update(ctx, m.db, boil.Infer()); err != nil {
+		return err
+	}
+
+	_, err = m.FailDependentTasks(ctx, task.ID)
 	return err
 }
 
+// FailDependentTasks fails all unfinished tasks that depend on the task
+// provided, directly or through other tasks. It is used when a task fails or
+// is canceled, as the tasks which depend on it can no longer be run. The runs
+// that were failed are returned so their status can be reported.
+func (m *Model) FailDependentTasks(ctx context.Context, taskID int64) ([]*models.Run, error) {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	failed := []*models.Run{}
+	seen := map[int64]struct{}{taskID: {}}
+	pending := []int64{taskID}
+	now := null.TimeFrom(time.Now())
+
+	for len(pending) > 0 {
+		id := pending[0]
+		pending = pending[1:]
+
+		tasks, err := models.Tasks(qm.Where("depends_on @> array[?]::bigint[]", id), models.TaskWhere.FinishedAt.IsNull()).All(ctx, tx)
+		if err != nil {
+			return nil, utils.WrapError(err, "locating tasks which depend on task %d", id)
+		}
+
+		for _, task := range tasks {
+			if _, ok := seen[task.ID]; ok {
+				continue
+			}
+
+			seen[task.ID] = struct{}{}
+			pending = append(pending, task.ID)
+
+			runs, err := task.Runs(models.RunWhere.Status.IsNull()).All(ctx, tx)
+			if err != nil {
+				return nil, utils.WrapError(err, "locating runs to be failed for task %d", task.ID)
+			}
+
+			for _, run := range runs {
+				if _, err := models.QueueItems(models.QueueItemWhere.RunID.EQ(run.ID)).DeleteAll(ctx, tx); err != nil {
+					return nil, err
+				}
+
+				run.Status = null.BoolFrom(false)
+				run.FinishedAt = now
+
+				if _, err := run.Update(ctx, tx, boil.Infer()); err != nil {
+					return nil, err
+				}
+
+				failed = append(failed, run)
+			}
+
+			task.Status = null.BoolFrom(false)
+			task.FinishedAt = now
+
+			if _, err := task.Update(ctx, tx, boil.Infer()); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return failed, tx.Commit()
+}
+
+// RerunTask copies the finished task into a new task of the same submission,
+// linked back to it, and queues a new attempt of each of its runs. Only the
+// latest re-run of a task can be re-run, and only if the tasks it depends on
+// have not failed; tasks depending on it are not re-run.
+func (m *Model) RerunTask(ctx context.Context, taskID int64) (*models.Task, []*models.Run, error) {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback()
+
+	task, err := models.Tasks(models.TaskWhere.ID.EQ(taskID), qm.For("update")).One(ctx, tx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !task.FinishedAt.Valid {
+		return nil, nil, fmt.Errorf("task %d has not finished", taskID)
+	}
+
+	latest, err := latestTask(ctx, tx, taskID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if latest != taskID {
+		return nil, nil, fmt.Errorf("task %d was already re-run as task %d", taskID, latest)
+	}
+
+	dependsOn := boilTypes.Int64Array{}
+	for _, dep := range task.DependsOn {
+		id, err := latestTask(ctx, tx, dep)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		dependsOn = append(dependsOn, id)
+	}
+
+	if len(dependsOn) > 0 {
+		failed, err := models.Tasks(
+			qm.Where("id = any(?)", dependsOn),
+			qm.Where("finished_at is not null and status is not true"),
+		).Exists(ctx, tx)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if failed {
+			return nil, nil, fmt.Errorf("task %d depends on tasks which did not succeed; re-run its submission instead", taskID)
+		}
+	}
+
+	clone, runs, err := rerunTask(ctx, tx, task, dependsOn)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return clone, runs, tx.Commit()
+}
+
+// latestTask returns the ID of the latest re-run of the task, which is the
+// task itself if it has not been re-run.
+func latestTask(ctx context.Context, exec boil.ContextExecutor, taskID int64) (int64, error) {
+	for {
+		next, err := models.Tasks(models.TaskWhere.RerunOfID.EQ(null.Int64From(taskID))).One(ctx, exec)
+		if errors.Is(err, sql.ErrNoRows) {
+			return taskID, nil
+		} else if err != nil {
+			return 0, err
+		}
+
+		taskID = next.ID
+	}
+}
+
+// rerunTask inserts a copy of the task depending on the tasks given, and
+// queues a new attempt of the latest attempt of each of its runs in it.
+func rerunTask(ctx context.Context, exec boil.ContextExecutor, task *models.Task, dependsOn boilTypes.Int64Array) (*models.Task, []*models.Run, error) {
+	clone := &models.Task{
+		TaskSettings: task.TaskSettings,
+		Path:         task.Path,
+		SubmissionID: task.SubmissionID,
+		DependsOn:    dependsOn,
+		RerunOfID:    null.Int64From(task.ID),
+		Priority:     task.Priority,
+	}
+
+	if err := clone.Insert(ctx, exec, boil.Infer()); err != nil {
+		return nil, nil, err
+	}
+
+	runs, err := task.Runs(
+		qm.Where("not exists (select 1 from runs retry where retry.previous_attempt_id = runs.id)"),
+		qm.OrderBy("id"),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, nil, utils.WrapError(err, "locating runs to re-run for task %d", task.ID)
+	}
+
+	reruns := []*models.Run{}
+
+	for _, run := range runs {
+		next, err := rerun(ctx, exec, run, clone)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		reruns = append(reruns, next)
+	}
+
+	return clone, reruns, nil
+}
+
 // CancelTaskForPR cancels a task for a given pull request ID, by repository.
 func (m *Model) CancelTaskForPR(ctx context.Context, repoName string, prID int64) error {
 	tasks, err := models.Tasks(
@@ -156,7 +342,10 @@ func (m *Model) CountTasks(ctx context.Context, repoName, sha string) (int64, er
 }
 
 // UpdateTaskStatus is triggered when a run state change happens that is *not* a cancellation.
-func (m *Model) UpdateTaskStatus(ctx context.Context, taskID int64, status null.Bool, finishedAt null.Time) error {
+// The task finishes once the latest attempt of each of its runs has; it
+// succeeds only if all of those succeeded, as runs may have been retried or
+// re-run since their earlier attempts failed.
+func (m *Model) UpdateTaskStatus(ctx context.Context, taskID int64, finishedAt null.Time) error {
 	task, err := models.Tasks(models.TaskWhere.ID.EQ(taskID)).One(ctx, m.db)
 	if err != nil {
 		return err
@@ -166,19 +355,25 @@ func (m *Model) UpdateTaskStatus(ctx context.Context, taskID int64, status null.
 		return nil
 	}
 
-	runs, err := task.Runs(qm.OrderBy("id DESC")).All(ctx, m.db)
+	runs, err := task.Runs(
+		qm.Where("not exists (select 1 from runs retry where retry.previous_attempt_id = runs.id)"),
+	).All(ctx, m.db)
 	if err != nil {
 		return err
 	}
 
+	status := true
+
 	for _, run := range runs {
 		if !run.Status.Valid || !run.FinishedAt.Valid {
 			return nil
 		}
+
+		status = status && run.Status.Bool
 	}
 
 	task.FinishedAt = finishedAt
-	task.Status = status
+	task.Status = null.BoolFrom(status)
 
 	_, err = task.Update(ctx, m.db, boil.Infer())
 	return err
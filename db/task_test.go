@@ -118,6 +118,55 @@ func TestTaskValidate(t *testing.T) {
 	assert.Assert(t, cmp.Equal(t2repo.Name, fork.Name))
 }
 
+func TestRerunTask(t *testing.T) {
+	m := testInit(t)
+
+	run, err := m.CreateTestRun(ctx)
+	assert.NilError(t, err)
+	assert.NilError(t, (&models.QueueItem{RunID: run.ID, QueueName: "default"}).Insert(ctx, m.db, boil.Infer()))
+
+	task, err := run.Task().One(ctx, m.db)
+	assert.NilError(t, err)
+
+	_, _, err = m.RerunTask(ctx, task.ID)
+	assert.ErrorContains(t, err, "has not finished")
+
+	assert.NilError(t, m.SetRunStatus(ctx, run.ID, false, ""))
+
+	clone, runs, err := m.RerunTask(ctx, task.ID)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Equal(clone.RerunOfID.Int64, task.ID))
+	assert.Assert(t, cmp.Equal(clone.SubmissionID, task.SubmissionID))
+	assert.Assert(t, cmp.Len(runs, 1))
+	assert.Assert(t, cmp.Equal(runs[0].TaskID, clone.ID))
+	assert.Assert(t, cmp.Equal(runs[0].PreviousAttemptID.Int64, run.ID))
+
+	_, _, err = m.RerunTask(ctx, task.ID)
+	assert.ErrorContains(t, err, "already re-run")
+
+	// a task depending on the failed one cannot be re-run alone.
+	sub, err := task.Submission().One(ctx, m.db)
+	assert.NilError(t, err)
+
+	dep, err := m.CreateTestTaskForSubmission(ctx, sub)
+	assert.NilError(t, err)
+
+	dep.DependsOn = []int64{task.ID}
+	_, err = dep.Update(ctx, m.db, boil.Infer())
+	assert.NilError(t, err)
+
+	qi, err := m.NextQueueItem(ctx, "hostname", "default")
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Equal(qi.RunID, runs[0].ID))
+	assert.NilError(t, m.SetRunStatus(ctx, runs[0].ID, false, ""))
+
+	_, err = m.FailDependentTasks(ctx, task.ID)
+	assert.NilError(t, err)
+
+	_, _, err = m.RerunTask(ctx, dep.ID)
+	assert.ErrorContains(t, err, "depends on tasks which did not succeed")
+}
+
 /*
 func TestTaskList(t *testing.T) {
 	m := testInit(t)
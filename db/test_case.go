@@ -0,0 +1,233 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/tinyci/ci-agents/db/models"
+	topTypes "github.com/tinyci/ci-agents/types"
+	"github.com/tinyci/ci-agents/utils"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// foreignKeyViolation is the postgres error code for rows which refer to rows
+// that do not exist.
+const foreignKeyViolation = pq.ErrorCode("23503")
+
+// TestSummary counts the test cases of a run by status.
+type TestSummary struct {
+	Total    int64
+	Passed   int64
+	Failed   int64
+	Skipped  int64
+	Errored  int64
+	Duration float64  // Sum of the durations of the tests in seconds
+	Failures []string // Names of the first failed and errored tests
+}
+
+// FlakyTest scores how often a test of a repository both passed and failed on
+// the same SHA, whether within a run or across its retries and resubmissions.
+type FlakyTest struct {
+	Suite      string  `boil:"suite"`
+	Name       string  `boil:"name"`
+	Score      float64 `boil:"score"`      // Flipped / SHAs
+	Executions int64   `boil:"executions"` // Times the test passed or failed
+	Failures   int64   `boil:"failures"`   // Times the test failed or errored
+	SHAs       int64   `boil:"shas"`       // SHAs the test ran on
+	Flipped    int64   `boil:"flipped"`    // SHAs the test both passed and failed on
+}
+
+// flakyTestsQuery groups the outcomes of each test of the repository by the SHA
+// tested, then counts the SHAs on which the test both passed and failed.
+const flakyTestsQuery = `
+select suite, name,
+  count(*) filter (where passed > 0 and failed > 0)::float / count(*) as score,
+  sum(passed + failed) as executions,
+  sum(failed) as failures,
+  count(*) as shas,
+  count(*) filter (where passed > 0 and failed > 0) as flipped
+from (
+  select test_cases.suite, test_cases.name, head.sha,
+    count(*) filter (where test_cases.status = $1) as passed,
+    count(*) filter (where test_cases.status in ($2, $3)) as failed
+  from test_cases
+  inner join runs on runs.id = test_cases.run_id
+  inner join tasks on tasks.id = runs.task_id
+  inner join submissions on submissions.id = tasks.submission_id
+  inner join refs base on base.id = submissions.base_ref_id
+  inner join refs head on head.id = coalesce(submissions.head_ref_id, submissions.base_ref_id)
+  where base.repository_id = $4 and test_cases.created_at >= $5
+  group by test_cases.suite, test_cases.name, head.sha
+) as outcomes
+group by suite, name
+having count(*) filter (where passed > 0 and failed > 0) > 0
+order by score desc, flipped desc, suite, name
+limit $6
+`
+
+// AddTestCases records the results of the run's tests. Each report the run
+// uploads adds to the test cases it already has. utils.ErrNotFound is returned
+// if the run does not exist.
+func (m *Model) AddTestCases(ctx context.Context, runID int64, cases models.TestCaseSlice) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, tc := range cases {
+		if tc.Name == "" {
+			return errors.New("test cases must have a name")
+		}
+
+		switch tc.Status {
+		case topTypes.TestPassed, topTypes.TestFailed, topTypes.TestSkipped, topTypes.TestError:
+		default:
+			return utils.WrapError(errors.New("invalid status"), "test case %q", tc.Name)
+		}
+
+		tc.ID = 0
+		tc.RunID = runID
+
+		if err := tc.Insert(ctx, tx, boil.Infer()); err != nil {
+			var pqErr *pq.Error
+			if errors.As(err, &pqErr) && pqErr.Code == foreignKeyViolation {
+				return utils.ErrNotFound // the run does not exist
+			}
+
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ListTestCases lists the test cases of the run by suite and name. If a status
+// is given, only the test cases with it are listed.
+func (m *Model) ListTestCases(ctx context.Context, runID int64, status string) (models.TestCaseSlice, error) {
+	mods := []qm.QueryMod{models.TestCaseWhere.RunID.EQ(runID), qm.OrderBy("suite, name, id")}
+	if status != "" {
+		mods = append(mods, models.TestCaseWhere.Status.EQ(status))
+	}
+
+	return models.TestCases(mods...).All(ctx, m.db)
+}
+
+// GetTestSummary counts the test cases of the run by status, naming up to
+// maxFailures of the tests which failed or errored in the order they were
+// reported.
+func (m *Model) GetTestSummary(ctx context.Context, runID int64, maxFailures int) (*TestSummary, error) {
+	var counts []struct {
+		Status   string  `boil:"status"`
+		Count    int64   `boil:"count"`
+		Duration float64 `boil:"duration"`
+	}
+
+	err := models.NewQuery(
+		qm.Select("status", "count(*) as count", "coalesce(sum(duration), 0) as duration"),
+		qm.From(models.TableNames.TestCases),
+		models.TestCaseWhere.RunID.EQ(runID),
+		qm.GroupBy("status"),
+	).Bind(ctx, m.db, &counts)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &TestSummary{Failures: []string{}}
+
+	for _, c := range counts {
+		summary.Total += c.Count
+		summary.Duration += c.Duration
+
+		switch c.Status {
+		case topTypes.TestPassed:
+			summary.Passed = c.Count
+		case topTypes.TestFailed:
+			summary.Failed = c.Count
+		case topTypes.TestSkipped:
+			summary.Skipped = c.Count
+		case topTypes.TestError:
+			summary.Errored = c.Count
+		}
+	}
+
+	if summary.Failed+summary.Errored > 0 && maxFailures > 0 {
+		failures, err := models.TestCases(
+			models.TestCaseWhere.RunID.EQ(runID),
+			models.TestCaseWhere.Status.IN([]string{topTypes.TestFailed, topTypes.TestError}),
+			qm.OrderBy("id"),
+			qm.Limit(maxFailures),
+		).All(ctx, m.db)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tc := range failures {
+			summary.Failures = append(summary.Failures, tc.Name)
+		}
+	}
+
+	return summary, nil
+}
+
+// FlakyTests lists up to limit of the tests of the repository which both
+// passed and failed on the same SHA since the time given, most flaky first.
+func (m *Model) FlakyTests(ctx context.Context, repoID int64, since time.Time, limit int) ([]*FlakyTest, error) {
+	tests := []*FlakyTest{}
+
+	err := queries.Raw(
+		flakyTestsQuery,
+		topTypes.TestPassed, topTypes.TestFailed, topTypes.TestError,
+		repoID, since, limit,
+	).Bind(ctx, m.db, &tests)
+
+	return tests, err
+}
+
+// QuarantinedFailures returns the names of the run's failed tests if all of
+// them are quarantined by the repository's configuration. If the run has no
+// failed tests, or any of them are not quarantined, nothing is returned.
+func (m *Model) QuarantinedFailures(ctx context.Context, runID int64) ([]string, error) {
+	config, err := m.GetRepoConfigForRun(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(config.Quarantine) == 0 {
+		return nil, nil
+	}
+
+	failures, err := models.TestCases(
+		models.TestCaseWhere.RunID.EQ(runID),
+		models.TestCaseWhere.Status.IN([]string{topTypes.TestFailed, topTypes.TestError}),
+		qm.OrderBy("id"),
+	).All(ctx, m.db)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+
+	for _, tc := range failures {
+		ok, err := config.Quarantines(tc.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			return nil, nil
+		}
+
+		names = append(names, tc.Name)
+	}
+
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	return names, nil
+}
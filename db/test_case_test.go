@@ -0,0 +1,147 @@
+package db
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tinyci/ci-agents/db/models"
+	topTypes "github.com/tinyci/ci-agents/types"
+	"github.com/tinyci/ci-agents/utils"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+)
+
+func TestTestCases(t *testing.T) {
+	m := testInit(t)
+
+	run, err := m.CreateTestRun(ctx)
+	assert.NilError(t, err)
+
+	assert.Assert(t, errors.Is(m.AddTestCases(ctx, run.ID+1, models.TestCaseSlice{{Name: "TestA", Status: topTypes.TestPassed}}), utils.ErrNotFound))
+	assert.Assert(t, m.AddTestCases(ctx, run.ID, models.TestCaseSlice{{Name: "TestA", Status: "flaky"}}) != nil)
+	assert.Assert(t, m.AddTestCases(ctx, run.ID, models.TestCaseSlice{{Status: topTypes.TestPassed}}) != nil)
+
+	assert.NilError(t, m.AddTestCases(ctx, run.ID, models.TestCaseSlice{
+		{Suite: "b", Name: "TestB", Status: topTypes.TestFailed, Duration: 1.5, Message: "wanted 1, got 2"},
+		{Suite: "a", Name: "TestA", Status: topTypes.TestPassed, Duration: 0.5},
+		{Suite: "a", Name: "TestC", Status: topTypes.TestSkipped},
+	}))
+
+	// a second report adds to the first.
+	assert.NilError(t, m.AddTestCases(ctx, run.ID, models.TestCaseSlice{
+		{Suite: "c", Name: "TestD", Status: topTypes.TestError, Duration: 2},
+	}))
+
+	cases, err := m.ListTestCases(ctx, run.ID, "")
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(cases, 4))
+	assert.Assert(t, cmp.Equal(cases[0].Name, "TestA"))
+	assert.Assert(t, cmp.Equal(cases[1].Name, "TestC"))
+	assert.Assert(t, cmp.Equal(cases[2].Message, "wanted 1, got 2"))
+
+	cases, err = m.ListTestCases(ctx, run.ID, topTypes.TestFailed)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(cases, 1))
+	assert.Assert(t, cmp.Equal(cases[0].Name, "TestB"))
+
+	summary, err := m.GetTestSummary(ctx, run.ID, 1)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.DeepEqual(summary, &TestSummary{
+		Total:    4,
+		Passed:   1,
+		Failed:   1,
+		Skipped:  1,
+		Errored:  1,
+		Duration: 4,
+		Failures: []string{"TestB"},
+	}))
+
+	summary, err = m.GetTestSummary(ctx, run.ID+1, 1)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.DeepEqual(summary, &TestSummary{Failures: []string{}}))
+}
+
+func TestFlakyTests(t *testing.T) {
+	m := testInit(t)
+
+	run, err := m.CreateTestRun(ctx)
+	assert.NilError(t, err)
+
+	// a second run of the same task tests the same SHA.
+	again := &models.Run{Name: run.Name, RunSettings: run.RunSettings, TaskID: run.TaskID}
+	assert.NilError(t, again.Insert(ctx, m.db, boil.Infer()))
+
+	assert.NilError(t, m.AddTestCases(ctx, run.ID, models.TestCaseSlice{
+		{Name: "TestFlaky", Status: topTypes.TestPassed},
+		{Name: "TestBroken", Status: topTypes.TestFailed},
+		{Name: "TestStable", Status: topTypes.TestPassed},
+	}))
+
+	assert.NilError(t, m.AddTestCases(ctx, again.ID, models.TestCaseSlice{
+		{Name: "TestFlaky", Status: topTypes.TestError},
+		{Name: "TestBroken", Status: topTypes.TestFailed},
+		{Name: "TestStable", Status: topTypes.TestPassed},
+	}))
+
+	repos, err := m.RunRepositories(ctx, []int64{run.ID})
+	assert.NilError(t, err)
+
+	tests, err := m.FlakyTests(ctx, repos[run.ID], time.Now().Add(-time.Hour), 10)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.DeepEqual(tests, []*FlakyTest{
+		{Name: "TestFlaky", Score: 1, Executions: 2, Failures: 1, SHAs: 1, Flipped: 1},
+	}))
+
+	tests, err = m.FlakyTests(ctx, repos[run.ID], time.Now().Add(time.Hour), 10)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(tests, 0))
+
+	tests, err = m.FlakyTests(ctx, repos[run.ID]+1, time.Now().Add(-time.Hour), 10)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(tests, 0))
+}
+
+func TestQuarantinedFailures(t *testing.T) {
+	m := testInit(t)
+
+	run, err := m.CreateTestRun(ctx)
+	assert.NilError(t, err)
+
+	assert.NilError(t, m.AddTestCases(ctx, run.ID, models.TestCaseSlice{
+		{Name: "TestFlaky", Status: topTypes.TestFailed},
+		{Name: "TestNetwork/dial", Status: topTypes.TestError},
+		{Name: "TestStable", Status: topTypes.TestPassed},
+	}))
+
+	// nothing is quarantined yet.
+	names, err := m.QuarantinedFailures(ctx, run.ID)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(names, 0))
+
+	setQuarantine := func(patterns ...string) {
+		task, err := m.GetTaskForRun(ctx, run.ID)
+		assert.NilError(t, err)
+
+		ts := &topTypes.TaskSettings{}
+		assert.NilError(t, task.TaskSettings.Unmarshal(ts))
+		ts.Config.Quarantine = patterns
+
+		task.TaskSettings, err = json.Marshal(ts)
+		assert.NilError(t, err)
+		_, err = task.Update(ctx, m.db, boil.Infer())
+		assert.NilError(t, err)
+	}
+
+	setQuarantine("TestFlaky")
+	names, err = m.QuarantinedFailures(ctx, run.ID)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(names, 0))
+
+	setQuarantine("TestFlaky", "TestNetwork/*")
+	names, err = m.QuarantinedFailures(ctx, run.ID)
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.DeepEqual(names, []string{"TestFlaky", "TestNetwork/dial"}))
+}
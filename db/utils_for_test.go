@@ -141,6 +141,52 @@ func (m *Model) CreateTestTaskForSubmission(ctx context.Context, sub *models.Sub
 	return task, nil
 }
 
+// CreateTestConcurrentTask queues a task in the concurrency group given, with
+// the runs given, for the submission. Settings are stored in their protobuf
+// representation, which is what the queue inspects.
+func (m *Model) CreateTestConcurrentTask(ctx context.Context, subID int64, cc *types.Concurrency, runs ...*types.RunSettings) (*models.Task, []*models.Run, error) {
+	ts := &types.TaskSettings{Mountpoint: "/tmp", Runs: map[string]*types.RunSettings{}, Concurrency: cc}
+
+	for _, rs := range runs {
+		rs.Image = "foo"
+		rs.Command = []string{"run", "me"}
+		rs.Queue = "default"
+		ts.Runs[rs.Name] = rs
+	}
+
+	content, err := json.Marshal(ts.ToProto())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	task := &models.Task{TaskSettings: content, SubmissionID: subID}
+	if err := task.Insert(ctx, m.db, boil.Infer()); err != nil {
+		return nil, nil, err
+	}
+
+	modelRuns := []*models.Run{}
+
+	for _, rs := range runs {
+		content, err := json.Marshal(rs.ToProto())
+		if err != nil {
+			return nil, nil, err
+		}
+
+		run := &models.Run{Name: rs.Name, RunSettings: content, TaskID: task.ID}
+		if err := run.Insert(ctx, m.db, boil.Infer()); err != nil {
+			return nil, nil, err
+		}
+
+		if err := (&models.QueueItem{RunID: run.ID, QueueName: "default"}).Insert(ctx, m.db, boil.Infer()); err != nil {
+			return nil, nil, err
+		}
+
+		modelRuns = append(modelRuns, run)
+	}
+
+	return task, modelRuns, nil
+}
+
 func (m *Model) CreateTestRun(ctx context.Context) (*models.Run, error) {
 	parent, err := m.CreateTestRepository(ctx)
 	if err != nil {
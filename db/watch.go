@@ -0,0 +1,152 @@
+package db
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	// queueChannel is the postgres channel notified with the name of the queue
+	// whenever an item is added to a queue or put back into it, and with the
+	// name of every queue with items waiting whenever a run or a task finishes;
+	// see the notify_queue_items and notify_waiting_queues triggers.
+	queueChannel = "queue_items"
+	// cancelChannel is the postgres channel notified with the ID of a task
+	// when it is canceled or finishes, or one of its runs is canceled; see the
+	// notify_tasks_canceled and notify_runs_canceled triggers.
+	cancelChannel = "tasks_canceled"
+	// liveLogChannel is the postgres channel notified with the ID of a run
+	// whenever a chunk is added to its live log or the log is finished; see
+	// the notify_live_logs trigger.
+	liveLogChannel = "live_logs"
+)
+
+// watchPingInterval is how long a listener may go without notifications
+// before its connection is checked.
+const watchPingInterval = 90 * time.Second
+
+// watcher listens for notifications on a postgres channel, on a connection of
+// its own, and wakes the subscribers of the key named in each. This way the
+// waiters of every service sharing the database are woken. It starts
+// listening when the first subscriber arrives.
+type watcher struct {
+	dsn       string
+	channel   string
+	mutex     sync.Mutex
+	started   bool
+	listening bool
+	subs      map[string]map[chan struct{}]struct{}
+}
+
+func newWatcher(dsn, channel string) *watcher {
+	return &watcher{dsn: dsn, channel: channel, subs: map[string]map[chan struct{}]struct{}{}}
+}
+
+// subscribe returns a channel which receives a value whenever any of the keys
+// is notified, and a function to stop watching. Once the watcher is listening,
+// a value is sent at once, as well as whenever the listener had to reconnect,
+// so the subscriber can check for anything it missed in between.
+func (w *watcher) subscribe(keys ...string) (<-chan struct{}, func()) {
+	sub := make(chan struct{}, 1)
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if !w.started {
+		w.started = true
+		go w.listen()
+	}
+
+	for _, key := range keys {
+		if w.subs[key] == nil {
+			w.subs[key] = map[chan struct{}]struct{}{}
+		}
+
+		w.subs[key][sub] = struct{}{}
+	}
+
+	if w.listening {
+		sub <- struct{}{}
+	}
+
+	return sub, func() {
+		w.mutex.Lock()
+		defer w.mutex.Unlock()
+
+		for _, key := range keys {
+			delete(w.subs[key], sub)
+			if len(w.subs[key]) == 0 {
+				delete(w.subs, key)
+			}
+		}
+	}
+}
+
+// listen runs for the rest of the process; the listener reconnects by itself
+// when its connection is lost.
+func (w *watcher) listen() {
+	listener := pq.NewListener(w.dsn, 100*time.Millisecond, time.Minute, nil)
+
+	// this blocks until the listener has connected; errors come from the
+	// server rejecting the LISTEN.
+	for {
+		err := listener.Listen(w.channel)
+		if err == nil || errors.Is(err, pq.ErrChannelAlreadyOpen) {
+			break
+		}
+
+		time.Sleep(time.Second)
+	}
+
+	w.mutex.Lock()
+	w.listening = true
+	w.mutex.Unlock()
+
+	w.wakeAll()
+
+	for {
+		select {
+		case n := <-listener.Notify:
+			if n == nil {
+				// the connection was re-established, so notifications may have
+				// been missed while it was down.
+				w.wakeAll()
+				continue
+			}
+
+			w.wake(n.Extra)
+		case <-time.After(watchPingInterval):
+			go listener.Ping()
+		}
+	}
+}
+
+func (w *watcher) wake(key string) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	for sub := range w.subs[key] {
+		notify(sub)
+	}
+}
+
+func (w *watcher) wakeAll() {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	for _, subs := range w.subs {
+		for sub := range subs {
+			notify(sub)
+		}
+	}
+}
+
+func notify(sub chan struct{}) {
+	select {
+	case sub <- struct{}{}:
+	default: // a wakeup is already pending
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	github "github.com/google/go-github/github"
+	github0 "github.com/tinyci/ci-agents/clients/github"
 )
 
 // MockClient is a mock of Client interface.
@@ -225,6 +226,20 @@ func (mr *MockClientMockRecorder) PendingStatus(arg0, arg1, arg2, arg3, arg4, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingStatus", reflect.TypeOf((*MockClient)(nil).PendingStatus), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
+// ReportCheckRun mocks base method.
+func (m *MockClient) ReportCheckRun(arg0 context.Context, arg1, arg2 string, arg3 *github0.CheckRun) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReportCheckRun", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReportCheckRun indicates an expected call of ReportCheckRun.
+func (mr *MockClientMockRecorder) ReportCheckRun(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportCheckRun", reflect.TypeOf((*MockClient)(nil).ReportCheckRun), arg0, arg1, arg2, arg3)
+}
+
 // SetupHook mocks base method.
 func (m *MockClient) SetupHook(arg0 context.Context, arg1, arg2, arg3, arg4 string) error {
 	m.ctrl.T.Helper()
@@ -239,6 +254,20 @@ func (mr *MockClientMockRecorder) SetupHook(arg0, arg1, arg2, arg3, arg4 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetupHook", reflect.TypeOf((*MockClient)(nil).SetupHook), arg0, arg1, arg2, arg3, arg4)
 }
 
+// SkippedStatus mocks base method.
+func (m *MockClient) SkippedStatus(arg0 context.Context, arg1, arg2, arg3, arg4, arg5, arg6 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SkippedStatus", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SkippedStatus indicates an expected call of SkippedStatus.
+func (mr *MockClientMockRecorder) SkippedStatus(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SkippedStatus", reflect.TypeOf((*MockClient)(nil).SkippedStatus), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
 // StartedStatus mocks base method.
 func (m *MockClient) StartedStatus(arg0 context.Context, arg1, arg2, arg3, arg4, arg5 string) error {
 	m.ctrl.T.Helper()
@@ -34,6 +34,11 @@ var (
 		"subscriptions",
 		"user_capabilities",
 		"user_errors",
+		"runners",
+		"log_objects",
+		"test_cases",
+		"live_logs",
+		"live_log_chunks",
 	}
 )
 
@@ -15,7 +15,12 @@ const (
 	CapabilityCancel Capability = "cancel"
 	// CapabilityReadLogs allows you to read CI logs
 	CapabilityReadLogs Capability = "logs"
+	// CapabilityReadArtifacts allows you to list and download the artifacts of runs
+	CapabilityReadArtifacts Capability = "artifacts"
+	// CapabilityPriority allows manual submissions to be queued at a higher
+	// priority than the repository's
+	CapabilityPriority Capability = "priority"
 )
 
 // AllCapabilities comprises the superuser account's list of capabilities.
-var AllCapabilities = []Capability{CapabilityModifyCI, CapabilityModifyUser, CapabilitySubmit, CapabilityCancel, CapabilityReadLogs}
+var AllCapabilities = []Capability{CapabilityModifyCI, CapabilityModifyUser, CapabilitySubmit, CapabilityCancel, CapabilityReadLogs, CapabilityReadArtifacts, CapabilityPriority}
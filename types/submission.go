@@ -2,10 +2,23 @@ package types
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/tinyci/ci-agents/utils"
 )
 
+const (
+	// EventPush is the event of submissions triggered by a push to a branch.
+	EventPush = "push"
+	// EventPullRequest is the event of submissions triggered by a pull request.
+	EventPullRequest = "pull_request"
+
+	// MinPriority and MaxPriority bound the priority of manual submissions.
+	// Raising it above the repository's requires CapabilityPriority.
+	MinPriority = -100
+	MaxPriority = 100
+)
+
 // Submission is the encapsulation of a submission to the queuesvc.
 type Submission struct {
 	Parent      string `json:"parent"`
@@ -15,8 +28,10 @@ type Submission struct {
 	TicketID    int64  `json:"ticket_id"`
 	SubmittedBy string `json:"submitted_by"`
 	All         bool   `json:"all"`
+	Priority    *int32 `json:"priority"` // if nil, the repository's configured priority is used.
 
-	Manual bool `json:"-"`
+	Manual bool   `json:"-"`
+	Event  string `json:"-"` // EventPush or EventPullRequest for hook submissions; empty for manual ones.
 }
 
 // Validate validates the submission, and returns an error if it encounters any.
@@ -29,6 +44,22 @@ func (sub *Submission) Validate() error {
 		return errors.New("hook-triggered submissions may not force all")
 	}
 
+	if sub.Priority != nil {
+		if !sub.Manual {
+			return errors.New("only manual submissions may set a priority")
+		}
+
+		if *sub.Priority < MinPriority || *sub.Priority > MaxPriority {
+			return fmt.Errorf("priority %d is out of range: must be between %d and %d", *sub.Priority, MinPriority, MaxPriority)
+		}
+	}
+
+	switch sub.Event {
+	case "", EventPush, EventPullRequest:
+	default:
+		return fmt.Errorf("invalid event %q", sub.Event)
+	}
+
 	if !utils.IsOwnerRepo(sub.Fork) {
 		return errors.New("fork is invalid")
 	}
@@ -5,6 +5,8 @@ import (
 )
 
 func (ts *typesSuite) TestSubmissionValidation(c *check.C) {
+	priority := func(p int32) *int32 { return &p }
+
 	submap := map[bool][]*Submission{
 		true: {
 			{
@@ -33,8 +35,47 @@ func (ts *typesSuite) TestSubmissionValidation(c *check.C) {
 				All:     true,
 				Manual:  true,
 			},
+			{
+				Parent:  "foo/bar",
+				Fork:    "bar/foo",
+				BaseSHA: "master",
+				HeadSHA: "master",
+				Event:   EventPullRequest,
+			},
+			{
+				Fork:     "bar/foo",
+				HeadSHA:  "master",
+				Manual:   true,
+				Priority: priority(MinPriority),
+			},
+			{
+				Fork:     "bar/foo",
+				HeadSHA:  "master",
+				Manual:   true,
+				Priority: priority(0),
+			},
 		},
 		false: {
+			{
+				Parent:  "foo/bar",
+				Fork:    "bar/foo",
+				BaseSHA: "master",
+				HeadSHA: "master",
+				Event:   "release",
+			},
+			{
+				Parent:   "foo/bar",
+				Fork:     "bar/foo",
+				BaseSHA:  "master",
+				HeadSHA:  "master",
+				Priority: priority(1),
+			},
+			{
+				Fork:     "bar/foo",
+				HeadSHA:  "master",
+				Manual:   true,
+				Priority: priority(MaxPriority + 1),
+			},
 			{
 				Parent:  "/",
 				Fork:    "bar/foo",
@@ -2,7 +2,10 @@ package types
 
 import (
 	"fmt"
+	"path"
 	"reflect"
+	"regexp"
+	"strings"
 	"time"
 
 	"errors"
@@ -44,6 +47,79 @@ type TaskSettings struct {
 	Metadata         map[string]interface{}  `yaml:"metadata"`
 	Config           RepoConfig              `yaml:"-"`
 	DefaultResources Resources               `yaml:"default_resources"`
+	On               *EventFilters           `yaml:"on"`
+	Concurrency      *Concurrency            `yaml:"concurrency"` // only one task of the group runs at a time
+}
+
+// EventFilters limits runs to certain events, and the branches within them.
+// When set, events which are not listed do not trigger the runs. Manual
+// submissions are never filtered.
+type EventFilters struct {
+	Push        *BranchFilter `yaml:"push"`
+	PullRequest *BranchFilter `yaml:"pull_request"` // matched against the branch the pull request targets
+}
+
+// BranchFilter is a list of branch names, globs or "re:" regexes. An empty list
+// matches all branches.
+type BranchFilter struct {
+	Branches []string `yaml:"branches"`
+}
+
+// NewEventFiltersFromProto returns the local type for the protobuf type.
+func NewEventFiltersFromProto(ef *types.EventFilters) *EventFilters {
+	if ef == nil {
+		return nil
+	}
+
+	filters := &EventFilters{}
+
+	if ef.Push != nil {
+		filters.Push = &BranchFilter{Branches: ef.Push.Branches}
+	}
+
+	if ef.PullRequest != nil {
+		filters.PullRequest = &BranchFilter{Branches: ef.PullRequest.Branches}
+	}
+
+	return filters
+}
+
+// ToProto converts the filters to protobuf.
+func (ef *EventFilters) ToProto() *types.EventFilters {
+	if ef == nil {
+		return nil
+	}
+
+	filters := &types.EventFilters{}
+
+	if ef.Push != nil {
+		filters.Push = &types.BranchFilter{Branches: ef.Push.Branches}
+	}
+
+	if ef.PullRequest != nil {
+		filters.PullRequest = &types.BranchFilter{Branches: ef.PullRequest.Branches}
+	}
+
+	return filters
+}
+
+// Validate checks the branch patterns of the filters.
+func (ef *EventFilters) Validate() error {
+	if ef == nil {
+		return nil
+	}
+
+	for _, filter := range []*BranchFilter{ef.Push, ef.PullRequest} {
+		if filter == nil {
+			continue
+		}
+
+		if _, err := utils.NewMatcher(filter.Branches); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // NewTaskSettingsFromProto creates a task settings object from a proto representation.
@@ -65,6 +141,8 @@ func NewTaskSettingsFromProto(ts *types.TaskSettings) *TaskSettings {
 		DefaultImage:   ts.DefaultImage,
 		Metadata:       ts.Metadata.AsMap(),
 		Config:         NewRepoConfigFromProto(ts.Config),
+		On:             NewEventFiltersFromProto(ts.On),
+		Concurrency:    NewConcurrencyFromProto(ts.Concurrency),
 	}
 }
 
@@ -88,6 +166,8 @@ func (t *TaskSettings) ToProto() *types.TaskSettings {
 		Metadata:       mkStruct(t.Metadata),
 		Resources:      t.DefaultResources.toProto(),
 		Config:         t.Config.ToProto(),
+		On:             t.On.ToProto(),
+		Concurrency:    t.Concurrency.ToProto(),
 	}
 }
 
@@ -197,6 +277,14 @@ func (t *TaskSettings) Validate(requireRuns bool) error {
 		}
 	}
 
+	if err := t.On.Validate(); err != nil {
+		return err
+	}
+
+	if err := t.Concurrency.Validate(); err != nil {
+		return err
+	}
+
 	if len(t.Runs) != 0 {
 		if t.Mountpoint == "" {
 			return errors.New("no mountpoint")
@@ -211,6 +299,10 @@ func (t *TaskSettings) Validate(requireRuns bool) error {
 				return err
 			}
 		}
+
+		if err := t.validateNeeds(); err != nil {
+			return err
+		}
 	} else if requireRuns {
 		return errors.New("Runs are required to proceed further with this task")
 	} else if len(t.Dependencies) == 0 {
@@ -220,18 +312,286 @@ func (t *TaskSettings) Validate(requireRuns bool) error {
 	return nil
 }
 
+func (t *TaskSettings) validateNeeds() error {
+	graph := map[string][]string{}
+
+	for name, run := range t.Runs {
+		for _, need := range run.Needs {
+			if _, ok := t.Runs[need]; !ok {
+				return fmt.Errorf("run %q needs run %q, which does not exist", name, need)
+			}
+
+			// a needed run which is filtered out is never run, so its dependents
+			// could not tell whether it would have passed.
+			needed := t.Runs[need]
+			if len(needed.Paths) != 0 || len(needed.PathsIgnore) != 0 {
+				return fmt.Errorf("run %q needs run %q, which is filtered by paths", name, need)
+			}
+
+			if needed.On != nil {
+				return fmt.Errorf("run %q needs run %q, which is filtered by events", name, need)
+			}
+		}
+
+		graph[name] = run.Needs
+	}
+
+	if _, err := utils.TopoSort(graph); err != nil {
+		return utils.WrapError(err, "validating run needs")
+	}
+
+	return nil
+}
+
 // RunSettings encompasses things that are a part of a run that are
 // configurable by a user.
 type RunSettings struct {
-	Privileged bool                   `yaml:"privileged"`
-	Command    []string               `yaml:"command"`
-	Image      string                 `yaml:"image"`
-	Queue      string                 `yaml:"queue"`
-	Metadata   map[string]interface{} `yaml:"metadata"`
-	Name       string                 `yaml:"-"`
-	Timeout    time.Duration          `yaml:"timeout"`
-	Resources  Resources              `yaml:"resources"`
-	Env        []string               `yaml:"env"`
+	Privileged  bool                   `yaml:"privileged"`
+	Command     []string               `yaml:"command"`
+	Image       string                 `yaml:"image"`
+	Queue       string                 `yaml:"queue"`
+	Metadata    map[string]interface{} `yaml:"metadata"`
+	Name        string                 `yaml:"-"`
+	Timeout     time.Duration          `yaml:"timeout"`
+	Resources   Resources              `yaml:"resources"`
+	Env         []string               `yaml:"env"`
+	Needs       []string               `yaml:"needs"`
+	Matrix      map[string]*MatrixAxis `yaml:"matrix"`
+	Paths       []string               `yaml:"paths"`        // only run when a changed file (relative to the repository root) matches these patterns
+	PathsIgnore []string               `yaml:"paths_ignore"` // changed files matching these patterns do not trigger the run
+	On          *EventFilters          `yaml:"on"`           // applied in addition to the task's filters
+	RunsOn      []string               `yaml:"runs_on"`      // labels, e.g. `arch=arm64`, a runner must have to be handed the run, besides the label of the run's queue; see QueueLabel
+	Retries     *RetryPolicy           `yaml:"retries"`      // new attempts are enqueued automatically when the run fails
+	Artifacts   []*Artifact            `yaml:"artifacts"`    // files the runner uploads to the assetsvc when the run finishes
+	Concurrency *Concurrency           `yaml:"concurrency"`  // only one run of the group runs at a time
+}
+
+// Concurrency keeps the members of a group from running at the same time in
+// a repository. The group may refer to `${ref}` (the branch, e.g. `main`),
+// `${sha}` and, for runs, `${matrix.<axis>}`; they are substituted when the
+// task is queued. Members are handed out of the queue one at a time, and if
+// CancelInProgress is set, queuing a new member cancels the tasks of the
+// older ones.
+type Concurrency struct {
+	Group            string `yaml:"group"`
+	CancelInProgress bool   `yaml:"cancel_in_progress"`
+}
+
+// NewConcurrencyFromProto returns the local type for the protobuf type.
+func NewConcurrencyFromProto(c *types.Concurrency) *Concurrency {
+	if c == nil {
+		return nil
+	}
+
+	return &Concurrency{Group: c.Group, CancelInProgress: c.CancelInProgress}
+}
+
+// ToProto converts the concurrency settings to protobuf.
+func (c *Concurrency) ToProto() *types.Concurrency {
+	if c == nil {
+		return nil
+	}
+
+	return &types.Concurrency{Group: c.Group, CancelInProgress: c.CancelInProgress}
+}
+
+// Validate checks that the concurrency settings name a group.
+func (c *Concurrency) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	if strings.TrimSpace(c.Group) == "" {
+		return errors.New("concurrency group was empty")
+	}
+
+	return nil
+}
+
+// Artifact declares a file produced by a run, which the runner uploads to the
+// assetsvc under the name once the run has finished.
+type Artifact struct {
+	Name        string `yaml:"name"`
+	Path        string `yaml:"path"`         // relative to the working directory of the run
+	ContentType string `yaml:"content_type"` // guessed from the path's extension if unset
+	Report      string `yaml:"report"`       // junit or go-test-json; test reports are parsed into the run's test cases
+}
+
+var artifactNameRegexp = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+
+// ValidateArtifactName checks that the name can be used for an artifact: it
+// must be made of letters, digits, dots, dashes and underscores, and be no
+// longer than 255 characters.
+func ValidateArtifactName(name string) error {
+	if name == "." || name == ".." || len(name) > 255 || !artifactNameRegexp.MatchString(name) {
+		return fmt.Errorf("invalid artifact name %q: must be made of letters, digits, '.', '-' and '_'", name)
+	}
+
+	return nil
+}
+
+func newArtifactsFromProto(artifacts []*types.Artifact) []*Artifact {
+	if artifacts == nil {
+		return nil
+	}
+
+	ret := make([]*Artifact, len(artifacts))
+	for i, a := range artifacts {
+		ret[i] = &Artifact{Name: a.Name, Path: a.Path, ContentType: a.ContentType, Report: a.Report}
+	}
+
+	return ret
+}
+
+func artifactsToProto(artifacts []*Artifact) []*types.Artifact {
+	if artifacts == nil {
+		return nil
+	}
+
+	ret := make([]*types.Artifact, len(artifacts))
+	for i, a := range artifacts {
+		ret[i] = &types.Artifact{Name: a.Name, Path: a.Path, ContentType: a.ContentType, Report: a.Report}
+	}
+
+	return ret
+}
+
+func validateArtifacts(artifacts []*Artifact) error {
+	names := map[string]struct{}{}
+
+	for _, a := range artifacts {
+		if a == nil {
+			return errors.New("artifact was empty")
+		}
+
+		if err := ValidateArtifactName(a.Name); err != nil {
+			return err
+		}
+
+		if _, ok := names[a.Name]; ok {
+			return fmt.Errorf("artifact %q is declared more than once", a.Name)
+		}
+		names[a.Name] = struct{}{}
+
+		if a.Path == "" || path.IsAbs(a.Path) || strings.HasPrefix(path.Clean(a.Path), "../") || path.Clean(a.Path) == ".." {
+			return fmt.Errorf("artifact %q must have a path within the run's working directory", a.Name)
+		}
+
+		if err := ValidateTestReportFormat(a.Report); err != nil {
+			return fmt.Errorf("artifact %q: %w", a.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// The reasons a run can fail for. Runners report these with the failed status;
+// retry policies select the ones they apply to. Runners report tests when the
+// run's own tests failed, which is the only failure quarantined tests can
+// excuse; retry policies treat it as a plain failure unless they name it. The
+// queuesvc fails runs which exceed their timeout itself, expires those which
+// wait in the queue for too long, and fails those it could not start once
+// claimed; expired runs and runs which could not be started are never
+// retried, as they would only wait or fail to start again.
+const (
+	FailureReasonFailure    = "failure"     // the run itself failed; the default
+	FailureReasonTests      = "tests"       // the run's tests failed
+	FailureReasonInfraError = "infra_error" // the runner or its environment failed, e.g. the runner was lost
+	FailureReasonTimeout    = "timeout"     // the run exceeded its timeout
+	FailureReasonExpired    = "expired"     // the run could be claimed from the queue for longer than the queue's TTL
+	FailureReasonStartError = "start_error" // the queuesvc could not start the run it handed out, e.g. its repository has no owner
+)
+
+var failureReasons = map[string]struct{}{
+	FailureReasonFailure:    {},
+	FailureReasonTests:      {},
+	FailureReasonInfraError: {},
+	FailureReasonTimeout:    {},
+}
+
+// RetryPolicy controls how many more attempts are made of a failed run, and
+// for which failure reasons. An empty On list retries for any reason.
+type RetryPolicy struct {
+	Count int      `yaml:"count"`
+	On    []string `yaml:"on"`
+}
+
+// NewRetryPolicyFromProto returns the local type for the protobuf type.
+func NewRetryPolicyFromProto(rp *types.RetryPolicy) *RetryPolicy {
+	if rp == nil {
+		return nil
+	}
+
+	return &RetryPolicy{Count: int(rp.Count), On: rp.On}
+}
+
+// ToProto converts the retry policy to protobuf.
+func (rp *RetryPolicy) ToProto() *types.RetryPolicy {
+	if rp == nil {
+		return nil
+	}
+
+	return &types.RetryPolicy{Count: int64(rp.Count), On: rp.On}
+}
+
+// Validate checks the count and the failure reasons of the policy.
+func (rp *RetryPolicy) Validate() error {
+	if rp == nil {
+		return nil
+	}
+
+	if rp.Count < 0 {
+		return errors.New("retry count was negative")
+	}
+
+	for _, reason := range rp.On {
+		if _, ok := failureReasons[reason]; !ok {
+			return fmt.Errorf("invalid retry reason %q: must be one of failure, tests, infra_error or timeout", reason)
+		}
+	}
+
+	return nil
+}
+
+// Allows returns true if the given attempt of a run (starting at 1), having
+// failed for the reason given, should be followed by another attempt. An empty
+// reason is treated as a plain failure, and so are failed tests unless the
+// policy names them. Expired runs and runs which could not be started are
+// never retried.
+func (rp *RetryPolicy) Allows(reason string, attempt int) bool {
+	if rp == nil || attempt > rp.Count || reason == FailureReasonExpired || reason == FailureReasonStartError {
+		return false
+	}
+
+	if reason == "" {
+		reason = FailureReasonFailure
+	}
+
+	if len(rp.On) == 0 {
+		return true
+	}
+
+	for _, on := range rp.On {
+		if on == reason || (on == FailureReasonFailure && reason == FailureReasonTests) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MatrixAxis is the list of values one axis of a run matrix can take. The run
+// is expanded into one run per combination of axis values when it is queued,
+// and each value is substituted wherever `${matrix.<axis>}` appears in the
+// image, command, environment, runs_on labels, artifact paths or concurrency
+// group.
+type MatrixAxis struct {
+	Values []string
+}
+
+// UnmarshalYAML reads the axis as a plain list of values.
+func (ma *MatrixAxis) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshal(&ma.Values)
 }
 
 // Resources communicates what resources should be available to the runner.
@@ -276,28 +636,72 @@ func newResources(rs *types.Resources) Resources {
 // NewRunSettingsFromProto creates a runsettings from a proto representation.
 func NewRunSettingsFromProto(rs *types.RunSettings) *RunSettings {
 	return &RunSettings{
-		Privileged: rs.Privileged,
-		Command:    rs.Command,
-		Image:      rs.Image,
-		Queue:      rs.Queue,
-		Metadata:   rs.Metadata.AsMap(),
-		Name:       rs.Name,
-		Timeout:    time.Duration(rs.Timeout),
-		Env:        rs.Env,
+		Privileged:  rs.Privileged,
+		Command:     rs.Command,
+		Image:       rs.Image,
+		Queue:       rs.Queue,
+		Metadata:    rs.Metadata.AsMap(),
+		Name:        rs.Name,
+		Timeout:     time.Duration(rs.Timeout),
+		Env:         rs.Env,
+		Needs:       rs.Needs,
+		Matrix:      newMatrixFromProto(rs.Matrix),
+		Paths:       rs.Paths,
+		PathsIgnore: rs.PathsIgnore,
+		On:          NewEventFiltersFromProto(rs.On),
+		RunsOn:      rs.RunsOn,
+		Retries:     NewRetryPolicyFromProto(rs.Retries),
+		Artifacts:   newArtifactsFromProto(rs.Artifacts),
+		Concurrency: NewConcurrencyFromProto(rs.Concurrency),
+	}
+}
+
+func newMatrixFromProto(matrix map[string]*types.MatrixAxis) map[string]*MatrixAxis {
+	if matrix == nil {
+		return nil
 	}
+
+	m := map[string]*MatrixAxis{}
+	for axis, values := range matrix {
+		m[axis] = &MatrixAxis{Values: values.Values}
+	}
+
+	return m
+}
+
+func matrixToProto(matrix map[string]*MatrixAxis) map[string]*types.MatrixAxis {
+	if matrix == nil {
+		return nil
+	}
+
+	m := map[string]*types.MatrixAxis{}
+	for axis, values := range matrix {
+		m[axis] = &types.MatrixAxis{Values: values.Values}
+	}
+
+	return m
 }
 
 // ToProto converts the run settings to the protobuf representation.
 func (rs *RunSettings) ToProto() *types.RunSettings {
 	return &types.RunSettings{
-		Privileged: rs.Privileged,
-		Command:    rs.Command,
-		Image:      rs.Image,
-		Queue:      rs.Queue,
-		Metadata:   mkStruct(rs.Metadata),
-		Name:       rs.Name,
-		Timeout:    rs.Timeout.Nanoseconds(),
-		Env:        rs.Env,
+		Privileged:  rs.Privileged,
+		Command:     rs.Command,
+		Image:       rs.Image,
+		Queue:       rs.Queue,
+		Metadata:    mkStruct(rs.Metadata),
+		Name:        rs.Name,
+		Timeout:     rs.Timeout.Nanoseconds(),
+		Env:         rs.Env,
+		Needs:       rs.Needs,
+		Matrix:      matrixToProto(rs.Matrix),
+		Paths:       rs.Paths,
+		PathsIgnore: rs.PathsIgnore,
+		On:          rs.On.ToProto(),
+		RunsOn:      rs.RunsOn,
+		Retries:     rs.Retries.ToProto(),
+		Artifacts:   artifactsToProto(rs.Artifacts),
+		Concurrency: rs.Concurrency.ToProto(),
 	}
 }
 
@@ -315,14 +719,77 @@ func (rs *RunSettings) Validate() error {
 		return errors.New("queue name was empty")
 	}
 
-	return nil
+	for axis, values := range rs.Matrix {
+		if axis == "" {
+			return errors.New("matrix axis name was empty")
+		}
+
+		if values == nil || len(values.Values) == 0 {
+			return fmt.Errorf("matrix axis %q has no values", axis)
+		}
+	}
+
+	for _, patterns := range [][]string{rs.Paths, rs.PathsIgnore} {
+		if _, err := utils.NewMatcher(patterns); err != nil {
+			return err
+		}
+	}
+
+	for _, label := range rs.RunsOn {
+		if strings.TrimSpace(label) == "" {
+			return errors.New("runs_on label was empty")
+		}
+	}
+
+	if err := rs.Retries.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateArtifacts(rs.Artifacts); err != nil {
+		return err
+	}
+
+	if err := rs.Concurrency.Validate(); err != nil {
+		return err
+	}
+
+	return rs.On.Validate()
+}
+
+// QueueLabelKey is the key of the labels naming the queues a runner serves. A
+// runner carries the label of the queue it asks for, e.g. `queue=default`, and
+// may serve other queues by carrying their labels too.
+const QueueLabelKey = "queue"
+
+// QueueLabel returns the label of the named queue.
+func QueueLabel(queueName string) string {
+	return QueueLabelKey + "=" + queueName
+}
+
+// RunnerLabels returns the labels of a runner asking for items of the named
+// queue: the labels given, along with the queue's label.
+func RunnerLabels(queueName string, labels []string) []string {
+	return append([]string{QueueLabel(queueName)}, labels...)
+}
+
+// LabelQueues returns the names of the queues in the labels.
+func LabelQueues(labels []string) []string {
+	queues := []string{}
+
+	for _, label := range labels {
+		if name := strings.TrimPrefix(label, QueueLabelKey+"="); name != label {
+			queues = append(queues, name)
+		}
+	}
+
+	return queues
 }
 
 // RepoConfigMergeOptions is the operations around merging branches before
 // launching the container.
 type RepoConfigMergeOptions struct {
 	DoNotMerge bool     `yaml:"do_not_merge"` // do not merge any branch with the default branch.
-	IgnoreRefs []string `yaml:"ignore_refs"`  // be sure to include the full ref name "heads/my_branch" etc FIXME make this globbable later.
+	IgnoreRefs []string `yaml:"ignore_refs"`  // be sure to include the full ref name "heads/my_branch" etc. globs and "re:" regexes are accepted.
 }
 
 // NewRepoConfigMergeOptionsFromProto returns a local type for the protobuf type
@@ -341,6 +808,17 @@ func (rcm RepoConfigMergeOptions) ToProto() *types.Merge {
 	}
 }
 
+// Status APIs which the state of a repository's runs can be reported to GitHub
+// through.
+const (
+	StatusAPIStatuses = "statuses" // commit statuses; the default
+	// StatusAPIChecks reports check runs, whose output summarizes the run's
+	// tests and annotates their failures, and which can be re-run from GitHub.
+	// Only GitHub Apps can write check runs, so tinyCI's app must be installed
+	// on the repository; see github_app in the service configuration.
+	StatusAPIChecks = "checks"
+)
+
 // RepoConfig is the global configuration for the repository. It allows setting
 // of global attributes as well as overrides and defaults for certain
 // task-related items. It is typically named `tinyci.yml`.
@@ -349,15 +827,25 @@ type RepoConfig struct {
 	WorkDir          string                 `yaml:"workdir"`
 	Queue            string                 `yaml:"queue"`
 	OverrideQueue    bool                   `yaml:"override_queue"`
+	Priority         int                    `yaml:"priority"`       // queue priority of this repository's runs; higher is handed out first.
 	GlobalTimeout    time.Duration          `yaml:"global_timeout"` // run timeout. if unset, or 0, no timeout.
 	OverrideTimeout  bool                   `yaml:"override_timeout"`
-	IgnoreDirs       []string               `yaml:"ignore_directories"`
+	IgnoreDirs       []string               `yaml:"ignore_directories"`  // globs and "re:" regexes; a directory is ignored if it or a parent matches.
+	IncludeDirs      []string               `yaml:"include_directories"` // if set, only tasks in matching directories (and the root) are considered.
 	Metadata         map[string]interface{} `yaml:"metadata"`
 	OverrideMetadata bool                   `yaml:"override_metadata"`
 	DefaultImage     string                 `yaml:"default_image"`
 	DefaultResources Resources              `yaml:"default_resources"`
 	Merge            RepoConfigMergeOptions `yaml:"merge_options"`
+	Quarantine       []string               `yaml:"quarantine"` // test names, as globs or "re:" regexes, whose failures don't fail runs failed for their tests.
+	StatusAPI        string                 `yaml:"status_api"` // statuses (the default) or checks; see StatusAPIChecks.
 	//OptimizeDiff  bool   `yaml:"optimize_diff"` // diff dir selection -- FIXME defaulted to on for now, will add this logic later
+
+	// compiled patterns, filled in as they are first used.
+	ignoreDirs  cachedMatcher
+	includeDirs cachedMatcher
+	ignoreRefs  cachedMatcher
+	quarantine  cachedMatcher
 }
 
 // NewRepoConfig creates a new repo config from a byte buffer.
@@ -386,13 +874,17 @@ func NewRepoConfigFromProto(rs *types.RepoConfig) RepoConfig {
 		WorkDir:          rs.Workdir,
 		Queue:            rs.Queue,
 		OverrideQueue:    rs.OverrideQueue,
+		Priority:         int(rs.Priority),
 		GlobalTimeout:    time.Duration(rs.GlobalTimeout),
 		OverrideTimeout:  rs.OverrideTimeout,
 		IgnoreDirs:       rs.IgnoreDirectories,
+		IncludeDirs:      rs.IncludeDirectories,
 		Metadata:         metadata,
 		OverrideMetadata: rs.OverrideMetadata,
 		DefaultImage:     rs.DefaultImage,
 		Merge:            NewRepoConfigMergeOptionsFromProto(rs.MergeOptions),
+		Quarantine:       rs.Quarantine,
+		StatusAPI:        rs.StatusApi,
 	}
 }
 
@@ -408,17 +900,21 @@ func (r *RepoConfig) ToProto() *types.RepoConfig {
 	}
 
 	return &types.RepoConfig{
-		AllowPrivileged:   r.AllowPrivileged,
-		Workdir:           r.WorkDir,
-		Queue:             r.Queue,
-		OverrideQueue:     r.OverrideQueue,
-		GlobalTimeout:     int64(r.GlobalTimeout),
-		OverrideTimeout:   r.OverrideTimeout,
-		IgnoreDirectories: r.IgnoreDirs,
-		Metadata:          metadata,
-		OverrideMetadata:  r.OverrideMetadata,
-		DefaultImage:      r.DefaultImage,
-		MergeOptions:      r.Merge.ToProto(),
+		AllowPrivileged:    r.AllowPrivileged,
+		Workdir:            r.WorkDir,
+		Queue:              r.Queue,
+		OverrideQueue:      r.OverrideQueue,
+		Priority:           int32(r.Priority),
+		GlobalTimeout:      int64(r.GlobalTimeout),
+		OverrideTimeout:    r.OverrideTimeout,
+		IgnoreDirectories:  r.IgnoreDirs,
+		IncludeDirectories: r.IncludeDirs,
+		Metadata:           metadata,
+		OverrideMetadata:   r.OverrideMetadata,
+		DefaultImage:       r.DefaultImage,
+		MergeOptions:       r.Merge.ToProto(),
+		Quarantine:         r.Quarantine,
+		StatusApi:          r.StatusAPI,
 	}
 }
 
@@ -430,10 +926,113 @@ func (r *RepoConfig) handleOverrides() {
 
 // Validate returns any error if there are validation errors in the repo config.
 func (r *RepoConfig) Validate() error {
-	// it doesn't do much right now..
 	if r.Queue == "" {
 		return errors.New("queue was empty")
 	}
 
+	switch r.StatusAPI {
+	case "", StatusAPIStatuses, StatusAPIChecks:
+	default:
+		return fmt.Errorf("invalid status_api %q: must be %s or %s", r.StatusAPI, StatusAPIStatuses, StatusAPIChecks)
+	}
+
+	for _, patterns := range [][]string{r.IgnoreDirs, r.IncludeDirs, r.Merge.IgnoreRefs, r.Quarantine} {
+		if _, err := utils.NewMatcher(patterns); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+// cachedMatcher is a matcher along with the patterns it was compiled from, so
+// it is only compiled again when they change.
+type cachedMatcher struct {
+	patterns []string
+	matcher  *utils.Matcher
+}
+
+func (cm *cachedMatcher) get(patterns []string, compile func([]string) (*utils.Matcher, error)) (*utils.Matcher, error) {
+	if cm.matcher != nil && reflect.DeepEqual(cm.patterns, patterns) {
+		return cm.matcher, nil
+	}
+
+	m, err := compile(patterns)
+	if err != nil {
+		return nil, err
+	}
+
+	cm.patterns = append([]string(nil), patterns...)
+	cm.matcher = m
+
+	return m, nil
+}
+
+// dirMatcher makes a matcher for directory patterns; trailing slashes are
+// dropped from globs so "vendor/" behaves like "vendor".
+func dirMatcher(patterns []string) (*utils.Matcher, error) {
+	cleaned := []string{}
+
+	for _, pattern := range patterns {
+		if !strings.HasPrefix(pattern, utils.RegexPrefix) && pattern != "/" {
+			pattern = strings.TrimSuffix(pattern, "/")
+		}
+
+		cleaned = append(cleaned, pattern)
+	}
+
+	return utils.NewMatcher(cleaned)
+}
+
+// ProcessesDir returns true if the tasks in the directory should be
+// considered for testing, according to the ignore_directories and
+// include_directories settings. The root directory is always included.
+func (r *RepoConfig) ProcessesDir(dir string) (bool, error) {
+	ignore, err := r.ignoreDirs.get(r.IgnoreDirs, dirMatcher)
+	if err != nil {
+		return false, err
+	}
+
+	if ignore.MatchPath(dir) {
+		return false, nil
+	}
+
+	include, err := r.includeDirs.get(r.IncludeDirs, dirMatcher)
+	if err != nil {
+		return false, err
+	}
+
+	if include.Empty() || path.Clean(dir) == "." {
+		return true, nil
+	}
+
+	return include.MatchPath(dir), nil
+}
+
+// IgnoresRef returns true if the ref (e.g. "heads/my_branch") matches any of
+// the merge options' ignore_refs patterns, and should therefore not be merged.
+func (r *RepoConfig) IgnoresRef(ref string) (bool, error) {
+	m, err := r.ignoreRefs.get(r.Merge.IgnoreRefs, utils.NewMatcher)
+	if err != nil {
+		return false, err
+	}
+
+	return m.Match(ref), nil
+}
+
+// Quarantines returns true if the test is quarantined; failures of quarantined
+// tests don't fail the run.
+func (r *RepoConfig) Quarantines(name string) (bool, error) {
+	m, err := r.quarantine.get(r.Quarantine, utils.NewMatcher)
+	if err != nil {
+		return false, err
+	}
+
+	return m.Match(name), nil
+}
+
+// UsesChecks returns true if the state of the repository's runs is reported
+// through the Checks API instead of commit statuses.
+func (r *RepoConfig) UsesChecks() bool {
+	return r.StatusAPI == StatusAPIChecks
+}
@@ -71,6 +71,125 @@ func (ts *typesSuite) TestTaskSettingsNew(c *check.C) {
 				},
 			},
 		},
+
+		"needs": {
+			Mountpoint:   "/tmp",
+			WorkDir:      "/foobar",
+			DefaultQueue: "frobnik",
+			Runs: map[string]*RunSettings{
+				"build": {
+					Command: []string{"make"},
+					Image:   "foobar",
+					Queue:   "frobnik",
+					Name:    "build",
+				},
+				"integration": {
+					Command: []string{"make", "integration"},
+					Image:   "foobar",
+					Queue:   "frobnik",
+					Name:    "integration",
+					Needs:   []string{"build"},
+				},
+			},
+		},
+
+		"on": {
+			Mountpoint:   "/tmp",
+			WorkDir:      "/foobar",
+			DefaultQueue: "frobnik",
+			On: &EventFilters{
+				Push:        &BranchFilter{Branches: []string{"master", "release-*"}},
+				PullRequest: &BranchFilter{},
+			},
+			Runs: map[string]*RunSettings{
+				"deploy": {
+					Command: []string{"deploy"},
+					Image:   "foobar",
+					Queue:   "frobnik",
+					Name:    "deploy",
+					On: &EventFilters{
+						Push: &BranchFilter{Branches: []string{"master"}},
+					},
+				},
+			},
+		},
+		"runs_on": {
+			Mountpoint:   "/tmp",
+			WorkDir:      "/foobar",
+			DefaultQueue: "frobnik",
+			Runs: map[string]*RunSettings{
+				"build": {
+					Command: []string{"make"},
+					Image:   "foobar",
+					Queue:   "frobnik",
+					Name:    "build",
+					RunsOn:  []string{"arch=arm64", "docker"},
+				},
+			},
+		},
+		"retries": {
+			Mountpoint:   "/tmp",
+			WorkDir:      "/foobar",
+			DefaultQueue: "frobnik",
+			Runs: map[string]*RunSettings{
+				"integration": {
+					Command: []string{"make", "integration"},
+					Image:   "foobar",
+					Queue:   "frobnik",
+					Name:    "integration",
+					Retries: &RetryPolicy{Count: 2, On: []string{FailureReasonInfraError, FailureReasonTimeout}},
+				},
+			},
+		},
+		"artifacts": {
+			Mountpoint:   "/tmp",
+			WorkDir:      "/foobar",
+			DefaultQueue: "frobnik",
+			Runs: map[string]*RunSettings{
+				"build": {
+					Command: []string{"make"},
+					Image:   "foobar",
+					Queue:   "frobnik",
+					Name:    "build",
+					Artifacts: []*Artifact{
+						{Name: "tinyci-linux-amd64", Path: "build/tinyci"},
+						{Name: "coverage.html", Path: "coverage.html", ContentType: "text/html"},
+						{Name: "report.xml", Path: "report.xml", Report: TestReportJUnit},
+					},
+				},
+			},
+		},
+		"matrix": {
+			Mountpoint:   "/tmp",
+			WorkDir:      "/foobar",
+			DefaultQueue: "frobnik",
+			Runs: map[string]*RunSettings{
+				"test": {
+					Command: []string{"go", "test"},
+					Image:   "golang:${matrix.go}",
+					Queue:   "frobnik",
+					Name:    "test",
+					Matrix: map[string]*MatrixAxis{
+						"go": {Values: []string{"1.15", "1.16"}},
+					},
+				},
+			},
+		},
+		"concurrency": {
+			Mountpoint:   "/tmp",
+			WorkDir:      "/foobar",
+			DefaultQueue: "frobnik",
+			Concurrency:  &Concurrency{Group: "deploy-${ref}", CancelInProgress: true},
+			Runs: map[string]*RunSettings{
+				"migrate": {
+					Command:     []string{"make", "migrate"},
+					Image:       "foobar",
+					Queue:       "frobnik",
+					Name:        "migrate",
+					Concurrency: &Concurrency{Group: "database"},
+				},
+			},
+		},
 	}
 
 	for file, task := range iters {
@@ -84,6 +203,99 @@ func (ts *typesSuite) TestTaskSettingsNew(c *check.C) {
 	}
 }
 
+func (ts *typesSuite) TestRetryPolicy(c *check.C) {
+	var rp *RetryPolicy
+	c.Assert(rp.Validate(), check.IsNil)
+	c.Assert(rp.Allows(FailureReasonFailure, 1), check.Equals, false)
+
+	rp = &RetryPolicy{Count: 2}
+	c.Assert(rp.Validate(), check.IsNil)
+	c.Assert(rp.Allows("", 1), check.Equals, true)
+	c.Assert(rp.Allows(FailureReasonTimeout, 2), check.Equals, true)
+	c.Assert(rp.Allows(FailureReasonTimeout, 3), check.Equals, false)
+	c.Assert(rp.Allows(FailureReasonExpired, 1), check.Equals, false)
+	c.Assert(rp.Allows(FailureReasonStartError, 1), check.Equals, false)
+
+	rp.On = []string{FailureReasonInfraError}
+	c.Assert(rp.Allows(FailureReasonInfraError, 1), check.Equals, true)
+	c.Assert(rp.Allows(FailureReasonStartError, 1), check.Equals, false)
+	c.Assert(rp.Allows(FailureReasonFailure, 1), check.Equals, false)
+	c.Assert(rp.Allows("", 1), check.Equals, false)
+	c.Assert(rp.Allows(FailureReasonTests, 1), check.Equals, false)
+
+	// failed tests are failures, unless the policy names them.
+	rp.On = []string{FailureReasonFailure}
+	c.Assert(rp.Validate(), check.IsNil)
+	c.Assert(rp.Allows(FailureReasonTests, 1), check.Equals, true)
+
+	rp.On = []string{FailureReasonTests}
+	c.Assert(rp.Validate(), check.IsNil)
+	c.Assert(rp.Allows(FailureReasonTests, 1), check.Equals, true)
+	c.Assert(rp.Allows(FailureReasonFailure, 1), check.Equals, false)
+
+	c.Assert(NewRetryPolicyFromProto(rp.ToProto()), check.DeepEquals, rp)
+
+	rp.On = []string{"bogus"}
+	c.Assert(rp.Validate(), check.NotNil)
+
+	rp.On = []string{FailureReasonExpired}
+	c.Assert(rp.Validate(), check.NotNil)
+
+	rp.On = []string{FailureReasonStartError}
+	c.Assert(rp.Validate(), check.NotNil)
+
+	rp = &RetryPolicy{Count: -1}
+	c.Assert(rp.Validate(), check.NotNil)
+}
+
+func (ts *typesSuite) TestConcurrency(c *check.C) {
+	var cc *Concurrency
+	c.Assert(cc.Validate(), check.IsNil)
+	c.Assert(cc.ToProto(), check.IsNil)
+
+	cc = &Concurrency{Group: " "}
+	c.Assert(cc.Validate(), check.NotNil)
+
+	cc = &Concurrency{Group: "deploy-${ref}", CancelInProgress: true}
+	c.Assert(cc.Validate(), check.IsNil)
+	c.Assert(NewConcurrencyFromProto(cc.ToProto()), check.DeepEquals, cc)
+
+	rs := &RunSettings{Command: []string{"make"}, Image: "foobar", Queue: "default", Concurrency: &Concurrency{}}
+	c.Assert(rs.Validate(), check.NotNil)
+}
+
+func (ts *typesSuite) TestQueueLabels(c *check.C) {
+	labels := RunnerLabels("default", []string{"arch=arm64", QueueLabel("gpu"), "queue"})
+	c.Assert(labels, check.DeepEquals, []string{"queue=default", "arch=arm64", "queue=gpu", "queue"})
+	c.Assert(LabelQueues(labels), check.DeepEquals, []string{"default", "gpu"})
+	c.Assert(LabelQueues(nil), check.DeepEquals, []string{})
+}
+
+func (ts *typesSuite) TestArtifacts(c *check.C) {
+	rs := &RunSettings{Command: []string{"make"}, Image: "foobar", Queue: "default"}
+
+	for _, artifacts := range [][]*Artifact{
+		{{Name: "", Path: "a"}},
+		{{Name: "..", Path: "a"}},
+		{{Name: "a/b", Path: "a"}},
+		{{Name: "a", Path: ""}},
+		{{Name: "a", Path: "/etc/passwd"}},
+		{{Name: "a", Path: "../a"}},
+		{{Name: "a", Path: "a"}, {Name: "a", Path: "b"}},
+		{{Name: "a", Path: "a", Report: "tap"}},
+	} {
+		rs.Artifacts = artifacts
+		c.Assert(rs.Validate(), check.NotNil, check.Commentf("%v", artifacts[0]))
+	}
+
+	rs.Artifacts = []*Artifact{
+		{Name: "coverage.html", Path: "out/../coverage.html", ContentType: "text/html"},
+		{Name: "tests.json", Path: "tests.json", Report: TestReportGoTestJSON},
+	}
+	c.Assert(rs.Validate(), check.IsNil)
+	c.Assert(NewRunSettingsFromProto(rs.ToProto()).Artifacts, check.DeepEquals, rs.Artifacts)
+}
+
 func (ts *typesSuite) TestRepoConfig(c *check.C) {
 	iters := map[string]RepoConfig{
 		"basic": {
@@ -95,6 +307,21 @@ func (ts *typesSuite) TestRepoConfig(c *check.C) {
 			WorkDir:       "/",
 			OverrideQueue: true,
 		},
+
+		"priority": {
+			Queue:    "default",
+			Priority: 10,
+		},
+
+		"quarantine": {
+			Queue:      "default",
+			Quarantine: []string{"TestFlaky", "TestNetwork*"},
+		},
+
+		"checks": {
+			Queue:     "default",
+			StatusAPI: StatusAPIChecks,
+		},
 	}
 
 	for file, config := range iters {
@@ -105,7 +332,83 @@ func (ts *typesSuite) TestRepoConfig(c *check.C) {
 		c.Assert(err, check.IsNil)
 
 		c.Assert(rc, check.DeepEquals, config)
+
+		proto := rc.ToProto()
+		c.Assert(proto.Priority, check.Equals, int32(config.Priority))
+		fromProto := NewRepoConfigFromProto(proto)
+		c.Assert(fromProto.Quarantine, check.DeepEquals, config.Quarantine)
+		c.Assert(fromProto.UsesChecks(), check.Equals, config.StatusAPI == StatusAPIChecks)
 	}
+
+	_, err := NewRepoConfig([]byte("status_api: email"))
+	c.Assert(err, check.ErrorMatches, "invalid status_api.*")
+}
+
+func (ts *typesSuite) TestRepoConfigMatching(c *check.C) {
+	rc := RepoConfig{
+		Queue:       "default",
+		IgnoreDirs:  []string{"vendor/", "re:^tools/.*-old$"},
+		IncludeDirs: []string{"services/**", "lib"},
+		Merge: RepoConfigMergeOptions{
+			IgnoreRefs: []string{"heads/release-*", "re:^heads/wip/"},
+		},
+	}
+
+	c.Assert(rc.Validate(), check.IsNil)
+
+	for dir, processed := range map[string]bool{
+		".":                   true,
+		"vendor":              false,
+		"vendor/lib":          false,
+		"vendor-tools":        false, // not ignored, but not included either
+		"lib":                 true,
+		"lib/sub":             true,
+		"libfoo":              false,
+		"services/api":        true,
+		"services/vendor":     true,
+		"tools/linter-old":    false,
+		"services/foo/bar":    true,
+		"documentation/guide": false,
+	} {
+		ok, err := rc.ProcessesDir(dir)
+		c.Assert(err, check.IsNil)
+		c.Assert(ok, check.Equals, processed, check.Commentf("%s", dir))
+	}
+
+	rc.IncludeDirs = nil
+	ok, err := rc.ProcessesDir("vendor-tools")
+	c.Assert(err, check.IsNil)
+	c.Assert(ok, check.Equals, true)
+
+	for ref, ignored := range map[string]bool{
+		"heads/release-1.0": true,
+		"heads/wip/foo":     true,
+		"heads/master":      false,
+		"heads/release":     false,
+	} {
+		ok, err := rc.IgnoresRef(ref)
+		c.Assert(err, check.IsNil)
+		c.Assert(ok, check.Equals, ignored, check.Commentf("%s", ref))
+	}
+
+	rc.Quarantine = []string{"TestFlaky", "re:^TestNetwork/"}
+	for name, quarantined := range map[string]bool{
+		"TestFlaky":          true,
+		"TestFlakyToo":       false,
+		"TestNetwork/dial":   true,
+		"TestNetworkTimeout": false,
+	} {
+		ok, err := rc.Quarantines(name)
+		c.Assert(err, check.IsNil)
+		c.Assert(ok, check.Equals, quarantined, check.Commentf("%s", name))
+	}
+
+	rc.Merge.IgnoreRefs = []string{"re:("}
+	c.Assert(rc.Validate(), check.NotNil)
+
+	rc.Merge.IgnoreRefs = nil
+	rc.Quarantine = []string{"re:("}
+	c.Assert(rc.Validate(), check.NotNil)
 }
 
 func (ts *typesSuite) TestNewTaskWithRepoConfig(c *check.C) {
@@ -417,6 +720,44 @@ func (ts *typesSuite) TestTaskDependenciesNoRuns(c *check.C) {
 	c.Assert(t.Validate(false), check.IsNil)
 }
 
+func (ts *typesSuite) TestRunNeeds(c *check.C) {
+	mkTask := func(needs map[string][]string) *TaskSettings {
+		t := &TaskSettings{
+			Mountpoint:   "/tmp",
+			DefaultImage: "foobar",
+			Runs:         map[string]*RunSettings{},
+		}
+
+		for name, need := range needs {
+			t.Runs[name] = &RunSettings{Command: []string{"foo"}, Needs: need}
+		}
+
+		return t
+	}
+
+	c.Assert(mkTask(map[string][]string{"build": nil, "test": {"build"}, "deploy": {"build", "test"}}).Validate(true), check.IsNil)
+	c.Assert(mkTask(map[string][]string{"test": {"build"}}).Validate(true), check.ErrorMatches, `.*does not exist`)
+	c.Assert(mkTask(map[string][]string{"test": {"test"}}).Validate(true), check.ErrorMatches, `.*dependency cycle.*`)
+	c.Assert(mkTask(map[string][]string{"build": {"test"}, "test": {"build"}}).Validate(true), check.ErrorMatches, `.*dependency cycle.*`)
+
+	t := mkTask(map[string][]string{"build": nil, "test": {"build"}})
+	t.Runs["build"].Paths = []string{"src/**"}
+	c.Assert(t.Validate(true), check.ErrorMatches, `.*filtered by paths`)
+
+	t = mkTask(map[string][]string{"build": nil, "test": {"build"}})
+	t.Runs["build"].PathsIgnore = []string{"docs/**"}
+	c.Assert(t.Validate(true), check.ErrorMatches, `.*filtered by paths`)
+
+	t = mkTask(map[string][]string{"build": nil, "test": {"build"}})
+	t.Runs["build"].On = &EventFilters{Push: &BranchFilter{}}
+	c.Assert(t.Validate(true), check.ErrorMatches, `.*filtered by events`)
+
+	t = mkTask(map[string][]string{"build": nil, "test": {"build"}})
+	t.Runs["test"].Paths = []string{"src/**"}
+	t.Runs["test"].On = &EventFilters{Push: &BranchFilter{}}
+	c.Assert(t.Validate(true), check.IsNil)
+}
+
 func (ts *typesSuite) TestResourceCascade(c *check.C) {
 	c.Skip("resources are currently not working")
 
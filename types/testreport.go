@@ -0,0 +1,254 @@
+package types
+
+import (
+	"bufio"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/tinyci/ci-agents/ci-gen/grpc/types"
+)
+
+// Test report formats which an artifact can be declared as. Reports are
+// parsed into test cases when they are uploaded.
+const (
+	TestReportJUnit      = "junit"        // JUnit XML
+	TestReportGoTestJSON = "go-test-json" // the output of `go test -json`
+)
+
+// Test case statuses.
+const (
+	TestPassed  = "passed"
+	TestFailed  = "failed"
+	TestSkipped = "skipped"
+	TestError   = "error"
+)
+
+// maxTestMessageLength is the most of a test's failure output which is kept.
+const maxTestMessageLength = 4096
+
+// ValidateTestReportFormat checks that the format is one reports can be
+// parsed from. The empty format is valid, and means the artifact is not a
+// test report.
+func ValidateTestReportFormat(format string) error {
+	switch format {
+	case "", TestReportJUnit, TestReportGoTestJSON:
+		return nil
+	default:
+		return fmt.Errorf("invalid test report format %q: must be one of %s or %s", format, TestReportJUnit, TestReportGoTestJSON)
+	}
+}
+
+// ParseTestReport reads the test cases from a report in the format.
+func ParseTestReport(format string, r io.Reader) ([]*types.TestCase, error) {
+	switch format {
+	case TestReportJUnit:
+		return parseJUnit(r)
+	case TestReportGoTestJSON:
+		return parseGoTestJSON(r)
+	default:
+		return nil, ValidateTestReportFormat(format)
+	}
+}
+
+func truncateTestMessage(msg string) string {
+	msg = strings.TrimSpace(msg)
+	if len(msg) <= maxTestMessageLength {
+		return msg
+	}
+
+	// cut on a rune boundary, so the message stays valid UTF-8.
+	n := maxTestMessageLength
+	for n > 0 && !utf8.RuneStart(msg[n]) {
+		n--
+	}
+
+	return msg[:n]
+}
+
+type junitResult struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+func (jr *junitResult) String() string {
+	switch {
+	case jr.Message != "" && strings.TrimSpace(jr.Text) != "":
+		return jr.Message + "\n" + jr.Text
+	case jr.Message != "":
+		return jr.Message
+	default:
+		return jr.Text
+	}
+}
+
+type junitCase struct {
+	Name      string       `xml:"name,attr"`
+	ClassName string       `xml:"classname,attr"`
+	Time      string       `xml:"time,attr"`
+	Failure   *junitResult `xml:"failure"`
+	Error     *junitResult `xml:"error"`
+	Skipped   *junitResult `xml:"skipped"`
+}
+
+type junitSuite struct {
+	Name   string       `xml:"name,attr"`
+	Cases  []junitCase  `xml:"testcase"`
+	Suites []junitSuite `xml:"testsuite"`
+}
+
+// parseJUnit reads JUnit XML, either a <testsuites> document or a single
+// <testsuite>. Suites may nest; cases are named for their class if they have
+// one, or their suite otherwise.
+func parseJUnit(r io.Reader) ([]*types.TestCase, error) {
+	var root struct {
+		XMLName xml.Name
+		junitSuite
+	}
+
+	if err := xml.NewDecoder(r).Decode(&root); err != nil {
+		return nil, fmt.Errorf("reading JUnit report: %w", err)
+	}
+
+	if root.XMLName.Local != "testsuites" && root.XMLName.Local != "testsuite" {
+		return nil, fmt.Errorf("reading JUnit report: unexpected <%s> element", root.XMLName.Local)
+	}
+
+	cases := []*types.TestCase{}
+	var walk func(suite junitSuite) error
+
+	walk = func(suite junitSuite) error {
+		for _, jc := range suite.Cases {
+			tc := &types.TestCase{Suite: jc.ClassName, Name: jc.Name, Status: TestPassed}
+			if tc.Suite == "" {
+				tc.Suite = suite.Name
+			}
+
+			if jc.Time != "" {
+				duration, err := strconv.ParseFloat(strings.ReplaceAll(jc.Time, ",", ""), 64)
+				if err != nil {
+					return fmt.Errorf("reading JUnit report: invalid time %q for test %q", jc.Time, jc.Name)
+				}
+
+				tc.Duration = duration
+			}
+
+			switch {
+			case jc.Failure != nil:
+				tc.Status = TestFailed
+				tc.Message = truncateTestMessage(jc.Failure.String())
+			case jc.Error != nil:
+				tc.Status = TestError
+				tc.Message = truncateTestMessage(jc.Error.String())
+			case jc.Skipped != nil:
+				tc.Status = TestSkipped
+				tc.Message = truncateTestMessage(jc.Skipped.String())
+			}
+
+			cases = append(cases, tc)
+		}
+
+		for _, child := range suite.Suites {
+			if err := walk(child); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return cases, walk(root.junitSuite)
+}
+
+// goTestEvent is a line of `go test -json` output; see `go doc test2json`.
+type goTestEvent struct {
+	Action  string
+	Package string
+	Test    string
+	Elapsed float64
+	Output  string
+}
+
+// parseGoTestJSON reads the output of `go test -json`. A package which fails
+// without any of its tests failing, such as one which doesn't build, is
+// reported as an errored case named for the package.
+func parseGoTestJSON(r io.Reader) ([]*types.TestCase, error) {
+	type key struct{ pkg, test string }
+
+	var (
+		cases   = []*types.TestCase{}
+		output  = map[key]*strings.Builder{}
+		failed  = map[string]bool{} // packages with failed tests
+		scanner = bufio.NewScanner(r)
+		line    int
+	)
+
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line++
+
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		var ev goTestEvent
+		if err := json.Unmarshal([]byte(text), &ev); err != nil {
+			return nil, fmt.Errorf("reading go test report: line %d: %w", line, err)
+		}
+
+		k := key{ev.Package, ev.Test}
+
+		switch ev.Action {
+		case "output":
+			if output[k] == nil {
+				output[k] = &strings.Builder{}
+			}
+
+			if output[k].Len() < maxTestMessageLength {
+				output[k].WriteString(ev.Output)
+			}
+		case "pass", "fail", "skip":
+			if ev.Test == "" && (ev.Action != "fail" || failed[ev.Package]) {
+				delete(output, k)
+				continue
+			}
+
+			tc := &types.TestCase{Suite: ev.Package, Name: ev.Test, Duration: ev.Elapsed}
+
+			switch ev.Action {
+			case "pass":
+				tc.Status = TestPassed
+			case "skip":
+				tc.Status = TestSkipped
+			case "fail":
+				tc.Status = TestFailed
+				failed[ev.Package] = true
+
+				if ev.Test == "" {
+					tc.Status = TestError
+					tc.Name = ev.Package
+				}
+			}
+
+			if tc.Status != TestPassed && output[k] != nil {
+				tc.Message = truncateTestMessage(output[k].String())
+			}
+
+			delete(output, k)
+			cases = append(cases, tc)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading go test report: %w", err)
+	}
+
+	return cases, nil
+}
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	check "github.com/erikh/check"
+	"github.com/tinyci/ci-agents/ci-gen/grpc/types"
+)
+
+type testCaseSummary struct {
+	Suite, Name, Status string
+	Duration            float64
+}
+
+func summarizeTestCases(cases []*types.TestCase) []testCaseSummary {
+	ret := []testCaseSummary{}
+	for _, tc := range cases {
+		ret = append(ret, testCaseSummary{tc.Suite, tc.Name, tc.Status, tc.Duration})
+	}
+
+	return ret
+}
+
+func (ts *typesSuite) TestParseJUnit(c *check.C) {
+	f, err := os.Open("testdata/reports/junit.xml")
+	c.Assert(err, check.IsNil)
+	defer f.Close()
+
+	cases, err := ParseTestReport(TestReportJUnit, f)
+	c.Assert(err, check.IsNil)
+	c.Assert(summarizeTestCases(cases), check.DeepEquals, []testCaseSummary{
+		{"api.auth", "TestLogin", TestPassed, 0.25},
+		{"api.auth", "TestLogout", TestFailed, 1000.5},
+		{"api", "TestSession", TestSkipped, 0.1},
+		{"db.migrations", "TestMigrate", TestError, 2},
+	})
+
+	c.Assert(cases[0].Message, check.Equals, "")
+	c.Assert(cases[1].Message, check.Equals, "expected 200, got 500\nauth_test.go:42: expected 200, got 500")
+	c.Assert(cases[2].Message, check.Equals, "needs redis")
+	c.Assert(cases[3].Message, check.Equals, "connection refused")
+
+	// a lone suite is a report too.
+	cases, err = ParseTestReport(TestReportJUnit, strings.NewReader(`<testsuite name="s"><testcase name="t"/></testsuite>`))
+	c.Assert(err, check.IsNil)
+	c.Assert(summarizeTestCases(cases), check.DeepEquals, []testCaseSummary{{"s", "t", TestPassed, 0}})
+
+	for _, report := range []string{
+		"",
+		"<html></html>",
+		`<testsuite><testcase name="t" time="soon"/></testsuite>`,
+		`{"Action":"pass"}`,
+	} {
+		_, err := ParseTestReport(TestReportJUnit, strings.NewReader(report))
+		c.Assert(err, check.NotNil, check.Commentf("%q", report))
+	}
+}
+
+func (ts *typesSuite) TestParseGoTestJSON(c *check.C) {
+	f, err := os.Open("testdata/reports/go-test.json")
+	c.Assert(err, check.IsNil)
+	defer f.Close()
+
+	cases, err := ParseTestReport(TestReportGoTestJSON, f)
+	c.Assert(err, check.IsNil)
+	c.Assert(summarizeTestCases(cases), check.DeepEquals, []testCaseSummary{
+		{"example.com/pkg", "TestPass", TestPassed, 0.5},
+		{"example.com/pkg", "TestFail", TestFailed, 0.25},
+		{"example.com/pkg", "TestSkip", TestSkipped, 0},
+		{"example.com/broken", "example.com/broken", TestError, 0},
+	})
+
+	c.Assert(cases[0].Message, check.Equals, "")
+	c.Assert(cases[1].Message, check.Equals, "=== RUN   TestFail\n    pkg_test.go:10: wanted 1, got 2\n--- FAIL: TestFail (0.25s)")
+	c.Assert(cases[2].Message, check.Equals, "pkg_test.go:20: not on this platform")
+	c.Assert(cases[3].Message, check.Equals, "# example.com/broken\nbroken.go:3:1: syntax error")
+
+	// long output is truncated.
+	cases, err = ParseTestReport(TestReportGoTestJSON, strings.NewReader(
+		`{"Action":"output","Package":"p","Test":"T","Output":"`+strings.Repeat("x", 5000)+`"}`+"\n"+
+			`{"Action":"fail","Package":"p","Test":"T"}`,
+	))
+	c.Assert(err, check.IsNil)
+	c.Assert(len(cases[0].Message), check.Equals, maxTestMessageLength)
+
+	// multibyte runes are not cut in half.
+	cases, err = ParseTestReport(TestReportGoTestJSON, strings.NewReader(
+		`{"Action":"output","Package":"p","Test":"T","Output":"x`+strings.Repeat("é", 5000)+`"}`+"\n"+
+			`{"Action":"fail","Package":"p","Test":"T"}`,
+	))
+	c.Assert(err, check.IsNil)
+	c.Assert(len(cases[0].Message), check.Equals, maxTestMessageLength-1)
+	c.Assert(utf8.ValidString(cases[0].Message), check.Equals, true)
+
+	_, err = ParseTestReport(TestReportGoTestJSON, strings.NewReader("ok  \tpkg\t0.01s\n"))
+	c.Assert(err, check.ErrorMatches, ".*line 1.*")
+
+	_, err = ParseTestReport("tap", strings.NewReader(""))
+	c.Assert(err, check.NotNil)
+}
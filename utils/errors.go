@@ -14,6 +14,15 @@ var (
 
 	// ErrRunCanceled is thrown to github when the run is canceled.
 	ErrRunCanceled = errors.New("run canceled by user intervention")
+
+	// ErrLogExists is returned when a log is written for a run which already has one.
+	ErrLogExists = errors.New("log already exists")
+
+	// ErrLogWriting is returned when a log is written for a run whose log is already being written.
+	ErrLogWriting = errors.New("log writing is currently in progress for this ID")
+
+	// ErrArtifactExists is returned when an artifact is stored under a name the run already has one for.
+	ErrArtifactExists = errors.New("artifact already exists")
 )
 
 // WrapError wraps an error with fmt.Error.
@@ -0,0 +1,76 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ErrDependencyCycle is returned by TopoSort when the graph it is handed is not
+// a DAG.
+var ErrDependencyCycle = errors.New("dependency cycle")
+
+// TopoSort sorts the graph so that every node comes after the nodes it depends
+// on. The graph is keyed by node name, and each value is the list of nodes the
+// key depends on. Dependencies which are not keys in the graph are treated as
+// leaves and are also returned. Nodes that are ready at the same time are
+// returned in lexical order, so the result is stable for the same input.
+func TopoSort(graph map[string][]string) ([]string, error) {
+	deps := map[string]map[string]struct{}{}
+	dependents := map[string][]string{}
+
+	for node, edges := range graph {
+		if _, ok := deps[node]; !ok {
+			deps[node] = map[string]struct{}{}
+		}
+
+		for _, edge := range edges {
+			if _, ok := deps[edge]; !ok {
+				deps[edge] = map[string]struct{}{}
+			}
+
+			if _, ok := deps[node][edge]; !ok {
+				deps[node][edge] = struct{}{}
+				dependents[edge] = append(dependents[edge], node)
+			}
+		}
+	}
+
+	ready := []string{}
+	for node, edges := range deps {
+		if len(edges) == 0 {
+			ready = append(ready, node)
+		}
+	}
+
+	sorted := []string{}
+
+	for len(ready) > 0 {
+		sort.Strings(ready)
+		node := ready[0]
+		ready = ready[1:]
+		sorted = append(sorted, node)
+
+		for _, dependent := range dependents[node] {
+			delete(deps[dependent], node)
+			if len(deps[dependent]) == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	if len(sorted) != len(deps) {
+		cycle := []string{}
+		for node, edges := range deps {
+			if len(edges) != 0 {
+				cycle = append(cycle, node)
+			}
+		}
+		sort.Strings(cycle)
+
+		return nil, fmt.Errorf("%w involving %s", ErrDependencyCycle, strings.Join(cycle, ", "))
+	}
+
+	return sorted, nil
+}
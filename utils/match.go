@@ -0,0 +1,84 @@
+package utils
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+)
+
+// RegexPrefix is the prefix given to patterns in a Matcher that are regular
+// expressions instead of globs.
+const RegexPrefix = "re:"
+
+// Matcher matches strings against a list of patterns. Each pattern is either a
+// doublestar glob (`vendor/**`, `heads/release-*`) or, when prefixed with
+// `re:`, a regular expression. A string matches if any pattern does.
+type Matcher struct {
+	globs   []string
+	regexes []*regexp.Regexp
+}
+
+// NewMatcher compiles the patterns into a Matcher. An error is returned for
+// the first pattern that is not a valid glob or regular expression.
+func NewMatcher(patterns []string) (*Matcher, error) {
+	m := &Matcher{}
+
+	for _, pattern := range patterns {
+		if strings.HasPrefix(pattern, RegexPrefix) {
+			re, err := regexp.Compile(strings.TrimPrefix(pattern, RegexPrefix))
+			if err != nil {
+				return nil, WrapError(err, "invalid regex pattern %q", pattern)
+			}
+
+			m.regexes = append(m.regexes, re)
+			continue
+		}
+
+		if !doublestar.ValidatePattern(pattern) {
+			return nil, fmt.Errorf("invalid glob pattern %q", pattern)
+		}
+
+		m.globs = append(m.globs, pattern)
+	}
+
+	return m, nil
+}
+
+// Empty returns true if the matcher has no patterns.
+func (m *Matcher) Empty() bool {
+	return len(m.globs) == 0 && len(m.regexes) == 0
+}
+
+// Match returns true if the whole string matches any of the patterns.
+func (m *Matcher) Match(s string) bool {
+	for _, glob := range m.globs {
+		// patterns were validated in NewMatcher, so no error is possible here.
+		if ok, _ := doublestar.Match(glob, s); ok {
+			return true
+		}
+	}
+
+	for _, re := range m.regexes {
+		if re.MatchString(s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MatchPath returns true if the slash-separated path, or any directory above
+// it, matches any of the patterns. `vendor` therefore matches `vendor/foo` but
+// not `vendor-tools`.
+func (m *Matcher) MatchPath(p string) bool {
+	for p = path.Clean(p); p != "." && p != "/"; p = path.Dir(p) {
+		if m.Match(p) {
+			return true
+		}
+	}
+
+	return false
+}
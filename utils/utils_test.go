@@ -2,6 +2,7 @@ package utils
 
 import (
 	"encoding/json"
+	"errors"
 	. "testing"
 
 	"github.com/erikh/check"
@@ -183,3 +184,57 @@ func (us *utilsSuite) TestQualifyBranch(c *check.C) {
 		c.Assert(newBranch, check.Equals, result.branch)
 	}
 }
+
+func (us *utilsSuite) TestTopoSort(c *check.C) {
+	sorted, err := TopoSort(map[string][]string{
+		".":   {"bar"},
+		"bar": {"foo"},
+		"foo": nil,
+		"baz": {"foo", "quux"},
+	})
+	c.Assert(err, check.IsNil)
+	c.Assert(sorted, check.DeepEquals, []string{"foo", "bar", ".", "quux", "baz"})
+
+	sorted, err = TopoSort(map[string][]string{})
+	c.Assert(err, check.IsNil)
+	c.Assert(len(sorted), check.Equals, 0)
+
+	failures := []map[string][]string{
+		{"foo": {"foo"}},
+		{"foo": {"bar"}, "bar": {"foo"}},
+		{".": {"foo"}, "foo": {"bar"}, "bar": {"baz"}, "baz": {"foo"}},
+	}
+
+	for _, failure := range failures {
+		_, err := TopoSort(failure)
+		c.Assert(errors.Is(err, ErrDependencyCycle), check.Equals, true, check.Commentf("%v", failure))
+	}
+}
+
+func (us *utilsSuite) TestMatcher(c *check.C) {
+	m, err := NewMatcher([]string{"vendor", "docs/**/generated", "re:^heads/release-[0-9.]+$"})
+	c.Assert(err, check.IsNil)
+	c.Assert(m.Empty(), check.Equals, false)
+
+	c.Assert(m.Match("vendor"), check.Equals, true)
+	c.Assert(m.Match("vendor/foo"), check.Equals, false)
+	c.Assert(m.MatchPath("vendor/foo"), check.Equals, true)
+	c.Assert(m.MatchPath("vendor-tools"), check.Equals, false)
+	c.Assert(m.MatchPath("vendor-tools/vendor"), check.Equals, false)
+	c.Assert(m.MatchPath("docs/api/v1/generated/task.yml"), check.Equals, true)
+	c.Assert(m.MatchPath("docs/generated"), check.Equals, true)
+	c.Assert(m.MatchPath("."), check.Equals, false)
+
+	c.Assert(m.Match("heads/release-1.2"), check.Equals, true)
+	c.Assert(m.Match("heads/release-foo"), check.Equals, false)
+
+	m, err = NewMatcher(nil)
+	c.Assert(err, check.IsNil)
+	c.Assert(m.Empty(), check.Equals, true)
+	c.Assert(m.MatchPath("vendor"), check.Equals, false)
+
+	_, err = NewMatcher([]string{"re:("})
+	c.Assert(err, check.NotNil)
+	_, err = NewMatcher([]string{"foo["})
+	c.Assert(err, check.NotNil)
+}